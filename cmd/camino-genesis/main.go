@@ -0,0 +1,123 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ava-labs/avalanchego/genesis"
+)
+
+const usage = `camino-genesis builds and validates genesis of Camino networks.
+
+Usage:
+  camino-genesis build [flags]     build genesis from csv spec, validate it and write genesis json
+  camino-genesis validate [flags]  validate existing genesis json
+
+Both commands print genesis hash, chain IDs, deposit offer IDs and multisig alias addresses.
+Run "camino-genesis <command> -h" for command flags.
+`
+
+var errUnknownCommand = errors.New("unknown command")
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "camino-genesis: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return errUnknownCommand
+	}
+
+	switch args[0] {
+	case "build":
+		return build(args[1:])
+	case "validate":
+		return validate(args[1:])
+	case "-h", "-help", "--help", "help":
+		fmt.Fprint(os.Stdout, usage)
+		return nil
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		return fmt.Errorf("%w: %s", errUnknownCommand, args[0])
+	}
+}
+
+func build(args []string) error {
+	fs := flag.NewFlagSet("build", flag.ContinueOnError)
+	s := spec{}
+	fs.UintVar(&s.networkID, "network-id", 0, "network ID")
+	fs.Uint64Var(&s.startTime, "start-time", uint64(time.Now().Unix()), "genesis start time, unix seconds")
+	fs.StringVar(&s.initialAdmin, "initial-admin", "", "initial admin address")
+	fs.BoolVar(&s.verifyNodeSignature, "verify-node-signature", true, "require node signatures in node registration and validator txs")
+	fs.StringVar(&s.message, "message", "", "genesis message")
+	fs.StringVar(&s.cChainGenesisFile, "c-chain-genesis", "", "C-chain genesis json file, local network C-chain genesis is used if empty")
+	fs.StringVar(&s.offersFile, "offers", "", "deposit offers csv file")
+	fs.StringVar(&s.allocationsFile, "allocations", "", "allocations csv file")
+	fs.StringVar(&s.multisigFile, "multisig", "", "multisig aliases csv file")
+	output := fs.String("output", "genesis.json", "output genesis json file")
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), "Usage: camino-genesis build [flags]\n\n")
+		fs.PrintDefaults()
+		fmt.Fprint(fs.Output(), specUsage)
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	config, err := s.config()
+	if err != nil {
+		return err
+	}
+
+	r, err := newReport(config)
+	if err != nil {
+		return err
+	}
+
+	unparsedConfig, err := config.Unparse()
+	if err != nil {
+		return fmt.Errorf("couldn't unparse genesis config: %w", err)
+	}
+	genesisJSON, err := json.MarshalIndent(unparsedConfig, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Clean(*output), genesisJSON, 0o644); err != nil { //#nosec G306
+		return fmt.Errorf("couldn't write genesis: %w", err)
+	}
+
+	r.print(os.Stdout)
+	return nil
+}
+
+func validate(args []string) error {
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	genesisFile := fs.String("genesis", "", "genesis json file")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	config, err := genesis.GetConfigFile(*genesisFile)
+	if err != nil {
+		return err
+	}
+
+	r, err := newReport(config)
+	if err != nil {
+		return err
+	}
+	r.print(os.Stdout)
+	return nil
+}
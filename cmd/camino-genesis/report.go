@@ -0,0 +1,96 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+)
+
+type namedID struct {
+	name string
+	id   string
+}
+
+// report contains IDs computed from valid genesis config
+type report struct {
+	genesisHash  ids.ID
+	avaxAssetID  ids.ID
+	xChainID     ids.ID
+	cChainID     ids.ID
+	offers       []namedID
+	multisigAddr []namedID
+}
+
+// newReport validates [config] the same way, as node does on start,
+// builds genesis from it and returns its IDs.
+func newReport(config *genesis.Config) (*report, error) {
+	stakingConfig := genesis.GetStakingConfig(config.NetworkID)
+	if err := genesis.ValidateConfig(config, &stakingConfig); err != nil {
+		return nil, fmt.Errorf("genesis config validation failed: %w", err)
+	}
+
+	genesisBytes, avaxAssetID, err := genesis.FromConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't build genesis: %w", err)
+	}
+
+	chains, _, err := genesis.GenesisChainData(genesisBytes, []ids.ID{constants.AVMID, constants.EVMID})
+	if err != nil {
+		return nil, err
+	}
+
+	r := &report{
+		genesisHash:  hashing.ComputeHash256Array(genesisBytes),
+		avaxAssetID:  avaxAssetID,
+		xChainID:     chains[0].ID(),
+		cChainID:     chains[1].ID(),
+		offers:       make([]namedID, len(config.Camino.DepositOffers)),
+		multisigAddr: make([]namedID, len(config.Camino.InitialMultisigAddresses)),
+	}
+
+	for i, configOffer := range config.Camino.DepositOffers {
+		offer, err := genesis.DepositOfferFromConfig(configOffer)
+		if err != nil {
+			return nil, err
+		}
+		r.offers[i] = namedID{name: configOffer.Memo, id: offer.ID.String()}
+	}
+
+	hrp := constants.GetHRP(config.NetworkID)
+	for i, alias := range config.Camino.InitialMultisigAddresses {
+		aliasAddr, err := address.Format("X", hrp, alias.Alias.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		r.multisigAddr[i] = namedID{name: alias.Memo, id: aliasAddr}
+	}
+
+	return r, nil
+}
+
+func (r *report) print(w io.Writer) {
+	fmt.Fprintf(w, "genesis hash:  %s\n", r.genesisHash)
+	fmt.Fprintf(w, "avax asset ID: %s\n", r.avaxAssetID)
+	fmt.Fprintf(w, "X-chain ID:    %s\n", r.xChainID)
+	fmt.Fprintf(w, "C-chain ID:    %s\n", r.cChainID)
+	if len(r.offers) > 0 {
+		fmt.Fprintln(w, "deposit offers:")
+		for _, offer := range r.offers {
+			fmt.Fprintf(w, "  %s: %s\n", offer.name, offer.id)
+		}
+	}
+	if len(r.multisigAddr) > 0 {
+		fmt.Fprintln(w, "multisig aliases:")
+		for _, alias := range r.multisigAddr {
+			fmt.Fprintf(w, "  %s: %s\n", alias.name, alias.id)
+		}
+	}
+}
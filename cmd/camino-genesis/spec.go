@@ -0,0 +1,445 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+)
+
+const specUsage = `
+Csv files must have header row with column names, columns could be in any order.
+Empty cells are zero values, lines starting with # are ignored.
+
+offers: memo, upgradeVersion, interestRateNominator, startOffset, endOffset, minAmount,
+  minDuration, maxDuration, unlockPeriodDuration, noRewardsPeriodDuration, locked,
+  totalMaxAmount, totalMaxRewardAmount, ownerAddress
+  Offsets are seconds from genesis start time. totalMaxRewardAmount and ownerAddress
+  require upgradeVersion 1.
+
+allocations: address, ethAddress, xAmount, consortiumMember, kycVerified, nodeDeferred,
+  offersCreator, roleAdmin, roleKYC, roleOffersAdmin, amount, nodeID, validatorDuration,
+  depositOfferMemo, depositDuration, timestampOffset, memo
+  Every row with non-zero amount is P-chain allocation of address. Rows of the same
+  address are merged: x-chain amounts are summed, address states are combined.
+
+multisig: memo, threshold, addresses
+  Addresses are separated by spaces. Alias addresses are computed by the tool.
+`
+
+var (
+	errMissingColumn       = errors.New("missing required column")
+	errWrongHRP            = errors.New("address hrp doesn't match network")
+	errConflictingETHAddr  = errors.New("conflicting eth addresses for the same address")
+	errNoNetworkID         = errors.New("network ID isn't specified")
+	errInvalidETHAddress   = errors.New("invalid eth address")
+	errInvalidBoolValue    = errors.New("invalid bool value")
+	errEmptyMultisigMemo   = errors.New("multisig alias has no memo")
+	errDuplicateMultisigID = errors.New("multisig alias duplicate")
+)
+
+// spec is a compact genesis definition, that is expanded to camino genesis config
+type spec struct {
+	networkID           uint
+	startTime           uint64
+	initialAdmin        string
+	verifyNodeSignature bool
+	message             string
+	cChainGenesisFile   string
+	offersFile          string
+	allocationsFile     string
+	multisigFile        string
+}
+
+func (s *spec) config() (*genesis.Config, error) {
+	if s.networkID == 0 {
+		return nil, errNoNetworkID
+	}
+	networkID := uint32(s.networkID)
+	hrp := constants.GetHRP(networkID)
+
+	config := &genesis.Config{
+		NetworkID:     networkID,
+		StartTime:     s.startTime,
+		CChainGenesis: genesis.GetConfig(networkID).CChainGenesis,
+		Message:       s.message,
+		Camino: genesis.Camino{
+			VerifyNodeSignature: s.verifyNodeSignature,
+			LockModeBondDeposit: true,
+		},
+	}
+
+	initialAdmin, err := parseAddress(s.initialAdmin, hrp)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse initial admin: %w", err)
+	}
+	config.Camino.InitialAdmin = initialAdmin
+
+	if s.cChainGenesisFile != "" {
+		cChainGenesis, err := os.ReadFile(filepath.Clean(s.cChainGenesisFile))
+		if err != nil {
+			return nil, fmt.Errorf("couldn't read C-chain genesis: %w", err)
+		}
+		config.CChainGenesis = strings.TrimSpace(string(cChainGenesis))
+	}
+
+	if s.offersFile != "" {
+		rows, err := readCSV(s.offersFile)
+		if err != nil {
+			return nil, err
+		}
+		if config.Camino.DepositOffers, err = parseOffers(rows, s.startTime, hrp); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.allocationsFile != "" {
+		rows, err := readCSV(s.allocationsFile)
+		if err != nil {
+			return nil, err
+		}
+		if config.Camino.Allocations, err = parseAllocations(rows, hrp); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.multisigFile != "" {
+		rows, err := readCSV(s.multisigFile)
+		if err != nil {
+			return nil, err
+		}
+		if config.Camino.InitialMultisigAddresses, err = parseMultisigAliases(rows, hrp); err != nil {
+			return nil, err
+		}
+	}
+
+	return config, nil
+}
+
+func parseOffers(rows []csvRow, startTime uint64, hrp string) ([]genesis.DepositOffer, error) {
+	offers := make([]genesis.DepositOffer, len(rows))
+	for i, row := range rows {
+		offer := &offers[i]
+		offer.Memo = row.string("memo")
+		offer.UpgradeVersion = row.uint16("upgradeVersion")
+		offer.InterestRateNominator = row.uint64("interestRateNominator")
+		startOffset := row.uint64("startOffset")
+		endOffset := row.uint64("endOffset")
+		offer.MinAmount = row.uint64("minAmount")
+		offer.MinDuration = row.uint32("minDuration")
+		offer.MaxDuration = row.uint32("maxDuration")
+		offer.UnlockPeriodDuration = row.uint32("unlockPeriodDuration")
+		offer.NoRewardsPeriodDuration = row.uint32("noRewardsPeriodDuration")
+		if row.bool("locked") {
+			offer.Flags |= deposit.OfferFlagLocked
+		}
+		offer.TotalMaxAmount = row.uint64("totalMaxAmount")
+		offer.TotalMaxRewardAmount = row.uint64("totalMaxRewardAmount")
+		if row.string("ownerAddress") != "" {
+			offer.OwnerAddress = row.address("ownerAddress", hrp)
+		}
+		if row.err != nil {
+			return nil, row.err
+		}
+
+		var err error
+		if offer.Start, err = math.Add64(startTime, startOffset); err != nil {
+			return nil, row.wrap(err)
+		}
+		if offer.End, err = math.Add64(startTime, endOffset); err != nil {
+			return nil, row.wrap(err)
+		}
+	}
+	return offers, nil
+}
+
+func parseAllocations(rows []csvRow, hrp string) ([]genesis.CaminoAllocation, error) {
+	allocations := []genesis.CaminoAllocation{}
+	allocationIndices := map[ids.ShortID]int{}
+	for _, row := range rows {
+		addr := row.address("address", hrp)
+		ethAddr := row.ethAddress("ethAddress")
+		xAmount := row.uint64("xAmount")
+		addressStates := genesis.AddressStates{
+			ConsortiumMember: row.bool("consortiumMember"),
+			KYCVerified:      row.bool("kycVerified"),
+			NodeDeferred:     row.bool("nodeDeferred"),
+			OffersCreator:    row.bool("offersCreator"),
+			RoleAdmin:        row.bool("roleAdmin"),
+			RoleKYC:          row.bool("roleKYC"),
+			RoleOffersAdmin:  row.bool("roleOffersAdmin"),
+		}
+		platformAllocation := genesis.PlatformAllocation{
+			Amount:            row.uint64("amount"),
+			NodeID:            row.nodeID("nodeID"),
+			ValidatorDuration: row.uint64("validatorDuration"),
+			DepositOfferMemo:  row.string("depositOfferMemo"),
+			DepositDuration:   row.uint64("depositDuration"),
+			TimestampOffset:   row.uint64("timestampOffset"),
+			Memo:              row.string("memo"),
+		}
+		if row.err != nil {
+			return nil, row.err
+		}
+
+		allocationIndex, ok := allocationIndices[addr]
+		if !ok {
+			allocationIndex = len(allocations)
+			allocationIndices[addr] = allocationIndex
+			allocations = append(allocations, genesis.CaminoAllocation{AVAXAddr: addr})
+		}
+		allocation := &allocations[allocationIndex]
+
+		if ethAddr != ids.ShortEmpty {
+			if allocation.ETHAddr != ids.ShortEmpty && allocation.ETHAddr != ethAddr {
+				return nil, row.wrap(errConflictingETHAddr)
+			}
+			allocation.ETHAddr = ethAddr
+		}
+
+		var err error
+		if allocation.XAmount, err = math.Add64(allocation.XAmount, xAmount); err != nil {
+			return nil, row.wrap(err)
+		}
+		allocation.AddressStates = mergeAddressStates(allocation.AddressStates, addressStates)
+
+		if platformAllocation.Amount != 0 {
+			allocation.PlatformAllocations = append(allocation.PlatformAllocations, platformAllocation)
+		}
+	}
+	return allocations, nil
+}
+
+// mergeAddressStates returns address states, that are set in any of [a] or [b]
+func mergeAddressStates(a, b genesis.AddressStates) genesis.AddressStates {
+	return genesis.AddressStates{
+		ConsortiumMember: a.ConsortiumMember || b.ConsortiumMember,
+		KYCVerified:      a.KYCVerified || b.KYCVerified,
+		NodeDeferred:     a.NodeDeferred || b.NodeDeferred,
+		OffersCreator:    a.OffersCreator || b.OffersCreator,
+		RoleAdmin:        a.RoleAdmin || b.RoleAdmin,
+		RoleKYC:          a.RoleKYC || b.RoleKYC,
+		RoleOffersAdmin:  a.RoleOffersAdmin || b.RoleOffersAdmin,
+	}
+}
+
+func parseMultisigAliases(rows []csvRow, hrp string) ([]genesis.MultisigAlias, error) {
+	aliases := make([]genesis.MultisigAlias, len(rows))
+	aliasIDs := map[ids.ShortID]struct{}{}
+	for i, row := range rows {
+		alias := &aliases[i]
+		alias.Memo = row.string("memo")
+		alias.Threshold = row.uint32("threshold")
+		for _, addrStr := range strings.Fields(row.string("addresses")) {
+			addr, err := parseAddress(addrStr, hrp)
+			if err != nil {
+				return nil, row.wrap(err)
+			}
+			alias.Addresses = append(alias.Addresses, addr)
+		}
+		if row.err != nil {
+			return nil, row.err
+		}
+		if alias.Memo == "" {
+			// memo is the only way to make aliases with the same owners different
+			return nil, row.wrap(errEmptyMultisigMemo)
+		}
+
+		utils.Sort(alias.Addresses)
+		alias.Alias = alias.ComputeAlias(ids.Empty)
+		if _, ok := aliasIDs[alias.Alias]; ok {
+			return nil, row.wrap(errDuplicateMultisigID)
+		}
+		aliasIDs[alias.Alias] = struct{}{}
+	}
+	return aliases, nil
+}
+
+func parseAddress(addrStr, hrp string) (ids.ShortID, error) {
+	_, addrHRP, addrBytes, err := address.Parse(addrStr)
+	if err != nil {
+		return ids.ShortEmpty, err
+	}
+	if addrHRP != hrp {
+		return ids.ShortEmpty, fmt.Errorf("%w: expected %s, got %s", errWrongHRP, hrp, addrHRP)
+	}
+	return ids.ToShortID(addrBytes)
+}
+
+type csvRow struct {
+	file   string
+	line   int
+	values map[string]string
+	// first error, that occurred while parsing row values
+	err error
+}
+
+// readCSV reads csv file with header row and returns its rows
+func readCSV(path string) ([]csvRow, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	switch {
+	case err == io.EOF:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("couldn't read %s: %w", path, err)
+	}
+
+	rows := []csvRow{}
+	for {
+		record, err := reader.Read()
+		switch {
+		case err == io.EOF:
+			return rows, nil
+		case err != nil:
+			return nil, fmt.Errorf("couldn't read %s: %w", path, err)
+		}
+		line, _ := reader.FieldPos(0)
+		row := csvRow{
+			file:   path,
+			line:   line,
+			values: make(map[string]string, len(header)),
+		}
+		for i, column := range header {
+			row.values[strings.TrimSpace(column)] = strings.TrimSpace(record[i])
+		}
+		rows = append(rows, row)
+	}
+}
+
+func (r *csvRow) wrap(err error) error {
+	return fmt.Errorf("%s (row %d): %w", r.file, r.line, err)
+}
+
+func (r *csvRow) setErr(column string, err error) {
+	if r.err == nil {
+		r.err = r.wrap(fmt.Errorf("%s: %w", column, err))
+	}
+}
+
+// returns column value, sets row error if column is missing
+func (r *csvRow) value(column string, required bool) string {
+	value, ok := r.values[column]
+	if !ok && required {
+		r.setErr(column, errMissingColumn)
+	}
+	return value
+}
+
+func (r *csvRow) string(column string) string {
+	return r.value(column, false)
+}
+
+func (r *csvRow) uint64(column string) uint64 {
+	value := r.value(column, false)
+	if value == "" {
+		return 0
+	}
+	result, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		r.setErr(column, err)
+	}
+	return result
+}
+
+func (r *csvRow) uint32(column string) uint32 {
+	value := r.value(column, false)
+	if value == "" {
+		return 0
+	}
+	result, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		r.setErr(column, err)
+	}
+	return uint32(result)
+}
+
+func (r *csvRow) uint16(column string) uint16 {
+	value := r.value(column, false)
+	if value == "" {
+		return 0
+	}
+	result, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		r.setErr(column, err)
+	}
+	return uint16(result)
+}
+
+func (r *csvRow) bool(column string) bool {
+	switch strings.ToLower(r.value(column, false)) {
+	case "", "0", "false", "no":
+		return false
+	case "1", "true", "yes", "x":
+		return true
+	default:
+		r.setErr(column, errInvalidBoolValue)
+		return false
+	}
+}
+
+func (r *csvRow) address(column, hrp string) ids.ShortID {
+	addr, err := parseAddress(r.value(column, true), hrp)
+	if err != nil {
+		r.setErr(column, err)
+	}
+	return addr
+}
+
+func (r *csvRow) ethAddress(column string) ids.ShortID {
+	value := r.value(column, false)
+	if value == "" {
+		return ids.ShortEmpty
+	}
+	if !strings.HasPrefix(value, "0x") {
+		r.setErr(column, errInvalidETHAddress)
+		return ids.ShortEmpty
+	}
+	addrBytes, err := hex.DecodeString(value[2:])
+	if err != nil {
+		r.setErr(column, err)
+		return ids.ShortEmpty
+	}
+	addr, err := ids.ToShortID(addrBytes)
+	if err != nil {
+		r.setErr(column, err)
+	}
+	return addr
+}
+
+func (r *csvRow) nodeID(column string) ids.NodeID {
+	value := r.value(column, false)
+	if value == "" {
+		return ids.EmptyNodeID
+	}
+	nodeID, err := ids.NodeIDFromString(value)
+	if err != nil {
+		r.setErr(column, err)
+	}
+	return nodeID
+}
@@ -0,0 +1,166 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+)
+
+func TestParseAllocations(t *testing.T) {
+	addr := "X-local18jma8ppw3nhx5r4ap8clazz0dps7rv5u00z96u"
+	addrID, err := parseAddress(addr, "local")
+	require.NoError(t, err)
+	nodeID, err := ids.NodeIDFromString("NodeID-AK7sPBsZM9rQwse23aLhEEBPHZD5gkLrL")
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		rows                []csvRow
+		expectedAllocations []genesis.CaminoAllocation
+		expectedErr         error
+	}{
+		"Rows of the same address are merged": {
+			rows: []csvRow{
+				{values: map[string]string{
+					"address":           addr,
+					"ethAddress":        "0x0100000000000000000000000000000000000000",
+					"xAmount":           "1",
+					"consortiumMember":  "x",
+					"amount":            "10",
+					"nodeID":            nodeID.String(),
+					"validatorDuration": "100",
+				}},
+				{values: map[string]string{
+					"address":          addr,
+					"xAmount":          "2",
+					"kycVerified":      "true",
+					"amount":           "20",
+					"depositOfferMemo": "offer",
+					"depositDuration":  "200",
+				}},
+				{values: map[string]string{
+					"address":      addr,
+					"xAmount":      "3",
+					"nodeDeferred": "yes",
+					"roleAdmin":    "1",
+				}},
+			},
+			expectedAllocations: []genesis.CaminoAllocation{{
+				ETHAddr:  ids.ShortID{1},
+				AVAXAddr: addrID,
+				XAmount:  6,
+				AddressStates: genesis.AddressStates{
+					ConsortiumMember: true,
+					KYCVerified:      true,
+					NodeDeferred:     true,
+					RoleAdmin:        true,
+				},
+				PlatformAllocations: []genesis.PlatformAllocation{
+					{Amount: 10, NodeID: nodeID, ValidatorDuration: 100},
+					{Amount: 20, DepositOfferMemo: "offer", DepositDuration: 200},
+				},
+			}},
+		},
+		"Conflicting eth addresses": {
+			rows: []csvRow{
+				{values: map[string]string{"address": addr, "ethAddress": "0x0100000000000000000000000000000000000000"}},
+				{values: map[string]string{"address": addr, "ethAddress": "0x0200000000000000000000000000000000000000"}},
+			},
+			expectedErr: errConflictingETHAddr,
+		},
+		"Missing address column": {
+			rows:        []csvRow{{values: map[string]string{"xAmount": "1"}}},
+			expectedErr: errMissingColumn,
+		},
+		"Wrong address hrp": {
+			rows:        []csvRow{{values: map[string]string{"address": "X-kopernikus18jma8ppw3nhx5r4ap8clazz0dps7rv5uuvjh68"}}},
+			expectedErr: errWrongHRP,
+		},
+		"Invalid bool value": {
+			rows:        []csvRow{{values: map[string]string{"address": addr, "kycVerified": "maybe"}}},
+			expectedErr: errInvalidBoolValue,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			allocations, err := parseAllocations(tt.rows, "local")
+			require.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr == nil {
+				require.Equal(t, tt.expectedAllocations, allocations)
+			}
+		})
+	}
+}
+
+func TestParseMultisigAliases(t *testing.T) {
+	addr1 := "X-local18jma8ppw3nhx5r4ap8clazz0dps7rv5u00z96u"
+	addr2 := "X-local1j24ulx3x7ac5sl0sy7jgdhwrl898fvp6lfewc9"
+
+	tests := map[string]struct {
+		rows        []csvRow
+		expectedErr error
+	}{
+		"OK": {
+			rows: []csvRow{
+				{values: map[string]string{"memo": "ms1", "threshold": "1", "addresses": addr1 + " " + addr2}},
+				{values: map[string]string{"memo": "ms2", "threshold": "1", "addresses": addr2 + " " + addr1}},
+			},
+		},
+		"Empty memo": {
+			rows:        []csvRow{{values: map[string]string{"threshold": "1", "addresses": addr1}}},
+			expectedErr: errEmptyMultisigMemo,
+		},
+		"Duplicate alias": {
+			rows: []csvRow{
+				{values: map[string]string{"memo": "ms", "threshold": "1", "addresses": addr1 + " " + addr2}},
+				{values: map[string]string{"memo": "ms", "threshold": "1", "addresses": addr2 + " " + addr1}},
+			},
+			expectedErr: errDuplicateMultisigID,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			aliases, err := parseMultisigAliases(tt.rows, "local")
+			require.ErrorIs(err, tt.expectedErr)
+			for _, alias := range aliases {
+				require.Len(alias.Addresses, 2)
+				require.Equal(alias.ComputeAlias(ids.Empty), alias.Alias)
+				require.True(alias.Addresses[0].Less(alias.Addresses[1]))
+			}
+		})
+	}
+}
+
+func TestParseOffers(t *testing.T) {
+	owner := "X-local18jma8ppw3nhx5r4ap8clazz0dps7rv5u00z96u"
+	ownerID, err := parseAddress(owner, "local")
+	require.NoError(t, err)
+
+	rows := []csvRow{{values: map[string]string{
+		"memo":                 "offer",
+		"upgradeVersion":       "1",
+		"startOffset":          "10",
+		"endOffset":            "20",
+		"locked":               "true",
+		"totalMaxRewardAmount": "30",
+		"ownerAddress":         owner,
+	}}}
+	offers, err := parseOffers(rows, 100, "local")
+	require.NoError(t, err)
+	require.Equal(t, []genesis.DepositOffer{{
+		UpgradeVersion:       1,
+		Start:                110,
+		End:                  120,
+		Memo:                 "offer",
+		Flags:                deposit.OfferFlagLocked,
+		TotalMaxRewardAmount: 30,
+		OwnerAddress:         ownerID,
+	}}, offers)
+}
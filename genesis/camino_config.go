@@ -15,6 +15,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	pchaintxs "github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
 
 type Camino struct {
@@ -24,6 +25,7 @@ type Camino struct {
 	DepositOffers            []DepositOffer     `json:"depositOffers"`
 	Allocations              []CaminoAllocation `json:"allocations"`
 	InitialMultisigAddresses []MultisigAlias    `json:"initialMultisigAddresses"`
+	Treasury                 *TreasuryConfig    `json:"treasury,omitempty"`
 }
 
 func (c Camino) Unparse(networkID uint32, starttime uint64) (UnparsedCamino, error) {
@@ -54,7 +56,7 @@ func (c Camino) Unparse(networkID uint32, starttime uint64) (UnparsedCamino, err
 	}
 
 	for i := range uc.DepositOffers {
-		uc.DepositOffers[i], err = c.DepositOffers[i].Unparse(starttime)
+		uc.DepositOffers[i], err = c.DepositOffers[i].Unparse(networkID, starttime)
 		if err != nil {
 			return uc, err
 		}
@@ -67,6 +69,14 @@ func (c Camino) Unparse(networkID uint32, starttime uint64) (UnparsedCamino, err
 		}
 	}
 
+	if c.Treasury != nil {
+		treasury, err := c.Treasury.Unparse(networkID)
+		if err != nil {
+			return uc, err
+		}
+		uc.Treasury = &treasury
+	}
+
 	return uc, nil
 }
 
@@ -153,6 +163,33 @@ func (a PlatformAllocation) Unparse() (UnparsedPlatformAllocation, error) {
 	return ua, nil
 }
 
+// TreasuryConfig defines treasury owner and treasury spending cap
+type TreasuryConfig struct {
+	Threshold      uint32        `json:"threshold"`
+	Addresses      []ids.ShortID `json:"addresses"`
+	SpendingPeriod uint64        `json:"spendingPeriod"`
+	SpendingCap    uint64        `json:"spendingCap"`
+}
+
+func (tc TreasuryConfig) Unparse(networkID uint32) (UnparsedTreasuryConfig, error) {
+	utc := UnparsedTreasuryConfig{
+		Threshold:      tc.Threshold,
+		Addresses:      make([]string, len(tc.Addresses)),
+		SpendingPeriod: tc.SpendingPeriod,
+		SpendingCap:    tc.SpendingCap,
+	}
+
+	for i, elem := range tc.Addresses {
+		addr, err := address.Format(configChainIDAlias, constants.GetHRP(networkID), elem.Bytes())
+		if err != nil {
+			return utc, fmt.Errorf("while unparsing cannot format treasury owner address %s: %w", addr, err)
+		}
+		utc.Addresses[i] = addr
+	}
+
+	return utc, nil
+}
+
 type MultisigAlias struct {
 	Alias     ids.ShortID   `serialize:"true" json:"alias"`
 	Threshold uint32        `serialize:"true" json:"threshold"`
@@ -208,9 +245,42 @@ func (ma MultisigAlias) ComputeAlias(txID ids.ID) ids.ShortID {
 type AddressStates struct {
 	ConsortiumMember bool `json:"consortiumMember"`
 	KYCVerified      bool `json:"kycVerified"`
+	NodeDeferred     bool `json:"nodeDeferred,omitempty"`
+	OffersCreator    bool `json:"offersCreator,omitempty"`
+	RoleAdmin        bool `json:"roleAdmin,omitempty"`
+	RoleKYC          bool `json:"roleKYC,omitempty"`
+	RoleOffersAdmin  bool `json:"roleOffersAdmin,omitempty"`
+}
+
+// AddressState returns p-chain address state with bits of [as] states
+func (as AddressStates) AddressState() pchaintxs.AddressState {
+	addrState := pchaintxs.AddressStateEmpty
+	if as.ConsortiumMember {
+		addrState |= pchaintxs.AddressStateConsortiumMember
+	}
+	if as.KYCVerified {
+		addrState |= pchaintxs.AddressStateKYCVerified
+	}
+	if as.NodeDeferred {
+		addrState |= pchaintxs.AddressStateNodeDeferred
+	}
+	if as.OffersCreator {
+		addrState |= pchaintxs.AddressStateOffersCreator
+	}
+	if as.RoleAdmin {
+		addrState |= pchaintxs.AddressStateRoleAdmin
+	}
+	if as.RoleKYC {
+		addrState |= pchaintxs.AddressStateRoleKYC
+	}
+	if as.RoleOffersAdmin {
+		addrState |= pchaintxs.AddressStateRoleOffersAdmin
+	}
+	return addrState
 }
 
 type DepositOffer struct {
+	UpgradeVersion          uint16            `json:"upgradeVersion"`
 	InterestRateNominator   uint64            `json:"interestRateNominator"`
 	Start                   uint64            `json:"start"`
 	End                     uint64            `json:"end"`
@@ -221,10 +291,14 @@ type DepositOffer struct {
 	NoRewardsPeriodDuration uint32            `json:"noRewardsPeriodDuration"`
 	Memo                    string            `json:"memo"`
 	Flags                   deposit.OfferFlag `json:"flags"`
+	TotalMaxAmount          uint64            `json:"totalMaxAmount"`
+	TotalMaxRewardAmount    uint64            `json:"totalMaxRewardAmount"` // upgrade version 1
+	OwnerAddress            ids.ShortID       `json:"ownerAddress"`         // upgrade version 1
 }
 
-func (parsedOffer DepositOffer) Unparse(startime uint64) (UnparsedDepositOffer, error) {
+func (parsedOffer DepositOffer) Unparse(networkID uint32, startime uint64) (UnparsedDepositOffer, error) {
 	unparsedOffer := UnparsedDepositOffer{
+		UpgradeVersion:          parsedOffer.UpgradeVersion,
 		InterestRateNominator:   parsedOffer.InterestRateNominator,
 		MinAmount:               parsedOffer.MinAmount,
 		MinDuration:             parsedOffer.MinDuration,
@@ -232,6 +306,16 @@ func (parsedOffer DepositOffer) Unparse(startime uint64) (UnparsedDepositOffer,
 		UnlockPeriodDuration:    parsedOffer.UnlockPeriodDuration,
 		NoRewardsPeriodDuration: parsedOffer.NoRewardsPeriodDuration,
 		Memo:                    parsedOffer.Memo,
+		TotalMaxAmount:          parsedOffer.TotalMaxAmount,
+		TotalMaxRewardAmount:    parsedOffer.TotalMaxRewardAmount,
+	}
+
+	if parsedOffer.OwnerAddress != ids.ShortEmpty {
+		ownerAddr, err := address.Format(configChainIDAlias, constants.GetHRP(networkID), parsedOffer.OwnerAddress.Bytes())
+		if err != nil {
+			return unparsedOffer, fmt.Errorf("while unparsing cannot format deposit offer owner address %s: %w", parsedOffer.OwnerAddress, err)
+		}
+		unparsedOffer.OwnerAddress = ownerAddr
 	}
 
 	offerStartOffset, err := math.Sub(parsedOffer.Start, startime)
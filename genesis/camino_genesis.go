@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
@@ -24,6 +25,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
 	pchaintxs "github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/propertyfx"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
@@ -34,6 +36,12 @@ var (
 	errEmptyAllocation    = errors.New("allocation with zero value")
 	errNonExistingOffer   = errors.New("allocation deposit offer memo doesn't match any offer")
 	errWrongMisgAliasAddr = errors.New("wrong msig alias addr")
+
+	errUnsupportedOfferVersion = errors.New("deposit offer upgrade version isn't supported")
+	errOfferVersionMismatch    = errors.New("deposit offer has fields of greater upgrade version")
+	errOfferDepositedTooMuch   = errors.New("allocation deposits amount is more than deposit offer total max amount")
+	errOfferRewardedTooMuch    = errors.New("allocation deposits reward is more than deposit offer total max reward amount")
+	errDeferredNodeNotFound    = errors.New("consortium member with deferred node has no node")
 )
 
 // ValidateConfig validates the generated config. Exposed for camino-node/tools/genesis generator
@@ -162,6 +170,30 @@ func validateCaminoConfig(config *Config) error {
 				if depositEndTime > offer.End {
 					return errors.New("allocation deposit end time is greater than deposit offer end time")
 				}
+
+				// deposits are counted towards offer limits the same way, as deposit txs are
+				switch {
+				case offer.TotalMaxAmount > 0:
+					offer.DepositedAmount, err = math.Add64(offer.DepositedAmount, platformAllocation.Amount)
+					if err != nil {
+						return err
+					}
+					if offer.DepositedAmount > offer.TotalMaxAmount {
+						return fmt.Errorf("%w (%s)", errOfferDepositedTooMuch, platformAllocation.DepositOfferMemo)
+					}
+				case offer.TotalMaxRewardAmount > 0:
+					reward := (&deposit.Deposit{
+						Duration: uint32(platformAllocation.DepositDuration),
+						Amount:   platformAllocation.Amount,
+					}).TotalReward(offer)
+					offer.RewardedAmount, err = math.Add64(offer.RewardedAmount, reward)
+					if err != nil {
+						return err
+					}
+					if offer.RewardedAmount > offer.TotalMaxRewardAmount {
+						return fmt.Errorf("%w (%s)", errOfferRewardedTooMuch, platformAllocation.DepositOfferMemo)
+					}
+				}
 			}
 
 			if !isDeposited && platformAllocation.DepositDuration != 0 {
@@ -191,6 +223,12 @@ func validateCaminoConfig(config *Config) error {
 		}
 	}
 
+	for _, allocation := range config.Camino.Allocations {
+		if allocation.AddressStates.NodeDeferred && !consortiumMembersWithNodes.Contains(allocation.AVAXAddr) {
+			return errDeferredNodeNotFound
+		}
+	}
+
 	// validate msig aliases
 	txID := ids.Empty
 	uniqAliases := set.NewSet[ids.ShortID](len(config.Camino.InitialMultisigAddresses))
@@ -361,17 +399,16 @@ func buildPGenesis(config *Config, hrp string, xGenesisBytes []byte, xGenesisDat
 		platformvmArgs.Camino.MultisigAliases[i] = multisigAlias
 	}
 
+	// Getting args from treasury config
+
+	if config.Camino.Treasury != nil {
+		platformvmArgs.Camino.TreasuryConfig = TreasuryConfigFromConfig(*config.Camino.Treasury)
+	}
+
 	// Getting args from allocations
 
 	for _, allocation := range config.Camino.Allocations {
-		var addrState pchaintxs.AddressState
-		if allocation.AddressStates.ConsortiumMember {
-			addrState |= pchaintxs.AddressStateConsortiumMember
-		}
-		if allocation.AddressStates.KYCVerified {
-			addrState |= pchaintxs.AddressStateKYCVerified
-		}
-		if addrState != 0 {
+		if addrState := allocation.AddressStates.AddressState(); addrState != 0 {
 			platformvmArgs.Camino.AddressStates = append(platformvmArgs.Camino.AddressStates, genesis.AddressState{
 				Address: allocation.AVAXAddr,
 				State:   addrState,
@@ -558,6 +595,14 @@ func GetGenesisBlocksIDs(genesisBytes []byte, genesis *genesis.Genesis) ([]ids.I
 }
 
 func DepositOfferFromConfig(configDepositOffer DepositOffer) (*deposit.Offer, error) {
+	switch {
+	case configDepositOffer.UpgradeVersion > 1:
+		return nil, fmt.Errorf("%w: %d", errUnsupportedOfferVersion, configDepositOffer.UpgradeVersion)
+	case configDepositOffer.UpgradeVersion == 0 &&
+		(configDepositOffer.TotalMaxRewardAmount != 0 || configDepositOffer.OwnerAddress != ids.ShortEmpty):
+		return nil, errOfferVersionMismatch
+	}
+
 	offer := &deposit.Offer{
 		InterestRateNominator:   configDepositOffer.InterestRateNominator,
 		Start:                   configDepositOffer.Start,
@@ -569,6 +614,12 @@ func DepositOfferFromConfig(configDepositOffer DepositOffer) (*deposit.Offer, er
 		NoRewardsPeriodDuration: configDepositOffer.NoRewardsPeriodDuration,
 		Memo:                    types.JSONByteSlice(configDepositOffer.Memo),
 		Flags:                   configDepositOffer.Flags,
+		TotalMaxAmount:          configDepositOffer.TotalMaxAmount,
+		TotalMaxRewardAmount:    configDepositOffer.TotalMaxRewardAmount,
+		OwnerAddress:            configDepositOffer.OwnerAddress,
+	}
+	if configDepositOffer.UpgradeVersion > 0 {
+		offer.UpgradeVersionID = codec.BuildUpgradeVersionID(configDepositOffer.UpgradeVersion)
 	}
 	if err := genesis.SetDepositOfferID(offer); err != nil {
 		return nil, err
@@ -576,6 +627,17 @@ func DepositOfferFromConfig(configDepositOffer DepositOffer) (*deposit.Offer, er
 	return offer, nil
 }
 
+func TreasuryConfigFromConfig(configTreasury TreasuryConfig) *treasury.Config {
+	return &treasury.Config{
+		Owner: secp256k1fx.OutputOwners{
+			Threshold: configTreasury.Threshold,
+			Addrs:     configTreasury.Addresses,
+		},
+		SpendingPeriod: configTreasury.SpendingPeriod,
+		SpendingCap:    configTreasury.SpendingCap,
+	}
+}
+
 func MultisigAliasFromConfig(configMsigAlias MultisigAlias) (*multisig.Alias, error) {
 	return &multisig.Alias{
 		Owners: &secp256k1fx.OutputOwners{
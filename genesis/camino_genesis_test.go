@@ -9,6 +9,8 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/stretchr/testify/require"
+
+	pchaintxs "github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
 
 func TestGenesisChainData(t *testing.T) {
@@ -53,3 +55,168 @@ func TestGenesisChainData(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCaminoConfig(t *testing.T) {
+	const (
+		startTime      = uint64(1000)
+		depositAmount  = uint64(1_000_000_000)
+		depositReward  = uint64(100_000_000) // 10% for a year
+		offerDuration  = uint32(365 * 24 * 60 * 60)
+		offerMemo      = "offer"
+		interestRate   = uint64(100_000)
+		offerMinAmount = uint64(1_000_000)
+	)
+	consortiumMemberAddr := ids.ShortID{1}
+	ownerAddr := ids.ShortID{2}
+	nodeID := ids.NodeID{1}
+
+	validConfig := func() *Config {
+		return &Config{
+			NetworkID: constants.LocalID,
+			StartTime: startTime,
+			Camino: Camino{
+				LockModeBondDeposit: true,
+				InitialAdmin:        consortiumMemberAddr,
+				DepositOffers: []DepositOffer{{
+					InterestRateNominator: interestRate,
+					Start:                 startTime,
+					End:                   startTime + uint64(offerDuration),
+					MinAmount:             offerMinAmount,
+					MinDuration:           100,
+					MaxDuration:           offerDuration,
+					Memo:                  offerMemo,
+				}},
+				Allocations: []CaminoAllocation{{
+					AVAXAddr: consortiumMemberAddr,
+					AddressStates: AddressStates{
+						ConsortiumMember: true,
+						KYCVerified:      true,
+					},
+					PlatformAllocations: []PlatformAllocation{{
+						Amount:            depositAmount,
+						NodeID:            nodeID,
+						ValidatorDuration: 100,
+						DepositOfferMemo:  offerMemo,
+						DepositDuration:   uint64(offerDuration),
+					}},
+				}},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		config      func() *Config
+		expectedErr error
+	}{
+		"OK": {
+			config: validConfig,
+		},
+		"OK: upgrade version 1 offer": {
+			config: func() *Config {
+				config := validConfig()
+				config.Camino.DepositOffers[0].UpgradeVersion = 1
+				config.Camino.DepositOffers[0].OwnerAddress = ownerAddr
+				config.Camino.DepositOffers[0].TotalMaxRewardAmount = depositReward
+				return config
+			},
+		},
+		"OK: deferred node and admin roles": {
+			config: func() *Config {
+				config := validConfig()
+				config.Camino.Allocations[0].AddressStates.NodeDeferred = true
+				config.Camino.Allocations = append(config.Camino.Allocations, CaminoAllocation{
+					AVAXAddr: ownerAddr,
+					XAmount:  1,
+					AddressStates: AddressStates{
+						OffersCreator:   true,
+						RoleAdmin:       true,
+						RoleKYC:         true,
+						RoleOffersAdmin: true,
+					},
+				})
+				return config
+			},
+		},
+		"Unsupported offer upgrade version": {
+			config: func() *Config {
+				config := validConfig()
+				config.Camino.DepositOffers[0].UpgradeVersion = 2
+				return config
+			},
+			expectedErr: errUnsupportedOfferVersion,
+		},
+		"Upgrade version 0 offer with owner": {
+			config: func() *Config {
+				config := validConfig()
+				config.Camino.DepositOffers[0].OwnerAddress = ownerAddr
+				return config
+			},
+			expectedErr: errOfferVersionMismatch,
+		},
+		"Upgrade version 0 offer with total max reward amount": {
+			config: func() *Config {
+				config := validConfig()
+				config.Camino.DepositOffers[0].TotalMaxRewardAmount = depositReward
+				return config
+			},
+			expectedErr: errOfferVersionMismatch,
+		},
+		"Deposits exceed offer total max amount": {
+			config: func() *Config {
+				config := validConfig()
+				config.Camino.DepositOffers[0].TotalMaxAmount = depositAmount - 1
+				return config
+			},
+			expectedErr: errOfferDepositedTooMuch,
+		},
+		"Deposits exceed offer total max reward amount": {
+			config: func() *Config {
+				config := validConfig()
+				config.Camino.DepositOffers[0].UpgradeVersion = 1
+				config.Camino.DepositOffers[0].TotalMaxRewardAmount = depositReward - 1
+				return config
+			},
+			expectedErr: errOfferRewardedTooMuch,
+		},
+		"Deferred node without node": {
+			config: func() *Config {
+				config := validConfig()
+				config.Camino.Allocations = append(config.Camino.Allocations, CaminoAllocation{
+					AVAXAddr: ownerAddr,
+					XAmount:  1,
+					AddressStates: AddressStates{
+						ConsortiumMember: true,
+						KYCVerified:      true,
+						NodeDeferred:     true,
+					},
+				})
+				return config
+			},
+			expectedErr: errDeferredNodeNotFound,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, validateCaminoConfig(tt.config()), tt.expectedErr)
+		})
+	}
+}
+
+func TestAddressStatesAddressState(t *testing.T) {
+	require.Equal(t, pchaintxs.AddressStateEmpty, AddressStates{}.AddressState())
+	require.Equal(t,
+		pchaintxs.AddressStateConsortiumMember|pchaintxs.AddressStateKYCVerified|
+			pchaintxs.AddressStateNodeDeferred|pchaintxs.AddressStateOffersCreator|
+			pchaintxs.AddressStateRoleAdmin|pchaintxs.AddressStateRoleKYC|
+			pchaintxs.AddressStateRoleOffersAdmin,
+		AddressStates{
+			ConsortiumMember: true,
+			KYCVerified:      true,
+			NodeDeferred:     true,
+			OffersCreator:    true,
+			RoleAdmin:        true,
+			RoleKYC:          true,
+			RoleOffersAdmin:  true,
+		}.AddressState(),
+	)
+}
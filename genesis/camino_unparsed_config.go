@@ -23,6 +23,7 @@ type UnparsedCamino struct {
 	DepositOffers            []UnparsedDepositOffer     `json:"depositOffers"`
 	Allocations              []UnparsedCaminoAllocation `json:"allocations"`
 	InitialMultisigAddresses []UnparsedMultisigAlias    `json:"initialMultisigAddresses"`
+	Treasury                 *UnparsedTreasuryConfig    `json:"treasury,omitempty"`
 }
 
 func (uc UnparsedCamino) Parse(startTime uint64) (Camino, error) {
@@ -67,6 +68,14 @@ func (uc UnparsedCamino) Parse(startTime uint64) (Camino, error) {
 		}
 	}
 
+	if uc.Treasury != nil {
+		treasury, err := uc.Treasury.Parse()
+		if err != nil {
+			return c, err
+		}
+		c.Treasury = &treasury
+	}
+
 	return c, nil
 }
 
@@ -191,7 +200,34 @@ func (uma UnparsedMultisigAlias) Parse() (MultisigAlias, error) {
 	return ma, nil
 }
 
+type UnparsedTreasuryConfig struct {
+	Threshold      uint32   `json:"threshold"`
+	Addresses      []string `json:"addresses"`
+	SpendingPeriod uint64   `json:"spendingPeriod,omitempty"`
+	SpendingCap    uint64   `json:"spendingCap,omitempty"`
+}
+
+func (utc UnparsedTreasuryConfig) Parse() (TreasuryConfig, error) {
+	tc := TreasuryConfig{
+		Threshold:      utc.Threshold,
+		Addresses:      make([]ids.ShortID, len(utc.Addresses)),
+		SpendingPeriod: utc.SpendingPeriod,
+		SpendingCap:    utc.SpendingCap,
+	}
+
+	for i, unparsedAddr := range utc.Addresses {
+		addr, err := address.ParseToID(unparsedAddr)
+		if err != nil {
+			return tc, err
+		}
+		tc.Addresses[i] = addr
+	}
+
+	return tc, nil
+}
+
 type UnparsedDepositOffer struct {
+	UpgradeVersion          uint16                    `json:"upgradeVersion,omitempty"`
 	InterestRateNominator   uint64                    `json:"interestRateNominator"`
 	StartOffset             uint64                    `json:"startOffset"`
 	EndOffset               uint64                    `json:"endOffset"`
@@ -202,6 +238,9 @@ type UnparsedDepositOffer struct {
 	NoRewardsPeriodDuration uint32                    `json:"noRewardsPeriodDuration"`
 	Memo                    string                    `json:"memo"`
 	Flags                   UnparsedDepositOfferFlags `json:"flags"`
+	TotalMaxAmount          uint64                    `json:"totalMaxAmount,omitempty"`
+	TotalMaxRewardAmount    uint64                    `json:"totalMaxRewardAmount,omitempty"`
+	OwnerAddress            string                    `json:"ownerAddress,omitempty"`
 }
 
 type UnparsedDepositOfferFlags struct {
@@ -210,6 +249,7 @@ type UnparsedDepositOfferFlags struct {
 
 func (udo UnparsedDepositOffer) Parse(startTime uint64) (DepositOffer, error) {
 	do := DepositOffer{
+		UpgradeVersion:          udo.UpgradeVersion,
 		InterestRateNominator:   udo.InterestRateNominator,
 		MinAmount:               udo.MinAmount,
 		MinDuration:             udo.MinDuration,
@@ -217,6 +257,16 @@ func (udo UnparsedDepositOffer) Parse(startTime uint64) (DepositOffer, error) {
 		UnlockPeriodDuration:    udo.UnlockPeriodDuration,
 		NoRewardsPeriodDuration: udo.NoRewardsPeriodDuration,
 		Memo:                    udo.Memo,
+		TotalMaxAmount:          udo.TotalMaxAmount,
+		TotalMaxRewardAmount:    udo.TotalMaxRewardAmount,
+	}
+
+	if udo.OwnerAddress != "" {
+		ownerAddr, err := address.ParseToID(udo.OwnerAddress)
+		if err != nil {
+			return do, err
+		}
+		do.OwnerAddress = ownerAddr
 	}
 
 	offerStartTime, err := math.Add64(startTime, udo.StartOffset)
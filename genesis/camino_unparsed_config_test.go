@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
@@ -229,6 +230,22 @@ func TestParsingAndUnparsingDepositOffer(t *testing.T) {
 				NoRewardsPeriodDuration: 31536000,
 			},
 		},
+		"Upgrade version 1 offer with owner and total max reward amount": {
+			startTime: uint64(1670956381),
+			udo: UnparsedDepositOffer{
+				UpgradeVersion:          1,
+				InterestRateNominator:   80000,
+				StartOffset:             0,
+				EndOffset:               112795200,
+				MinAmount:               1000000,
+				MinDuration:             110376000,
+				MaxDuration:             110376000,
+				UnlockPeriodDuration:    31536000,
+				NoRewardsPeriodDuration: 15768000,
+				TotalMaxRewardAmount:    1000000000000,
+				OwnerAddress:            xAddress,
+			},
+		},
 		"Template does require OfferID as well": {
 			startTime: uint64(0),
 			udo: UnparsedDepositOffer{
@@ -251,7 +268,7 @@ func TestParsingAndUnparsingDepositOffer(t *testing.T) {
 
 			// Don't check template equality
 			if tt.startTime > 0 {
-				udo, err := do.Unparse(tt.startTime)
+				udo, err := do.Unparse(constants.CaminoID, tt.startTime)
 				require.NoError(t, err)
 				require.Equal(t, tt.udo, udo)
 			}
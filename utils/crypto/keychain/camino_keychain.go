@@ -0,0 +1,56 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package keychain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+var (
+	_ MultiSigner = (*ledgerKeychain)(nil)
+
+	ErrUnknownAddress = errors.New("address isn't in keychain")
+)
+
+// MultiSigner is implemented by keychains, that are able to sign tx with
+// several addresses in a single request. Hardware wallets require user
+// confirmation of every request, so owners of multisig aliases, that are held
+// by the same device, could co-sign with a single confirmation.
+type MultiSigner interface {
+	// MultiSign signs [unsignedTxBytes] with every address from [addrs].
+	// Returned signatures are in the same order as [addrs].
+	MultiSign(unsignedTxBytes []byte, addrs []ids.ShortID) ([][]byte, error)
+}
+
+// expects to receive the unsigned tx bytes, ledger signs their hash only if
+// they are too large to be parsed by the device
+func (l *ledgerKeychain) MultiSign(unsignedTxBytes []byte, addrs []ids.ShortID) ([][]byte, error) {
+	indices := make([]uint32, len(addrs))
+	for i, addr := range addrs {
+		idx, ok := l.addrToIdx[addr]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownAddress, addr)
+		}
+		indices[i] = idx
+	}
+
+	sigs, err := l.ledger.Sign(unsignedTxBytes, indices)
+	if err != nil {
+		return nil, err
+	}
+
+	if sigsLen := len(sigs); sigsLen != len(addrs) {
+		return nil, fmt.Errorf(
+			"%w. expected %d, got %d",
+			ErrInvalidNumSignatures,
+			len(addrs),
+			sigsLen,
+		)
+	}
+
+	return sigs, nil
+}
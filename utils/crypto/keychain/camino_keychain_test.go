@@ -0,0 +1,87 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package keychain
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestLedgerKeychain_MultiSign(t *testing.T) {
+	addr1 := ids.ShortID{1}
+	addr2 := ids.ShortID{2}
+	addr3 := ids.ShortID{3}
+	toSign := []byte{1, 2, 3, 4, 5}
+	signature1 := []byte{1, 1, 1}
+	signature3 := []byte{3, 3, 3}
+
+	tests := map[string]struct {
+		ledger             func(*gomock.Controller) *MockLedger
+		addrs              []ids.ShortID
+		expectedSignatures [][]byte
+		expectedErr        error
+	}{
+		"Unknown address": {
+			ledger: func(ctrl *gomock.Controller) *MockLedger {
+				ledger := NewMockLedger(ctrl)
+				ledger.EXPECT().Addresses([]uint32{0, 1, 2}).Return([]ids.ShortID{addr1, addr2, addr3}, nil)
+				return ledger
+			},
+			addrs:       []ids.ShortID{addr1, {4}},
+			expectedErr: ErrUnknownAddress,
+		},
+		"Ledger returns an error": {
+			ledger: func(ctrl *gomock.Controller) *MockLedger {
+				ledger := NewMockLedger(ctrl)
+				ledger.EXPECT().Addresses([]uint32{0, 1, 2}).Return([]ids.ShortID{addr1, addr2, addr3}, nil)
+				ledger.EXPECT().Sign(toSign, []uint32{2, 0}).Return(nil, errTest)
+				return ledger
+			},
+			addrs:       []ids.ShortID{addr3, addr1},
+			expectedErr: errTest,
+		},
+		"Ledger returns an incorrect number of signatures": {
+			ledger: func(ctrl *gomock.Controller) *MockLedger {
+				ledger := NewMockLedger(ctrl)
+				ledger.EXPECT().Addresses([]uint32{0, 1, 2}).Return([]ids.ShortID{addr1, addr2, addr3}, nil)
+				ledger.EXPECT().Sign(toSign, []uint32{2, 0}).Return([][]byte{signature3}, nil)
+				return ledger
+			},
+			addrs:       []ids.ShortID{addr3, addr1},
+			expectedErr: ErrInvalidNumSignatures,
+		},
+		"OK": {
+			ledger: func(ctrl *gomock.Controller) *MockLedger {
+				ledger := NewMockLedger(ctrl)
+				ledger.EXPECT().Addresses([]uint32{0, 1, 2}).Return([]ids.ShortID{addr1, addr2, addr3}, nil)
+				ledger.EXPECT().Sign(toSign, []uint32{2, 0}).Return([][]byte{signature3, signature1}, nil)
+				return ledger
+			},
+			addrs:              []ids.ShortID{addr3, addr1},
+			expectedSignatures: [][]byte{signature3, signature1},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			kc, err := NewLedgerKeychain(tt.ledger(ctrl), 3)
+			require.NoError(err)
+
+			multiSigner, ok := kc.(MultiSigner)
+			require.True(ok)
+
+			signatures, err := multiSigner.MultiSign(toSign, tt.addrs)
+			require.ErrorIs(err, tt.expectedErr)
+			require.Equal(tt.expectedSignatures, signatures)
+		})
+	}
+}
@@ -1,8 +1,20 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
 package config
 
+import "time"
+
 // Struct collecting all the foundational parameters of the AVM
 type Config struct {
 	// Fee that is burned by every non-asset creating transaction
@@ -10,4 +22,11 @@ type Config struct {
 
 	// Fee that must be burned by every asset creating transaction
 	CreateAssetTxFee uint64
+
+	// Time of the Berlin Phase network upgrade
+	BerlinPhaseTime time.Time
+}
+
+func (c *Config) IsBerlinPhaseActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.BerlinPhaseTime)
 }
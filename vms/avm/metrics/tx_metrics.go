@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -19,7 +29,8 @@ type txMetrics struct {
 	numCreateAssetTxs,
 	numOperationTxs,
 	numImportTxs,
-	numExportTxs prometheus.Counter
+	numExportTxs,
+	numMultisigImportTxs prometheus.Counter
 }
 
 func newTxMetrics(
@@ -33,6 +44,8 @@ func newTxMetrics(
 		numOperationTxs:   newTxMetric(namespace, "operation", registerer, &errs),
 		numImportTxs:      newTxMetric(namespace, "import", registerer, &errs),
 		numExportTxs:      newTxMetric(namespace, "export", registerer, &errs),
+
+		numMultisigImportTxs: newTxMetric(namespace, "multisig_import", registerer, &errs),
 	}
 	return m, errs.Err
 }
@@ -76,3 +89,8 @@ func (m *txMetrics) ExportTx(*txs.ExportTx) error {
 	m.numExportTxs.Inc()
 	return nil
 }
+
+func (m *txMetrics) MultisigImportTx(*txs.MultisigImportTx) error {
+	m.numMultisigImportTxs.Inc()
+	return nil
+}
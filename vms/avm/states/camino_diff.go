@@ -0,0 +1,27 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package states
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+)
+
+func (d *diff) GetMultisigAlias(aliasID ids.ShortID) (*multisig.AliasWithNonce, error) {
+	if alias, exists := d.modifiedMultisigAliases[aliasID]; exists {
+		return alias, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+	return parentState.GetMultisigAlias(aliasID)
+}
+
+func (d *diff) SetMultisigAlias(alias *multisig.AliasWithNonce) {
+	d.modifiedMultisigAliases[alias.ID] = alias
+}
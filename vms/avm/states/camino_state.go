@@ -0,0 +1,63 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package states
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+)
+
+func (s *state) GetMultisigAlias(aliasID ids.ShortID) (*multisig.AliasWithNonce, error) {
+	if alias, exists := s.modifiedMultisigAliases[aliasID]; exists {
+		return alias, nil
+	}
+	if alias, cached := s.multisigAliasCache.Get(aliasID); cached {
+		if alias == nil {
+			return nil, database.ErrNotFound
+		}
+		return alias, nil
+	}
+
+	aliasBytes, err := s.multisigAliasDB.Get(aliasID[:])
+	if err == database.ErrNotFound {
+		s.multisigAliasCache.Put(aliasID, nil)
+		return nil, database.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	alias := &multisig.AliasWithNonce{}
+	if _, err := s.parser.Codec().Unmarshal(aliasBytes, alias); err != nil {
+		return nil, err
+	}
+
+	s.multisigAliasCache.Put(aliasID, alias)
+	return alias, nil
+}
+
+func (s *state) SetMultisigAlias(alias *multisig.AliasWithNonce) {
+	s.modifiedMultisigAliases[alias.ID] = alias
+}
+
+func (s *state) writeMultisigAliases() error {
+	for aliasID, alias := range s.modifiedMultisigAliases {
+		aliasID := aliasID
+
+		delete(s.modifiedMultisigAliases, aliasID)
+		aliasBytes, err := s.parser.Codec().Marshal(txs.CodecVersion, alias)
+		if err != nil {
+			return fmt.Errorf("failed to marshal multisig alias: %w", err)
+		}
+		s.multisigAliasCache.Put(aliasID, alias)
+		if err := s.multisigAliasDB.Put(aliasID[:], aliasBytes); err != nil {
+			return fmt.Errorf("failed to add multisig alias: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package states
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/versiondb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestMultisigAlias(t *testing.T) {
+	require := require.New(t)
+
+	alias := &multisig.AliasWithNonce{
+		Alias: multisig.Alias{
+			ID:   ids.ShortID{1},
+			Memo: []byte("memo"),
+			Owners: &secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{{2}},
+			},
+		},
+		Nonce: 1,
+	}
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	s, err := New(vdb, parser, prometheus.NewRegistry())
+	require.NoError(err)
+
+	_, err = s.GetMultisigAlias(alias.ID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	s.SetMultisigAlias(alias)
+	require.NoError(s.Commit())
+
+	s, err = New(vdb, parser, prometheus.NewRegistry())
+	require.NoError(err)
+
+	storedAlias, err := s.GetMultisigAlias(alias.ID)
+	require.NoError(err)
+	require.Equal(alias, storedAlias)
+}
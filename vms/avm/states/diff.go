@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -13,6 +23,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/avm/blocks"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
 )
 
 var (
@@ -37,6 +48,8 @@ type diff struct {
 	addedBlockIDs map[uint64]ids.ID       // map of height -> blockID
 	addedBlocks   map[ids.ID]blocks.Block // map of blockID -> block
 
+	modifiedMultisigAliases map[ids.ShortID]*multisig.AliasWithNonce // map of aliasID -> alias
+
 	lastAccepted ids.ID
 	timestamp    time.Time
 }
@@ -58,6 +71,8 @@ func NewDiff(
 		addedBlocks:   make(map[ids.ID]blocks.Block),
 		lastAccepted:  parentState.GetLastAccepted(),
 		timestamp:     parentState.GetTimestamp(),
+
+		modifiedMultisigAliases: make(map[ids.ShortID]*multisig.AliasWithNonce),
 	}, nil
 }
 
@@ -167,6 +182,10 @@ func (d *diff) Apply(state Chain) {
 		state.AddBlock(blk)
 	}
 
+	for _, alias := range d.modifiedMultisigAliases {
+		state.SetMultisigAlias(alias)
+	}
+
 	state.SetLastAccepted(d.lastAccepted)
 	state.SetTimestamp(d.timestamp)
 }
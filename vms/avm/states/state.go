@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -20,6 +30,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/avm/blocks"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
 )
 
 const (
@@ -27,6 +38,8 @@ const (
 	txCacheSize      = 8192
 	blockIDCacheSize = 8192
 	blockCacheSize   = 2048
+
+	multisigAliasCacheSize = 1024
 )
 
 var (
@@ -37,6 +50,8 @@ var (
 	blockPrefix     = []byte("block")
 	singletonPrefix = []byte("singleton")
 
+	multisigAliasPrefix = []byte("multisigAlias")
+
 	isInitializedKey = []byte{0x00}
 	timestampKey     = []byte{0x01}
 	lastAcceptedKey  = []byte{0x02}
@@ -55,6 +70,10 @@ type ReadOnlyChain interface {
 	GetBlock(blkID ids.ID) (blocks.Block, error)
 	GetLastAccepted() ids.ID
 	GetTimestamp() time.Time
+
+	// GetMultisigAlias returns multisig alias registered on this chain
+	// or database.ErrNotFound, if [aliasID] isn't registered alias.
+	GetMultisigAlias(aliasID ids.ShortID) (*multisig.AliasWithNonce, error)
 }
 
 type Chain interface {
@@ -66,6 +85,8 @@ type Chain interface {
 	AddBlock(block blocks.Block)
 	SetLastAccepted(blkID ids.ID)
 	SetTimestamp(t time.Time)
+
+	SetMultisigAlias(alias *multisig.AliasWithNonce)
 }
 
 // State persistently maintains a set of UTXOs, transaction, statuses, and
@@ -116,10 +137,12 @@ type State interface {
  * | '-- height -> blockID
  * |-. blocks
  * | '-- blockID -> block bytes
- * '-. singletons
- *   |-- initializedKey -> nil
- *   |-- timestampKey -> timestamp
- *   '-- lastAcceptedKey -> lastAccepted
+ * |-. singletons
+ * | |-- initializedKey -> nil
+ * | |-- timestampKey -> timestamp
+ * | '-- lastAcceptedKey -> lastAccepted
+ * '-. multisigAliases
+ *   '-- aliasID -> alias bytes
  */
 type state struct {
 	parser blocks.Parser
@@ -149,6 +172,10 @@ type state struct {
 	lastAccepted, persistedLastAccepted ids.ID
 	timestamp, persistedTimestamp       time.Time
 	singletonDB                         database.Database
+
+	modifiedMultisigAliases map[ids.ShortID]*multisig.AliasWithNonce            // map of aliasID -> alias
+	multisigAliasCache      cache.Cacher[ids.ShortID, *multisig.AliasWithNonce] // cache of aliasID -> alias. If the entry is nil, it is not in the database
+	multisigAliasDB         database.Database
 }
 
 func New(
@@ -162,6 +189,7 @@ func New(
 	blockIDDB := prefixdb.New(blockIDPrefix, db)
 	blockDB := prefixdb.New(blockPrefix, db)
 	singletonDB := prefixdb.New(singletonPrefix, db)
+	multisigAliasDB := prefixdb.New(multisigAliasPrefix, db)
 
 	statusCache, err := metercacher.New[ids.ID, *choices.Status](
 		"status_cache",
@@ -199,6 +227,15 @@ func New(
 		return nil, err
 	}
 
+	multisigAliasCache, err := metercacher.New[ids.ShortID, *multisig.AliasWithNonce](
+		"multisig_alias_cache",
+		metrics,
+		&cache.LRU[ids.ShortID, *multisig.AliasWithNonce]{Size: multisigAliasCacheSize},
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	utxoState, err := avax.NewMeteredUTXOState(utxoDB, parser.Codec(), metrics)
 	return &state{
 		parser: parser,
@@ -225,6 +262,10 @@ func New(
 		blockDB:     blockDB,
 
 		singletonDB: singletonDB,
+
+		modifiedMultisigAliases: make(map[ids.ShortID]*multisig.AliasWithNonce),
+		multisigAliasCache:      multisigAliasCache,
+		multisigAliasDB:         multisigAliasDB,
 	}, err
 }
 
@@ -471,6 +512,7 @@ func (s *state) Close() error {
 		s.blockIDDB.Close(),
 		s.blockDB.Close(),
 		s.singletonDB.Close(),
+		s.multisigAliasDB.Close(),
 		s.db.Close(),
 	)
 	return errs.Err
@@ -485,6 +527,7 @@ func (s *state) write() error {
 		s.writeBlocks(),
 		s.writeMetadata(),
 		s.writeStatuses(),
+		s.writeMultisigAliases(),
 	)
 	return errs.Err
 }
@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -111,6 +121,10 @@ func (t *txInit) ImportTx(tx *txs.ImportTx) error {
 	return t.BaseTx(&tx.BaseTx)
 }
 
+func (t *txInit) MultisigImportTx(tx *txs.MultisigImportTx) error {
+	return t.ImportTx(&tx.ImportTx)
+}
+
 func (t *txInit) ExportTx(tx *txs.ExportTx) error {
 	if err := t.init(); err != nil {
 		return err
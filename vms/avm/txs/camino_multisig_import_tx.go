@@ -0,0 +1,54 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	_ UnsignedTx             = (*MultisigImportTx)(nil)
+	_ secp256k1fx.UnsignedTx = (*MultisigImportTx)(nil)
+)
+
+// MultisigImportTx is an import tx, that also registers multisig aliases
+// on this chain. Aliases must be attached by the source chain to the imported
+// utxos. If tx doesn't import any utxos, aliases must be published by the
+// source chain, when they were changed, and this tx consumes them. P-chain
+// publishes only alias changes made after BerlinPhase activation, so older
+// aliases must be re-registered on P-chain with MultisigAliasTx before they
+// can be imported without utxos. Once registered, aliases are used to verify
+// spending of outputs, that are owned by them.
+type MultisigImportTx struct {
+	ImportTx `serialize:"true"`
+
+	// Multisig aliases to register. Must be sorted by alias ID and unique.
+	Aliases []*multisig.AliasWithNonce `serialize:"true" json:"aliases"`
+}
+
+func (t *MultisigImportTx) InitCtx(ctx *snow.Context) {
+	for _, alias := range t.Aliases {
+		alias.InitCtx(ctx)
+	}
+	t.ImportTx.InitCtx(ctx)
+}
+
+func (t *MultisigImportTx) InputIDs() set.Set[ids.ID] {
+	inputs := t.ImportTx.InputIDs()
+	if len(t.ImportedIns) != 0 {
+		return inputs
+	}
+	for _, alias := range t.Aliases {
+		inputs.Add(multisig.AliasUpdateKey(alias.ID, alias.Nonce))
+	}
+	return inputs
+}
+
+func (t *MultisigImportTx) Visit(v Visitor) error {
+	return v.MultisigImportTx(t)
+}
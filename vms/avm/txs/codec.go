@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -14,12 +24,12 @@ import (
 )
 
 var (
-	_ codec.Registry = (*codecRegistry)(nil)
-	_ secp256k1fx.VM = (*fxVM)(nil)
+	_ codec.CaminoRegistry = (*codecRegistry)(nil)
+	_ secp256k1fx.VM       = (*fxVM)(nil)
 )
 
 type codecRegistry struct {
-	codecs      []codec.Registry
+	codecs      []codec.CaminoRegistry
 	index       int
 	typeToIndex map[reflect.Type]int
 }
@@ -35,6 +45,17 @@ func (cr *codecRegistry) RegisterType(val interface{}) error {
 	return errs.Err
 }
 
+func (cr *codecRegistry) RegisterCustomType(val interface{}) error {
+	valType := reflect.TypeOf(val)
+	cr.typeToIndex[valType] = cr.index
+
+	errs := wrappers.Errs{}
+	for _, c := range cr.codecs {
+		errs.Add(c.RegisterCustomType(val))
+	}
+	return errs.Err
+}
+
 type fxVM struct {
 	typeToFxIndex map[reflect.Type]int
 
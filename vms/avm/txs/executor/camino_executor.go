@@ -0,0 +1,57 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+)
+
+func (e *Executor) MultisigImportTx(tx *txs.MultisigImportTx) error {
+	if len(tx.ImportedIns) != 0 {
+		if err := e.ImportTx(&tx.ImportTx); err != nil {
+			return err
+		}
+	} else if err := e.syncPublishedAliases(tx); err != nil {
+		return err
+	}
+
+	for _, alias := range tx.Aliases {
+		// Aliases could be imported out of order, so we only keep the latest one
+		existingAlias, err := e.State.GetMultisigAlias(alias.ID)
+		switch {
+		case err == database.ErrNotFound:
+		case err != nil:
+			return err
+		case existingAlias.Nonce > alias.Nonce:
+			continue
+		}
+		e.State.SetMultisigAlias(alias)
+	}
+	return nil
+}
+
+// syncPublishedAliases consumes alias definitions, that were published
+// by the source chain for the aliases of [tx].
+func (e *Executor) syncPublishedAliases(tx *txs.MultisigImportTx) error {
+	if err := e.BaseTx(&tx.BaseTx); err != nil {
+		return err
+	}
+
+	aliasKeys := make([][]byte, len(tx.Aliases))
+	for i, alias := range tx.Aliases {
+		aliasKey := multisig.AliasUpdateKey(alias.ID, alias.Nonce)
+		e.Inputs.Add(aliasKey)
+		aliasKeys[i] = aliasKey[:]
+	}
+	e.AtomicRequests = map[ids.ID]*atomic.Requests{
+		tx.SourceChain: {
+			RemoveRequests: aliasKeys,
+		},
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/avm/states"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+)
+
+func TestExecutorMultisigImportTx(t *testing.T) {
+	newAlias := func(id byte, nonce uint64) *multisig.AliasWithNonce {
+		return &multisig.AliasWithNonce{
+			Alias: multisig.Alias{ID: ids.ShortID{id}},
+			Nonce: nonce,
+		}
+	}
+
+	tests := map[string]struct {
+		existingAlias *multisig.AliasWithNonce
+		importedAlias *multisig.AliasWithNonce
+		expectSet     bool
+	}{
+		"New alias": {
+			importedAlias: newAlias(1, 0),
+			expectSet:     true,
+		},
+		"Newer alias": {
+			existingAlias: newAlias(1, 1),
+			importedAlias: newAlias(1, 2),
+			expectSet:     true,
+		},
+		"Same alias": {
+			existingAlias: newAlias(1, 1),
+			importedAlias: newAlias(1, 1),
+			expectSet:     true,
+		},
+		"Older alias": {
+			existingAlias: newAlias(1, 2),
+			importedAlias: newAlias(1, 1),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			state := states.NewMockChain(ctrl)
+			if tt.existingAlias != nil {
+				state.EXPECT().GetMultisigAlias(tt.importedAlias.ID).Return(tt.existingAlias, nil)
+			} else {
+				state.EXPECT().GetMultisigAlias(tt.importedAlias.ID).Return(nil, database.ErrNotFound)
+			}
+			if tt.expectSet {
+				state.EXPECT().SetMultisigAlias(tt.importedAlias)
+			}
+
+			// tx without imported utxos consumes published alias
+			tx := &txs.Tx{Unsigned: &txs.MultisigImportTx{
+				ImportTx: txs.ImportTx{SourceChain: constants.PlatformChainID},
+				Aliases:  []*multisig.AliasWithNonce{tt.importedAlias},
+			}}
+			executor := &Executor{
+				State:  state,
+				Tx:     tx,
+				Inputs: set.NewSet[ids.ID](0),
+			}
+			require.NoError(t, tx.Unsigned.Visit(executor))
+
+			aliasKey := multisig.AliasUpdateKey(tt.importedAlias.ID, tt.importedAlias.Nonce)
+			require.Equal(t, set.Set[ids.ID]{aliasKey: struct{}{}}, executor.Inputs)
+			require.Equal(t, map[ids.ID]*atomic.Requests{
+				constants.PlatformChainID: {RemoveRequests: [][]byte{aliasKey[:]}},
+			}, executor.AtomicRequests)
+		})
+	}
+}
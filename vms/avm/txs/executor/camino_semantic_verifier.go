@@ -0,0 +1,165 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	platformtxs "github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+var (
+	_ secp256k1fx.AliasGetter = (*importedAliasGetter)(nil)
+
+	errNotBerlinPhase         = errors.New("not allowed before BerlinPhase")
+	errWrongImportedAliasType = errors.New("imported multisig alias has wrong type")
+	errAliasNotImported       = errors.New("multisig alias isn't attached to imported utxos")
+	errImportedAliasMismatch  = errors.New("multisig alias doesn't match imported one")
+)
+
+// multisigFx is implemented by fxs, that support outputs owned by multisig aliases.
+type multisigFx interface {
+	VerifyTransferWithAliases(txIntf, inIntf, credIntf, utxoIntf, msigIntf interface{}) error
+	VerifyOperationWithAliases(txIntf, opIntf, credIntf interface{}, utxosIntf []interface{}, msigIntf interface{}) error
+}
+
+func (v *SemanticVerifier) MultisigImportTx(tx *txs.MultisigImportTx) error {
+	if !v.Config.IsBerlinPhaseActivated(v.State.GetTimestamp()) {
+		return errNotBerlinPhase
+	}
+
+	var (
+		importedAliases map[ids.ShortID]*multisig.AliasWithNonce
+		err             error
+	)
+	if len(tx.ImportedIns) != 0 {
+		importedAliases, err = v.verifyImportTx(&tx.ImportTx)
+	} else {
+		importedAliases, err = v.verifyPublishedAliases(tx)
+	}
+	if err != nil || !v.Bootstrapped {
+		return err
+	}
+
+	for _, alias := range tx.Aliases {
+		importedAlias, ok := importedAliases[alias.ID]
+		if !ok {
+			return fmt.Errorf("%w: %s", errAliasNotImported, alias.ID)
+		}
+
+		aliasBytes, err := v.Codec.Marshal(txs.CodecVersion, alias)
+		if err != nil {
+			return err
+		}
+		importedAliasBytes, err := v.Codec.Marshal(txs.CodecVersion, importedAlias)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(aliasBytes, importedAliasBytes) {
+			return fmt.Errorf("%w: %s", errImportedAliasMismatch, alias.ID)
+		}
+	}
+	return nil
+}
+
+// verifyPublishedAliases verifies [tx], that doesn't import any utxos, and
+// returns alias definitions, that were published by the source chain for the
+// aliases of [tx].
+func (v *SemanticVerifier) verifyPublishedAliases(tx *txs.MultisigImportTx) (map[ids.ShortID]*multisig.AliasWithNonce, error) {
+	if err := v.BaseTx(&tx.BaseTx); err != nil {
+		return nil, err
+	}
+
+	if !v.Bootstrapped {
+		return nil, nil
+	}
+
+	if err := verify.SameSubnet(context.TODO(), v.Ctx, tx.SourceChain); err != nil {
+		return nil, err
+	}
+
+	aliasKeys := make([][]byte, len(tx.Aliases))
+	for i, alias := range tx.Aliases {
+		aliasKey := multisig.AliasUpdateKey(alias.ID, alias.Nonce)
+		aliasKeys[i] = aliasKey[:]
+	}
+
+	allAliasBytes, err := v.Ctx.SharedMemory.Get(tx.SourceChain, aliasKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	publishedAliases := make(map[ids.ShortID]*multisig.AliasWithNonce, len(allAliasBytes))
+	for _, aliasBytes := range allAliasBytes {
+		alias := &multisig.AliasWithNonce{}
+		if _, err := platformtxs.Codec.Unmarshal(aliasBytes, alias); err != nil {
+			return nil, err
+		}
+		publishedAliases[alias.ID] = alias
+	}
+	return publishedAliases, nil
+}
+
+// getMultisigFx returns [fx] as multisigFx, if it supports multisig aliases and
+// BerlinPhase is activated. Otherwise, returns nil and multisig credentials
+// aren't allowed.
+func (v *SemanticVerifier) getMultisigFx(fx interface{}, cred verify.Verifiable) (multisigFx, error) {
+	msigFx, ok := fx.(multisigFx)
+	if ok && v.Config.IsBerlinPhaseActivated(v.State.GetTimestamp()) {
+		return msigFx, nil
+	}
+	if _, ok := cred.(*secp256k1fx.MultisigCredential); ok {
+		return nil, errNotBerlinPhase
+	}
+	return nil, nil
+}
+
+// unmarshalImportedUTXO unmarshals utxo, that was exported from [sourceChain].
+// Since BerlinPhase, utxos exported by the P-chain are unmarshalled with its
+// codec and could be wrapped together with multisig aliases, that own them.
+func (v *SemanticVerifier) unmarshalImportedUTXO(sourceChain ids.ID, utxoBytes []byte) (*avax.UTXO, []verify.State, error) {
+	if sourceChain == constants.PlatformChainID && v.Config.IsBerlinPhaseActivated(v.State.GetTimestamp()) {
+		return avax.UnmarshalUTXO(platformtxs.Codec, utxoBytes)
+	}
+
+	utxo := &avax.UTXO{}
+	if _, err := v.Codec.Unmarshal(utxoBytes, utxo); err != nil {
+		return nil, nil, err
+	}
+	return utxo, nil, nil
+}
+
+// importedAliasGetter resolves multisig aliases attached to imported utxos
+// and aliases registered in the state. If both are present, the one with
+// the higher nonce is returned, so an outdated alias is never used.
+type importedAliasGetter struct {
+	aliases map[ids.ShortID]*multisig.AliasWithNonce
+	state   secp256k1fx.AliasGetter
+}
+
+func (g *importedAliasGetter) GetMultisigAlias(aliasID ids.ShortID) (*multisig.AliasWithNonce, error) {
+	importedAlias, imported := g.aliases[aliasID]
+	alias, err := g.state.GetMultisigAlias(aliasID)
+	switch {
+	case err == database.ErrNotFound && imported:
+		return importedAlias, nil
+	case err != nil:
+		return nil, err
+	case imported && importedAlias.Nonce > alias.Nonce:
+		return importedAlias, nil
+	}
+	return alias, nil
+}
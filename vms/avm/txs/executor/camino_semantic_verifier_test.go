@@ -0,0 +1,362 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/avm/config"
+	"github.com/ava-labs/avalanchego/vms/avm/fxs"
+	"github.com/ava-labs/avalanchego/vms/avm/states"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	platformtxs "github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+func TestSemanticVerifierMultisigImportTx(t *testing.T) {
+	ctx := newContext(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validatorState := validators.NewMockState(ctrl)
+	validatorState.EXPECT().GetSubnetID(gomock.Any(), constants.PlatformChainID).AnyTimes().Return(ctx.SubnetID, nil)
+	ctx.ValidatorState = validatorState
+
+	typeToFxIndex := make(map[reflect.Type]int)
+	secpFx := &secp256k1fx.CaminoFx{}
+	parser, err := txs.NewCustomParser(
+		typeToFxIndex,
+		new(mockable.Clock),
+		logging.NoWarn{},
+		[]fxs.Fx{
+			secpFx,
+		},
+	)
+	require.NoError(t, err)
+	require.NoError(t, secpFx.Bootstrapped())
+	codec := parser.Codec()
+
+	// alias owners addresses must be sorted, so do signers
+	signers := []*secp256k1.PrivateKey{keys[0], keys[1]}
+	if keys[1].Address().Less(keys[0].Address()) {
+		signers = []*secp256k1.PrivateKey{keys[1], keys[0]}
+	}
+	alias := &multisig.AliasWithNonce{
+		Alias: multisig.Alias{
+			ID: ids.ShortID{1},
+			Owners: &secp256k1fx.OutputOwners{
+				Threshold: 2,
+				Addrs:     []ids.ShortID{signers[0].Address(), signers[1].Address()},
+			},
+		},
+		Nonce: 1,
+	}
+
+	asset := avax.Asset{ID: ids.ID{1}}
+	utxo := &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.ID{2}},
+		Asset:  asset,
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 12345,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{alias.ID},
+			},
+		},
+	}
+
+	// utxo is exported from P-chain together with its owner alias
+	utxoBytes, err := platformtxs.Codec.Marshal(platformtxs.Version, &avax.UTXOWithMSig{
+		UTXO:    *utxo,
+		Aliases: []verify.State{alias},
+	})
+	require.NoError(t, err)
+	// alias update is published by P-chain
+	publishedAlias := &multisig.AliasWithNonce{
+		Alias: multisig.Alias{
+			ID: alias.ID,
+			Owners: &secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{signers[0].Address()},
+			},
+		},
+		Nonce: alias.Nonce + 1,
+	}
+	publishedAliasBytes, err := platformtxs.Codec.Marshal(platformtxs.Version, publishedAlias)
+	require.NoError(t, err)
+	publishedAliasKey := multisig.AliasUpdateKey(publishedAlias.ID, publishedAlias.Nonce)
+
+	m := atomic.NewMemory(memdb.New())
+	inputID := utxo.InputID()
+	require.NoError(t, m.NewSharedMemory(constants.PlatformChainID).Apply(map[ids.ID]*atomic.Requests{
+		ctx.ChainID: {PutRequests: []*atomic.Element{
+			{
+				Key:    inputID[:],
+				Value:  utxoBytes,
+				Traits: [][]byte{alias.ID[:]},
+			},
+			{
+				Key:   publishedAliasKey[:],
+				Value: publishedAliasBytes,
+			},
+		}},
+	}))
+	ctx.SharedMemory = m.NewSharedMemory(ctx.ChainID)
+
+	backend := &Backend{
+		Ctx:    ctx,
+		Config: &feeConfig,
+		Fxs: []*fxs.ParsedFx{{
+			ID: secp256k1fx.ID,
+			Fx: secpFx,
+		}},
+		TypeToFxIndex: typeToFxIndex,
+		Codec:         codec,
+		FeeAssetID:    ids.ID{3},
+		Bootstrapped:  true,
+	}
+
+	chainTime := time.Unix(100, 0)
+	createAssetTx := &txs.Tx{Unsigned: &txs.CreateAssetTx{
+		States: []*txs.InitialState{{FxIndex: 0}},
+	}}
+
+	newSyncTx := func(aliases []*multisig.AliasWithNonce) *txs.Tx {
+		tx := &txs.Tx{Unsigned: &txs.MultisigImportTx{
+			ImportTx: txs.ImportTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    constants.UnitTestID,
+					BlockchainID: ctx.ChainID,
+				}},
+				SourceChain: constants.PlatformChainID,
+			},
+			Aliases: aliases,
+		}}
+		require.NoError(t, tx.SignSECP256K1Fx(codec, nil))
+		return tx
+	}
+
+	newTx := func(aliases []*multisig.AliasWithNonce, signers []*secp256k1.PrivateKey, sigIndices []uint32) *txs.Tx {
+		tx := &txs.Tx{Unsigned: &txs.MultisigImportTx{
+			ImportTx: txs.ImportTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    constants.UnitTestID,
+					BlockchainID: ctx.ChainID,
+				}},
+				SourceChain: constants.PlatformChainID,
+				ImportedIns: []*avax.TransferableInput{{
+					UTXOID: utxo.UTXOID,
+					Asset:  asset,
+					In: &secp256k1fx.TransferInput{
+						Amt:   12345,
+						Input: secp256k1fx.Input{SigIndices: sigIndices},
+					},
+				}},
+			},
+			Aliases: aliases,
+		}}
+		require.NoError(t, tx.SignSECP256K1Fx(codec, [][]*secp256k1.PrivateKey{signers}))
+		return tx
+	}
+
+	tests := map[string]struct {
+		tx              *txs.Tx
+		stateAlias      *multisig.AliasWithNonce
+		berlinPhaseTime time.Time
+		expectedErr     error
+	}{
+		"OK": {
+			tx: newTx([]*multisig.AliasWithNonce{alias}, signers, []uint32{0, 1}),
+		},
+		"OK, sync published alias": {
+			tx: newSyncTx([]*multisig.AliasWithNonce{publishedAlias}),
+		},
+		"Before BerlinPhase": {
+			tx:              newTx([]*multisig.AliasWithNonce{alias}, signers, []uint32{0, 1}),
+			berlinPhaseTime: chainTime.Add(time.Second),
+			expectedErr:     errNotBerlinPhase,
+		},
+		"Imported alias is outdated": {
+			tx:          newTx([]*multisig.AliasWithNonce{alias}, signers, []uint32{0, 1}),
+			stateAlias:  publishedAlias,
+			expectedErr: secp256k1fx.ErrTooManySigners,
+		},
+		"Published alias doesn't exist": {
+			tx: newSyncTx([]*multisig.AliasWithNonce{{
+				Alias: publishedAlias.Alias,
+				Nonce: publishedAlias.Nonce + 1,
+			}}),
+			expectedErr: database.ErrNotFound,
+		},
+		"Published alias doesn't match": {
+			tx: newSyncTx([]*multisig.AliasWithNonce{{
+				Alias: multisig.Alias{
+					ID:     publishedAlias.ID,
+					Owners: alias.Owners,
+				},
+				Nonce: publishedAlias.Nonce,
+			}}),
+			expectedErr: errImportedAliasMismatch,
+		},
+		"Not enough alias owners signatures": {
+			tx:          newTx([]*multisig.AliasWithNonce{alias}, signers[:1], []uint32{0, 1}),
+			expectedErr: secp256k1fx.ErrInputCredentialSignersMismatch,
+		},
+		"Alias isn't attached to imported utxos": {
+			tx: newTx([]*multisig.AliasWithNonce{{
+				Alias: multisig.Alias{
+					ID:     ids.ShortID{2},
+					Owners: alias.Owners,
+				},
+			}}, signers, []uint32{0, 1}),
+			expectedErr: errAliasNotImported,
+		},
+		"Alias doesn't match imported one": {
+			tx: newTx([]*multisig.AliasWithNonce{{
+				Alias: alias.Alias,
+				Nonce: alias.Nonce + 1,
+			}}, signers, []uint32{0, 1}),
+			expectedErr: errImportedAliasMismatch,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			state := states.NewMockChain(ctrl)
+			state.EXPECT().GetTimestamp().Return(chainTime).AnyTimes()
+			state.EXPECT().GetTx(asset.ID).Return(createAssetTx, nil).AnyTimes()
+			if tt.stateAlias != nil {
+				state.EXPECT().GetMultisigAlias(tt.stateAlias.ID).Return(tt.stateAlias, nil).AnyTimes()
+			}
+			state.EXPECT().GetMultisigAlias(gomock.Any()).Return(nil, database.ErrNotFound).AnyTimes()
+
+			backend := *backend
+			backend.Config = &config.Config{BerlinPhaseTime: tt.berlinPhaseTime}
+			err := tt.tx.Unsigned.Visit(&SemanticVerifier{
+				Backend: &backend,
+				State:   state,
+				Tx:      tt.tx,
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestSemanticVerifierBeforeBerlinPhase(t *testing.T) {
+	ctx := newContext(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	typeToFxIndex := make(map[reflect.Type]int)
+	secpFx := &secp256k1fx.CaminoFx{}
+	parser, err := txs.NewCustomParser(
+		typeToFxIndex,
+		new(mockable.Clock),
+		logging.NoWarn{},
+		[]fxs.Fx{
+			secpFx,
+		},
+	)
+	require.NoError(t, err)
+	require.NoError(t, secpFx.Bootstrapped())
+	codec := parser.Codec()
+
+	chainTime := time.Unix(100, 0)
+	backend := &Backend{
+		Ctx:    ctx,
+		Config: &config.Config{BerlinPhaseTime: chainTime.Add(time.Second)},
+		Fxs: []*fxs.ParsedFx{{
+			ID: secp256k1fx.ID,
+			Fx: secpFx,
+		}},
+		TypeToFxIndex: typeToFxIndex,
+		Codec:         codec,
+		FeeAssetID:    ids.ID{3},
+		Bootstrapped:  true,
+	}
+
+	asset := avax.Asset{ID: ids.ID{1}}
+	utxo := &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.ID{2}},
+		Asset:  asset,
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 12345,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{keys[0].Address()},
+			},
+		},
+	}
+	createAssetTx := &txs.Tx{Unsigned: &txs.CreateAssetTx{
+		States: []*txs.InitialState{{FxIndex: 0}},
+	}}
+
+	newTx := func(cred func(*secp256k1fx.Credential) verify.Verifiable) *txs.Tx {
+		tx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    constants.UnitTestID,
+			BlockchainID: ctx.ChainID,
+			Ins: []*avax.TransferableInput{{
+				UTXOID: utxo.UTXOID,
+				Asset:  asset,
+				In: &secp256k1fx.TransferInput{
+					Amt:   12345,
+					Input: secp256k1fx.Input{SigIndices: []uint32{0}},
+				},
+			}},
+		}}}
+		require.NoError(t, tx.SignSECP256K1Fx(codec, [][]*secp256k1.PrivateKey{{keys[0]}}))
+		tx.Creds[0].Verifiable = cred(tx.Creds[0].Verifiable.(*secp256k1fx.Credential))
+		return tx
+	}
+
+	tests := map[string]struct {
+		tx          *txs.Tx
+		expectedErr error
+	}{
+		"OK, regular credential": {
+			tx: newTx(func(cred *secp256k1fx.Credential) verify.Verifiable {
+				return cred
+			}),
+		},
+		"Multisig credential": {
+			tx: newTx(func(cred *secp256k1fx.Credential) verify.Verifiable {
+				return &secp256k1fx.MultisigCredential{Credential: *cred}
+			}),
+			expectedErr: errNotBerlinPhase,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			state := states.NewMockChain(ctrl)
+			state.EXPECT().GetTimestamp().Return(chainTime).AnyTimes()
+			state.EXPECT().GetUTXOFromID(&utxo.UTXOID).Return(utxo, nil)
+			state.EXPECT().GetTx(asset.ID).Return(createAssetTx, nil)
+
+			err := tt.tx.Unsigned.Visit(&SemanticVerifier{
+				Backend: backend,
+				State:   state,
+				Tx:      tt.tx,
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
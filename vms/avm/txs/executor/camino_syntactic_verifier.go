@@ -0,0 +1,43 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+)
+
+var (
+	errNoMultisigAliases              = errors.New("no multisig aliases")
+	errMultisigAliasesNotSortedUnique = errors.New("multisig aliases not sorted and unique")
+	errNotPlatformSourceChain         = errors.New("source chain isn't P-chain")
+)
+
+func (v *SyntacticVerifier) MultisigImportTx(tx *txs.MultisigImportTx) error {
+	if len(tx.Aliases) == 0 {
+		return errNoMultisigAliases
+	}
+
+	for i, alias := range tx.Aliases {
+		if i > 0 && bytes.Compare(tx.Aliases[i-1].ID[:], alias.ID[:]) >= 0 {
+			return errMultisigAliasesNotSortedUnique
+		}
+		if err := alias.Verify(); err != nil {
+			return err
+		}
+	}
+
+	if tx.SourceChain != constants.PlatformChainID {
+		return errNotPlatformSourceChain
+	}
+
+	// Tx without imported utxos only syncs aliases published by P-chain
+	if len(tx.ImportedIns) == 0 {
+		return v.BaseTx(&tx.BaseTx)
+	}
+	return v.ImportTx(&tx.ImportTx)
+}
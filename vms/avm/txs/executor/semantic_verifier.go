@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -12,7 +22,9 @@ import (
 	"github.com/ava-labs/avalanchego/vms/avm/states"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
 var (
@@ -81,16 +93,23 @@ func (v *SemanticVerifier) OperationTx(tx *txs.OperationTx) error {
 }
 
 func (v *SemanticVerifier) ImportTx(tx *txs.ImportTx) error {
+	_, err := v.verifyImportTx(tx)
+	return err
+}
+
+// verifyImportTx verifies [tx] and returns multisig aliases, that were attached
+// to imported utxos by the source chain.
+func (v *SemanticVerifier) verifyImportTx(tx *txs.ImportTx) (map[ids.ShortID]*multisig.AliasWithNonce, error) {
 	if err := v.BaseTx(&tx.BaseTx); err != nil {
-		return err
+		return nil, err
 	}
 
 	if !v.Bootstrapped {
-		return nil
+		return nil, nil
 	}
 
 	if err := verify.SameSubnet(context.TODO(), v.Ctx, tx.SourceChain); err != nil {
-		return err
+		return nil, err
 	}
 
 	utxoIDs := make([][]byte, len(tx.ImportedIns))
@@ -101,24 +120,40 @@ func (v *SemanticVerifier) ImportTx(tx *txs.ImportTx) error {
 
 	allUTXOBytes, err := v.Ctx.SharedMemory.Get(tx.SourceChain, utxoIDs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	offset := len(tx.Ins)
-	for i, in := range tx.ImportedIns {
-		utxo := avax.UTXO{}
-		if _, err := v.Codec.Unmarshal(allUTXOBytes[i], &utxo); err != nil {
-			return err
+	utxos := make([]*avax.UTXO, len(tx.ImportedIns))
+	importedAliases := make(map[ids.ShortID]*multisig.AliasWithNonce)
+	for i := range tx.ImportedIns {
+		utxo, aliases, err := v.unmarshalImportedUTXO(tx.SourceChain, allUTXOBytes[i])
+		if err != nil {
+			return nil, err
 		}
+		for _, aliasIntf := range aliases {
+			alias, ok := aliasIntf.(*multisig.AliasWithNonce)
+			if !ok {
+				return nil, errWrongImportedAliasType
+			}
+			importedAliases[alias.ID] = alias
+		}
+		utxos[i] = utxo
+	}
 
+	msig := &importedAliasGetter{
+		aliases: importedAliases,
+		state:   v.State,
+	}
+	offset := len(tx.Ins)
+	for i, in := range tx.ImportedIns {
 		// Note: Verification of the length of [t.tx.Creds] happens during
 		// syntactic verification, which happens before semantic verification.
 		cred := v.Tx.Creds[i+offset].Verifiable
-		if err := v.verifyTransferOfUTXO(tx, in, cred, &utxo); err != nil {
-			return err
+		if err := v.verifyTransferOfUTXO(tx, in, cred, utxos[i], msig); err != nil {
+			return nil, err
 		}
 	}
-	return nil
+	return importedAliases, nil
 }
 
 func (v *SemanticVerifier) ExportTx(tx *txs.ExportTx) error {
@@ -155,7 +190,7 @@ func (v *SemanticVerifier) verifyTransfer(
 	if err != nil {
 		return err
 	}
-	return v.verifyTransferOfUTXO(tx, in, cred, utxo)
+	return v.verifyTransferOfUTXO(tx, in, cred, utxo, v.State)
 }
 
 func (v *SemanticVerifier) verifyTransferOfUTXO(
@@ -163,6 +198,7 @@ func (v *SemanticVerifier) verifyTransferOfUTXO(
 	in *avax.TransferableInput,
 	cred verify.Verifiable,
 	utxo *avax.UTXO,
+	msig secp256k1fx.AliasGetter,
 ) error {
 	utxoAssetID := utxo.AssetID()
 	inAssetID := in.AssetID()
@@ -180,6 +216,13 @@ func (v *SemanticVerifier) verifyTransferOfUTXO(
 	}
 
 	fx := v.Fxs[fxIndex].Fx
+	msigFx, err := v.getMultisigFx(fx, cred)
+	if err != nil {
+		return err
+	}
+	if msigFx != nil {
+		return msigFx.VerifyTransferWithAliases(tx, in.In, cred, utxo.Out, msig)
+	}
 	return fx.VerifyTransfer(tx, in.In, cred, utxo.Out)
 }
 
@@ -216,6 +259,13 @@ func (v *SemanticVerifier) verifyOperation(
 	}
 
 	fx := v.Fxs[fxIndex].Fx
+	msigFx, err := v.getMultisigFx(fx, cred)
+	if err != nil {
+		return err
+	}
+	if msigFx != nil {
+		return msigFx.VerifyOperationWithAliases(tx, op.Op, cred, utxos, v.State)
+	}
 	return fx.VerifyOperation(tx, op.Op, cred, utxos)
 }
 
@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -15,6 +25,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/vms/avm/fxs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
 // CodecVersion is the current default codec version
@@ -39,8 +50,8 @@ type Parser interface {
 type parser struct {
 	cm  codec.Manager
 	gcm codec.Manager
-	c   linearcodec.Codec
-	gc  linearcodec.Codec
+	c   linearcodec.CaminoCodec
+	gc  linearcodec.CaminoCodec
 }
 
 func NewParser(fxs []fxs.Fx) (Parser, error) {
@@ -58,8 +69,8 @@ func NewCustomParser(
 	log logging.Logger,
 	fxs []fxs.Fx,
 ) (Parser, error) {
-	gc := linearcodec.New([]string{reflectcodec.DefaultTagName}, 1<<20)
-	c := linearcodec.NewDefault()
+	gc := linearcodec.NewCamino([]string{reflectcodec.DefaultTagName}, 1<<20)
+	c := linearcodec.NewCaminoDefault()
 
 	gcm := codec.NewManager(math.MaxInt32)
 	cm := codec.NewDefaultManager()
@@ -71,6 +82,9 @@ func NewCustomParser(
 		c.RegisterType(&OperationTx{}),
 		c.RegisterType(&ImportTx{}),
 		c.RegisterType(&ExportTx{}),
+		c.RegisterCustomType(&MultisigImportTx{}),
+		c.RegisterCustomType(&secp256k1fx.OutputOwners{}),
+		c.RegisterCustomType(&secp256k1fx.WeightedOutputOwners{}),
 		cm.RegisterCodec(CodecVersion, c),
 
 		gc.RegisterType(&BaseTx{}),
@@ -78,6 +92,9 @@ func NewCustomParser(
 		gc.RegisterType(&OperationTx{}),
 		gc.RegisterType(&ImportTx{}),
 		gc.RegisterType(&ExportTx{}),
+		gc.RegisterCustomType(&MultisigImportTx{}),
+		gc.RegisterCustomType(&secp256k1fx.OutputOwners{}),
+		gc.RegisterCustomType(&secp256k1fx.WeightedOutputOwners{}),
 		gcm.RegisterCodec(CodecVersion, gc),
 	)
 	if errs.Errored() {
@@ -91,7 +108,7 @@ func NewCustomParser(
 	}
 	for i, fx := range fxs {
 		vm.codecRegistry = &codecRegistry{
-			codecs:      []codec.Registry{gc, c},
+			codecs:      []codec.CaminoRegistry{gc, c},
 			index:       i,
 			typeToIndex: vm.typeToFxIndex,
 		}
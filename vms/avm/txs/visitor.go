@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -14,6 +24,7 @@ type Visitor interface {
 	OperationTx(*OperationTx) error
 	ImportTx(*ImportTx) error
 	ExportTx(*ExportTx) error
+	MultisigImportTx(*MultisigImportTx) error
 }
 
 // utxoGetter returns the UTXOs transaction is producing.
@@ -46,6 +57,10 @@ func (u *utxoGetter) ExportTx(tx *ExportTx) error {
 	return u.BaseTx(&tx.BaseTx)
 }
 
+func (u *utxoGetter) MultisigImportTx(tx *MultisigImportTx) error {
+	return u.BaseTx(&tx.BaseTx)
+}
+
 func (u *utxoGetter) CreateAssetTx(t *CreateAssetTx) error {
 	if err := u.BaseTx(&t.BaseTx); err != nil {
 		return err
@@ -9,6 +9,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/types"
 )
@@ -16,6 +17,8 @@ import (
 // MaxMemoSize is the maximum number of bytes in the memo field
 const MaxMemoSize = 256
 
+var aliasUpdateKeyPrefix = []byte("aliasUpdate")
+
 type Alias struct {
 	ID     ids.ShortID         `serialize:"true" json:"id"`
 	Memo   types.JSONByteSlice `serialize:"true" json:"memo"`
@@ -48,3 +51,15 @@ func (ma *Alias) VerifyState() error {
 func ComputeAliasID(txID ids.ID) ids.ShortID {
 	return hashing.ComputeHash160Array(txID[:])
 }
+
+// AliasUpdateKey returns the shared memory key of the alias definition with
+// [nonce], that P-chain publishes for other chains of the primary network.
+func AliasUpdateKey(aliasID ids.ShortID, nonce uint64) ids.ID {
+	packer := wrappers.Packer{
+		Bytes: make([]byte, len(aliasUpdateKeyPrefix)+len(aliasID)+wrappers.LongLen),
+	}
+	packer.PackFixedBytes(aliasUpdateKeyPrefix)
+	packer.PackFixedBytes(aliasID[:])
+	packer.PackLong(nonce)
+	return hashing.ComputeHash256Array(packer.Bytes)
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/formatting"
@@ -17,6 +18,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/vms/types"
@@ -46,10 +48,11 @@ type Camino struct {
 	ValidatorConsortiumMembers []ids.ShortID          `json:"validatorConsortiumMembers"`
 	UTXODeposits               []UTXODeposit          `json:"utxoDeposits"`
 	MultisigAliases            []*multisig.Alias      `json:"multisigAliases"`
+	TreasuryConfig             *treasury.Config       `json:"treasuryConfig,omitempty"`
 }
 
 func (c Camino) ParseToGenesis() genesis.Camino {
-	return genesis.Camino{
+	caminoGenesis := genesis.Camino{
 		VerifyNodeSignature: c.VerifyNodeSignature,
 		LockModeBondDeposit: c.LockModeBondDeposit,
 		InitialAdmin:        c.InitialAdmin,
@@ -57,6 +60,11 @@ func (c Camino) ParseToGenesis() genesis.Camino {
 		DepositOffers:       c.DepositOffers,
 		MultisigAliases:     c.MultisigAliases,
 	}
+	if c.TreasuryConfig != nil {
+		caminoGenesis.UpgradeVersionID = codec.UpgradeVersion1
+		caminoGenesis.TreasuryConfig = *c.TreasuryConfig
+	}
+	return caminoGenesis
 }
 
 // BuildGenesis build the genesis state of the Platform Chain (and thereby the Avalanche network.)
@@ -70,6 +78,11 @@ func buildCaminoGenesis(args *BuildGenesisArgs, reply *BuildGenesisReply) error
 	if len(args.Camino.ValidatorDeposits) != len(args.Validators) {
 		return errWrongValidatorNumber
 	}
+	if args.Camino.TreasuryConfig != nil {
+		if err := args.Camino.TreasuryConfig.Verify(); err != nil {
+			return fmt.Errorf("invalid treasury config: %w", err)
+		}
+	}
 	for i := range args.Validators {
 		if len(args.Camino.ValidatorDeposits[i]) != len(args.Validators[i].Staked) {
 			return errWrongDepositsAndStakedNumber
@@ -77,7 +77,7 @@ func caminoBuildBlock(
 		return nil, fmt.Errorf("could not find next deposits to unlock: %w", err)
 	}
 	if shouldUnlock {
-		unlockDepositTx, err := txBuilder.NewSystemUnlockDepositTx(depositsTxIDs)
+		unlockDepositTx, err := txBuilder.NewSystemUnlockDepositTx(depositsTxIDs, timestamp)
 		if err != nil {
 			return nil, fmt.Errorf("could not build tx to unlock deposits: %w", err)
 		}
@@ -90,6 +90,31 @@ func caminoBuildBlock(
 		)
 	}
 
+	// proposals could exist only after BerlinPhase
+	if !builder.txExecutorBackend.Config.IsBerlinPhaseActivated(timestamp) {
+		return nil, nil
+	}
+
+	// deposited tokens could also be bonded for proposal,
+	// so proposals are finished in separate block after deposits are unlocked
+	proposalIDs, shouldFinish, err := getNextProposalsToFinish(parentState, timestamp)
+	if err != nil {
+		return nil, fmt.Errorf("could not find next proposals to finish: %w", err)
+	}
+	if shouldFinish {
+		finishProposalsTx, err := txBuilder.NewSystemFinishProposalsTx(proposalIDs)
+		if err != nil {
+			return nil, fmt.Errorf("could not build tx to finish proposals: %w", err)
+		}
+
+		return blocks.NewBanffStandardBlock(
+			timestamp,
+			parentID,
+			height,
+			[]*txs.Tx{finishProposalsTx},
+		)
+	}
+
 	return nil, nil
 }
 
@@ -133,3 +158,21 @@ func getNextDepositsToUnlock(
 
 	return nextDeposits, nextDepositsEndtime.Equal(chainTime), nil
 }
+
+func getNextProposalsToFinish(
+	preferredState state.Chain,
+	chainTime time.Time,
+) ([]ids.ID, bool, error) {
+	if !chainTime.Before(mockable.MaxTime) {
+		return nil, false, errEndOfTime
+	}
+
+	nextProposals, nextProposalsEndtime, err := preferredState.GetNextToExpireProposalIDsAndTime(nil)
+	if err == database.ErrNotFound {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+
+	return nextProposals, nextProposalsEndtime.Equal(chainTime), nil
+}
@@ -5,8 +5,13 @@ package platformvm
 
 import (
 	"context"
+	"errors"
+
+	"github.com/gorilla/rpc/v2/json2"
 
 	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/rpc"
 )
 
@@ -15,7 +20,17 @@ type CaminoClient interface {
 	GetConfiguration(ctx context.Context, options ...rpc.Option) (*GetConfigurationReply, error)
 
 	// GetMultisigAlias returns the alias definition of the given multisig address
+	// or database.ErrNotFound, if the given address isn't multisig alias
 	GetMultisigAlias(ctx context.Context, multisigAddress string, options ...rpc.Option) (*GetMultisigAliasReply, error)
+
+	// GetMultisigAliasesByMember returns multisig aliases, that have the given address as their owner
+	GetMultisigAliasesByMember(ctx context.Context, memberAddress string, options ...rpc.Option) (*GetMultisigAliasesByMemberReply, error)
+
+	// GetAddressStates returns the address states bitmask of the given address
+	GetAddressStates(ctx context.Context, address string, options ...rpc.Option) (uint64, error)
+
+	// GetKYCExpiration returns the time, when kyc verification of the given address will expire, zero if it doesn't expire
+	GetKYCExpiration(ctx context.Context, address string, options ...rpc.Option) (uint64, error)
 }
 
 func (c *client) GetConfiguration(ctx context.Context, options ...rpc.Option) (*GetConfigurationReply, error) {
@@ -29,5 +44,33 @@ func (c *client) GetMultisigAlias(ctx context.Context, multisigAddress string, o
 	err := c.requester.SendRequest(ctx, "platform.getMultisigAlias", &api.JSONAddress{
 		Address: multisigAddress,
 	}, res, options...)
+	var rpcErr *json2.Error
+	if errors.As(err, &rpcErr) && rpcErr.Code == errCodeNotFound {
+		return nil, database.ErrNotFound
+	}
 	return res, err
 }
+
+func (c *client) GetMultisigAliasesByMember(ctx context.Context, memberAddress string, options ...rpc.Option) (*GetMultisigAliasesByMemberReply, error) {
+	res := &GetMultisigAliasesByMemberReply{}
+	err := c.requester.SendRequest(ctx, "platform.getMultisigAliasesByMember", &api.JSONAddress{
+		Address: memberAddress,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetAddressStates(ctx context.Context, address string, options ...rpc.Option) (uint64, error) {
+	res := json.Uint64(0)
+	err := c.requester.SendRequest(ctx, "platform.getAddressStates", &api.JSONAddress{
+		Address: address,
+	}, &res, options...)
+	return uint64(res), err
+}
+
+func (c *client) GetKYCExpiration(ctx context.Context, address string, options ...rpc.Option) (uint64, error) {
+	res := &GetKYCExpirationReply{}
+	err := c.requester.SendRequest(ctx, "platform.getKYCExpiration", &api.JSONAddress{
+		Address: address,
+	}, res, options...)
+	return uint64(res.KYCExpiration), err
+}
@@ -10,26 +10,35 @@ import (
 	"net/http"
 
 	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/chains/atomic"
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/formatting"
 	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/keystore"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/builder"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/vms/types"
+	"github.com/gorilla/rpc/v2/json2"
 	"go.uber.org/zap"
 
 	utilsjson "github.com/ava-labs/avalanchego/utils/json"
 	platformapi "github.com/ava-labs/avalanchego/vms/platformvm/api"
 )
 
+// errCodeNotFound is json-rpc error code, that is returned, when requested item doesn't exist
+const errCodeNotFound json2.ErrorCode = -32001
+
 var (
 	errInvalidChangeAddr      = "couldn't parse changeAddr: %w"
 	errCreateTx               = "couldn't create tx: %w"
@@ -38,8 +47,12 @@ var (
 	errEncodeTransferables    = errors.New("can't encode transferables as string")
 	ErrWrongOwnerType         = errors.New("wrong owner type")
 	errSerializeOwners        = errors.New("can't serialize owners")
+	errNoDepositCreator       = errors.New("deposit offer has eligibility rules, but deposit creator address is empty")
 )
 
+// Default number of deposit schedule points returned by simulateDeposit
+const defaultDepositScheduleSteps = 10
+
 // CaminoService defines the API calls that can be made to the platform chain
 type CaminoService struct {
 	Service
@@ -314,12 +327,114 @@ func (s *CaminoService) GetAddressStates(_ *http.Request, args *api.JSONAddress,
 	return nil
 }
 
+type GetKYCExpirationReply struct {
+	// Timestamp, when kyc verification will expire, zero if it doesn't expire
+	KYCExpiration utilsjson.Uint64 `json:"kycExpiration"`
+}
+
+// GetKYCExpiration returns the time, when kyc verification of an address will expire
+func (s *CaminoService) GetKYCExpiration(_ *http.Request, args *api.JSONAddress, response *GetKYCExpirationReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetKYCExpiration called")
+
+	addr, err := avax.ParseServiceAddress(s.addrManager, args.Address)
+	if err != nil {
+		return err
+	}
+
+	kycExpiration, err := s.vm.state.GetKYCExpiration(addr)
+	if err != nil {
+		return err
+	}
+
+	response.KYCExpiration = utilsjson.Uint64(kycExpiration)
+
+	return nil
+}
+
+type GetAddressStateHistoryArgs struct {
+	// Address, which state changes will be returned
+	Address string `json:"address"`
+	// Start of time range, inclusive
+	StartTime utilsjson.Uint64 `json:"startTime"`
+	// Tx ID of the last change made at StartTime, that was already returned, exclusive
+	StartIndex ids.ID `json:"startIndex"`
+	// Max number of changes to return
+	Limit utilsjson.Uint32 `json:"limit"`
+}
+
+type APIAddressStateChange struct {
+	TxID      ids.ID              `json:"txID"`      // ID of address state tx, that made this change, or id of automatic change
+	Timestamp utilsjson.Uint64    `json:"timestamp"` // Chain time, when change was made
+	State     txs.AddressStateBit `json:"state"`     // Address state bit, that was added or removed
+	Remove    bool                `json:"remove"`    // True, if bit was removed
+	Executors []string            `json:"executors"` // Tx executor or, for txs without executor, addresses that signed tx
+}
+
+type GetAddressStateHistoryReply struct {
+	// Address state changes, sorted by time
+	Changes []APIAddressStateChange `json:"changes"`
+	// Time and tx ID of the last returned change,
+	// should be used as StartTime and StartIndex for the next page
+	EndTime  utilsjson.Uint64 `json:"endTime"`
+	EndIndex ids.ID           `json:"endIndex"`
+}
+
+// GetAddressStateHistory returns address state changes made by address state txs
+// or automatically on chain time advance for given address
+func (s *CaminoService) GetAddressStateHistory(_ *http.Request, args *GetAddressStateHistoryArgs, reply *GetAddressStateHistoryReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetAddressStateHistory called")
+
+	addr, err := avax.ParseServiceAddress(s.addrManager, args.Address)
+	if err != nil {
+		return fmt.Errorf("couldn't parse address %q: %w", args.Address, err)
+	}
+
+	limit := int(args.Limit)
+	if limit <= 0 || builder.MaxPageSize < limit {
+		limit = builder.MaxPageSize
+	}
+
+	changes, err := s.vm.state.GetAddressStateChanges(addr, uint64(args.StartTime), args.StartIndex, limit)
+	if err != nil {
+		return fmt.Errorf("couldn't get address state changes: %w", err)
+	}
+
+	reply.Changes = make([]APIAddressStateChange, len(changes))
+	reply.EndTime = args.StartTime
+	reply.EndIndex = args.StartIndex
+	for i, change := range changes {
+		executors := make([]string, len(change.Executors))
+		for j, executor := range change.Executors {
+			executors[j], err = s.addrManager.FormatLocalAddress(executor)
+			if err != nil {
+				return err
+			}
+		}
+		reply.Changes[i] = APIAddressStateChange{
+			TxID:      change.TxID,
+			Timestamp: utilsjson.Uint64(change.Timestamp),
+			State:     change.Bit,
+			Remove:    change.Remove,
+			Executors: executors,
+		}
+		reply.EndTime = utilsjson.Uint64(change.Timestamp)
+		reply.EndIndex = change.TxID
+	}
+
+	return nil
+}
+
 type GetMultisigAliasReply struct {
 	Memo types.JSONByteSlice `json:"memo"`
 	platformapi.Owner
+	// Weights of owner addresses, only set for weighted multisig aliases
+	Weights []utilsjson.Uint32 `json:"weights,omitempty"`
+	// Alias nonce, incremented with every alias update
+	Nonce utilsjson.Uint64 `json:"nonce"`
 }
 
-// GetMultisigAlias retrieves the owners and threshold for a given multisig alias
+// GetMultisigAlias retrieves the owners and threshold for a given multisig alias.
+// If given address isn't multisig alias, returned json-rpc error has errCodeNotFound code.
 func (s *CaminoService) GetMultisigAlias(_ *http.Request, args *api.JSONAddress, response *GetMultisigAliasReply) error {
 	s.vm.ctx.Log.Debug("Platform: GetMultisigAlias called")
 
@@ -329,20 +444,35 @@ func (s *CaminoService) GetMultisigAlias(_ *http.Request, args *api.JSONAddress,
 	}
 
 	alias, err := s.vm.state.GetMultisigAlias(addr)
-	if err != nil {
+	if err == database.ErrNotFound {
+		return &json2.Error{Code: errCodeNotFound, Message: err.Error()}
+	} else if err != nil {
 		return err
 	}
-	owners, ok := alias.Owners.(*secp256k1fx.OutputOwners)
-	if !ok {
+
+	var addrs []ids.ShortID
+	switch owners := alias.Owners.(type) {
+	case *secp256k1fx.OutputOwners:
+		response.Locktime = utilsjson.Uint64(owners.Locktime)
+		response.Threshold = utilsjson.Uint32(owners.Threshold)
+		addrs = owners.Addrs
+	case *secp256k1fx.WeightedOutputOwners:
+		response.Locktime = utilsjson.Uint64(owners.Locktime)
+		response.Threshold = utilsjson.Uint32(owners.Threshold)
+		response.Weights = make([]utilsjson.Uint32, len(owners.Weights))
+		for i, weight := range owners.Weights {
+			response.Weights[i] = utilsjson.Uint32(weight)
+		}
+		addrs = owners.Addrs
+	default:
 		return ErrWrongOwnerType
 	}
 
 	response.Memo = alias.Memo
-	response.Locktime = utilsjson.Uint64(owners.Locktime)
-	response.Threshold = utilsjson.Uint32(owners.Threshold)
-	response.Addresses = make([]string, len(owners.Addrs))
+	response.Nonce = utilsjson.Uint64(alias.Nonce)
+	response.Addresses = make([]string, len(addrs))
 
-	for index, addr := range owners.Addrs {
+	for index, addr := range addrs {
 		addrString, err := s.addrManager.FormatLocalAddress(addr)
 		if err != nil {
 			return err
@@ -353,6 +483,36 @@ func (s *CaminoService) GetMultisigAlias(_ *http.Request, args *api.JSONAddress,
 	return nil
 }
 
+type GetMultisigAliasesByMemberReply struct {
+	// Multisig aliases, that have given address as their owner directly or through nested aliases
+	Aliases []string `json:"aliases"`
+}
+
+// GetMultisigAliasesByMember returns multisig aliases, that given address can sign for
+func (s *CaminoService) GetMultisigAliasesByMember(_ *http.Request, args *api.JSONAddress, response *GetMultisigAliasesByMemberReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetMultisigAliasesByMember called")
+
+	addr, err := avax.ParseServiceAddress(s.addrManager, args.Address)
+	if err != nil {
+		return err
+	}
+
+	aliasIDs, err := s.vm.state.GetMultisigAliasesByMember(addr)
+	if err != nil {
+		return err
+	}
+
+	response.Aliases = make([]string, len(aliasIDs))
+	for i, aliasID := range aliasIDs {
+		response.Aliases[i], err = s.addrManager.FormatLocalAddress(aliasID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type SpendArgs struct {
 	api.JSONFromAddrs
 
@@ -648,6 +808,193 @@ func (s *CaminoService) GetRegisteredShortIDLink(_ *http.Request, args *api.JSON
 	return nil
 }
 
+type APIRegisteredNode struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	// Address of consortium member, that registered node
+	ConsortiumMemberAddress string `json:"consortiumMemberAddress"`
+	// Empty, if node was registered in genesis or before registration txs were recorded
+	RegistrationTxID ids.ID `json:"registrationTxID"`
+	// True, if node is in current primary network validator set
+	Validating bool `json:"validating"`
+	// True, if node is in pending primary network validator set
+	Pending bool `json:"pending"`
+	// True, if node is in deferred primary network validator set
+	Deferred bool `json:"deferred"`
+}
+
+type GetRegisteredNodesReply struct {
+	Nodes []APIRegisteredNode `json:"nodes"`
+}
+
+// GetRegisteredNodes returns all nodes registered by consortium members
+func (s *CaminoService) GetRegisteredNodes(_ *http.Request, _ *struct{}, reply *GetRegisteredNodesReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetRegisteredNodes called")
+
+	links, err := s.vm.state.GetShortIDLinks(state.ShortLinkKeyRegisterNode)
+	if err != nil {
+		return fmt.Errorf("couldn't get registered nodes: %w", err)
+	}
+
+	reply.Nodes = []APIRegisteredNode{}
+	for _, link := range links {
+		// links are stored in both directions: node -> consortium member and consortium member -> node
+		nodeID := ids.NodeID(link.ID)
+		registrationTxID, err := s.vm.state.GetNodeRegistrationTxID(nodeID)
+		switch {
+		case err == database.ErrNotFound:
+			isNode, err := s.isNodeLink(link)
+			if err != nil {
+				return err
+			}
+			if !isNode {
+				continue
+			}
+		case err != nil:
+			return err
+		}
+
+		consortiumMemberAddr, err := s.addrManager.FormatLocalAddress(link.Link)
+		if err != nil {
+			return err
+		}
+
+		node := APIRegisteredNode{
+			NodeID:                  nodeID,
+			ConsortiumMemberAddress: consortiumMemberAddr,
+			RegistrationTxID:        registrationTxID,
+		}
+		node.Validating, node.Pending, node.Deferred, err = s.validatorStatus(nodeID)
+		if err != nil {
+			return err
+		}
+		reply.Nodes = append(reply.Nodes, node)
+	}
+
+	return nil
+}
+
+// Returns true, if [link] is from node to consortium member. Used for links
+// without recorded registration tx, which direction is resolved by consortium
+// member address state or, if it wasn't enough, by validator sets.
+func (s *CaminoService) isNodeLink(link state.ShortIDLink) (bool, error) {
+	if _, err := s.vm.state.GetNodeRegistrationTxID(ids.NodeID(link.Link)); err == nil {
+		return false, nil
+	} else if err != database.ErrNotFound {
+		return false, err
+	}
+
+	idAddressState, err := s.vm.state.GetAddressStates(link.ID)
+	if err != nil {
+		return false, err
+	}
+	linkAddressState, err := s.vm.state.GetAddressStates(link.Link)
+	if err != nil {
+		return false, err
+	}
+	idIsConsortiumMember := idAddressState&txs.AddressStateConsortiumMember != 0
+	linkIsConsortiumMember := linkAddressState&txs.AddressStateConsortiumMember != 0
+	if idIsConsortiumMember != linkIsConsortiumMember {
+		return linkIsConsortiumMember, nil
+	}
+
+	idValidating, idPending, idDeferred, err := s.validatorStatus(ids.NodeID(link.ID))
+	if err != nil {
+		return false, err
+	}
+	linkValidating, linkPending, linkDeferred, err := s.validatorStatus(ids.NodeID(link.Link))
+	if err != nil {
+		return false, err
+	}
+	return (idValidating || idPending || idDeferred) && !(linkValidating || linkPending || linkDeferred), nil
+}
+
+// Returns if node is in current, pending or deferred primary network validator set
+func (s *CaminoService) validatorStatus(nodeID ids.NodeID) (current, pending, deferred bool, err error) {
+	if _, err := s.vm.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID); err == nil {
+		current = true
+	} else if err != database.ErrNotFound {
+		return false, false, false, err
+	}
+	if _, err := s.vm.state.GetPendingValidator(constants.PrimaryNetworkID, nodeID); err == nil {
+		pending = true
+	} else if err != database.ErrNotFound {
+		return false, false, false, err
+	}
+	if _, err := s.vm.state.GetDeferredValidator(constants.PrimaryNetworkID, nodeID); err == nil {
+		deferred = true
+	} else if err != database.ErrNotFound {
+		return false, false, false, err
+	}
+	return current, pending, deferred, nil
+}
+
+type APIDeferredValidator struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	// ID of tx, that added validator
+	TxID      ids.ID           `json:"txID"`
+	StartTime utilsjson.Uint64 `json:"startTime"`
+	EndTime   utilsjson.Uint64 `json:"endTime"`
+	Weight    utilsjson.Uint64 `json:"weight"`
+	// Address of consortium member, that registered node
+	ConsortiumMemberAddress string `json:"consortiumMemberAddress"`
+	// ID of address state tx, that deferred node. Empty, if deferral wasn't recorded
+	DeferralTxID ids.ID `json:"deferralTxID"`
+	// Chain time, when node was deferred. Zero, if deferral wasn't recorded
+	DeferralStartTime utilsjson.Uint64 `json:"deferralStartTime"`
+	// Chain time, when node will be resumed automatically. Zero means no automatic resume
+	DeferralEndTime utilsjson.Uint64 `json:"deferralEndTime"`
+}
+
+type GetDeferredValidatorsReply struct {
+	Validators []APIDeferredValidator `json:"validators"`
+}
+
+// GetDeferredValidators returns primary network validators, which nodes are deferred
+func (s *CaminoService) GetDeferredValidators(_ *http.Request, _ *struct{}, reply *GetDeferredValidatorsReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetDeferredValidators called")
+
+	deferredStakerIterator, err := s.vm.state.GetDeferredStakerIterator()
+	if err != nil {
+		return fmt.Errorf("couldn't get deferred stakers: %w", err)
+	}
+	defer deferredStakerIterator.Release()
+
+	reply.Validators = []APIDeferredValidator{}
+	for deferredStakerIterator.Next() {
+		staker := deferredStakerIterator.Value()
+		validator := APIDeferredValidator{
+			NodeID:    staker.NodeID,
+			TxID:      staker.TxID,
+			StartTime: utilsjson.Uint64(staker.StartTime.Unix()),
+			EndTime:   utilsjson.Uint64(staker.EndTime.Unix()),
+			Weight:    utilsjson.Uint64(staker.Weight),
+		}
+
+		consortiumMemberAddr, err := s.vm.state.GetShortIDLink(ids.ShortID(staker.NodeID), state.ShortLinkKeyRegisterNode)
+		if err != nil {
+			return fmt.Errorf("couldn't get consortium member of node %s: %w", staker.NodeID, err)
+		}
+		validator.ConsortiumMemberAddress, err = s.addrManager.FormatLocalAddress(consortiumMemberAddr)
+		if err != nil {
+			return err
+		}
+
+		nodeDeferral, err := s.vm.state.GetNodeDeferral(consortiumMemberAddr)
+		switch {
+		case err == nil:
+			validator.DeferralTxID = nodeDeferral.TxID
+			validator.DeferralStartTime = utilsjson.Uint64(nodeDeferral.Start)
+			validator.DeferralEndTime = utilsjson.Uint64(nodeDeferral.End)
+		case err != database.ErrNotFound:
+			return err
+		}
+
+		reply.Validators = append(reply.Validators, validator)
+	}
+
+	return nil
+}
+
 type APIClaimable struct {
 	RewardOwner           platformapi.Owner `json:"rewardOwner"`
 	ValidatorRewards      utilsjson.Uint64  `json:"validatorRewards"`
@@ -695,6 +1042,221 @@ func (s *CaminoService) GetClaimables(_ *http.Request, args *GetClaimablesArgs,
 	return nil
 }
 
+type APIRewardsImportUTXO struct {
+	UTXOID    ids.ID           `json:"utxoID"`
+	Amount    utilsjson.Uint64 `json:"amount"`
+	Timestamp utilsjson.Uint64 `json:"timestamp"`
+	// True, if utxo is old enough to be imported
+	Importable bool `json:"importable"`
+}
+
+type GetRewardsImportStatusReply struct {
+	// C-chain fee utxos, that are exported to treasury, but not imported yet
+	PendingUTXOs []APIRewardsImportUTXO `json:"pendingUTXOs"`
+	// Total amount of pending utxos
+	PendingAmount utilsjson.Uint64 `json:"pendingAmount"`
+	// Total amount of pending utxos, that are old enough to be imported
+	ImportableAmount utilsjson.Uint64 `json:"importableAmount"`
+	// Number of already imported utxos
+	ImportedUTXOs utilsjson.Uint64 `json:"importedUTXOs"`
+	// Total amount of already imported utxos
+	ImportedAmount utilsjson.Uint64 `json:"importedAmount"`
+	LastImportTxID ids.ID           `json:"lastImportTxID"`
+	LastImportTime utilsjson.Uint64 `json:"lastImportTime"`
+	NotDistributed utilsjson.Uint64 `json:"notDistributed"`
+	ChainTimestamp utilsjson.Uint64 `json:"chainTimestamp"`
+}
+
+// GetRewardsImportStatus returns c-chain fee utxos pending for import and totals of already imported rewards
+func (s *CaminoService) GetRewardsImportStatus(_ *http.Request, _ *struct{}, reply *GetRewardsImportStatusReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetRewardsImportStatus called")
+
+	importProgress, err := s.vm.state.GetRewardsImportProgress()
+	if err != nil {
+		return fmt.Errorf("couldn't get rewards import progress: %w", err)
+	}
+
+	notDistributed, err := s.vm.state.GetNotDistributedValidatorReward()
+	if err != nil {
+		return fmt.Errorf("couldn't get not distributed validator reward: %w", err)
+	}
+
+	utxos, err := builder.GetTreasuryTimedUTXOs(s.vm.ctx.SharedMemory, s.vm.ctx.CChainID)
+	if err != nil {
+		return err
+	}
+
+	chainTimestamp := uint64(s.vm.state.GetTimestamp().Unix())
+	pendingAmount := uint64(0)
+	importableAmount := uint64(0)
+	reply.PendingUTXOs = make([]APIRewardsImportUTXO, len(utxos))
+	for i, utxo := range utxos {
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			return locked.ErrWrongOutType
+		}
+		importable := utxo.Timestamp <= chainTimestamp-atomic.SharedMemorySyncBound
+		pendingAmount, err = math.Add64(pendingAmount, out.Amt)
+		if err != nil {
+			return err
+		}
+		if importable {
+			importableAmount += out.Amt
+		}
+		reply.PendingUTXOs[i] = APIRewardsImportUTXO{
+			UTXOID:     utxo.InputID(),
+			Amount:     utilsjson.Uint64(out.Amt),
+			Timestamp:  utilsjson.Uint64(utxo.Timestamp),
+			Importable: importable,
+		}
+	}
+
+	reply.PendingAmount = utilsjson.Uint64(pendingAmount)
+	reply.ImportableAmount = utilsjson.Uint64(importableAmount)
+	reply.ImportedUTXOs = utilsjson.Uint64(importProgress.ImportedUTXOs)
+	reply.ImportedAmount = utilsjson.Uint64(importProgress.ImportedAmount)
+	reply.LastImportTxID = importProgress.LastImportTxID
+	reply.LastImportTime = utilsjson.Uint64(importProgress.LastImportTime)
+	reply.NotDistributed = utilsjson.Uint64(notDistributed)
+	reply.ChainTimestamp = utilsjson.Uint64(chainTimestamp)
+	return nil
+}
+
+type APIValidatorReward struct {
+	NodeID      ids.NodeID        `json:"nodeID"`
+	RewardOwner platformapi.Owner `json:"rewardOwner"`
+	Amount      utilsjson.Uint64  `json:"amount"`
+}
+
+type APIValidatorRewardsDistribution struct {
+	TxID                           ids.ID               `json:"txID"`
+	Timestamp                      utilsjson.Uint64     `json:"timestamp"`
+	ImportedAmount                 utilsjson.Uint64     `json:"importedAmount"`
+	PreviouslyNotDistributedAmount utilsjson.Uint64     `json:"previouslyNotDistributedAmount"`
+	NotDistributedAmount           utilsjson.Uint64     `json:"notDistributedAmount"`
+	Rewards                        []APIValidatorReward `json:"rewards"`
+}
+
+type APIOwnerRewards struct {
+	RewardOwner platformapi.Owner `json:"rewardOwner"`
+	Amount      utilsjson.Uint64  `json:"amount"`
+}
+
+type GetValidatorRewardHistoryArgs struct {
+	// Start of time range, inclusive
+	StartTime utilsjson.Uint64 `json:"startTime"`
+	// End of time range, inclusive. If zero, current chain time is used
+	EndTime utilsjson.Uint64 `json:"endTime"`
+	// Tx ID of the last distribution made at StartTime, that was already returned, exclusive
+	StartIndex ids.ID `json:"startIndex"`
+	// Reward owners, which rewards will be returned. If empty, rewards of all owners are returned
+	Owners []platformapi.Owner `json:"owners"`
+	// Max number of distributions to look through
+	Limit utilsjson.Uint32 `json:"limit"`
+}
+
+type GetValidatorRewardHistoryReply struct {
+	// Distributions, that have rewards of requested owners
+	Distributions []APIValidatorRewardsDistribution `json:"distributions"`
+	// Total rewards of each reward owner in returned distributions
+	OwnerRewards []APIOwnerRewards `json:"ownerRewards"`
+	// Time and tx ID of the last looked through distribution,
+	// should be used as StartTime and StartIndex for the next page
+	EndTime  utilsjson.Uint64 `json:"endTime"`
+	EndIndex ids.ID           `json:"endIndex"`
+}
+
+// GetValidatorRewardHistory returns validator rewards distributions made in given time range
+func (s *CaminoService) GetValidatorRewardHistory(_ *http.Request, args *GetValidatorRewardHistoryArgs, reply *GetValidatorRewardHistoryReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetValidatorRewardHistory called")
+
+	endTime := uint64(args.EndTime)
+	if endTime == 0 {
+		endTime = uint64(s.vm.state.GetTimestamp().Unix())
+	}
+
+	limit := int(args.Limit)
+	if limit <= 0 || builder.MaxPageSize < limit {
+		limit = builder.MaxPageSize
+	}
+
+	ownerIDs := set.NewSet[ids.ID](len(args.Owners))
+	for i := range args.Owners {
+		owner, err := s.secpOwnerFromAPI(&args.Owners[i])
+		if err != nil {
+			return err
+		}
+		ownerID, err := txs.GetOwnerID(owner)
+		if err != nil {
+			return err
+		}
+		ownerIDs.Add(ownerID)
+	}
+
+	distributions, err := s.vm.state.GetValidatorRewardsDistributions(uint64(args.StartTime), args.StartIndex, endTime, limit)
+	if err != nil {
+		return fmt.Errorf("couldn't get validator rewards distributions: %w", err)
+	}
+
+	reply.Distributions = []APIValidatorRewardsDistribution{}
+	reply.OwnerRewards = []APIOwnerRewards{}
+	reply.EndTime = args.StartTime
+	reply.EndIndex = args.StartIndex
+
+	ownerRewardsIndexes := map[ids.ID]int{}
+	for _, distribution := range distributions {
+		reply.EndTime = utilsjson.Uint64(distribution.Timestamp)
+		reply.EndIndex = distribution.TxID
+
+		rewards := []APIValidatorReward{}
+		for _, reward := range distribution.Rewards {
+			ownerID, err := txs.GetOwnerID(reward.RewardOwner)
+			if err != nil {
+				return err
+			}
+			if ownerIDs.Len() > 0 && !ownerIDs.Contains(ownerID) {
+				continue
+			}
+
+			apiOwner, err := s.apiOwnerFromSECP(reward.RewardOwner)
+			if err != nil {
+				return err
+			}
+			rewards = append(rewards, APIValidatorReward{
+				NodeID:      reward.NodeID,
+				RewardOwner: *apiOwner,
+				Amount:      utilsjson.Uint64(reward.Amount),
+			})
+
+			ownerRewardsIndex, ok := ownerRewardsIndexes[ownerID]
+			if !ok {
+				ownerRewardsIndex = len(reply.OwnerRewards)
+				ownerRewardsIndexes[ownerID] = ownerRewardsIndex
+				reply.OwnerRewards = append(reply.OwnerRewards, APIOwnerRewards{RewardOwner: *apiOwner})
+			}
+			ownerAmount, err := math.Add64(uint64(reply.OwnerRewards[ownerRewardsIndex].Amount), reward.Amount)
+			if err != nil {
+				return err
+			}
+			reply.OwnerRewards[ownerRewardsIndex].Amount = utilsjson.Uint64(ownerAmount)
+		}
+
+		if len(rewards) == 0 && ownerIDs.Len() > 0 {
+			continue
+		}
+
+		reply.Distributions = append(reply.Distributions, APIValidatorRewardsDistribution{
+			TxID:                           distribution.TxID,
+			Timestamp:                      utilsjson.Uint64(distribution.Timestamp),
+			ImportedAmount:                 utilsjson.Uint64(distribution.ImportedAmount),
+			PreviouslyNotDistributedAmount: utilsjson.Uint64(distribution.PreviouslyNotDistributedAmount),
+			NotDistributedAmount:           utilsjson.Uint64(distribution.NotDistributedAmount),
+			Rewards:                        rewards,
+		})
+	}
+	return nil
+}
+
 type APIDeposit struct {
 	DepositTxID         ids.ID            `json:"depositTxID"`
 	DepositOfferID      ids.ID            `json:"depositOfferID"`
@@ -704,6 +1266,7 @@ type APIDeposit struct {
 	Duration            uint32            `json:"duration"`
 	Amount              utilsjson.Uint64  `json:"amount"`
 	RewardOwner         platformapi.Owner `json:"rewardOwner"`
+	Flags               utilsjson.Uint64  `json:"flags"`
 }
 
 func (s *CaminoService) apiDepositFromDeposit(depositTxID ids.ID, deposit *deposit.Deposit) (*APIDeposit, error) {
@@ -724,6 +1287,7 @@ func (s *CaminoService) apiDepositFromDeposit(depositTxID ids.ID, deposit *depos
 		Duration:            deposit.Duration,
 		Amount:              utilsjson.Uint64(deposit.Amount),
 		RewardOwner:         *apiOwner,
+		Flags:               utilsjson.Uint64(deposit.Flags),
 	}, nil
 }
 
@@ -762,6 +1326,76 @@ func (s *CaminoService) GetDeposits(_ *http.Request, args *GetDepositsArgs, repl
 	return nil
 }
 
+type GetDepositsByOwnerArgs struct {
+	// Address of deposited utxos owner or deposit reward owner
+	Address string `json:"address"`
+	// Deposit tx ID to start from, exclusive
+	StartIndex ids.ID `json:"startIndex"`
+	// Max number of deposits to return
+	Limit utilsjson.Uint32 `json:"limit"`
+}
+
+type GetDepositsByOwnerReply struct {
+	Deposits         []*APIDeposit      `json:"deposits"`
+	AvailableRewards []utilsjson.Uint64 `json:"availableRewards"`
+	// Offers of returned deposits
+	DepositOffers []*APIDepositOffer `json:"depositOffers"`
+	Timestamp     utilsjson.Uint64   `json:"timestamp"`
+	// Deposit tx ID of the last returned deposit, should be used as StartIndex for the next page
+	EndIndex ids.ID `json:"endIndex"`
+}
+
+// GetDepositsByOwner returns deposits that have given address as deposited utxos owner or reward owner
+func (s *CaminoService) GetDepositsByOwner(_ *http.Request, args *GetDepositsByOwnerArgs, reply *GetDepositsByOwnerReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetDepositsByOwner called")
+
+	addr, err := avax.ParseServiceAddress(s.addrManager, args.Address)
+	if err != nil {
+		return fmt.Errorf("couldn't parse address %q: %w", args.Address, err)
+	}
+
+	limit := int(args.Limit)
+	if limit <= 0 || builder.MaxPageSize < limit {
+		limit = builder.MaxPageSize
+	}
+
+	depositTxIDs, err := s.vm.state.GetDepositIDsByOwner(addr, args.StartIndex, limit)
+	if err != nil {
+		return fmt.Errorf("couldn't get deposit ids: %w", err)
+	}
+
+	timestamp := s.vm.clock.Unix()
+	reply.Deposits = make([]*APIDeposit, len(depositTxIDs))
+	reply.AvailableRewards = make([]utilsjson.Uint64, len(depositTxIDs))
+	reply.DepositOffers = []*APIDepositOffer{}
+	reply.Timestamp = utilsjson.Uint64(timestamp)
+	reply.EndIndex = args.StartIndex
+
+	offers := map[ids.ID]*deposit.Offer{}
+	for i, depositTxID := range depositTxIDs {
+		deposit, err := s.vm.state.GetDeposit(depositTxID)
+		if err != nil {
+			return fmt.Errorf("could't get deposit from state: %w", err)
+		}
+		offer, ok := offers[deposit.DepositOfferID]
+		if !ok {
+			offer, err = s.vm.state.GetDepositOffer(deposit.DepositOfferID)
+			if err != nil {
+				return err
+			}
+			offers[deposit.DepositOfferID] = offer
+			reply.DepositOffers = append(reply.DepositOffers, apiOfferFromOffer(offer))
+		}
+		reply.Deposits[i], err = s.apiDepositFromDeposit(depositTxID, deposit)
+		if err != nil {
+			return err
+		}
+		reply.AvailableRewards[i] = utilsjson.Uint64(deposit.ClaimableReward(offer, timestamp))
+		reply.EndIndex = depositTxID
+	}
+	return nil
+}
+
 // GetLastAcceptedBlock returns the last accepted block
 func (s *CaminoService) GetLastAcceptedBlock(r *http.Request, _ *struct{}, reply *api.GetBlockResponse) error {
 	s.vm.ctx.Log.Debug("Platform: GetLastAcceptedBlock called")
@@ -959,6 +1593,20 @@ type APIDepositOffer struct {
 	TotalMaxRewardAmount    utilsjson.Uint64    `json:"totalMaxRewardAmount"`    // Maximum amount that can be rewarded for all deposits created with this offer in total
 	RewardedAmount          utilsjson.Uint64    `json:"rewardedAmount"`          // Amount that was already rewarded (including potential rewards) for deposits created with this offer
 	OwnerAddress            ids.ShortID         `json:"ownerAddress"`            // Address that can sign deposit-creator permission
+
+	EarlyUnlockPenaltyRateNominator utilsjson.Uint64 `json:"earlyUnlockPenaltyRateNominator"` // deposit early unlocked amount * (earlyUnlockPenaltyRateNominator / 1_000_000) == penalty for unlocking before unlock period
+	SuccessorOfferID                ids.ID           `json:"successorOfferID"`                // ID of offer that expired rollover deposits will be re-deposited with
+
+	RequiredAddressState utilsjson.Uint64 `json:"requiredAddressState"` // Address state bits that deposit creator must have
+	AllowListEnabled     bool             `json:"allowListEnabled"`     // If true, deposit creator must be in offer allow-list
+
+	InterestRateTiers []APIInterestRateTier `json:"interestRateTiers"` // Tiers with interest rates, that are applied instead of base interest rate to deposits with not less duration and amount
+}
+
+type APIInterestRateTier struct {
+	MinDuration           uint32           `json:"minDuration"`           // Minimum deposit duration for this tier
+	MinAmount             utilsjson.Uint64 `json:"minAmount"`             // Minimum deposit amount for this tier
+	InterestRateNominator utilsjson.Uint64 `json:"interestRateNominator"` // Interest rate nominator, that is applied to deposits matching this tier
 }
 
 type GetAllDepositOffersArgs struct {
@@ -989,6 +1637,15 @@ func (s *CaminoService) GetAllDepositOffers(_ *http.Request, args *GetAllDeposit
 }
 
 func apiOfferFromOffer(offer *deposit.Offer) *APIDepositOffer {
+	var interestRateTiers []APIInterestRateTier
+	for _, tier := range offer.InterestRateTiers {
+		interestRateTiers = append(interestRateTiers, APIInterestRateTier{
+			MinDuration:           tier.MinDuration,
+			MinAmount:             utilsjson.Uint64(tier.MinAmount),
+			InterestRateNominator: utilsjson.Uint64(tier.InterestRateNominator),
+		})
+	}
+
 	return &APIDepositOffer{
 		UpgradeVersion:          offer.UpgradeVersionID.Version(),
 		ID:                      offer.ID,
@@ -1007,7 +1664,175 @@ func apiOfferFromOffer(offer *deposit.Offer) *APIDepositOffer {
 		TotalMaxRewardAmount:    utilsjson.Uint64(offer.TotalMaxRewardAmount),
 		RewardedAmount:          utilsjson.Uint64(offer.RewardedAmount),
 		OwnerAddress:            offer.OwnerAddress,
+
+		EarlyUnlockPenaltyRateNominator: utilsjson.Uint64(offer.EarlyUnlockPenaltyRateNominator),
+		SuccessorOfferID:                offer.SuccessorOfferID,
+
+		RequiredAddressState: utilsjson.Uint64(offer.RequiredAddressState),
+		AllowListEnabled:     offer.AllowListEnabled,
+
+		InterestRateTiers: interestRateTiers,
+	}
+}
+
+type GetDepositOfferAllowListArgs struct {
+	DepositOfferID ids.ID `json:"depositOfferID"`
+}
+
+type GetDepositOfferAllowListReply struct {
+	// Addresses, that are allowed to create deposits with this offer
+	Addresses []string `json:"addresses"`
+}
+
+// GetDepositOfferAllowList returns addresses from allow-list of given deposit offer
+func (s *CaminoService) GetDepositOfferAllowList(_ *http.Request, args *GetDepositOfferAllowListArgs, reply *GetDepositOfferAllowListReply) error {
+	s.vm.ctx.Log.Debug("Platform: GetDepositOfferAllowList called")
+
+	addrs, err := s.vm.state.GetDepositOfferAllowList(args.DepositOfferID)
+	if err != nil {
+		return err
+	}
+
+	reply.Addresses = make([]string, len(addrs))
+	for i, addr := range addrs {
+		reply.Addresses[i], err = s.addrManager.FormatLocalAddress(addr)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type SimulateDepositArgs struct {
+	// ID of deposit offer that will be used for deposit
+	DepositOfferID ids.ID `json:"depositOfferID"`
+	// Amount of tokens that will be deposited
+	Amount utilsjson.Uint64 `json:"amount"`
+	// Duration of deposit in seconds
+	Duration utilsjson.Uint32 `json:"duration"`
+	// Deposit flags
+	DepositFlags utilsjson.Uint64 `json:"depositFlags"`
+	// Address of deposit creator. Required for offers with eligibility rules
+	DepositCreatorAddress string `json:"depositCreatorAddress"`
+	// Number of points in returned schedule, defaults to 10
+	Steps utilsjson.Uint32 `json:"steps"`
+}
+
+type APIDepositSchedulePoint struct {
+	Timestamp        utilsjson.Uint64 `json:"timestamp"`        // Unix time in seconds
+	ClaimableReward  utilsjson.Uint64 `json:"claimableReward"`  // Total reward, that can be claimed at timestamp
+	UnlockableAmount utilsjson.Uint64 `json:"unlockableAmount"` // Total deposited amount, that can be unlocked at timestamp
+}
+
+type SimulateDepositReply struct {
+	// Error, that deposit tx with given arguments would fail with. Empty, if deposit could be created
+	Error string `json:"error"`
+	// Chain time, that was used for simulation and as deposit start
+	Timestamp utilsjson.Uint64 `json:"timestamp"`
+	// Unix time in seconds, when deposit ends
+	End utilsjson.Uint64 `json:"end"`
+	// Unix time in seconds, when deposited tokens become unlockable
+	UnlockPeriodStart utilsjson.Uint64 `json:"unlockPeriodStart"`
+	// Unix time in seconds, when deposit stops accumulating rewards
+	RewardsPeriodEnd utilsjson.Uint64 `json:"rewardsPeriodEnd"`
+	// Interest rate nominator, that is applied to this deposit
+	InterestRateNominator utilsjson.Uint64 `json:"interestRateNominator"`
+	// Total reward, that will be issued for this deposit
+	TotalReward utilsjson.Uint64 `json:"totalReward"`
+	// Max amount, that can be deposited with this offer now. Zero means no limit
+	MaxAmount utilsjson.Uint64 `json:"maxAmount"`
+	// Claimable reward and unlockable amount at evenly spaced points of deposit duration
+	Schedule []APIDepositSchedulePoint `json:"schedule"`
+}
+
+// SimulateDeposit runs deposit offer checks of deposit tx with given arguments against current chain state
+// and returns deposit reward schedule or error, that such tx would fail with.
+// Signatures and spent utxos aren't verified.
+func (s *CaminoService) SimulateDeposit(_ *http.Request, args *SimulateDepositArgs, reply *SimulateDepositReply) error {
+	s.vm.ctx.Log.Debug("Platform: SimulateDeposit called")
+
+	depositCreatorAddress := ids.ShortEmpty
+	if args.DepositCreatorAddress != "" {
+		addr, err := avax.ParseServiceAddress(s.addrManager, args.DepositCreatorAddress)
+		if err != nil {
+			return fmt.Errorf("couldn't parse address %q: %w", args.DepositCreatorAddress, err)
+		}
+		depositCreatorAddress = addr
+	}
+
+	steps := uint64(args.Steps)
+	if steps == 0 {
+		steps = defaultDepositScheduleSteps
+	} else if steps > builder.MaxPageSize {
+		steps = builder.MaxPageSize
 	}
+
+	chainTime := s.vm.state.GetTimestamp()
+	reply.Timestamp = utilsjson.Uint64(chainTime.Unix())
+
+	offer, err := s.vm.state.GetDepositOffer(args.DepositOfferID)
+	if err != nil {
+		reply.Error = fmt.Sprintf("can't get deposit offer: %s", err)
+		return nil
+	}
+
+	switch {
+	case offer.TotalMaxAmount > 0:
+		reply.MaxAmount = utilsjson.Uint64(offer.RemainingAmount())
+	case offer.TotalMaxRewardAmount > 0:
+		reply.MaxAmount = utilsjson.Uint64(offer.MaxRemainingAmountByReward())
+	}
+
+	newDeposit := executor.NewDeposit(
+		args.DepositOfferID,
+		uint64(args.Amount),
+		uint32(args.Duration),
+		deposit.Flag(args.DepositFlags),
+		nil,
+		chainTime,
+	)
+
+	potentialReward, err := executor.VerifyNewDeposit(&s.vm.Config, chainTime, offer, newDeposit)
+	if err != nil {
+		reply.Error = err.Error()
+		return nil
+	}
+
+	if offer.HasEligibilityRules() {
+		if depositCreatorAddress == ids.ShortEmpty {
+			reply.Error = errNoDepositCreator.Error()
+			return nil
+		}
+		if err := executor.VerifyDepositCreatorEligibility(s.vm.state, offer, depositCreatorAddress); err != nil {
+			reply.Error = err.Error()
+			return nil
+		}
+	}
+
+	if _, _, err := executor.VerifyDepositRewardSupply(&s.vm.Config, s.vm.state, potentialReward); err != nil {
+		reply.Error = err.Error()
+		return nil
+	}
+
+	depositEnd := newDeposit.Start + uint64(newDeposit.Duration)
+	reply.End = utilsjson.Uint64(depositEnd)
+	reply.UnlockPeriodStart = utilsjson.Uint64(depositEnd - uint64(offer.UnlockPeriodDuration))
+	reply.RewardsPeriodEnd = utilsjson.Uint64(depositEnd - uint64(offer.NoRewardsPeriodDuration))
+	reply.InterestRateNominator = utilsjson.Uint64(offer.InterestRateNominatorFor(newDeposit.Amount, newDeposit.Duration))
+	reply.TotalReward = utilsjson.Uint64(potentialReward)
+
+	reply.Schedule = make([]APIDepositSchedulePoint, steps)
+	for i := uint64(1); i <= steps; i++ {
+		timestamp := newDeposit.Start + uint64(newDeposit.Duration)*i/steps
+		reply.Schedule[i-1] = APIDepositSchedulePoint{
+			Timestamp:        utilsjson.Uint64(timestamp),
+			ClaimableReward:  utilsjson.Uint64(newDeposit.ClaimableReward(offer, timestamp)),
+			UnlockableAmount: utilsjson.Uint64(newDeposit.UnlockableAmount(offer, timestamp)),
+		}
+	}
+
+	return nil
 }
 
 type GetUpgradePhasesReply struct {
@@ -19,10 +19,13 @@ import (
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/version"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/platformvm/api"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/gorilla/rpc/v2/json2"
 	"github.com/stretchr/testify/require"
 )
 
@@ -251,6 +254,9 @@ func TestCaminoService_GetAllDepositOffers(t *testing.T) {
 					ID:    ids.ID{3},
 					Start: 50,
 					End:   100,
+					InterestRateTiers: []APIInterestRateTier{
+						{MinDuration: 10, MinAmount: 20, InterestRateNominator: 30},
+					},
 				},
 			},
 			prepare: func(service CaminoService) {
@@ -273,6 +279,9 @@ func TestCaminoService_GetAllDepositOffers(t *testing.T) {
 					ID:    ids.ID{3},
 					Start: 50, // start at timestamp
 					End:   100,
+					InterestRateTiers: []deposit.InterestRateTier{
+						{MinDuration: 10, MinAmount: 20, InterestRateNominator: 30},
+					},
 				})
 				service.vm.state.SetDepositOffer(&deposit.Offer{
 					ID:    ids.ID{4},
@@ -292,6 +301,169 @@ func TestCaminoService_GetAllDepositOffers(t *testing.T) {
 	}
 }
 
+func TestCaminoService_SimulateDeposit(t *testing.T) {
+	const yearDuration = 365 * 24 * 60 * 60
+	depositAmount := uint64(yearDuration * 1000) // 1000 of reward per second with 100% interest rate
+	offer := &deposit.Offer{
+		ID:                      ids.ID{1},
+		End:                     1_000_000_000_000,
+		MinAmount:               1,
+		MinDuration:             100,
+		MaxDuration:             100,
+		UnlockPeriodDuration:    50,
+		NoRewardsPeriodDuration: 20,
+		InterestRateNominator:   1_000_000, // 100%
+	}
+	offerWithEligibilityRules := *offer
+	offerWithEligibilityRules.ID = ids.ID{2}
+	offerWithEligibilityRules.RequiredAddressState = uint64(txs.AddressStateKYCVerified)
+
+	tests := map[string]struct {
+		args          *SimulateDepositArgs
+		expectedReply func(timestamp uint64) *SimulateDepositReply
+	}{
+		"Offer not found": {
+			args: &SimulateDepositArgs{DepositOfferID: ids.ID{3}},
+			expectedReply: func(timestamp uint64) *SimulateDepositReply {
+				return &SimulateDepositReply{
+					Error:     "can't get deposit offer: not found",
+					Timestamp: json.Uint64(timestamp),
+				}
+			},
+		},
+		"Deposit duration is too small": {
+			args: &SimulateDepositArgs{
+				DepositOfferID: offer.ID,
+				Amount:         json.Uint64(depositAmount),
+				Duration:       99,
+			},
+			expectedReply: func(timestamp uint64) *SimulateDepositReply {
+				return &SimulateDepositReply{
+					Error:     "deposit duration is less than deposit offer minmum duration",
+					Timestamp: json.Uint64(timestamp),
+				}
+			},
+		},
+		"No deposit creator for offer with eligibility rules": {
+			args: &SimulateDepositArgs{
+				DepositOfferID: offerWithEligibilityRules.ID,
+				Amount:         json.Uint64(depositAmount),
+				Duration:       100,
+			},
+			expectedReply: func(timestamp uint64) *SimulateDepositReply {
+				return &SimulateDepositReply{
+					Error:     errNoDepositCreator.Error(),
+					Timestamp: json.Uint64(timestamp),
+				}
+			},
+		},
+		"OK": {
+			args: &SimulateDepositArgs{
+				DepositOfferID: offer.ID,
+				Amount:         json.Uint64(depositAmount),
+				Duration:       100,
+				Steps:          4,
+			},
+			expectedReply: func(timestamp uint64) *SimulateDepositReply {
+				return &SimulateDepositReply{
+					Timestamp:             json.Uint64(timestamp),
+					End:                   json.Uint64(timestamp + 100),
+					UnlockPeriodStart:     json.Uint64(timestamp + 50),
+					RewardsPeriodEnd:      json.Uint64(timestamp + 80),
+					InterestRateNominator: 1_000_000,
+					TotalReward:           80_000,
+					Schedule: []APIDepositSchedulePoint{
+						{Timestamp: json.Uint64(timestamp + 25), ClaimableReward: 25_000},
+						{Timestamp: json.Uint64(timestamp + 50), ClaimableReward: 50_000},
+						{Timestamp: json.Uint64(timestamp + 75), ClaimableReward: 75_000, UnlockableAmount: json.Uint64(depositAmount / 2)},
+						{Timestamp: json.Uint64(timestamp + 100), ClaimableReward: 80_000, UnlockableAmount: json.Uint64(depositAmount)},
+					},
+				}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			service := defaultCaminoService(t, api.Camino{LockModeBondDeposit: true}, []api.UTXO{})
+			service.vm.ctx.Lock.Lock()
+			defer func() {
+				require.NoError(t, service.vm.Shutdown(context.TODO()))
+				service.vm.ctx.Lock.Unlock()
+			}()
+			service.vm.state.SetDepositOffer(offer)
+			service.vm.state.SetDepositOffer(&offerWithEligibilityRules)
+
+			reply := &SimulateDepositReply{}
+			require.NoError(t, service.SimulateDeposit(nil, tt.args, reply))
+			require.Equal(t, tt.expectedReply(uint64(service.vm.state.GetTimestamp().Unix())), reply)
+		})
+	}
+}
+
+func TestCaminoService_GetAddressStatesAndKYCExpiration(t *testing.T) {
+	require := require.New(t)
+	hrp := constants.NetworkIDToHRP[testNetworkID]
+	id := keys[0].PublicKey().Address()
+	addr, err := address.FormatBech32(hrp, id.Bytes())
+	require.NoError(err)
+
+	service := defaultCaminoService(t, api.Camino{LockModeBondDeposit: true}, []api.UTXO{})
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.TODO()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+	service.vm.state.SetAddressStates(id, txs.AddressStateKYCVerified)
+	service.vm.state.SetKYCExpiration(id, 100)
+
+	states := json.Uint64(0)
+	require.NoError(service.GetAddressStates(nil, &json_api.JSONAddress{Address: "P-" + addr}, &states))
+	require.Equal(json.Uint64(txs.AddressStateKYCVerified), states)
+
+	kycExpirationReply := &GetKYCExpirationReply{}
+	require.NoError(service.GetKYCExpiration(nil, &json_api.JSONAddress{Address: "P-" + addr}, kycExpirationReply))
+	require.Equal(&GetKYCExpirationReply{KYCExpiration: 100}, kycExpirationReply)
+}
+
+func TestCaminoService_GetMultisigAlias(t *testing.T) {
+	require := require.New(t)
+	hrp := constants.NetworkIDToHRP[testNetworkID]
+	aliasID := ids.ShortID{1}
+	ownerAddr := keys[0].PublicKey().Address()
+	aliasAddr, err := address.FormatBech32(hrp, aliasID.Bytes())
+	require.NoError(err)
+	ownerAddrStr, err := address.FormatBech32(hrp, ownerAddr.Bytes())
+	require.NoError(err)
+
+	service := defaultCaminoService(t, api.Camino{LockModeBondDeposit: true}, []api.UTXO{})
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.TODO()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	reply := &GetMultisigAliasReply{}
+	err = service.GetMultisigAlias(nil, &json_api.JSONAddress{Address: "P-" + aliasAddr}, reply)
+	rpcErr, ok := err.(*json2.Error)
+	require.True(ok)
+	require.Equal(errCodeNotFound, rpcErr.Code)
+
+	service.vm.state.SetMultisigAlias(&multisig.AliasWithNonce{
+		Alias: multisig.Alias{
+			ID:     aliasID,
+			Memo:   []byte("memo"),
+			Owners: &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{ownerAddr}},
+		},
+		Nonce: 2,
+	})
+
+	require.NoError(service.GetMultisigAlias(nil, &json_api.JSONAddress{Address: "P-" + aliasAddr}, reply))
+	require.Equal(json.Uint64(2), reply.Nonce)
+	require.Equal(json.Uint32(1), reply.Threshold)
+	require.Equal([]string{"P-" + ownerAddrStr}, reply.Addresses)
+	require.Equal([]byte("memo"), []byte(reply.Memo))
+}
+
 func TestGetKeystoreKeys(t *testing.T) {
 	s, _ := defaultService(t)
 	userPass := json_api.UserPass{Username: testUsername, Password: testPassword}
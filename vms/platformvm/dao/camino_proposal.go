@@ -0,0 +1,147 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package dao
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/types"
+)
+
+const (
+	MinProposalDuration = uint64(24 * time.Hour / time.Second)      // 1 day
+	MaxProposalDuration = uint64(60 * 24 * time.Hour / time.Second) // 60 days
+	MinProposalOptions  = 2
+	MaxProposalOptions  = 10
+	MaxOptionSize       = 256
+)
+
+var (
+	errWrongProposalDuration = errors.New("proposal duration is out of allowed bounds")
+	errWrongOptionsCount     = errors.New("wrong number of proposal options")
+	errEmptyOption           = errors.New("proposal option is empty")
+	errOptionTooBig          = errors.New("proposal option is too big")
+	errMemoTooBig            = errors.New("proposal memo is too big")
+
+	ErrAlreadyVoted     = errors.New("address already voted for this proposal")
+	ErrWrongVoteOption  = errors.New("vote option doesn't exist")
+	ErrProposalInactive = errors.New("proposal is inactive")
+)
+
+// Proposal is a DAO proposal that consortium members can vote on
+type Proposal struct {
+	Start   uint64                `serialize:"true" json:"start"`   // Unix time in seconds, when voting starts
+	End     uint64                `serialize:"true" json:"end"`     // Unix time in seconds, when voting ends and proposal is finished
+	Options []types.JSONByteSlice `serialize:"true" json:"options"` // Options that could be voted for
+	Memo    types.JSONByteSlice   `serialize:"true" json:"memo"`    // Arbitrary proposal memo, e.g. description or link to it
+}
+
+func (p *Proposal) StartTime() time.Time {
+	return time.Unix(int64(p.Start), 0)
+}
+
+func (p *Proposal) EndTime() time.Time {
+	return time.Unix(int64(p.End), 0)
+}
+
+// Returns true if voting for this proposal is allowed at [timestamp]
+func (p *Proposal) IsActiveAt(timestamp uint64) bool {
+	return p.Start <= timestamp && timestamp < p.End
+}
+
+func (p *Proposal) Verify() error {
+	switch {
+	case p.Start >= p.End:
+		return fmt.Errorf("proposal start time (%d) is not before its end time (%d)", p.Start, p.End)
+	case p.End-p.Start < MinProposalDuration || p.End-p.Start > MaxProposalDuration:
+		return fmt.Errorf("%w: %d", errWrongProposalDuration, p.End-p.Start)
+	case len(p.Options) < MinProposalOptions || len(p.Options) > MaxProposalOptions:
+		return fmt.Errorf("%w: %d", errWrongOptionsCount, len(p.Options))
+	case len(p.Memo) > avax.MaxMemoSize:
+		return fmt.Errorf("%w: %d bytes, max %d bytes", errMemoTooBig, len(p.Memo), avax.MaxMemoSize)
+	}
+
+	for i, option := range p.Options {
+		switch {
+		case len(option) == 0:
+			return fmt.Errorf("%w: option %d", errEmptyOption, i)
+		case len(option) > MaxOptionSize:
+			return fmt.Errorf("%w: option %d is %d bytes, max %d bytes", errOptionTooBig, i, len(option), MaxOptionSize)
+		}
+	}
+
+	return nil
+}
+
+// ProposalState is a proposal with its voting progress
+type ProposalState struct {
+	Proposal `serialize:"true"`
+
+	Proposer ids.ShortID   `serialize:"true" json:"proposer"` // Address that created this proposal
+	Votes    []uint64      `serialize:"true" json:"votes"`    // Number of votes for each proposal option
+	Voters   []ids.ShortID `serialize:"true" json:"voters"`   // Sorted addresses that already voted
+}
+
+func NewProposalState(proposal *Proposal, proposer ids.ShortID) *ProposalState {
+	return &ProposalState{
+		Proposal: *proposal,
+		Proposer: proposer,
+		Votes:    make([]uint64, len(proposal.Options)),
+	}
+}
+
+func (p *ProposalState) HasVoted(voter ids.ShortID) bool {
+	_, found := p.voterIndex(voter)
+	return found
+}
+
+// Returns copy of this proposal state with added vote.
+// Returns error if [voter] has already voted or [option] doesn't exist.
+func (p *ProposalState) AddVote(voter ids.ShortID, option uint32) (*ProposalState, error) {
+	if int(option) >= len(p.Votes) {
+		return nil, fmt.Errorf("%w: %d", ErrWrongVoteOption, option)
+	}
+
+	index, found := p.voterIndex(voter)
+	if found {
+		return nil, ErrAlreadyVoted
+	}
+
+	updatedProposal := *p
+	updatedProposal.Votes = make([]uint64, len(p.Votes))
+	copy(updatedProposal.Votes, p.Votes)
+	updatedProposal.Votes[option]++
+
+	updatedProposal.Voters = make([]ids.ShortID, len(p.Voters)+1)
+	copy(updatedProposal.Voters, p.Voters[:index])
+	updatedProposal.Voters[index] = voter
+	copy(updatedProposal.Voters[index+1:], p.Voters[index:])
+
+	return &updatedProposal, nil
+}
+
+// Outcome returns the most voted option and whether it was voted by
+// the absolute majority of voters. Proposal without votes is never successful.
+func (p *ProposalState) Outcome() (uint32, bool) {
+	mostVotedOption := uint32(0)
+	for option, votes := range p.Votes {
+		if votes > p.Votes[mostVotedOption] {
+			mostVotedOption = uint32(option)
+		}
+	}
+	return mostVotedOption, len(p.Voters) > 0 && p.Votes[mostVotedOption] > uint64(len(p.Voters)/2)
+}
+
+func (p *ProposalState) voterIndex(voter ids.ShortID) (int, bool) {
+	index := sort.Search(len(p.Voters), func(i int) bool {
+		return bytes.Compare(p.Voters[i][:], voter[:]) >= 0
+	})
+	return index, index < len(p.Voters) && p.Voters[index] == voter
+}
@@ -0,0 +1,163 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposalVerify(t *testing.T) {
+	tests := map[string]struct {
+		proposal    *Proposal
+		expectedErr error
+	}{
+		"Too short duration": {
+			proposal: &Proposal{
+				Start:   100,
+				End:     100 + MinProposalDuration - 1,
+				Options: []types.JSONByteSlice{{1}, {2}},
+			},
+			expectedErr: errWrongProposalDuration,
+		},
+		"Too long duration": {
+			proposal: &Proposal{
+				Start:   100,
+				End:     100 + MaxProposalDuration + 1,
+				Options: []types.JSONByteSlice{{1}, {2}},
+			},
+			expectedErr: errWrongProposalDuration,
+		},
+		"Not enough options": {
+			proposal: &Proposal{
+				Start:   100,
+				End:     100 + MinProposalDuration,
+				Options: []types.JSONByteSlice{{1}},
+			},
+			expectedErr: errWrongOptionsCount,
+		},
+		"Empty option": {
+			proposal: &Proposal{
+				Start:   100,
+				End:     100 + MinProposalDuration,
+				Options: []types.JSONByteSlice{{1}, {}},
+			},
+			expectedErr: errEmptyOption,
+		},
+		"Too big option": {
+			proposal: &Proposal{
+				Start:   100,
+				End:     100 + MinProposalDuration,
+				Options: []types.JSONByteSlice{{1}, make([]byte, MaxOptionSize+1)},
+			},
+			expectedErr: errOptionTooBig,
+		},
+		"Too big memo": {
+			proposal: &Proposal{
+				Start:   100,
+				End:     100 + MinProposalDuration,
+				Options: []types.JSONByteSlice{{1}, {2}},
+				Memo:    make([]byte, avax.MaxMemoSize+1),
+			},
+			expectedErr: errMemoTooBig,
+		},
+		"OK": {
+			proposal: &Proposal{
+				Start:   100,
+				End:     100 + MaxProposalDuration,
+				Options: []types.JSONByteSlice{{1}, {2}},
+				Memo:    []byte{1},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.proposal.Verify(), tt.expectedErr)
+		})
+	}
+}
+
+func TestProposalStateAddVote(t *testing.T) {
+	require := require.New(t)
+
+	voter1 := ids.ShortID{1}
+	voter2 := ids.ShortID{2}
+	voter3 := ids.ShortID{3}
+
+	proposal := NewProposalState(&Proposal{
+		Start:   100,
+		End:     200,
+		Options: []types.JSONByteSlice{{1}, {2}, {3}},
+	}, ids.ShortID{10})
+
+	_, err := proposal.AddVote(voter1, 3)
+	require.ErrorIs(err, ErrWrongVoteOption)
+
+	proposal1, err := proposal.AddVote(voter3, 1)
+	require.NoError(err)
+	proposal2, err := proposal1.AddVote(voter1, 1)
+	require.NoError(err)
+
+	_, err = proposal2.AddVote(voter1, 2)
+	require.ErrorIs(err, ErrAlreadyVoted)
+
+	// original proposals must stay unchanged
+	require.Equal([]uint64{0, 0, 0}, proposal.Votes)
+	require.Empty(proposal.Voters)
+	require.Equal([]uint64{0, 1, 0}, proposal1.Votes)
+	require.Equal([]ids.ShortID{voter3}, proposal1.Voters)
+
+	require.Equal([]uint64{0, 2, 0}, proposal2.Votes)
+	require.Equal([]ids.ShortID{voter1, voter3}, proposal2.Voters)
+	require.True(proposal2.HasVoted(voter1))
+	require.False(proposal2.HasVoted(voter2))
+}
+
+func TestProposalStateOutcome(t *testing.T) {
+	tests := map[string]struct {
+		votes              []uint64
+		expectedOption     uint32
+		expectedSuccessful bool
+	}{
+		"No votes": {
+			votes:              []uint64{0, 0, 0},
+			expectedOption:     0,
+			expectedSuccessful: false,
+		},
+		"Absolute majority": {
+			votes:              []uint64{1, 3, 1},
+			expectedOption:     1,
+			expectedSuccessful: true,
+		},
+		"Relative majority": {
+			votes:              []uint64{1, 2, 1},
+			expectedOption:     1,
+			expectedSuccessful: false,
+		},
+		"Tie": {
+			votes:              []uint64{0, 2, 2},
+			expectedOption:     1,
+			expectedSuccessful: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			votersCount := uint64(0)
+			for _, votes := range tt.votes {
+				votersCount += votes
+			}
+			proposal := &ProposalState{
+				Votes:  tt.votes,
+				Voters: make([]ids.ShortID, votersCount),
+			}
+			option, successful := proposal.Outcome()
+			require.Equal(t, tt.expectedOption, option)
+			require.Equal(t, tt.expectedSuccessful, successful)
+		})
+	}
+}
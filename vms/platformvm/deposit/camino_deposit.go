@@ -7,19 +7,31 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 )
 
+type Flag uint64
+
+const (
+	FlagNone            Flag = 0
+	FlagRollover        Flag = 0b1  // Deposit will be re-deposited, when it expires
+	FlagRolloverRewards Flag = 0b10 // Deposit remaining reward will be re-deposited together with deposit, when it expires. Requires FlagRollover
+)
+
 type Deposit struct {
-	DepositOfferID      ids.ID   `serialize:"true"` // ID of deposit offer that was used to create this deposit
-	UnlockedAmount      uint64   `serialize:"true"` // How many tokens have already been unlocked from this deposit
-	ClaimedRewardAmount uint64   `serialize:"true"` // How many reward tokens have already been claimed for this deposit
-	Start               uint64   `serialize:"true"` // Timestamp of time, when this deposit was created
-	Duration            uint32   `serialize:"true"` // Duration of this deposit in seconds
-	Amount              uint64   `serialize:"true"` // How many tokens were locked with this deposit
-	RewardOwner         fx.Owner `serialize:"true"` // The owner who has right to claim rewards for this deposit
+	UpgradeVersionID codec.UpgradeVersionID
+
+	DepositOfferID      ids.ID   `serialize:"true"`                    // ID of deposit offer that was used to create this deposit
+	UnlockedAmount      uint64   `serialize:"true"`                    // How many tokens have already been unlocked from this deposit
+	ClaimedRewardAmount uint64   `serialize:"true"`                    // How many reward tokens have already been claimed for this deposit
+	Start               uint64   `serialize:"true"`                    // Timestamp of time, when this deposit was created
+	Duration            uint32   `serialize:"true"`                    // Duration of this deposit in seconds
+	Amount              uint64   `serialize:"true"`                    // How many tokens were locked with this deposit
+	RewardOwner         fx.Owner `serialize:"true"`                    // The owner who has right to claim rewards for this deposit
+	Flags               Flag     `serialize:"true" upgradeVersion:"1"` // Bitfield with flags
 }
 
 func (deposit *Deposit) StartTime() time.Time {
@@ -30,6 +42,11 @@ func (deposit *Deposit) EndTime() time.Time {
 	return deposit.StartTime().Add(time.Duration(deposit.Duration) * time.Second)
 }
 
+// Returns true if this deposit will be re-deposited, when it expires
+func (deposit *Deposit) IsRollover() bool {
+	return deposit.Flags&FlagRollover != 0
+}
+
 func (deposit *Deposit) IsExpired(timestamp uint64) bool {
 	depositEndTimestamp, err := math.Add64(deposit.Start, uint64(deposit.Duration))
 	if err != nil {
@@ -70,6 +87,24 @@ func (deposit *Deposit) UnlockableAmount(offer *Offer, unlockTime uint64) uint64
 	return bigTotalUnlockableAmount.Uint64() - deposit.UnlockedAmount
 }
 
+// Returns amount of tokens that must be paid as penalty for unlocking
+// the whole remaining [deposit] amount at [unlockTime] (seconds).
+// Only amount that isn't unlockable yet is penalized.
+//
+// Precondition: all args are valid in conjunction.
+func (deposit *Deposit) EarlyUnlockPenalty(offer *Offer, unlockTime uint64) uint64 {
+	earlyUnlockedAmount := deposit.Amount - deposit.UnlockedAmount - deposit.UnlockableAmount(offer, unlockTime)
+
+	bigPenaltyAmount := (&big.Int{}).SetUint64(earlyUnlockedAmount)
+	bigPenaltyRateNominator := (&big.Int{}).SetUint64(offer.EarlyUnlockPenaltyRateNominator)
+
+	// penaltyAmount := earlyUnlockedAmount * offer.EarlyUnlockPenaltyRate
+	bigPenaltyAmount.Mul(bigPenaltyAmount, bigPenaltyRateNominator)
+	bigPenaltyAmount.Div(bigPenaltyAmount, bigEarlyUnlockPenaltyRateDenominator)
+
+	return bigPenaltyAmount.Uint64()
+}
+
 // Returns amount of tokens that can be claimed as reward for [deposit] at [claimetime] (seconds).
 //
 // Precondition: all args are valid in conjunction.
@@ -91,7 +126,7 @@ func (deposit *Deposit) ClaimableReward(offer *Offer, claimTime uint64) uint64 {
 
 	bigTotalRewardAmount := (&big.Int{}).SetUint64(deposit.Amount)
 	bigPassedDepositDuration := (&big.Int{}).SetUint64(claimTime - deposit.Start)
-	bigInterestRateNominator := (&big.Int{}).SetUint64(offer.InterestRateNominator)
+	bigInterestRateNominator := (&big.Int{}).SetUint64(offer.InterestRateNominatorFor(deposit.Amount, deposit.Duration))
 
 	// totalRewardAmount := depositAmount * offer.InterestRate * passedDepositDuration / interestRateBase
 	bigTotalRewardAmount.Mul(bigTotalRewardAmount, bigPassedDepositDuration)
@@ -109,7 +144,7 @@ func (deposit *Deposit) TotalReward(offer *Offer) uint64 {
 
 	// rewardsPeriodDuration = deposit.Duration - offer.NoRewardsPeriodDuration
 	bigRewardsPeriodDuration := (&big.Int{}).SetUint64(uint64(deposit.Duration - offer.NoRewardsPeriodDuration))
-	bigInterestRateNominator := (&big.Int{}).SetUint64(offer.InterestRateNominator)
+	bigInterestRateNominator := (&big.Int{}).SetUint64(offer.InterestRateNominatorFor(deposit.Amount, deposit.Duration))
 
 	// totalRewardAmount := depositAmount * offer.InterestRate * rewardsPeriodDuration / interestRateBase
 	bigTotalRewardAmount.Mul(bigTotalRewardAmount, bigRewardsPeriodDuration)
@@ -118,3 +153,55 @@ func (deposit *Deposit) TotalReward(offer *Offer) uint64 {
 
 	return bigTotalRewardAmount.Uint64()
 }
+
+// Returns deposit that expired [deposit] created with [offer] will be rolled over into,
+// using [successorOffer] at [timestamp] (seconds), and amount of [deposit] reward that will be rolled over.
+// [successorOffer] could be the same as [offer]. Returns false, if [successorOffer] doesn't allow
+// to create such deposit, or if new deposit potential reward is more than [availableSupply].
+// Deposits are never rolled over into offers with eligibility rules or owner, cause there is
+// no deposit creator, who could satisfy these rules or have offer owner permission.
+//
+// Precondition: [deposit] is expired rollover deposit and all args are valid in conjunction.
+func (deposit *Deposit) Rollover(
+	offer *Offer,
+	successorOffer *Offer,
+	timestamp uint64,
+	availableSupply uint64,
+) (*Deposit, uint64, bool) {
+	rolledOverReward := uint64(0)
+	if deposit.Flags&FlagRolloverRewards != 0 {
+		rolledOverReward = deposit.TotalReward(offer) - deposit.ClaimedRewardAmount
+	}
+
+	// tokens that were unlocked during unlock period are not re-deposited
+	newAmount, err := math.Add64(deposit.Amount-deposit.UnlockedAmount, rolledOverReward)
+	if err != nil {
+		return nil, 0, false
+	}
+
+	newDeposit := &Deposit{
+		UpgradeVersionID: codec.UpgradeVersion1,
+		DepositOfferID:   successorOffer.ID,
+		Start:            timestamp,
+		Duration:         deposit.Duration,
+		Amount:           newAmount,
+		RewardOwner:      deposit.RewardOwner,
+		Flags:            deposit.Flags,
+	}
+	potentialReward := newDeposit.TotalReward(successorOffer)
+
+	switch {
+	case successorOffer.HasEligibilityRules(),
+		successorOffer.OwnerAddress != ids.ShortEmpty,
+		!successorOffer.IsActiveAt(timestamp),
+		newDeposit.Duration < successorOffer.MinDuration,
+		newDeposit.Duration > successorOffer.MaxDuration,
+		newDeposit.Amount < successorOffer.MinAmount,
+		successorOffer.TotalMaxAmount > 0 && newDeposit.Amount > successorOffer.RemainingAmount(),
+		successorOffer.TotalMaxRewardAmount > 0 && potentialReward > successorOffer.RemainingReward(),
+		potentialReward > availableSupply:
+		return nil, 0, false
+	}
+
+	return newDeposit, rolledOverReward, true
+}
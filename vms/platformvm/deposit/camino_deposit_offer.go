@@ -11,19 +11,23 @@ import (
 
 	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/types"
 )
 
 const (
-	interestRateBase               = 365 * 24 * 60 * 60
-	interestRateDenominator        = 1_000_000 * interestRateBase
-	OfferMinDepositAmount   uint64 = 1 * units.MilliAvax
+	interestRateBase                         = 365 * 24 * 60 * 60
+	interestRateDenominator                  = 1_000_000 * interestRateBase
+	EarlyUnlockPenaltyRateDenominator        = 1_000_000
+	OfferMinDepositAmount             uint64 = 1 * units.MilliAvax
+	MaxInterestRateTiers                     = 16
 )
 
 var (
-	bigInterestRateDenominator = (&big.Int{}).SetInt64(interestRateDenominator)
+	bigInterestRateDenominator           = (&big.Int{}).SetInt64(interestRateDenominator)
+	bigEarlyUnlockPenaltyRateDenominator = (&big.Int{}).SetInt64(EarlyUnlockPenaltyRateDenominator)
 
 	errWrongLimitValues           = errors.New("can only use either TotalMaxAmount or TotalMaxRewardAmount")
 	errDepositedMoreThanMaxAmount = errors.New("offer deposited amount is more than offer total max amount")
@@ -31,6 +35,12 @@ var (
 	errMinAmountTooSmall          = errors.New("offer minAmount is too small")
 	errMinAmountTooBig            = errors.New("offer minAmount is too big")
 	errWrongRewardValues          = errors.New("offer interest rate and total max reward amount must both be zero or not zero")
+	errEarlyUnlockPenaltyTooBig   = errors.New("offer early unlock penalty rate is more than 100%")
+	errAllowListWithoutOwner      = errors.New("offer with allow-list must have owner")
+	errTooManyInterestRateTiers   = errors.New("offer has too many interest rate tiers")
+	errInterestRateTiersNotSorted = errors.New("offer interest rate tiers aren't sorted and unique")
+	errInterestRateTierTooLong    = errors.New("offer interest rate tier min duration is greater than offer max duration")
+	errInterestRateTierTooLow     = errors.New("offer interest rate tier rate isn't greater than offer base interest rate")
 )
 
 type OfferFlag uint64
@@ -40,6 +50,20 @@ const (
 	OfferFlagLocked OfferFlag = 0b1
 )
 
+// InterestRateTier is interest rate, that is applied to deposits with
+// duration and amount not less than tier's min duration and min amount
+type InterestRateTier struct {
+	MinDuration           uint32 `serialize:"true" json:"minDuration"`           // Minimum deposit duration for this tier
+	MinAmount             uint64 `serialize:"true" json:"minAmount"`             // Minimum deposit amount for this tier
+	InterestRateNominator uint64 `serialize:"true" json:"interestRateNominator"` // Interest rate nominator, that is applied to deposits matching this tier
+}
+
+// Returns true if [tier] must be placed before [other] in offer tiers
+func (tier *InterestRateTier) Less(other *InterestRateTier) bool {
+	return tier.MinDuration < other.MinDuration ||
+		tier.MinDuration == other.MinDuration && tier.MinAmount < other.MinAmount
+}
+
 type Offer struct {
 	UpgradeVersionID codec.UpgradeVersionID
 	ID               ids.ID
@@ -59,6 +83,14 @@ type Offer struct {
 	TotalMaxRewardAmount    uint64              `serialize:"true" json:"totalMaxRewardAmount" upgradeVersion:"1"` // Maximum amount that can be rewarded for all deposits created with this offer in total
 	RewardedAmount          uint64              `serialize:"true" json:"rewardedAmount"       upgradeVersion:"1"` // Amount that was already rewarded (including potential rewards) for deposits created with this offer
 	OwnerAddress            ids.ShortID         `serialize:"true" json:"ownerAddress"         upgradeVersion:"1"` // Address that can sign deposit-creator permission
+
+	EarlyUnlockPenaltyRateNominator uint64 `serialize:"true" json:"earlyUnlockPenaltyRateNominator" upgradeVersion:"2"` // deposit early unlocked amount * (earlyUnlockPenaltyRateNominator / EarlyUnlockPenaltyRateDenominator) == penalty for unlocking before unlock period. Zero means that early unlock isn't allowed
+	SuccessorOfferID                ids.ID `serialize:"true" json:"successorOfferID"                upgradeVersion:"2"` // ID of offer that expired rollover deposits will be re-deposited with. Empty means this offer
+
+	RequiredAddressState uint64 `serialize:"true" json:"requiredAddressState" upgradeVersion:"3"` // Address state bits that deposit creator must have. Zero means no address state requirement
+	AllowListEnabled     bool   `serialize:"true" json:"allowListEnabled"     upgradeVersion:"3"` // If true, deposit creator must be in offer allow-list, which is managed by offer owner
+
+	InterestRateTiers []InterestRateTier `serialize:"true" json:"interestRateTiers" upgradeVersion:"4"` // Tiers with interest rates greater than base interest rate, sorted by min duration and min amount
 }
 
 // Time when this offer becomes active
@@ -71,6 +103,28 @@ func (o *Offer) EndTime() time.Time {
 	return time.Unix(int64(o.End), 0)
 }
 
+// Returns interest rate nominator, that is applied to deposit with [amount] and [duration]:
+// the greatest rate among base offer interest rate and rates of matching tiers
+func (o *Offer) InterestRateNominatorFor(amount uint64, duration uint32) uint64 {
+	interestRateNominator := o.InterestRateNominator
+	for i := range o.InterestRateTiers {
+		tier := &o.InterestRateTiers[i]
+		if duration >= tier.MinDuration && amount >= tier.MinAmount {
+			interestRateNominator = math.Max(interestRateNominator, tier.InterestRateNominator)
+		}
+	}
+	return interestRateNominator
+}
+
+// Returns the greatest interest rate nominator, that could be applied to deposit created with this offer
+func (o *Offer) MaxInterestRateNominator() uint64 {
+	interestRateNominator := o.InterestRateNominator
+	for i := range o.InterestRateTiers {
+		interestRateNominator = math.Max(interestRateNominator, o.InterestRateTiers[i].InterestRateNominator)
+	}
+	return interestRateNominator
+}
+
 // Return 0 if o.TotalMaxAmount is 0.
 func (o *Offer) RemainingAmount() uint64 {
 	return o.TotalMaxAmount - o.DepositedAmount
@@ -82,8 +136,8 @@ func (o *Offer) MaxRemainingAmountByReward() uint64 {
 	// using MaxDuration, cause its the case, where most reward per deposit amount is issued
 	maxRewardsPeriodDuration := (&big.Int{}).SetUint64(uint64(o.MaxDuration - o.NoRewardsPeriodDuration))
 
-	// maxDepositAmount = remainingRewardAmount * interestRateBase / (offer.InterestRate * rewardsPeriodDuration)
-	denominator := (&big.Int{}).SetUint64(o.InterestRateNominator)
+	// maxDepositAmount = remainingRewardAmount * interestRateBase / (offer.MaxInterestRate * rewardsPeriodDuration)
+	denominator := (&big.Int{}).SetUint64(o.MaxInterestRateNominator())
 	denominator.Mul(denominator, maxRewardsPeriodDuration)
 
 	nominator := (&big.Int{}).SetUint64(o.RemainingReward())
@@ -110,6 +164,25 @@ func (o *Offer) IsActiveAt(timestamp uint64) bool {
 	return o.Start <= timestamp && timestamp <= o.End && o.Flags&OfferFlagLocked == 0
 }
 
+// Returns ID of offer that expired rollover deposits created with this offer will be re-deposited with
+func (o *Offer) RolloverOfferID() ids.ID {
+	if o.SuccessorOfferID != ids.Empty {
+		return o.SuccessorOfferID
+	}
+	return o.ID
+}
+
+// Returns true if deposits created with this offer can be unlocked before their unlock period starts
+func (o *Offer) AllowsEarlyUnlock() bool {
+	return o.EarlyUnlockPenaltyRateNominator > 0
+}
+
+// Returns true if deposit creator must satisfy eligibility rules of this offer:
+// have required address state and/or be in offer allow-list
+func (o *Offer) HasEligibilityRules() bool {
+	return o.RequiredAddressState != 0 || o.AllowListEnabled
+}
+
 func (o *Offer) InterestRateFloat64() float64 {
 	return float64(o.InterestRateNominator) / float64(interestRateDenominator)
 }
@@ -154,8 +227,8 @@ func (o *Offer) Verify() error {
 			// using MaxDuration, cause its the case, where most reward per deposit amount is issued
 			maxRewardsPeriodDuration := (&big.Int{}).SetUint64(uint64(o.MaxDuration - o.NoRewardsPeriodDuration))
 
-			// maxDepositAmount = offer.TotalMaxRewardAmount * interestRateBase / (offer.InterestRate * rewardsPeriodDuration)
-			denominator := (&big.Int{}).SetUint64(o.InterestRateNominator)
+			// maxDepositAmount = offer.TotalMaxRewardAmount * interestRateBase / (offer.MaxInterestRate * rewardsPeriodDuration)
+			denominator := (&big.Int{}).SetUint64(o.MaxInterestRateNominator())
 			denominator.Mul(denominator, maxRewardsPeriodDuration)
 
 			nominator := (&big.Int{}).SetUint64(o.TotalMaxRewardAmount)
@@ -172,12 +245,37 @@ func (o *Offer) Verify() error {
 			return errWrongLimitValues
 		case o.MinAmount < OfferMinDepositAmount:
 			return errMinAmountTooSmall
-		case o.TotalMaxRewardAmount == 0 && o.InterestRateNominator != 0 ||
-			o.TotalMaxRewardAmount != 0 && o.InterestRateNominator == 0:
+		case o.TotalMaxRewardAmount == 0 && o.MaxInterestRateNominator() != 0 ||
+			o.TotalMaxRewardAmount != 0 && o.MaxInterestRateNominator() == 0:
 			return errWrongRewardValues
 		}
 	}
 
+	if o.UpgradeVersionID.Version() >= codec.UpgradeVersion2.Version() && o.EarlyUnlockPenaltyRateNominator > EarlyUnlockPenaltyRateDenominator {
+		return errEarlyUnlockPenaltyTooBig
+	}
+
+	if o.UpgradeVersionID.Version() >= codec.UpgradeVersion3.Version() && o.AllowListEnabled && o.OwnerAddress == ids.ShortEmpty {
+		return errAllowListWithoutOwner
+	}
+
+	if o.UpgradeVersionID.Version() >= codec.UpgradeVersion4.Version() {
+		if len(o.InterestRateTiers) > MaxInterestRateTiers {
+			return errTooManyInterestRateTiers
+		}
+		for i := range o.InterestRateTiers {
+			tier := &o.InterestRateTiers[i]
+			switch {
+			case i > 0 && !o.InterestRateTiers[i-1].Less(tier):
+				return errInterestRateTiersNotSorted
+			case tier.MinDuration > o.MaxDuration:
+				return errInterestRateTierTooLong
+			case tier.InterestRateNominator <= o.InterestRateNominator:
+				return errInterestRateTierTooLow
+			}
+		}
+	}
+
 	return nil
 }
 
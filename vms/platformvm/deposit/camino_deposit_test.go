@@ -5,6 +5,9 @@ package deposit
 import (
 	"testing"
 
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/stretchr/testify/require"
 )
 
@@ -48,3 +51,295 @@ func TestTotalReward(t *testing.T) {
 		})
 	}
 }
+
+func TestEarlyUnlockPenalty(t *testing.T) {
+	offer := &Offer{
+		UnlockPeriodDuration:            10,
+		EarlyUnlockPenaltyRateNominator: 100_000, // 10%
+	}
+
+	tests := map[string]struct {
+		deposit         *Deposit
+		unlockTime      uint64
+		expectedPenalty uint64
+	}{
+		"Before unlock period": {
+			deposit:         &Deposit{Start: 100, Duration: 100, Amount: 1000},
+			unlockTime:      150,
+			expectedPenalty: 100,
+		},
+		"Before unlock period, partially unlocked": {
+			deposit:         &Deposit{Start: 100, Duration: 100, Amount: 1000, UnlockedAmount: 200},
+			unlockTime:      150,
+			expectedPenalty: 80,
+		},
+		"Middle of unlock period": {
+			deposit:         &Deposit{Start: 100, Duration: 100, Amount: 1000},
+			unlockTime:      195,
+			expectedPenalty: 50,
+		},
+		"Deposit end": {
+			deposit:         &Deposit{Start: 100, Duration: 100, Amount: 1000},
+			unlockTime:      200,
+			expectedPenalty: 0,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.expectedPenalty, tt.deposit.EarlyUnlockPenalty(offer, tt.unlockTime))
+		})
+	}
+}
+
+func TestRollover(t *testing.T) {
+	rewardOwner := &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{1}}}
+	offer := &Offer{
+		ID:                    ids.ID{1},
+		End:                   100,
+		MinDuration:           10,
+		MaxDuration:           20,
+		MinAmount:             1,
+		InterestRateNominator: interestRateDenominator, // 100% per second
+		TotalMaxRewardAmount:  1000,
+	}
+	deposit := &Deposit{
+		DepositOfferID:      offer.ID,
+		Duration:            10,
+		Amount:              10,
+		UnlockedAmount:      2,
+		ClaimedRewardAmount: 30,
+		RewardOwner:         rewardOwner,
+		Flags:               FlagRollover,
+	}
+	depositWithRewards := *deposit
+	depositWithRewards.Flags = FlagRollover | FlagRolloverRewards
+
+	lockedOffer := *offer
+	lockedOffer.Flags = OfferFlagLocked
+	shortOffer := *offer
+	shortOffer.MaxDuration = deposit.Duration - 1
+	limitedOffer := *offer
+	limitedOffer.RewardedAmount = limitedOffer.TotalMaxRewardAmount - 79
+	offerWithAddressStateRule := *offer
+	offerWithAddressStateRule.RequiredAddressState = 1
+	offerWithAllowList := *offer
+	offerWithAllowList.AllowListEnabled = true
+	offerWithOwner := *offer
+	offerWithOwner.OwnerAddress = ids.ShortID{1}
+
+	tests := map[string]struct {
+		deposit          *Deposit
+		successorOffer   *Offer
+		availableSupply  uint64
+		expectedDeposit  *Deposit
+		expectedReward   uint64
+		expectedRollover bool
+	}{
+		"Inactive offer": {
+			deposit:         deposit,
+			successorOffer:  &lockedOffer,
+			availableSupply: 1000,
+		},
+		"Offer doesn't allow deposit duration": {
+			deposit:         deposit,
+			successorOffer:  &shortOffer,
+			availableSupply: 1000,
+		},
+		"Offer reward limit exceeded": {
+			deposit:         deposit,
+			successorOffer:  &limitedOffer,
+			availableSupply: 1000,
+		},
+		"Offer requires address state": {
+			deposit:         deposit,
+			successorOffer:  &offerWithAddressStateRule,
+			availableSupply: 1000,
+		},
+		"Offer has allow-list": {
+			deposit:         deposit,
+			successorOffer:  &offerWithAllowList,
+			availableSupply: 1000,
+		},
+		"Offer has owner": {
+			deposit:         deposit,
+			successorOffer:  &offerWithOwner,
+			availableSupply: 1000,
+		},
+		"Not enough supply": {
+			deposit:         deposit,
+			successorOffer:  offer,
+			availableSupply: 79,
+		},
+		"OK: principal": {
+			deposit:         deposit,
+			successorOffer:  offer,
+			availableSupply: 80,
+			expectedDeposit: &Deposit{
+				UpgradeVersionID: codec.UpgradeVersion1,
+				DepositOfferID:   offer.ID,
+				Start:            50,
+				Duration:         10,
+				Amount:           8,
+				RewardOwner:      rewardOwner,
+				Flags:            FlagRollover,
+			},
+			expectedRollover: true,
+		},
+		"OK: principal and reward": {
+			deposit:         &depositWithRewards,
+			successorOffer:  offer,
+			availableSupply: 1000,
+			expectedDeposit: &Deposit{
+				UpgradeVersionID: codec.UpgradeVersion1,
+				DepositOfferID:   offer.ID,
+				Start:            50,
+				Duration:         10,
+				Amount:           78,
+				RewardOwner:      rewardOwner,
+				Flags:            FlagRollover | FlagRolloverRewards,
+			},
+			expectedReward:   70,
+			expectedRollover: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			newDeposit, reward, ok := tt.deposit.Rollover(offer, tt.successorOffer, 50, tt.availableSupply)
+			require.Equal(t, tt.expectedRollover, ok)
+			require.Equal(t, tt.expectedDeposit, newDeposit)
+			require.Equal(t, tt.expectedReward, reward)
+		})
+	}
+}
+
+func TestInterestRateTiers(t *testing.T) {
+	offer := &Offer{
+		InterestRateNominator: 1 * interestRateBase,
+		InterestRateTiers: []InterestRateTier{
+			{MinDuration: 100, InterestRateNominator: 2 * interestRateBase},
+			{MinDuration: 100, MinAmount: 1000_000, InterestRateNominator: 4 * interestRateBase},
+			{MinDuration: 200, InterestRateNominator: 3 * interestRateBase},
+		},
+	}
+
+	tests := map[string]struct {
+		deposit                       *Deposit
+		expectedInterestRateNominator uint64
+	}{
+		"No matching tiers": {
+			deposit:                       &Deposit{Duration: 99, Amount: 1000_000},
+			expectedInterestRateNominator: 1 * interestRateBase,
+		},
+		"Duration tier": {
+			deposit:                       &Deposit{Duration: 150, Amount: 999_000},
+			expectedInterestRateNominator: 2 * interestRateBase,
+		},
+		"Duration and amount tier": {
+			deposit:                       &Deposit{Duration: 150, Amount: 1000_000},
+			expectedInterestRateNominator: 4 * interestRateBase,
+		},
+		"Greatest rate among matching tiers": {
+			deposit:                       &Deposit{Duration: 200, Amount: 1000_000},
+			expectedInterestRateNominator: 4 * interestRateBase,
+		},
+		"Longer duration tier": {
+			deposit:                       &Deposit{Duration: 200, Amount: 999_000},
+			expectedInterestRateNominator: 3 * interestRateBase,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			interestRateNominator := offer.InterestRateNominatorFor(tt.deposit.Amount, tt.deposit.Duration)
+			require.Equal(tt.expectedInterestRateNominator, interestRateNominator)
+			require.Equal(
+				tt.deposit.Amount*interestRateNominator*uint64(tt.deposit.Duration)/interestRateDenominator,
+				tt.deposit.TotalReward(offer),
+			)
+		})
+	}
+
+	require.Equal(t, uint64(4*interestRateBase), offer.MaxInterestRateNominator())
+}
+
+func TestOfferVerifyInterestRateTiers(t *testing.T) {
+	baseOffer := Offer{
+		UpgradeVersionID:      codec.UpgradeVersion4,
+		End:                   1,
+		MinAmount:             OfferMinDepositAmount,
+		MinDuration:           10,
+		MaxDuration:           100,
+		InterestRateNominator: 10,
+		TotalMaxRewardAmount:  OfferMinDepositAmount,
+	}
+
+	tests := map[string]struct {
+		upgradeVersionID codec.UpgradeVersionID
+		tiers            []InterestRateTier
+		expectedErr      error
+	}{
+		"Too many tiers": {
+			tiers:       make([]InterestRateTier, MaxInterestRateTiers+1),
+			expectedErr: errTooManyInterestRateTiers,
+		},
+		"Tiers not sorted": {
+			tiers: []InterestRateTier{
+				{MinDuration: 20, InterestRateNominator: 20},
+				{MinDuration: 10, MinAmount: 10, InterestRateNominator: 20},
+			},
+			expectedErr: errInterestRateTiersNotSorted,
+		},
+		"Tiers not unique": {
+			tiers: []InterestRateTier{
+				{MinDuration: 20, MinAmount: 10, InterestRateNominator: 20},
+				{MinDuration: 20, MinAmount: 10, InterestRateNominator: 30},
+			},
+			expectedErr: errInterestRateTiersNotSorted,
+		},
+		"Tier min duration is greater than offer max duration": {
+			tiers: []InterestRateTier{
+				{MinDuration: 101, InterestRateNominator: 20},
+			},
+			expectedErr: errInterestRateTierTooLong,
+		},
+		"Tier rate isn't greater than base rate": {
+			tiers: []InterestRateTier{
+				{MinDuration: 20, InterestRateNominator: 10},
+			},
+			expectedErr: errInterestRateTierTooLow,
+		},
+		"Tier min amount is too big for offer reward limit": {
+			tiers: []InterestRateTier{
+				{MinDuration: 20, InterestRateNominator: interestRateDenominator},
+			},
+			expectedErr: errMinAmountTooBig,
+		},
+		"Tiers are ignored before version 4": {
+			upgradeVersionID: codec.UpgradeVersion3,
+			tiers: []InterestRateTier{
+				{MinDuration: 20, InterestRateNominator: 10},
+			},
+		},
+		"OK": {
+			tiers: []InterestRateTier{
+				{MinDuration: 20, InterestRateNominator: 20},
+				{MinDuration: 20, MinAmount: 10, InterestRateNominator: 30},
+				{MinDuration: 30, InterestRateNominator: 25},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			offer := baseOffer
+			if tt.upgradeVersionID != 0 {
+				offer.UpgradeVersionID = tt.upgradeVersionID
+			}
+			offer.InterestRateTiers = tt.tiers
+			require.ErrorIs(t, offer.Verify(), tt.expectedErr)
+		})
+	}
+}
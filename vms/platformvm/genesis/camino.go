@@ -6,15 +6,18 @@ package genesis
 import (
 	"time"
 
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
 
 // Camino genesis args
 type Camino struct {
+	UpgradeVersionID         codec.UpgradeVersionID
 	VerifyNodeSignature      bool                     `serialize:"true"`
 	LockModeBondDeposit      bool                     `serialize:"true"`
 	InitialAdmin             ids.ShortID              `serialize:"true"`
@@ -23,6 +26,8 @@ type Camino struct {
 	Blocks                   []*Block                 `serialize:"true"` // arranged in a block order
 	ConsortiumMembersNodeIDs []ConsortiumMemberNodeID `serialize:"true"`
 	MultisigAliases          []*multisig.Alias        `serialize:"true"`
+	// Initial treasury config, only set when UpgradeVersionID is 1 or higher
+	TreasuryConfig treasury.Config `serialize:"true" upgradeVersion:"1"`
 }
 
 func (c *Camino) Init() error {
@@ -21,7 +21,16 @@ type caminoTxMetrics struct {
 	numRewardsImportTxs,
 	numBaseTxs,
 	numMultisigAliasTxs,
-	numAddDepositOfferTxs prometheus.Counter
+	numAddDepositOfferTxs,
+	numAddProposalTxs,
+	numAddVoteTxs,
+	numFinishProposalsTxs,
+	numUpdateDepositOfferTxs,
+	numTransferDepositTxs,
+	numTreasuryConfigTxs,
+	numTreasurySpendTxs,
+	numExtendValidatorTxs,
+	numUpdateDepositOfferAllowListTxs prometheus.Counter
 }
 
 func newCaminoTxMetrics(
@@ -37,15 +46,24 @@ func newCaminoTxMetrics(
 	m := &caminoTxMetrics{
 		txMetrics: *txm,
 		// Camino specific tx metrics
-		numAddressStateTxs:    newTxMetric(namespace, "add_address_state", registerer, &errs),
-		numDepositTxs:         newTxMetric(namespace, "deposit", registerer, &errs),
-		numUnlockDepositTxs:   newTxMetric(namespace, "unlock_deposit", registerer, &errs),
-		numClaimTxs:           newTxMetric(namespace, "claim", registerer, &errs),
-		numRegisterNodeTxs:    newTxMetric(namespace, "register_node", registerer, &errs),
-		numRewardsImportTxs:   newTxMetric(namespace, "rewards_import", registerer, &errs),
-		numBaseTxs:            newTxMetric(namespace, "base", registerer, &errs),
-		numMultisigAliasTxs:   newTxMetric(namespace, "multisig_alias", registerer, &errs),
-		numAddDepositOfferTxs: newTxMetric(namespace, "add_deposit_offer", registerer, &errs),
+		numAddressStateTxs:                newTxMetric(namespace, "add_address_state", registerer, &errs),
+		numDepositTxs:                     newTxMetric(namespace, "deposit", registerer, &errs),
+		numUnlockDepositTxs:               newTxMetric(namespace, "unlock_deposit", registerer, &errs),
+		numClaimTxs:                       newTxMetric(namespace, "claim", registerer, &errs),
+		numRegisterNodeTxs:                newTxMetric(namespace, "register_node", registerer, &errs),
+		numRewardsImportTxs:               newTxMetric(namespace, "rewards_import", registerer, &errs),
+		numBaseTxs:                        newTxMetric(namespace, "base", registerer, &errs),
+		numMultisigAliasTxs:               newTxMetric(namespace, "multisig_alias", registerer, &errs),
+		numAddDepositOfferTxs:             newTxMetric(namespace, "add_deposit_offer", registerer, &errs),
+		numAddProposalTxs:                 newTxMetric(namespace, "add_proposal", registerer, &errs),
+		numAddVoteTxs:                     newTxMetric(namespace, "add_vote", registerer, &errs),
+		numFinishProposalsTxs:             newTxMetric(namespace, "finish_proposals", registerer, &errs),
+		numUpdateDepositOfferTxs:          newTxMetric(namespace, "update_deposit_offer", registerer, &errs),
+		numTransferDepositTxs:             newTxMetric(namespace, "transfer_deposit", registerer, &errs),
+		numTreasuryConfigTxs:              newTxMetric(namespace, "treasury_config", registerer, &errs),
+		numTreasurySpendTxs:               newTxMetric(namespace, "treasury_spend", registerer, &errs),
+		numExtendValidatorTxs:             newTxMetric(namespace, "extend_validator", registerer, &errs),
+		numUpdateDepositOfferAllowListTxs: newTxMetric(namespace, "update_deposit_offer_allow_list", registerer, &errs),
 	}
 	return m, errs.Err
 }
@@ -88,6 +106,42 @@ func (*txMetrics) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
 	return nil
 }
 
+func (*txMetrics) AddProposalTx(*txs.AddProposalTx) error {
+	return nil
+}
+
+func (*txMetrics) AddVoteTx(*txs.AddVoteTx) error {
+	return nil
+}
+
+func (*txMetrics) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return nil
+}
+
+func (*txMetrics) UpdateDepositOfferTx(*txs.UpdateDepositOfferTx) error {
+	return nil
+}
+
+func (*txMetrics) TransferDepositTx(*txs.TransferDepositTx) error {
+	return nil
+}
+
+func (*txMetrics) TreasuryConfigTx(*txs.TreasuryConfigTx) error {
+	return nil
+}
+
+func (*txMetrics) TreasurySpendTx(*txs.TreasurySpendTx) error {
+	return nil
+}
+
+func (*txMetrics) ExtendValidatorTx(*txs.ExtendValidatorTx) error {
+	return nil
+}
+
+func (*txMetrics) UpdateDepositOfferAllowListTx(*txs.UpdateDepositOfferAllowListTx) error {
+	return nil
+}
+
 // camino metrics
 
 func (m *caminoTxMetrics) AddressStateTx(*txs.AddressStateTx) error {
@@ -134,3 +188,48 @@ func (m *caminoTxMetrics) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
 	m.numAddDepositOfferTxs.Inc()
 	return nil
 }
+
+func (m *caminoTxMetrics) AddProposalTx(*txs.AddProposalTx) error {
+	m.numAddProposalTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) AddVoteTx(*txs.AddVoteTx) error {
+	m.numAddVoteTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	m.numFinishProposalsTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) UpdateDepositOfferTx(*txs.UpdateDepositOfferTx) error {
+	m.numUpdateDepositOfferTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) TransferDepositTx(*txs.TransferDepositTx) error {
+	m.numTransferDepositTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) TreasuryConfigTx(*txs.TreasuryConfigTx) error {
+	m.numTreasuryConfigTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) TreasurySpendTx(*txs.TreasurySpendTx) error {
+	m.numTreasurySpendTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) ExtendValidatorTx(*txs.ExtendValidatorTx) error {
+	m.numExtendValidatorTxs.Inc()
+	return nil
+}
+
+func (m *caminoTxMetrics) UpdateDepositOfferAllowListTx(*txs.UpdateDepositOfferAllowListTx) error {
+	m.numUpdateDepositOfferAllowListTxs.Inc()
+	return nil
+}
@@ -23,10 +23,12 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -37,19 +39,33 @@ const (
 	shortLinksCacheSize   = 1024
 	msigOwnersCacheSize   = 16_384
 	claimablesCacheSize   = 1024
+	proposalsCacheSize    = 1024
 )
 
 var (
 	_ CaminoState = (*caminoState)(nil)
 
-	caminoPrefix              = []byte("camino")
-	addressStatePrefix        = []byte("addressState")
-	depositOffersPrefix       = []byte("depositOffers")
-	depositsPrefix            = []byte("deposits")
-	depositIDsByEndtimePrefix = []byte("depositIDsByEndtime")
-	multisigOwnersPrefix      = []byte("multisigOwners")
-	shortLinksPrefix          = []byte("shortLinks")
-	claimablesPrefix          = []byte("claimables")
+	caminoPrefix                  = []byte("camino")
+	addressStatePrefix            = []byte("addressState")
+	kycExpirationsPrefix          = []byte("kycExpirations")
+	kycExpirationsByTimePrefix    = []byte("kycExpirationsByTime")
+	nodeDeferralsPrefix           = []byte("nodeDeferrals")
+	nodeDeferralEndsByTimePrefix  = []byte("nodeDeferralEndsByTime")
+	depositOffersPrefix           = []byte("depositOffers")
+	depositOfferAllowListsPrefix  = []byte("depositOfferAllowLists")
+	depositsPrefix                = []byte("deposits")
+	depositIDsByEndtimePrefix     = []byte("depositIDsByEndtime")
+	depositIDsByOwnerPrefix       = []byte("depositIDsByOwner")
+	multisigOwnersPrefix          = []byte("multisigOwners")
+	multisigAliasesByMemberPrefix = []byte("multisigAliasesByMember")
+	shortLinksPrefix              = []byte("shortLinks")
+	nodeRegistrationsPrefix       = []byte("nodeRegistrations")
+	claimablesPrefix              = []byte("claimables")
+	proposalsPrefix               = []byte("proposals")
+	proposalIDsByEndtimePrefix    = []byte("proposalIDsByEndtime")
+	proposalOutcomesPrefix        = []byte("proposalOutcomes")
+	validatorRewardsHistoryPrefix = []byte("validatorRewardsHistory")
+	addressStateHistoryPrefix     = []byte("addressStateHistory")
 
 	// Used for prefixing the validatorsDB
 	deferredPrefix = []byte("deferred")
@@ -57,6 +73,11 @@ var (
 	nodeSignatureKey                 = []byte("nodeSignature")
 	depositBondModeKey               = []byte("depositBondMode")
 	notDistributedValidatorRewardKey = []byte("notDistributedValidatorReward")
+	rewardsImportProgressKey         = []byte("rewardsImportProgress")
+	treasuryConfigKey                = []byte("treasuryConfig")
+	treasurySpendingKey              = []byte("treasurySpending")
+	depositOwnersIndexedKey          = []byte("depositOwnersIndexed")
+	multisigAliasMembersIndexedKey   = []byte("multisigAliasMembersIndexed")
 
 	errWrongTxType      = errors.New("unexpected tx type")
 	errNonExistingOffer = errors.New("deposit offer doesn't exist")
@@ -72,6 +93,14 @@ type CaminoDiff interface {
 
 	SetAddressStates(ids.ShortID, txs.AddressState)
 	GetAddressStates(ids.ShortID) (txs.AddressState, error)
+	SetKYCExpiration(address ids.ShortID, expiration uint64)
+	GetKYCExpiration(address ids.ShortID) (uint64, error)
+	AddAddressStateChange(change *AddressStateChange)
+	GetNextKYCExpirationAddressesAndTime(excludedAddresses set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error)
+	SetNodeDeferral(address ids.ShortID, deferral *NodeDeferral)
+	// Returns database.ErrNotFound, if node of consortium member isn't deferred
+	GetNodeDeferral(address ids.ShortID) (*NodeDeferral, error)
+	GetNextNodeDeferralEndAddressesAndTime(excludedAddresses set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error)
 
 	// Deposit offers
 
@@ -79,6 +108,11 @@ type CaminoDiff interface {
 	GetDepositOffer(offerID ids.ID) (*deposit.Offer, error)
 	GetAllDepositOffers() ([]*deposit.Offer, error)
 
+	// Deposit offer allow-lists
+
+	SetDepositOfferAllowedAddress(offerID ids.ID, address ids.ShortID, allowed bool)
+	IsDepositOfferAllowedAddress(offerID ids.ID, address ids.ShortID) (bool, error)
+
 	// Deposits
 
 	// deposit should never be nil
@@ -101,12 +135,45 @@ type CaminoDiff interface {
 	SetShortIDLink(id ids.ShortID, key ShortLinkKey, link *ids.ShortID)
 	GetShortIDLink(id ids.ShortID, key ShortLinkKey) (ids.ShortID, error)
 
+	// Node registrations
+
+	// txID is nil, if node is unregistered; it's empty, if node is registered in genesis
+	SetNodeRegistrationTxID(nodeID ids.NodeID, txID *ids.ID)
+	// Returns database.ErrNotFound, if node isn't registered or was registered before registration txs were recorded
+	GetNodeRegistrationTxID(nodeID ids.NodeID) (ids.ID, error)
+
 	// Claimable & rewards
 
 	SetClaimable(ownerID ids.ID, claimable *Claimable)
 	GetClaimable(ownerID ids.ID) (*Claimable, error)
 	SetNotDistributedValidatorReward(reward uint64)
 	GetNotDistributedValidatorReward() (uint64, error)
+	SetRewardsImportProgress(progress *RewardsImportProgress)
+	GetRewardsImportProgress() (*RewardsImportProgress, error)
+	AddValidatorRewardsDistribution(distribution *ValidatorRewardsDistribution)
+
+	// DAO proposals
+
+	// proposal should never be nil
+	AddProposal(proposalID ids.ID, proposal *dao.ProposalState)
+	// proposal start and end should never be modified, proposal should never be nil
+	ModifyProposal(proposalID ids.ID, proposal *dao.ProposalState)
+	// proposal start and end should never be modified, proposal should never be nil
+	RemoveProposal(proposalID ids.ID, proposal *dao.ProposalState)
+	GetProposal(proposalID ids.ID) (*dao.ProposalState, error)
+	GetNextProposalExpirationTime(removedProposalIDs set.Set[ids.ID]) (time.Time, error)
+	GetNextToExpireProposalIDsAndTime(removedProposalIDs set.Set[ids.ID]) ([]ids.ID, time.Time, error)
+	SetProposalOutcome(proposalID ids.ID, outcome *ProposalOutcome)
+	// Returns database.ErrNotFound, if proposal isn't finished
+	GetProposalOutcome(proposalID ids.ID) (*ProposalOutcome, error)
+
+	// Treasury
+
+	SetTreasuryConfig(config *treasury.Config)
+	// Returns database.ErrNotFound, if treasury isn't configured
+	GetTreasuryConfig() (*treasury.Config, error)
+	SetTreasurySpending(spending *treasury.Spending)
+	GetTreasurySpending() (*treasury.Spending, error)
 
 	// Deferred validator set
 
@@ -130,7 +197,14 @@ type CaminoState interface {
 	CaminoDiff
 
 	CaminoConfig() *CaminoConfig
+	GetDepositIDsByOwner(owner ids.ShortID, startDepositTxID ids.ID, limit int) ([]ids.ID, error)
+	GetMultisigAliasesByMember(member ids.ShortID) ([]ids.ShortID, error)
+	GetShortIDLinks(key ShortLinkKey) ([]ShortIDLink, error)
+	GetDepositOfferAllowList(offerID ids.ID) ([]ids.ShortID, error)
+	GetValidatorRewardsDistributions(startTime uint64, startTxID ids.ID, endTime uint64, limit int) ([]*ValidatorRewardsDistribution, error)
+	GetAddressStateChanges(address ids.ShortID, startTime uint64, startTxID ids.ID, limit int) ([]*AddressStateChange, error)
 	SyncGenesis(*state, *genesis.State) error
+	updateDepositOwners(depositTxID ids.ID, addrs set.Set[ids.ShortID], add bool) error
 	Load(*state) error
 	Write() error
 	Close() error
@@ -144,12 +218,23 @@ type CaminoConfig struct {
 type caminoDiff struct {
 	deferredStakerDiffs                   diffStakers
 	modifiedAddressStates                 map[ids.ShortID]txs.AddressState
+	modifiedKYCExpirations                map[ids.ShortID]uint64
+	addedAddressStateChanges              []*AddressStateChange
+	modifiedNodeDeferrals                 map[ids.ShortID]*NodeDeferral
 	modifiedDepositOffers                 map[ids.ID]*deposit.Offer
+	modifiedDepositOfferAllowLists        map[depositOfferAllowListKey]bool
 	modifiedDeposits                      map[ids.ID]*depositDiff
 	modifiedMultisigAliases               map[ids.ShortID]*multisig.AliasWithNonce
 	modifiedShortLinks                    map[ids.ID]*ids.ShortID
+	modifiedNodeRegistrationTxIDs         map[ids.NodeID]*ids.ID
 	modifiedClaimables                    map[ids.ID]*Claimable
+	modifiedProposals                     map[ids.ID]*proposalDiff
+	modifiedProposalOutcomes              map[ids.ID]*ProposalOutcome
 	modifiedNotDistributedValidatorReward *uint64
+	modifiedRewardsImportProgress         *RewardsImportProgress
+	addedValidatorRewardsDistributions    []*ValidatorRewardsDistribution
+	modifiedTreasuryConfig                *treasury.Config
+	modifiedTreasurySpending              *treasury.Spending
 }
 
 type caminoState struct {
@@ -169,39 +254,78 @@ type caminoState struct {
 	addressStateCache cache.Cacher[ids.ShortID, txs.AddressState]
 	addressStateDB    database.Database
 
+	// Address state history
+	addressStateHistoryDB database.Database
+
+	// KYC expirations
+	kycExpirationsDB       database.Database
+	kycExpirationsByTimeDB database.Database
+
+	// Node deferrals
+	nodeDeferralsDB          database.Database
+	nodeDeferralEndsByTimeDB database.Database
+
 	// Deposit offers
 	depositOffers   map[ids.ID]*deposit.Offer
 	depositOffersDB database.Database
 
+	// Deposit offer allow-lists
+	depositOfferAllowListsDB database.Database
+
 	// Deposits
 	depositsNextToUnlockTime *time.Time
 	depositsNextToUnlockIDs  []ids.ID
 	depositsCache            cache.Cacher[ids.ID, *deposit.Deposit]
 	depositsDB               database.Database
 	depositIDsByEndtimeDB    database.Database
+	depositIDsByOwnerDB      database.Database
 
 	// MSIG aliases
-	multisigAliasesCache cache.Cacher[ids.ShortID, *multisig.AliasWithNonce]
-	multisigAliasesDB    database.Database
+	multisigAliasesCache      cache.Cacher[ids.ShortID, *multisig.AliasWithNonce]
+	multisigAliasesDB         database.Database
+	multisigAliasesByMemberDB database.Database
 
 	// ShortIDs link
 	shortLinksCache cache.Cacher[ids.ID, *ids.ShortID]
 	shortLinksDB    database.Database
 
+	// Node registrations
+	nodeRegistrationsDB database.Database
+
 	//  Claimables
 	notDistributedValidatorReward uint64
 	claimablesDB                  database.Database
 	claimablesCache               cache.Cacher[ids.ID, *Claimable]
+	rewardsImportProgress         *RewardsImportProgress
+	validatorRewardsHistoryDB     database.Database
+
+	// Treasury
+	treasuryConfig   *treasury.Config
+	treasurySpending *treasury.Spending
+
+	// DAO proposals
+	proposalsNextExpirationTime *time.Time
+	proposalsNextToExpireIDs    []ids.ID
+	proposalsCache              cache.Cacher[ids.ID, *dao.ProposalState]
+	proposalsDB                 database.Database
+	proposalIDsByEndtimeDB      database.Database
+	proposalOutcomesDB          database.Database
 }
 
 func newCaminoDiff() *caminoDiff {
 	return &caminoDiff{
-		modifiedAddressStates:   make(map[ids.ShortID]txs.AddressState),
-		modifiedDepositOffers:   make(map[ids.ID]*deposit.Offer),
-		modifiedDeposits:        make(map[ids.ID]*depositDiff),
-		modifiedMultisigAliases: make(map[ids.ShortID]*multisig.AliasWithNonce),
-		modifiedShortLinks:      make(map[ids.ID]*ids.ShortID),
-		modifiedClaimables:      make(map[ids.ID]*Claimable),
+		modifiedAddressStates:          make(map[ids.ShortID]txs.AddressState),
+		modifiedKYCExpirations:         make(map[ids.ShortID]uint64),
+		modifiedNodeDeferrals:          make(map[ids.ShortID]*NodeDeferral),
+		modifiedDepositOffers:          make(map[ids.ID]*deposit.Offer),
+		modifiedDepositOfferAllowLists: make(map[depositOfferAllowListKey]bool),
+		modifiedDeposits:               make(map[ids.ID]*depositDiff),
+		modifiedMultisigAliases:        make(map[ids.ShortID]*multisig.AliasWithNonce),
+		modifiedShortLinks:             make(map[ids.ID]*ids.ShortID),
+		modifiedNodeRegistrationTxIDs:  make(map[ids.NodeID]*ids.ID),
+		modifiedClaimables:             make(map[ids.ID]*Claimable),
+		modifiedProposals:              make(map[ids.ID]*proposalDiff),
+		modifiedProposalOutcomes:       make(map[ids.ID]*ProposalOutcome),
 	}
 }
 
@@ -251,6 +375,15 @@ func newCaminoState(baseDB, validatorsDB database.Database, metricsReg prometheu
 		return nil, err
 	}
 
+	proposalsCache, err := metercacher.New[ids.ID, *dao.ProposalState](
+		"proposals_cache",
+		metricsReg,
+		&cache.LRU[ids.ID, *dao.ProposalState]{Size: proposalsCacheSize},
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	deferredValidatorsDB := prefixdb.New(deferredPrefix, validatorsDB)
 
 	return &caminoState{
@@ -258,32 +391,63 @@ func newCaminoState(baseDB, validatorsDB database.Database, metricsReg prometheu
 		addressStateDB:    prefixdb.New(addressStatePrefix, baseDB),
 		addressStateCache: addressStateCache,
 
+		// Address state history
+		addressStateHistoryDB: prefixdb.New(addressStateHistoryPrefix, baseDB),
+
+		// KYC expirations
+		kycExpirationsDB:       prefixdb.New(kycExpirationsPrefix, baseDB),
+		kycExpirationsByTimeDB: prefixdb.New(kycExpirationsByTimePrefix, baseDB),
+
+		// Node deferrals
+		nodeDeferralsDB:          prefixdb.New(nodeDeferralsPrefix, baseDB),
+		nodeDeferralEndsByTimeDB: prefixdb.New(nodeDeferralEndsByTimePrefix, baseDB),
+
 		// Deposit offers
 		depositOffers:   make(map[ids.ID]*deposit.Offer),
 		depositOffersDB: prefixdb.New(depositOffersPrefix, baseDB),
 
+		// Deposit offer allow-lists
+		depositOfferAllowListsDB: prefixdb.New(depositOfferAllowListsPrefix, baseDB),
+
 		// Deposits
 		depositsCache:         depositsCache,
 		depositsDB:            prefixdb.New(depositsPrefix, baseDB),
 		depositIDsByEndtimeDB: prefixdb.New(depositIDsByEndtimePrefix, baseDB),
+		depositIDsByOwnerDB:   prefixdb.New(depositIDsByOwnerPrefix, baseDB),
 
 		// Multisig Owners
-		multisigAliasesCache: multisigOwnersCache,
-		multisigAliasesDB:    prefixdb.New(multisigOwnersPrefix, baseDB),
+		multisigAliasesCache:      multisigOwnersCache,
+		multisigAliasesDB:         prefixdb.New(multisigOwnersPrefix, baseDB),
+		multisigAliasesByMemberDB: prefixdb.New(multisigAliasesByMemberPrefix, baseDB),
 
 		// Short links
 		shortLinksCache: shortLinksCache,
 		shortLinksDB:    prefixdb.New(shortLinksPrefix, baseDB),
 
+		// Node registrations
+		nodeRegistrationsDB: prefixdb.New(nodeRegistrationsPrefix, baseDB),
+
 		//  Claimable & rewards
 		claimablesCache: claimablesCache,
 		claimablesDB:    prefixdb.New(claimablesPrefix, baseDB),
 
+		rewardsImportProgress:     &RewardsImportProgress{},
+		validatorRewardsHistoryDB: prefixdb.New(validatorRewardsHistoryPrefix, baseDB),
+
+		// DAO proposals
+		proposalsCache:         proposalsCache,
+		proposalsDB:            prefixdb.New(proposalsPrefix, baseDB),
+		proposalIDsByEndtimeDB: prefixdb.New(proposalIDsByEndtimePrefix, baseDB),
+		proposalOutcomesDB:     prefixdb.New(proposalOutcomesPrefix, baseDB),
+
 		// Deferred Stakers
 		deferredStakers:       newBaseStakers(),
 		deferredValidatorsDB:  deferredValidatorsDB,
 		deferredValidatorList: linkeddb.NewDefault(deferredValidatorsDB),
 
+		// Treasury
+		treasurySpending: &treasury.Spending{},
+
 		caminoDB:   prefixdb.New(caminoPrefix, baseDB),
 		caminoDiff: newCaminoDiff(),
 	}, nil
@@ -338,6 +502,7 @@ func (cs *caminoState) SyncGenesis(s *state, g *genesis.State) error {
 
 	// adding consortium member nodes
 
+	deferredNodes := set.Set[ids.NodeID]{}
 	for _, consortiumMemberNode := range g.Camino.ConsortiumMembersNodeIDs {
 		consortiumMemberNode := consortiumMemberNode
 		cs.SetShortIDLink(
@@ -351,6 +516,20 @@ func (cs *caminoState) SyncGenesis(s *state, g *genesis.State) error {
 			ShortLinkKeyRegisterNode,
 			&backLink,
 		)
+		genesisRegistrationTxID := ids.Empty
+		cs.SetNodeRegistrationTxID(consortiumMemberNode.NodeID, &genesisRegistrationTxID)
+
+		consortiumMemberAddressState, err := cs.GetAddressStates(consortiumMemberNode.ConsortiumMemberAddress)
+		if err != nil {
+			return err
+		}
+		if consortiumMemberAddressState&txs.AddressStateNodeDeferred != 0 {
+			deferredNodes.Add(consortiumMemberNode.NodeID)
+			cs.SetNodeDeferral(consortiumMemberNode.ConsortiumMemberAddress, &NodeDeferral{
+				TxID:  ids.Empty,
+				Start: g.Timestamp,
+			})
+		}
 	}
 
 	// adding deposit offers
@@ -367,6 +546,13 @@ func (cs *caminoState) SyncGenesis(s *state, g *genesis.State) error {
 		cs.SetMultisigAlias(&multisig.AliasWithNonce{Alias: *multisigAlias})
 	}
 
+	// adding treasury config
+
+	if g.Camino.UpgradeVersionID.Version() > 0 {
+		treasuryConfig := g.Camino.TreasuryConfig
+		cs.SetTreasuryConfig(&treasuryConfig)
+	}
+
 	// adding blocks (validators and deposits)
 
 	for blockIndex, block := range g.Camino.Blocks {
@@ -397,7 +583,11 @@ func (cs *caminoState) SyncGenesis(s *state, g *genesis.State) error {
 				return err
 			}
 
-			s.PutCurrentValidator(staker)
+			if deferredNodes.Contains(staker.NodeID) {
+				cs.PutDeferredValidator(staker)
+			} else {
+				s.PutCurrentValidator(staker)
+			}
 			s.AddTx(tx, status.Committed)
 		}
 
@@ -440,11 +630,23 @@ func (cs *caminoState) SyncGenesis(s *state, g *genesis.State) error {
 				return errNonExistingOffer
 			}
 
-			newCurrentSupply, err := math.Add64(currentSupply, deposit.TotalReward(offer))
+			potentialReward := deposit.TotalReward(offer)
+			newCurrentSupply, err := math.Add64(currentSupply, potentialReward)
 			if err != nil {
 				return err
 			}
 
+			if offer.TotalMaxAmount > 0 || offer.TotalMaxRewardAmount > 0 {
+				updatedOffer := *offer
+				if offer.TotalMaxAmount > 0 {
+					updatedOffer.DepositedAmount += deposit.Amount
+				} else {
+					updatedOffer.RewardedAmount += potentialReward
+				}
+				depositOffers[updatedOffer.ID] = &updatedOffer
+				cs.SetDepositOffer(&updatedOffer)
+			}
+
 			s.SetCurrentSupply(constants.PrimaryNetworkID, newCurrentSupply)
 			cs.AddDeposit(depositTxID, deposit)
 			s.AddTx(tx, status.Committed)
@@ -492,9 +694,22 @@ func (cs *caminoState) Load(s *state) error {
 		cs.loadDepositOffers(),
 		cs.loadDeposits(),
 		cs.loadValidatorRewards(),
+		cs.loadTreasury(),
 		cs.loadDeferredValidators(s),
+		cs.loadProposals(),
 	)
-	return errs.Err
+	if errs.Errored() {
+		return errs.Err
+	}
+
+	// building indexes, that didn't exist when state was written
+	if err := cs.indexDepositOwners(s); err != nil {
+		return err
+	}
+	if err := cs.indexMultisigAliasMembers(); err != nil {
+		return err
+	}
+	return s.baseDB.Commit()
 }
 
 func (cs *caminoState) Write() error {
@@ -504,16 +719,28 @@ func (cs *caminoState) Write() error {
 		errs.Add(
 			database.PutBool(cs.caminoDB, nodeSignatureKey, cs.verifyNodeSignature),
 			database.PutBool(cs.caminoDB, depositBondModeKey, cs.lockModeBondDeposit),
+			// genesis deposits and utxos are indexed, when they are written
+			database.PutBool(cs.caminoDB, depositOwnersIndexedKey, true),
+			database.PutBool(cs.caminoDB, multisigAliasMembersIndexedKey, true),
 		)
 	}
 	errs.Add(
 		cs.writeAddressStates(),
+		cs.writeAddressStateHistory(),
+		cs.writeKYCExpirations(),
+		cs.writeNodeDeferrals(),
 		cs.writeDepositOffers(),
+		cs.writeDepositOfferAllowLists(),
 		cs.writeDeposits(),
 		cs.writeMultisigAliases(),
 		cs.writeShortLinks(),
+		cs.writeNodeRegistrations(),
 		cs.writeClaimableAndValidatorRewards(),
+		cs.writeValidatorRewardsHistory(),
+		cs.writeTreasury(),
 		cs.writeDeferredStakers(),
+		cs.writeProposals(),
+		cs.writeProposalOutcomes(),
 	)
 	return errs.Err
 }
@@ -523,13 +750,26 @@ func (cs *caminoState) Close() error {
 	errs.Add(
 		cs.caminoDB.Close(),
 		cs.addressStateDB.Close(),
+		cs.addressStateHistoryDB.Close(),
+		cs.kycExpirationsDB.Close(),
+		cs.kycExpirationsByTimeDB.Close(),
+		cs.nodeDeferralsDB.Close(),
+		cs.nodeDeferralEndsByTimeDB.Close(),
 		cs.depositOffersDB.Close(),
+		cs.depositOfferAllowListsDB.Close(),
 		cs.depositsDB.Close(),
 		cs.depositIDsByEndtimeDB.Close(),
+		cs.depositIDsByOwnerDB.Close(),
 		cs.multisigAliasesDB.Close(),
+		cs.multisigAliasesByMemberDB.Close(),
 		cs.shortLinksDB.Close(),
+		cs.nodeRegistrationsDB.Close(),
 		cs.claimablesDB.Close(),
+		cs.validatorRewardsHistoryDB.Close(),
 		cs.deferredValidatorsDB.Close(),
+		cs.proposalsDB.Close(),
+		cs.proposalIDsByEndtimeDB.Close(),
+		cs.proposalOutcomesDB.Close(),
 	)
 	return errs.Err
 }
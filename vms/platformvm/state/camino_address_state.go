@@ -4,10 +4,17 @@
 package state
 
 import (
+	"bytes"
 	"encoding/binary"
+	"fmt"
+	"time"
 
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
 
@@ -58,3 +65,224 @@ func (cs *caminoState) writeAddressStates() error {
 	}
 	return nil
 }
+
+// AddressStateChange is a record of address state bit change made by address state tx
+// or automatically on chain time advance (kyc expiration, node deferral end)
+type AddressStateChange struct {
+	// Address, which state was changed
+	Address ids.ShortID `serialize:"true"`
+	// ID of address state tx, that made this change,
+	// or AutomaticAddressStateChangeID for automatic changes
+	TxID ids.ID `serialize:"true"`
+	// Chain time, when change was made
+	Timestamp uint64 `serialize:"true"`
+	// Address state bit, that was added or removed
+	Bit txs.AddressStateBit `serialize:"true"`
+	// True, if bit was removed
+	Remove bool `serialize:"true"`
+	// Tx executor or, for txs without executor, addresses that signed tx, sorted
+	Executors []ids.ShortID `serialize:"true"`
+}
+
+// AutomaticAddressStateChangeID returns id, that is used instead of tx id for changes of address state [bit],
+// that are made automatically on chain time advance. It's unique per bit, so changes of different bits
+// made at the same time don't overwrite each other in address state history.
+func AutomaticAddressStateChangeID(bit txs.AddressStateBit) ids.ID {
+	return ids.Empty.Prefix(uint64(bit))
+}
+
+func (cs *caminoState) AddAddressStateChange(change *AddressStateChange) {
+	cs.addedAddressStateChanges = append(cs.addedAddressStateChanges, change)
+}
+
+// Returns address state changes of [address] made since [startTime], sorted by time and tx id.
+// If [startTxID] isn't empty, changes made at [startTime] with tx id less or equal to [startTxID]
+// are skipped. Returns not more than [limit] changes.
+func (cs *caminoState) GetAddressStateChanges(address ids.ShortID, startTime uint64, startTxID ids.ID, limit int) ([]*AddressStateChange, error) {
+	startKey := addressStateChangeKey(address, startTime, startTxID)
+	changesIterator := cs.addressStateHistoryDB.NewIteratorWithStartAndPrefix(startKey, address[:])
+	defer changesIterator.Release()
+
+	var changes []*AddressStateChange
+	for len(changes) < limit && changesIterator.Next() {
+		if bytes.Equal(changesIterator.Key(), startKey) {
+			continue
+		}
+
+		change := &AddressStateChange{}
+		if _, err := blocks.GenesisCodec.Unmarshal(changesIterator.Value(), change); err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	if err := changesIterator.Error(); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+func (cs *caminoState) writeAddressStateHistory() error {
+	for _, change := range cs.addedAddressStateChanges {
+		changeBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, change)
+		if err != nil {
+			return fmt.Errorf("failed to serialize address state change: %w", err)
+		}
+		key := addressStateChangeKey(change.Address, change.Timestamp, change.TxID)
+		if err := cs.addressStateHistoryDB.Put(key, changeBytes); err != nil {
+			return err
+		}
+	}
+	cs.addedAddressStateChanges = nil
+	return nil
+}
+
+func addressStateChangeKey(address ids.ShortID, timestamp uint64, txID ids.ID) []byte {
+	key := make([]byte, len(address)+8+len(txID))
+	copy(key, address[:])
+	binary.BigEndian.PutUint64(key[len(address):], timestamp)
+	copy(key[len(address)+8:], txID[:])
+	return key
+}
+
+// Set kyc verification expiration timestamp for the address, zero removes expiration
+func (cs *caminoState) SetKYCExpiration(address ids.ShortID, expiration uint64) {
+	cs.modifiedKYCExpirations[address] = expiration
+}
+
+// Return kyc verification expiration timestamp for the address or zero, if it doesn't expire
+func (cs *caminoState) GetKYCExpiration(address ids.ShortID) (uint64, error) {
+	if expiration, ok := cs.modifiedKYCExpirations[address]; ok {
+		return expiration, nil
+	}
+	expiration, err := database.GetUInt64(cs.kycExpirationsDB, address[:])
+	if err == database.ErrNotFound {
+		return 0, nil
+	}
+	return expiration, err
+}
+
+// Return addresses with the earliest kyc verification expiration and that expiration time.
+// Addresses from [excludedAddresses] are ignored.
+func (cs *caminoState) GetNextKYCExpirationAddressesAndTime(excludedAddresses set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	excluded := set.NewSet[ids.ShortID](excludedAddresses.Len() + len(cs.modifiedKYCExpirations))
+	excluded.Union(excludedAddresses)
+	for address := range cs.modifiedKYCExpirations {
+		excluded.Add(address)
+	}
+
+	nextAddresses, nextTime, err := getNextAddressesAndTimeFromDB(cs.kycExpirationsByTimeDB, excluded)
+	if err != nil && err != database.ErrNotFound {
+		return nil, time.Time{}, err
+	}
+
+	return nextAddressesAndTime(cs.modifiedKYCExpirations, excludedAddresses, nextAddresses, nextTime)
+}
+
+func (cs *caminoState) writeKYCExpirations() error {
+	for address, expiration := range cs.modifiedKYCExpirations {
+		delete(cs.modifiedKYCExpirations, address)
+
+		oldExpiration, err := database.GetUInt64(cs.kycExpirationsDB, address[:])
+		switch {
+		case err == nil:
+			if err := cs.kycExpirationsByTimeDB.Delete(addressByTimeKey(address, oldExpiration)); err != nil {
+				return err
+			}
+		case err != database.ErrNotFound:
+			return err
+		}
+
+		if expiration == 0 {
+			if err := cs.kycExpirationsDB.Delete(address[:]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := database.PutUInt64(cs.kycExpirationsDB, address[:], expiration); err != nil {
+			return err
+		}
+		if err := cs.kycExpirationsByTimeDB.Put(addressByTimeKey(address, expiration), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns addresses with the earliest timestamp from [addressesByTimeDB] and that timestamp.
+// [addressesByTimeDB] keys must be created with addressByTimeKey. Addresses from [excludedAddresses] are ignored.
+func getNextAddressesAndTimeFromDB(addressesByTimeDB database.Database, excludedAddresses set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	iterator := addressesByTimeDB.NewIterator()
+	defer iterator.Release()
+
+	var nextAddresses []ids.ShortID
+	nextTimestamp := uint64(0)
+
+	for iterator.Next() {
+		key := iterator.Key()
+		timestamp := binary.BigEndian.Uint64(key[:8])
+		// we expect values to be sorted by timestamp in ascending order
+		if len(nextAddresses) > 0 && timestamp > nextTimestamp {
+			break
+		}
+		address, err := ids.ToShortID(key[8:])
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		if excludedAddresses.Contains(address) {
+			continue
+		}
+		nextTimestamp = timestamp
+		nextAddresses = append(nextAddresses, address)
+	}
+
+	if err := iterator.Error(); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	if len(nextAddresses) == 0 {
+		return nil, mockable.MaxTime, database.ErrNotFound
+	}
+
+	return nextAddresses, time.Unix(int64(nextTimestamp), 0), nil
+}
+
+// Merges addresses with the earliest timestamp from [modifiedTimestamps]
+// into [nextAddresses] with [nextTime] timestamp. Zero timestamps and addresses from [excludedAddresses] are ignored.
+// Returns sorted addresses with the earliest timestamp or database.ErrNotFound, if there are none.
+func nextAddressesAndTime(
+	modifiedTimestamps map[ids.ShortID]uint64,
+	excludedAddresses set.Set[ids.ShortID],
+	nextAddresses []ids.ShortID,
+	nextTime time.Time,
+) ([]ids.ShortID, time.Time, error) {
+	for address, timestamp := range modifiedTimestamps {
+		if timestamp == 0 || excludedAddresses.Contains(address) {
+			continue
+		}
+		addressTime := time.Unix(int64(timestamp), 0)
+		switch {
+		case addressTime.Before(nextTime):
+			nextTime = addressTime
+			nextAddresses = []ids.ShortID{address}
+		case addressTime.Equal(nextTime):
+			nextAddresses = append(nextAddresses, address)
+		}
+	}
+
+	if len(nextAddresses) == 0 {
+		return nil, mockable.MaxTime, database.ErrNotFound
+	}
+
+	utils.Sort(nextAddresses)
+	return nextAddresses, nextTime, nil
+}
+
+func addressByTimeKey(address ids.ShortID, timestamp uint64) []byte {
+	key := make([]byte, 8+len(address))
+	binary.BigEndian.PutUint64(key, timestamp)
+	copy(key[8:], address[:])
+	return key
+}
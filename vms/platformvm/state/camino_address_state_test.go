@@ -7,10 +7,12 @@ import (
 	"encoding/binary"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -284,3 +286,135 @@ func TestWriteAddressStates(t *testing.T) {
 		})
 	}
 }
+
+func TestKYCExpirations(t *testing.T) {
+	require := require.New(t)
+	addr1 := ids.ShortID{1}
+	addr2 := ids.ShortID{2}
+	addr3 := ids.ShortID{3}
+
+	s := newEmptyState(t)
+
+	requireNextExpiration := func(excluded set.Set[ids.ShortID], expectedAddrs []ids.ShortID, expectedTime uint64) {
+		addrs, nextTime, err := s.GetNextKYCExpirationAddressesAndTime(excluded)
+		if expectedAddrs == nil {
+			require.ErrorIs(err, database.ErrNotFound)
+			return
+		}
+		require.NoError(err)
+		require.Equal(expectedAddrs, addrs)
+		require.Equal(time.Unix(int64(expectedTime), 0), nextTime)
+	}
+
+	requireNextExpiration(nil, nil, 0)
+
+	// not written expirations
+
+	s.SetKYCExpiration(addr2, 10)
+	s.SetKYCExpiration(addr1, 10)
+	s.SetKYCExpiration(addr3, 20)
+	requireNextExpiration(nil, []ids.ShortID{addr1, addr2}, 10)
+
+	// written expirations
+
+	require.NoError(s.write(false, 0))
+	expiration, err := s.GetKYCExpiration(addr1)
+	require.NoError(err)
+	require.Equal(uint64(10), expiration)
+	requireNextExpiration(nil, []ids.ShortID{addr1, addr2}, 10)
+	requireNextExpiration(set.Set[ids.ShortID]{addr1: struct{}{}}, []ids.ShortID{addr2}, 10)
+	requireNextExpiration(set.Set[ids.ShortID]{addr1: struct{}{}, addr2: struct{}{}}, []ids.ShortID{addr3}, 20)
+
+	// modified expirations override written ones
+
+	s.SetKYCExpiration(addr1, 30)
+	s.SetKYCExpiration(addr2, 0)
+	requireNextExpiration(nil, []ids.ShortID{addr3}, 20)
+
+	require.NoError(s.write(false, 0))
+	expiration, err = s.GetKYCExpiration(addr2)
+	require.NoError(err)
+	require.Zero(expiration)
+	requireNextExpiration(nil, []ids.ShortID{addr3}, 20)
+	requireNextExpiration(set.Set[ids.ShortID]{addr3: struct{}{}}, []ids.ShortID{addr1}, 30)
+	requireNextExpiration(set.Set[ids.ShortID]{addr1: struct{}{}, addr3: struct{}{}}, nil, 0)
+}
+
+func TestAddressStateHistory(t *testing.T) {
+	require := require.New(t)
+	s := newEmptyState(t)
+
+	address1 := ids.ShortID{1}
+	address2 := ids.ShortID{2}
+	executor := ids.ShortID{3}
+	change := func(address ids.ShortID, txID ids.ID, timestamp uint64, bit txs.AddressStateBit, remove bool) *AddressStateChange {
+		return &AddressStateChange{
+			Address:   address,
+			TxID:      txID,
+			Timestamp: timestamp,
+			Bit:       bit,
+			Remove:    remove,
+			Executors: []ids.ShortID{executor},
+		}
+	}
+	change1 := change(address1, ids.ID{2}, 10, txs.AddressStateBitKYCVerified, false)
+	change2 := change(address1, ids.ID{1}, 20, txs.AddressStateBitConsortium, false)
+	change3 := change(address1, ids.ID{3}, 20, txs.AddressStateBitKYCVerified, true)
+	change4 := change(address2, ids.ID{4}, 15, txs.AddressStateBitRoleKYC, false)
+
+	// changes of different blocks
+	s.AddAddressStateChange(change3)
+	s.AddAddressStateChange(change4)
+	require.NoError(s.write(false, 0))
+	s.AddAddressStateChange(change1)
+	s.AddAddressStateChange(change2)
+	require.NoError(s.write(false, 0))
+
+	tests := map[string]struct {
+		address         ids.ShortID
+		startTime       uint64
+		startTxID       ids.ID
+		limit           int
+		expectedChanges []*AddressStateChange
+	}{
+		"All changes of address": {
+			address:         address1,
+			limit:           10,
+			expectedChanges: []*AddressStateChange{change1, change2, change3},
+		},
+		"Start time": {
+			address:         address1,
+			startTime:       11,
+			limit:           10,
+			expectedChanges: []*AddressStateChange{change2, change3},
+		},
+		"Start tx id": {
+			address:         address1,
+			startTime:       20,
+			startTxID:       change2.TxID,
+			limit:           10,
+			expectedChanges: []*AddressStateChange{change3},
+		},
+		"Limit": {
+			address:         address1,
+			limit:           1,
+			expectedChanges: []*AddressStateChange{change1},
+		},
+		"Other address": {
+			address:         address2,
+			limit:           10,
+			expectedChanges: []*AddressStateChange{change4},
+		},
+		"No changes": {
+			address: ids.ShortID{5},
+			limit:   10,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			changes, err := s.GetAddressStateChanges(tt.address, tt.startTime, tt.startTxID, tt.limit)
+			require.NoError(err)
+			require.Equal(tt.expectedChanges, changes)
+		})
+	}
+}
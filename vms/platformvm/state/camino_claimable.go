@@ -12,6 +12,18 @@ import (
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
+// RewardsImportProgress is a progress of c-chain fee rewards import
+type RewardsImportProgress struct {
+	// Number of imported treasury utxos
+	ImportedUTXOs uint64 `serialize:"true"`
+	// Total amount of imported treasury utxos
+	ImportedAmount uint64 `serialize:"true"`
+	// ID of the last rewards import tx
+	LastImportTxID ids.ID `serialize:"true"`
+	// Chain time, when the last rewards import tx was executed
+	LastImportTime uint64 `serialize:"true"`
+}
+
 type Claimable struct {
 	Owner                *secp256k1fx.OutputOwners `serialize:"true"`
 	ValidatorReward      uint64                    `serialize:"true"`
@@ -67,6 +79,17 @@ func (cs *caminoState) GetNotDistributedValidatorReward() (uint64, error) {
 	return cs.notDistributedValidatorReward, nil
 }
 
+func (cs *caminoState) SetRewardsImportProgress(progress *RewardsImportProgress) {
+	cs.modifiedRewardsImportProgress = progress
+}
+
+func (cs *caminoState) GetRewardsImportProgress() (*RewardsImportProgress, error) {
+	if cs.modifiedRewardsImportProgress != nil {
+		return cs.modifiedRewardsImportProgress, nil
+	}
+	return cs.rewardsImportProgress, nil
+}
+
 func (cs *caminoState) writeClaimableAndValidatorRewards() error {
 	if cs.modifiedNotDistributedValidatorReward != nil &&
 		*cs.modifiedNotDistributedValidatorReward != cs.notDistributedValidatorReward {
@@ -81,6 +104,18 @@ func (cs *caminoState) writeClaimableAndValidatorRewards() error {
 	}
 	cs.modifiedNotDistributedValidatorReward = nil
 
+	if cs.modifiedRewardsImportProgress != nil {
+		progressBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, cs.modifiedRewardsImportProgress)
+		if err != nil {
+			return fmt.Errorf("failed to serialize rewards import progress: %w", err)
+		}
+		if err := cs.caminoDB.Put(rewardsImportProgressKey, progressBytes); err != nil {
+			return fmt.Errorf("failed to write rewards import progress: %w", err)
+		}
+		cs.rewardsImportProgress = cs.modifiedRewardsImportProgress
+		cs.modifiedRewardsImportProgress = nil
+	}
+
 	for key, claimable := range cs.modifiedClaimables {
 		delete(cs.modifiedClaimables, key)
 		if claimable == nil {
@@ -109,5 +144,16 @@ func (cs *caminoState) loadValidatorRewards() error {
 		return err
 	}
 	cs.notDistributedValidatorReward = notDistributedValidatorReward
+
+	cs.rewardsImportProgress = &RewardsImportProgress{}
+	progressBytes, err := cs.caminoDB.Get(rewardsImportProgressKey)
+	switch {
+	case err == nil:
+		if _, err := blocks.GenesisCodec.Unmarshal(progressBytes, cs.rewardsImportProgress); err != nil {
+			return err
+		}
+	case err != database.ErrNotFound:
+		return err
+	}
 	return nil
 }
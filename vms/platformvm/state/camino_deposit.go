@@ -16,6 +16,9 @@ import (
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
 type depositDiff struct {
@@ -149,6 +152,9 @@ func (cs *caminoState) writeDeposits() error {
 	// adding new deposits to db, deleting removed deposits from db
 	for depositTxID, depositDiff := range cs.modifiedDeposits {
 		delete(cs.modifiedDeposits, depositTxID)
+		if err := cs.writeDepositRewardOwner(depositTxID, depositDiff); err != nil {
+			return err
+		}
 		if depositDiff.removed {
 			if err := cs.depositsDB.Delete(depositTxID[:]); err != nil {
 				return err
@@ -190,6 +196,162 @@ func (cs *caminoState) writeDeposits() error {
 	return nil
 }
 
+// Updates deposits owners index with deposit reward owner.
+// Must be called before deposit is written into depositsDB.
+func (cs *caminoState) writeDepositRewardOwner(depositTxID ids.ID, depositDiff *depositDiff) error {
+	var oldRewardOwner fx.Owner
+	if !depositDiff.added {
+		depositBytes, err := cs.depositsDB.Get(depositTxID[:])
+		switch {
+		case err == nil:
+			oldDeposit := &deposit.Deposit{}
+			if _, err := blocks.GenesisCodec.Unmarshal(depositBytes, oldDeposit); err != nil {
+				return err
+			}
+			oldRewardOwner = oldDeposit.RewardOwner
+		case err != database.ErrNotFound:
+			return err
+		}
+	}
+
+	newRewardOwner := depositDiff.RewardOwner
+	if depositDiff.removed {
+		if oldRewardOwner == nil {
+			oldRewardOwner = newRewardOwner
+		}
+		newRewardOwner = nil
+	}
+
+	removedAddrs := ownerAddresses(oldRewardOwner)
+	addedAddrs := ownerAddresses(newRewardOwner)
+	removedAddrs.Difference(ownerAddresses(newRewardOwner))
+	addedAddrs.Difference(ownerAddresses(oldRewardOwner))
+
+	if err := cs.updateDepositOwners(depositTxID, removedAddrs, false); err != nil {
+		return err
+	}
+	return cs.updateDepositOwners(depositTxID, addedAddrs, true)
+}
+
+// Increases (if [add] is true) or decreases number of references
+// from [addrs] to deposit with [depositTxID] in deposits owners index.
+// Address is considered deposit owner, if it has any references to that deposit:
+// it can be deposit reward owner or own deposited utxos.
+func (cs *caminoState) updateDepositOwners(depositTxID ids.ID, addrs set.Set[ids.ShortID], add bool) error {
+	for addr := range addrs {
+		key := depositOwnerKey(addr, depositTxID)
+		refs, err := database.GetUInt64(cs.depositIDsByOwnerDB, key)
+		if err != nil && err != database.ErrNotFound {
+			return err
+		}
+
+		switch {
+		case add:
+			refs++
+		case refs > 1:
+			refs--
+		default:
+			if err := cs.depositIDsByOwnerDB.Delete(key); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := database.PutUInt64(cs.depositIDsByOwnerDB, key, refs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns deposit tx IDs of deposits, that have [owner] in their reward owner
+// or have deposited utxos owned by [owner]. Deposit tx IDs are sorted and start
+// after [startDepositTxID]. Returns not more than [limit] IDs.
+func (cs *caminoState) GetDepositIDsByOwner(owner ids.ShortID, startDepositTxID ids.ID, limit int) ([]ids.ID, error) {
+	iterator := cs.depositIDsByOwnerDB.NewIteratorWithStartAndPrefix(
+		depositOwnerKey(owner, startDepositTxID),
+		owner[:],
+	)
+	defer iterator.Release()
+
+	depositTxIDs := []ids.ID(nil)
+	for len(depositTxIDs) < limit && iterator.Next() {
+		depositTxID, err := ids.ToID(iterator.Key()[len(owner):])
+		if err != nil {
+			return nil, err
+		}
+		if depositTxID == startDepositTxID {
+			continue
+		}
+		depositTxIDs = append(depositTxIDs, depositTxID)
+	}
+	return depositTxIDs, iterator.Error()
+}
+
+// Builds deposits owners index for deposits and deposited utxos, that were created
+// before the index was introduced. Index is built only once and then persisted.
+func (cs *caminoState) indexDepositOwners(s *state) error {
+	indexed, err := database.GetBool(cs.caminoDB, depositOwnersIndexedKey)
+	if err != nil && err != database.ErrNotFound {
+		return err
+	}
+	if indexed {
+		return nil
+	}
+
+	depositsIterator := cs.depositsDB.NewIterator()
+	defer depositsIterator.Release()
+
+	for depositsIterator.Next() {
+		depositTxID, err := ids.ToID(depositsIterator.Key())
+		if err != nil {
+			return err
+		}
+
+		d := &deposit.Deposit{}
+		if _, err := blocks.GenesisCodec.Unmarshal(depositsIterator.Value(), d); err != nil {
+			return err
+		}
+
+		if err := cs.updateDepositOwners(depositTxID, ownerAddresses(d.RewardOwner), true); err != nil {
+			return err
+		}
+
+		// deposited utxos are owned by owners of deposit tx outputs
+		depositTx, _, err := s.GetTx(depositTxID)
+		if err != nil {
+			return err
+		}
+		depositTxOwners := set.NewSet[ids.ShortID](0)
+		for _, out := range depositTx.Unsigned.Outputs() {
+			if lockedOut, ok := out.Out.(*locked.Out); ok && lockedOut.IsNewlyLockedWith(locked.StateDeposited) {
+				if owned, ok := lockedOut.TransferableOut.(fx.Owned); ok {
+					depositTxOwners.Union(ownerAddresses(owned.Owners()))
+				}
+			}
+		}
+
+		depositedUTXOs, err := s.LockedUTXOs(set.Set[ids.ID]{depositTxID: struct{}{}}, depositTxOwners, locked.StateDeposited)
+		if err != nil {
+			return err
+		}
+		for _, utxo := range depositedUTXOs {
+			owned, ok := utxo.Out.(*locked.Out).TransferableOut.(fx.Owned)
+			if !ok {
+				continue
+			}
+			if err := cs.updateDepositOwners(depositTxID, ownerAddresses(owned.Owners()), true); err != nil {
+				return err
+			}
+		}
+	}
+	if err := depositsIterator.Error(); err != nil {
+		return err
+	}
+
+	return database.PutBool(cs.caminoDB, depositOwnersIndexedKey, true)
+}
+
 func (cs *caminoState) loadDeposits() error {
 	cs.depositsNextToUnlockIDs = nil
 	cs.depositsNextToUnlockTime = nil
@@ -258,3 +420,21 @@ func bytesToDepositIDAndEndtime(depositSortKeyBytes []byte) (ids.ID, uint64, err
 	}
 	return depositID, binary.BigEndian.Uint64(depositSortKeyBytes[:8]), nil
 }
+
+func depositOwnerKey(owner ids.ShortID, depositTxID ids.ID) []byte {
+	key := make([]byte, len(owner)+len(depositTxID))
+	copy(key, owner[:])
+	copy(key[len(owner):], depositTxID[:])
+	return key
+}
+
+// Returns addresses of secp256k1fx owner or empty set for other owner types
+func ownerAddresses(owner interface{}) set.Set[ids.ShortID] {
+	secpOwner, ok := owner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return nil
+	}
+	addrs := set.NewSet[ids.ShortID](len(secpOwner.Addrs))
+	addrs.Add(secpOwner.Addrs...)
+	return addrs
+}
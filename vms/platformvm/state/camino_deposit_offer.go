@@ -97,3 +97,64 @@ func (cs *caminoState) writeDepositOffers() error {
 	}
 	return nil
 }
+
+type depositOfferAllowListKey struct {
+	offerID ids.ID
+	address ids.ShortID
+}
+
+func (k depositOfferAllowListKey) bytes() []byte {
+	key := make([]byte, len(k.offerID)+len(k.address))
+	copy(key, k.offerID[:])
+	copy(key[len(k.offerID):], k.address[:])
+	return key
+}
+
+func (cs *caminoState) SetDepositOfferAllowedAddress(offerID ids.ID, address ids.ShortID, allowed bool) {
+	cs.modifiedDepositOfferAllowLists[depositOfferAllowListKey{offerID: offerID, address: address}] = allowed
+}
+
+func (cs *caminoState) IsDepositOfferAllowedAddress(offerID ids.ID, address ids.ShortID) (bool, error) {
+	key := depositOfferAllowListKey{offerID: offerID, address: address}
+	if allowed, ok := cs.modifiedDepositOfferAllowLists[key]; ok {
+		return allowed, nil
+	}
+	return cs.depositOfferAllowListsDB.Has(key.bytes())
+}
+
+// Returns addresses from allow-list of deposit offer with [offerID] sorted by address
+func (cs *caminoState) GetDepositOfferAllowList(offerID ids.ID) ([]ids.ShortID, error) {
+	allowListIterator := cs.depositOfferAllowListsDB.NewIteratorWithPrefix(offerID[:])
+	defer allowListIterator.Release()
+
+	var addresses []ids.ShortID
+	for allowListIterator.Next() {
+		address, err := ids.ToShortID(allowListIterator.Key()[len(offerID):])
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, address)
+	}
+
+	if err := allowListIterator.Error(); err != nil {
+		return nil, err
+	}
+
+	return addresses, nil
+}
+
+func (cs *caminoState) writeDepositOfferAllowLists() error {
+	for key, allowed := range cs.modifiedDepositOfferAllowLists {
+		delete(cs.modifiedDepositOfferAllowLists, key)
+		if allowed {
+			if err := cs.depositOfferAllowListsDB.Put(key.bytes(), nil); err != nil {
+				return err
+			}
+		} else {
+			if err := cs.depositOfferAllowListsDB.Delete(key.bytes()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
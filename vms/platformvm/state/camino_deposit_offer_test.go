@@ -12,6 +12,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -487,3 +488,105 @@ func TestLoadDepositOffers(t *testing.T) {
 		})
 	}
 }
+
+func TestDepositOfferAllowList(t *testing.T) {
+	require := require.New(t)
+	s := newEmptyState(t)
+
+	offerID1 := ids.ID{1}
+	offerID2 := ids.ID{2}
+	addr1 := ids.ShortID{1}
+	addr2 := ids.ShortID{2}
+
+	requireAllowed := func(offerID ids.ID, addr ids.ShortID, expected bool) {
+		allowed, err := s.IsDepositOfferAllowedAddress(offerID, addr)
+		require.NoError(err)
+		require.Equal(expected, allowed)
+	}
+
+	// not written allow-list
+
+	s.SetDepositOfferAllowedAddress(offerID1, addr2, true)
+	s.SetDepositOfferAllowedAddress(offerID1, addr1, true)
+	s.SetDepositOfferAllowedAddress(offerID2, addr1, true)
+	requireAllowed(offerID1, addr1, true)
+	requireAllowed(offerID2, addr2, false)
+
+	// written allow-list
+
+	require.NoError(s.write(false, 0))
+	requireAllowed(offerID1, addr1, true)
+	requireAllowed(offerID1, addr2, true)
+	requireAllowed(offerID2, addr1, true)
+	requireAllowed(offerID2, addr2, false)
+
+	allowList, err := s.GetDepositOfferAllowList(offerID1)
+	require.NoError(err)
+	require.Equal([]ids.ShortID{addr1, addr2}, allowList)
+
+	// removed addresses
+
+	s.SetDepositOfferAllowedAddress(offerID1, addr1, false)
+	requireAllowed(offerID1, addr1, false)
+
+	require.NoError(s.write(false, 0))
+	requireAllowed(offerID1, addr1, false)
+
+	allowList, err = s.GetDepositOfferAllowList(offerID1)
+	require.NoError(err)
+	require.Equal([]ids.ShortID{addr2}, allowList)
+}
+
+func TestDepositOfferEligibilityRulesSerialization(t *testing.T) {
+	require := require.New(t)
+
+	offer := &deposit.Offer{
+		UpgradeVersionID:     codec.UpgradeVersion3,
+		End:                  1,
+		MinAmount:            1,
+		Memo:                 types.JSONByteSlice{},
+		OwnerAddress:         ids.ShortID{1},
+		RequiredAddressState: 0b11,
+		AllowListEnabled:     true,
+	}
+	offerBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, offer)
+	require.NoError(err)
+
+	parsedOffer := &deposit.Offer{}
+	_, err = blocks.GenesisCodec.Unmarshal(offerBytes, parsedOffer)
+	require.NoError(err)
+	require.Equal(offer, parsedOffer)
+}
+
+func TestDepositOfferInterestRateTiersSerialization(t *testing.T) {
+	require := require.New(t)
+
+	offer := &deposit.Offer{
+		UpgradeVersionID:      codec.UpgradeVersion4,
+		End:                   1,
+		MinAmount:             1,
+		Memo:                  types.JSONByteSlice{},
+		InterestRateNominator: 1,
+		InterestRateTiers: []deposit.InterestRateTier{
+			{MinDuration: 10, InterestRateNominator: 2},
+			{MinDuration: 10, MinAmount: 100, InterestRateNominator: 3},
+		},
+	}
+	offerBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, offer)
+	require.NoError(err)
+
+	parsedOffer := &deposit.Offer{}
+	_, err = blocks.GenesisCodec.Unmarshal(offerBytes, parsedOffer)
+	require.NoError(err)
+	require.Equal(offer, parsedOffer)
+
+	// offers with previous versions don't have interest rate tiers
+	offer.UpgradeVersionID = codec.UpgradeVersion3
+	offerBytes, err = blocks.GenesisCodec.Marshal(blocks.Version, offer)
+	require.NoError(err)
+
+	parsedOffer = &deposit.Offer{}
+	_, err = blocks.GenesisCodec.Unmarshal(offerBytes, parsedOffer)
+	require.NoError(err)
+	require.Nil(parsedOffer.InterestRateTiers)
+}
@@ -13,8 +13,12 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -621,6 +625,9 @@ func TestWriteDeposits(t *testing.T) {
 	require.NoError(t, err)
 	deposit2Bytes, err := blocks.GenesisCodec.Marshal(blocks.Version, deposit2)
 	require.NoError(t, err)
+	deposit1OwnerKey := depositOwnerKey(ids.ShortID{1}, depositTxID1)
+	deposit2OwnerKey := depositOwnerKey(ids.ShortID{2}, depositTxID2)
+	deposit3OwnerKey := depositOwnerKey(ids.ShortID{3}, depositTxID3)
 
 	tests := map[string]struct {
 		caminoState         func(*gomock.Controller) *caminoState
@@ -630,6 +637,7 @@ func TestWriteDeposits(t *testing.T) {
 		"Fail: db errored on modified deposit Put": {
 			caminoState: func(c *gomock.Controller) *caminoState {
 				depositsDB := database.NewMockDatabase(c)
+				depositsDB.EXPECT().Get(depositTxID1[:]).Return(deposit1Bytes, nil)
 				depositsDB.EXPECT().Put(depositTxID1[:], deposit1Bytes).Return(testError)
 				return &caminoState{
 					caminoDiff: &caminoDiff{
@@ -650,17 +658,45 @@ func TestWriteDeposits(t *testing.T) {
 			},
 			expectedErr: testError,
 		},
+		"Fail: db errored on deposit owners index Put": {
+			caminoState: func(c *gomock.Controller) *caminoState {
+				depositIDsByOwnerDB := database.NewMockDatabase(c)
+				depositIDsByOwnerDB.EXPECT().Get(deposit1OwnerKey).Return(nil, database.ErrNotFound)
+				depositIDsByOwnerDB.EXPECT().Put(deposit1OwnerKey, database.PackUInt64(1)).Return(testError)
+				return &caminoState{
+					caminoDiff: &caminoDiff{
+						modifiedDeposits: map[ids.ID]*depositDiff{
+							depositTxID1: {Deposit: deposit1, added: true},
+						},
+					},
+					depositIDsByOwnerDB: depositIDsByOwnerDB,
+				}
+			},
+			expectedCaminoState: func(actualCaminoState *caminoState) *caminoState {
+				return &caminoState{
+					caminoDiff: &caminoDiff{
+						modifiedDeposits: map[ids.ID]*depositDiff{},
+					},
+					depositIDsByOwnerDB: actualCaminoState.depositIDsByOwnerDB,
+				}
+			},
+			expectedErr: testError,
+		},
 		"Fail: db errored on added deposit Put": {
 			caminoState: func(c *gomock.Controller) *caminoState {
 				depositsDB := database.NewMockDatabase(c)
 				depositsDB.EXPECT().Put(depositTxID1[:], deposit1Bytes).Return(testError)
+				depositIDsByOwnerDB := database.NewMockDatabase(c)
+				depositIDsByOwnerDB.EXPECT().Get(deposit1OwnerKey).Return(nil, database.ErrNotFound)
+				depositIDsByOwnerDB.EXPECT().Put(deposit1OwnerKey, database.PackUInt64(1)).Return(nil)
 				return &caminoState{
 					caminoDiff: &caminoDiff{
 						modifiedDeposits: map[ids.ID]*depositDiff{
 							depositTxID1: {Deposit: deposit1, added: true},
 						},
 					},
-					depositsDB: depositsDB,
+					depositsDB:          depositsDB,
+					depositIDsByOwnerDB: depositIDsByOwnerDB,
 				}
 			},
 			expectedCaminoState: func(actualCaminoState *caminoState) *caminoState {
@@ -668,7 +704,8 @@ func TestWriteDeposits(t *testing.T) {
 					caminoDiff: &caminoDiff{
 						modifiedDeposits: map[ids.ID]*depositDiff{},
 					},
-					depositsDB: actualCaminoState.depositsDB,
+					depositsDB:          actualCaminoState.depositsDB,
+					depositIDsByOwnerDB: actualCaminoState.depositIDsByOwnerDB,
 				}
 			},
 			expectedErr: testError,
@@ -676,14 +713,19 @@ func TestWriteDeposits(t *testing.T) {
 		"Fail: db errored on removed deposit Delete": {
 			caminoState: func(c *gomock.Controller) *caminoState {
 				depositsDB := database.NewMockDatabase(c)
+				depositsDB.EXPECT().Get(depositTxID1[:]).Return(deposit1Bytes, nil)
 				depositsDB.EXPECT().Delete(depositTxID1[:]).Return(testError)
+				depositIDsByOwnerDB := database.NewMockDatabase(c)
+				depositIDsByOwnerDB.EXPECT().Get(deposit1OwnerKey).Return(database.PackUInt64(1), nil)
+				depositIDsByOwnerDB.EXPECT().Delete(deposit1OwnerKey).Return(nil)
 				return &caminoState{
 					caminoDiff: &caminoDiff{
 						modifiedDeposits: map[ids.ID]*depositDiff{
 							depositTxID1: {Deposit: deposit1, removed: true},
 						},
 					},
-					depositsDB: depositsDB,
+					depositsDB:          depositsDB,
+					depositIDsByOwnerDB: depositIDsByOwnerDB,
 				}
 			},
 			expectedCaminoState: func(actualCaminoState *caminoState) *caminoState {
@@ -691,7 +733,8 @@ func TestWriteDeposits(t *testing.T) {
 					caminoDiff: &caminoDiff{
 						modifiedDeposits: map[ids.ID]*depositDiff{},
 					},
-					depositsDB: actualCaminoState.depositsDB,
+					depositsDB:          actualCaminoState.depositsDB,
+					depositIDsByOwnerDB: actualCaminoState.depositIDsByOwnerDB,
 				}
 			},
 			expectedErr: testError,
@@ -700,15 +743,24 @@ func TestWriteDeposits(t *testing.T) {
 			caminoState: func(c *gomock.Controller) *caminoState {
 				depositsDB := database.NewMockDatabase(c)
 				depositsDB.EXPECT().Put(depositTxID1[:], deposit1Bytes).Return(nil)
+				depositsDB.EXPECT().Get(depositTxID2[:]).Return(deposit2Bytes, nil)
 				depositsDB.EXPECT().Put(depositTxID2[:], deposit2Bytes).Return(nil)
+				depositsDB.EXPECT().Get(depositTxID3[:]).Return(nil, database.ErrNotFound)
 				depositsDB.EXPECT().Delete(depositTxID3[:]).Return(nil)
 
 				depositIDsByEndtimeDB := database.NewMockDatabase(c)
 				depositIDsByEndtimeDB.EXPECT().Put(depositToKey(depositTxID1[:], deposit1), nil).Return(nil)
 				depositIDsByEndtimeDB.EXPECT().Delete(depositToKey(depositTxID3[:], deposit3)).Return(nil)
 
+				depositIDsByOwnerDB := database.NewMockDatabase(c)
+				depositIDsByOwnerDB.EXPECT().Get(deposit1OwnerKey).Return(nil, database.ErrNotFound)
+				depositIDsByOwnerDB.EXPECT().Put(deposit1OwnerKey, database.PackUInt64(1)).Return(nil)
+				depositIDsByOwnerDB.EXPECT().Get(deposit3OwnerKey).Return(database.PackUInt64(2), nil)
+				depositIDsByOwnerDB.EXPECT().Put(deposit3OwnerKey, database.PackUInt64(1)).Return(nil)
+
 				return &caminoState{
 					depositIDsByEndtimeDB: depositIDsByEndtimeDB,
+					depositIDsByOwnerDB:   depositIDsByOwnerDB,
 					depositsDB:            depositsDB,
 					caminoDiff: &caminoDiff{
 						modifiedDeposits: map[ids.ID]*depositDiff{
@@ -724,6 +776,7 @@ func TestWriteDeposits(t *testing.T) {
 			expectedCaminoState: func(actualCaminoState *caminoState) *caminoState {
 				return &caminoState{
 					depositIDsByEndtimeDB: actualCaminoState.depositIDsByEndtimeDB,
+					depositIDsByOwnerDB:   actualCaminoState.depositIDsByOwnerDB,
 					depositsDB:            actualCaminoState.depositsDB,
 					caminoDiff: &caminoDiff{
 						modifiedDeposits: map[ids.ID]*depositDiff{},
@@ -737,6 +790,7 @@ func TestWriteDeposits(t *testing.T) {
 			caminoState: func(c *gomock.Controller) *caminoState {
 				depositsDB := database.NewMockDatabase(c)
 				depositsDB.EXPECT().Put(depositTxID1[:], deposit1Bytes).Return(nil)
+				depositsDB.EXPECT().Get(depositTxID2[:]).Return(deposit2Bytes, nil)
 				depositsDB.EXPECT().Delete(depositTxID2[:]).Return(nil)
 
 				depositsIterator := database.NewMockIterator(c)
@@ -751,8 +805,15 @@ func TestWriteDeposits(t *testing.T) {
 				depositIDsByEndtimeDB.EXPECT().Delete(depositToKey(depositTxID2[:], deposit2)).Return(nil)
 				depositIDsByEndtimeDB.EXPECT().NewIterator().Return(depositsIterator)
 
+				depositIDsByOwnerDB := database.NewMockDatabase(c)
+				depositIDsByOwnerDB.EXPECT().Get(deposit1OwnerKey).Return(nil, database.ErrNotFound)
+				depositIDsByOwnerDB.EXPECT().Put(deposit1OwnerKey, database.PackUInt64(1)).Return(nil)
+				depositIDsByOwnerDB.EXPECT().Get(deposit2OwnerKey).Return(nil, database.ErrNotFound)
+				depositIDsByOwnerDB.EXPECT().Delete(deposit2OwnerKey).Return(nil)
+
 				return &caminoState{
 					depositIDsByEndtimeDB: depositIDsByEndtimeDB,
+					depositIDsByOwnerDB:   depositIDsByOwnerDB,
 					depositsDB:            depositsDB,
 					caminoDiff: &caminoDiff{
 						modifiedDeposits: map[ids.ID]*depositDiff{
@@ -767,6 +828,7 @@ func TestWriteDeposits(t *testing.T) {
 			expectedCaminoState: func(actualCaminoState *caminoState) *caminoState {
 				return &caminoState{
 					depositIDsByEndtimeDB: actualCaminoState.depositIDsByEndtimeDB,
+					depositIDsByOwnerDB:   actualCaminoState.depositIDsByOwnerDB,
 					depositsDB:            actualCaminoState.depositsDB,
 					caminoDiff: &caminoDiff{
 						modifiedDeposits: map[ids.ID]*depositDiff{},
@@ -851,3 +913,127 @@ func TestLoadDeposits(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDepositIDsByOwner(t *testing.T) {
+	require := require.New(t)
+	addr1 := ids.ShortID{1}
+	addr2 := ids.ShortID{2}
+	addr3 := ids.ShortID{3}
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr1}}
+	owner2 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr2}}
+	owner3 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr3}}
+	depositTxID1 := ids.ID{1}
+	depositTxID2 := ids.ID{2}
+	deposit1 := &deposit.Deposit{Duration: 100, Amount: 1, RewardOwner: &owner1}
+	deposit2 := &deposit.Deposit{Duration: 100, Amount: 1, RewardOwner: &owner2}
+	deposit1UTXO := generateTestUTXO(ids.ID{3}, ids.Empty, 1, owner2, depositTxID1, ids.Empty)
+	deposit2UTXO := generateTestUTXO(ids.ID{4}, ids.Empty, 1, owner2, depositTxID2, ids.ID{5})
+	unlockedUTXO := generateTestUTXO(ids.ID{6}, ids.Empty, 1, owner1, ids.Empty, ids.Empty)
+
+	s := newEmptyState(t)
+
+	requireDepositIDs := func(owner ids.ShortID, start ids.ID, limit int, expectedDepositTxIDs []ids.ID) {
+		depositTxIDs, err := s.GetDepositIDsByOwner(owner, start, limit)
+		require.NoError(err)
+		require.Equal(expectedDepositTxIDs, depositTxIDs)
+	}
+
+	// reward owners and deposited utxos owners are indexed
+
+	s.AddDeposit(depositTxID1, deposit1)
+	s.AddDeposit(depositTxID2, deposit2)
+	s.AddUTXO(deposit1UTXO)
+	s.AddUTXO(deposit2UTXO)
+	s.AddUTXO(unlockedUTXO)
+	require.NoError(s.write(false, 0))
+
+	requireDepositIDs(addr1, ids.Empty, 10, []ids.ID{depositTxID1})
+	requireDepositIDs(addr2, ids.Empty, 10, []ids.ID{depositTxID1, depositTxID2})
+	requireDepositIDs(addr2, ids.Empty, 1, []ids.ID{depositTxID1})
+	requireDepositIDs(addr2, depositTxID1, 10, []ids.ID{depositTxID2})
+	requireDepositIDs(addr3, ids.Empty, 10, nil)
+
+	// deposited utxo and reward owner are transferred
+
+	transferredDeposit1 := *deposit1
+	transferredDeposit1.RewardOwner = &owner3
+	transferredDeposit1UTXO := generateTestUTXO(ids.ID{7}, ids.Empty, 1, owner3, depositTxID1, ids.Empty)
+	s.ModifyDeposit(depositTxID1, &transferredDeposit1)
+	s.DeleteUTXO(deposit1UTXO.InputID())
+	s.AddUTXO(transferredDeposit1UTXO)
+	require.NoError(s.write(false, 0))
+
+	requireDepositIDs(addr1, ids.Empty, 10, nil)
+	requireDepositIDs(addr2, ids.Empty, 10, []ids.ID{depositTxID2})
+	requireDepositIDs(addr3, ids.Empty, 10, []ids.ID{depositTxID1})
+
+	// deposit is removed with its utxos
+
+	s.RemoveDeposit(depositTxID2, deposit2)
+	s.DeleteUTXO(deposit2UTXO.InputID())
+	require.NoError(s.write(false, 0))
+
+	requireDepositIDs(addr2, ids.Empty, 10, nil)
+	requireDepositIDs(addr3, ids.Empty, 10, []ids.ID{depositTxID1})
+}
+
+func TestIndexDepositOwners(t *testing.T) {
+	require := require.New(t)
+	addr1 := ids.ShortID{1}
+	addr2 := ids.ShortID{2}
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr1}}
+	owner2 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr2}}
+
+	depositTx := &txs.Tx{Unsigned: &txs.DepositTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{Outs: []*avax.TransferableOutput{{
+			Out: &locked.Out{
+				IDs:             locked.IDs{DepositTxID: locked.ThisTxID},
+				TransferableOut: &secp256k1fx.TransferOutput{Amt: 1, OutputOwners: owner2},
+			},
+		}}}},
+		RewardsOwner: &owner1,
+	}}
+	require.NoError(depositTx.Initialize(txs.Codec))
+	depositTxID := depositTx.ID()
+	deposit1 := &deposit.Deposit{Duration: 100, Amount: 1, RewardOwner: &owner1}
+	depositUTXO := generateTestUTXO(ids.ID{1}, ids.Empty, 1, owner2, depositTxID, ids.Empty)
+
+	s := newEmptyState(t)
+	cs := s.caminoState.(*caminoState)
+
+	// state, that was written before deposit owners index was introduced
+
+	s.AddTx(depositTx, status.Committed)
+	s.AddDeposit(depositTxID, deposit1)
+	s.AddUTXO(depositUTXO)
+	require.NoError(s.write(false, 0))
+
+	indexIterator := cs.depositIDsByOwnerDB.NewIterator()
+	for indexIterator.Next() {
+		require.NoError(cs.depositIDsByOwnerDB.Delete(indexIterator.Key()))
+	}
+	require.NoError(indexIterator.Error())
+	indexIterator.Release()
+
+	depositTxIDs, err := s.GetDepositIDsByOwner(addr1, ids.Empty, 10)
+	require.NoError(err)
+	require.Empty(depositTxIDs)
+
+	// index is built once
+
+	requireIndex := func() {
+		for _, addr := range []ids.ShortID{addr1, addr2} {
+			depositTxIDs, err := s.GetDepositIDsByOwner(addr, ids.Empty, 10)
+			require.NoError(err)
+			require.Equal([]ids.ID{depositTxID}, depositTxIDs)
+			refs, err := database.GetUInt64(cs.depositIDsByOwnerDB, depositOwnerKey(addr, depositTxID))
+			require.NoError(err)
+			require.Equal(uint64(1), refs)
+		}
+	}
+
+	require.NoError(cs.indexDepositOwners(s))
+	requireIndex()
+	require.NoError(cs.indexDepositOwners(s))
+	requireIndex()
+}
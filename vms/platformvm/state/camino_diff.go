@@ -15,8 +15,10 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
 
@@ -111,6 +113,89 @@ func (d *diff) GetAddressStates(address ids.ShortID) (txs.AddressState, error) {
 	return parentState.GetAddressStates(address)
 }
 
+func (d *diff) SetKYCExpiration(address ids.ShortID, expiration uint64) {
+	d.caminoDiff.modifiedKYCExpirations[address] = expiration
+}
+
+func (d *diff) AddAddressStateChange(change *AddressStateChange) {
+	d.caminoDiff.addedAddressStateChanges = append(d.caminoDiff.addedAddressStateChanges, change)
+}
+
+func (d *diff) GetKYCExpiration(address ids.ShortID) (uint64, error) {
+	if expiration, ok := d.caminoDiff.modifiedKYCExpirations[address]; ok {
+		return expiration, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetKYCExpiration(address)
+}
+
+func (d *diff) GetNextKYCExpirationAddressesAndTime(excludedAddresses set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, time.Time{}, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	// modified expirations override parent ones
+	excluded := set.NewSet[ids.ShortID](excludedAddresses.Len() + len(d.caminoDiff.modifiedKYCExpirations))
+	excluded.Union(excludedAddresses)
+	for address := range d.caminoDiff.modifiedKYCExpirations {
+		excluded.Add(address)
+	}
+
+	nextAddresses, nextTime, err := parentState.GetNextKYCExpirationAddressesAndTime(excluded)
+	if err != nil && err != database.ErrNotFound {
+		return nil, time.Time{}, err
+	}
+
+	return nextAddressesAndTime(d.caminoDiff.modifiedKYCExpirations, excludedAddresses, nextAddresses, nextTime)
+}
+
+func (d *diff) SetNodeDeferral(address ids.ShortID, deferral *NodeDeferral) {
+	d.caminoDiff.modifiedNodeDeferrals[address] = deferral
+}
+
+func (d *diff) GetNodeDeferral(address ids.ShortID) (*NodeDeferral, error) {
+	if deferral, ok := d.caminoDiff.modifiedNodeDeferrals[address]; ok {
+		if deferral == nil {
+			return nil, database.ErrNotFound
+		}
+		return deferral, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetNodeDeferral(address)
+}
+
+func (d *diff) GetNextNodeDeferralEndAddressesAndTime(excludedAddresses set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, time.Time{}, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	// modified deferrals override parent ones
+	excluded := set.NewSet[ids.ShortID](excludedAddresses.Len() + len(d.caminoDiff.modifiedNodeDeferrals))
+	excluded.Union(excludedAddresses)
+	for address := range d.caminoDiff.modifiedNodeDeferrals {
+		excluded.Add(address)
+	}
+
+	nextAddresses, nextTime, err := parentState.GetNextNodeDeferralEndAddressesAndTime(excluded)
+	if err != nil && err != database.ErrNotFound {
+		return nil, time.Time{}, err
+	}
+
+	return nextAddressesAndTime(nodeDeferralEnds(d.caminoDiff.modifiedNodeDeferrals), excludedAddresses, nextAddresses, nextTime)
+}
+
 func (d *diff) SetDepositOffer(offer *deposit.Offer) {
 	d.caminoDiff.modifiedDepositOffers[offer.ID] = offer
 }
@@ -159,6 +244,23 @@ func (d *diff) GetAllDepositOffers() ([]*deposit.Offer, error) {
 	return offers, nil
 }
 
+func (d *diff) SetDepositOfferAllowedAddress(offerID ids.ID, address ids.ShortID, allowed bool) {
+	d.caminoDiff.modifiedDepositOfferAllowLists[depositOfferAllowListKey{offerID: offerID, address: address}] = allowed
+}
+
+func (d *diff) IsDepositOfferAllowedAddress(offerID ids.ID, address ids.ShortID) (bool, error) {
+	if allowed, ok := d.caminoDiff.modifiedDepositOfferAllowLists[depositOfferAllowListKey{offerID: offerID, address: address}]; ok {
+		return allowed, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return false, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.IsDepositOfferAllowedAddress(offerID, address)
+}
+
 func (d *diff) AddDeposit(depositTxID ids.ID, deposit *deposit.Deposit) {
 	d.caminoDiff.modifiedDeposits[depositTxID] = &depositDiff{Deposit: deposit, added: true}
 }
@@ -312,6 +414,26 @@ func (d *diff) GetShortIDLink(id ids.ShortID, key ShortLinkKey) (ids.ShortID, er
 	return parentState.GetShortIDLink(id, key)
 }
 
+func (d *diff) SetNodeRegistrationTxID(nodeID ids.NodeID, txID *ids.ID) {
+	d.caminoDiff.modifiedNodeRegistrationTxIDs[nodeID] = txID
+}
+
+func (d *diff) GetNodeRegistrationTxID(nodeID ids.NodeID) (ids.ID, error) {
+	if txID, ok := d.caminoDiff.modifiedNodeRegistrationTxIDs[nodeID]; ok {
+		if txID == nil {
+			return ids.Empty, database.ErrNotFound
+		}
+		return *txID, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return ids.Empty, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetNodeRegistrationTxID(nodeID)
+}
+
 func (d *diff) SetClaimable(ownerID ids.ID, claimable *Claimable) {
 	d.caminoDiff.modifiedClaimables[ownerID] = claimable
 }
@@ -349,6 +471,196 @@ func (d *diff) GetNotDistributedValidatorReward() (uint64, error) {
 	return parentState.GetNotDistributedValidatorReward()
 }
 
+func (d *diff) SetRewardsImportProgress(progress *RewardsImportProgress) {
+	d.caminoDiff.modifiedRewardsImportProgress = progress
+}
+
+func (d *diff) GetRewardsImportProgress() (*RewardsImportProgress, error) {
+	if d.caminoDiff.modifiedRewardsImportProgress != nil {
+		return d.caminoDiff.modifiedRewardsImportProgress, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetRewardsImportProgress()
+}
+
+func (d *diff) AddValidatorRewardsDistribution(distribution *ValidatorRewardsDistribution) {
+	d.caminoDiff.addedValidatorRewardsDistributions = append(d.caminoDiff.addedValidatorRewardsDistributions, distribution)
+}
+
+func (d *diff) SetTreasuryConfig(config *treasury.Config) {
+	d.caminoDiff.modifiedTreasuryConfig = config
+}
+
+func (d *diff) GetTreasuryConfig() (*treasury.Config, error) {
+	if d.caminoDiff.modifiedTreasuryConfig != nil {
+		return d.caminoDiff.modifiedTreasuryConfig, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetTreasuryConfig()
+}
+
+func (d *diff) SetTreasurySpending(spending *treasury.Spending) {
+	d.caminoDiff.modifiedTreasurySpending = spending
+}
+
+func (d *diff) GetTreasurySpending() (*treasury.Spending, error) {
+	if d.caminoDiff.modifiedTreasurySpending != nil {
+		return d.caminoDiff.modifiedTreasurySpending, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetTreasurySpending()
+}
+
+func (d *diff) AddProposal(proposalID ids.ID, proposal *dao.ProposalState) {
+	d.caminoDiff.modifiedProposals[proposalID] = &proposalDiff{ProposalState: proposal, added: true}
+}
+
+func (d *diff) ModifyProposal(proposalID ids.ID, proposal *dao.ProposalState) {
+	if proposalDiff, ok := d.caminoDiff.modifiedProposals[proposalID]; ok && proposalDiff.added {
+		// proposal was added with this diff, so it must stay added
+		proposalDiff.ProposalState = proposal
+		return
+	}
+	d.caminoDiff.modifiedProposals[proposalID] = &proposalDiff{ProposalState: proposal}
+}
+
+func (d *diff) RemoveProposal(proposalID ids.ID, proposal *dao.ProposalState) {
+	d.caminoDiff.modifiedProposals[proposalID] = &proposalDiff{ProposalState: proposal, removed: true}
+}
+
+func (d *diff) GetProposal(proposalID ids.ID) (*dao.ProposalState, error) {
+	if proposalDiff, ok := d.caminoDiff.modifiedProposals[proposalID]; ok {
+		if proposalDiff.removed {
+			return nil, database.ErrNotFound
+		}
+		return proposalDiff.ProposalState, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetProposal(proposalID)
+}
+
+func (d *diff) SetProposalOutcome(proposalID ids.ID, outcome *ProposalOutcome) {
+	d.caminoDiff.modifiedProposalOutcomes[proposalID] = outcome
+}
+
+func (d *diff) GetProposalOutcome(proposalID ids.ID) (*ProposalOutcome, error) {
+	if outcome, ok := d.caminoDiff.modifiedProposalOutcomes[proposalID]; ok {
+		return outcome, nil
+	}
+
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	return parentState.GetProposalOutcome(proposalID)
+}
+
+func (d *diff) GetNextProposalExpirationTime(removedProposalIDs set.Set[ids.ID]) (time.Time, error) {
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	for proposalID, proposalDiff := range d.caminoDiff.modifiedProposals {
+		if proposalDiff.removed {
+			removedProposalIDs.Add(proposalID)
+		}
+	}
+
+	nextExpirationTime, err := parentState.GetNextProposalExpirationTime(removedProposalIDs)
+	if err != nil && err != database.ErrNotFound {
+		return time.Time{}, err
+	}
+
+	// calculating earliest expiration time from added proposals and parent expiration time
+	for proposalID, proposalDiff := range d.caminoDiff.modifiedProposals {
+		proposalEndtime := proposalDiff.EndTime()
+		if proposalDiff.added && proposalEndtime.Before(nextExpirationTime) && !removedProposalIDs.Contains(proposalID) {
+			nextExpirationTime = proposalEndtime
+		}
+	}
+
+	// no proposals
+	if nextExpirationTime.Equal(mockable.MaxTime) {
+		return mockable.MaxTime, database.ErrNotFound
+	}
+
+	return nextExpirationTime, nil
+}
+
+func (d *diff) GetNextToExpireProposalIDsAndTime(removedProposalIDs set.Set[ids.ID]) ([]ids.ID, time.Time, error) {
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return nil, time.Time{}, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+
+	for proposalID, proposalDiff := range d.caminoDiff.modifiedProposals {
+		if proposalDiff.removed {
+			removedProposalIDs.Add(proposalID)
+		}
+	}
+
+	parentNextProposalIDs, parentNextExpirationTime, err := parentState.GetNextToExpireProposalIDsAndTime(removedProposalIDs)
+	if err != nil && err != database.ErrNotFound {
+		return nil, time.Time{}, err
+	}
+
+	// calculating earliest expiration time from added proposals and parent expiration time
+	nextExpirationTime := parentNextExpirationTime
+	for proposalID, proposalDiff := range d.caminoDiff.modifiedProposals {
+		proposalEndtime := proposalDiff.EndTime()
+		if proposalDiff.added && proposalEndtime.Before(nextExpirationTime) && !removedProposalIDs.Contains(proposalID) {
+			nextExpirationTime = proposalEndtime
+		}
+	}
+
+	// no proposals
+	if nextExpirationTime.Equal(mockable.MaxTime) {
+		return nil, mockable.MaxTime, database.ErrNotFound
+	}
+
+	var nextProposalIDs []ids.ID
+	if !parentNextExpirationTime.After(nextExpirationTime) {
+		nextProposalIDs = parentNextProposalIDs
+	}
+
+	// getting added proposals with endtime matching nextExpirationTime
+	needSort := false
+	for proposalID, proposalDiff := range d.caminoDiff.modifiedProposals {
+		if proposalDiff.added && proposalDiff.EndTime().Equal(nextExpirationTime) && !removedProposalIDs.Contains(proposalID) {
+			nextProposalIDs = append(nextProposalIDs, proposalID)
+			needSort = true
+		}
+	}
+
+	if needSort {
+		utils.Sort(nextProposalIDs)
+	}
+
+	return nextProposalIDs, nextExpirationTime, nil
+}
+
 func (d *diff) GetDeferredValidator(subnetID ids.ID, nodeID ids.NodeID) (*Staker, error) {
 	// If the validator was modified in this diff, return the modified
 	// validator.
@@ -396,14 +708,46 @@ func (d *diff) ApplyCaminoState(baseState State) {
 		baseState.SetNotDistributedValidatorReward(*d.caminoDiff.modifiedNotDistributedValidatorReward)
 	}
 
+	if d.caminoDiff.modifiedRewardsImportProgress != nil {
+		baseState.SetRewardsImportProgress(d.caminoDiff.modifiedRewardsImportProgress)
+	}
+
+	for _, distribution := range d.caminoDiff.addedValidatorRewardsDistributions {
+		baseState.AddValidatorRewardsDistribution(distribution)
+	}
+
+	if d.caminoDiff.modifiedTreasuryConfig != nil {
+		baseState.SetTreasuryConfig(d.caminoDiff.modifiedTreasuryConfig)
+	}
+
+	if d.caminoDiff.modifiedTreasurySpending != nil {
+		baseState.SetTreasurySpending(d.caminoDiff.modifiedTreasurySpending)
+	}
+
 	for k, v := range d.caminoDiff.modifiedAddressStates {
 		baseState.SetAddressStates(k, v)
 	}
 
+	for address, expiration := range d.caminoDiff.modifiedKYCExpirations {
+		baseState.SetKYCExpiration(address, expiration)
+	}
+
+	for _, change := range d.caminoDiff.addedAddressStateChanges {
+		baseState.AddAddressStateChange(change)
+	}
+
+	for address, deferral := range d.caminoDiff.modifiedNodeDeferrals {
+		baseState.SetNodeDeferral(address, deferral)
+	}
+
 	for _, depositOffer := range d.caminoDiff.modifiedDepositOffers {
 		baseState.SetDepositOffer(depositOffer)
 	}
 
+	for key, allowed := range d.caminoDiff.modifiedDepositOfferAllowLists {
+		baseState.SetDepositOfferAllowedAddress(key.offerID, key.address, allowed)
+	}
+
 	for depositTxID, depositDiff := range d.caminoDiff.modifiedDeposits {
 		switch {
 		case depositDiff.added:
@@ -424,10 +768,29 @@ func (d *diff) ApplyCaminoState(baseState State) {
 		baseState.SetShortIDLink(id, key, link)
 	}
 
+	for nodeID, txID := range d.caminoDiff.modifiedNodeRegistrationTxIDs {
+		baseState.SetNodeRegistrationTxID(nodeID, txID)
+	}
+
 	for ownerID, claimable := range d.caminoDiff.modifiedClaimables {
 		baseState.SetClaimable(ownerID, claimable)
 	}
 
+	for proposalID, proposalDiff := range d.caminoDiff.modifiedProposals {
+		switch {
+		case proposalDiff.added:
+			baseState.AddProposal(proposalID, proposalDiff.ProposalState)
+		case proposalDiff.removed:
+			baseState.RemoveProposal(proposalID, proposalDiff.ProposalState)
+		default:
+			baseState.ModifyProposal(proposalID, proposalDiff.ProposalState)
+		}
+	}
+
+	for proposalID, outcome := range d.caminoDiff.modifiedProposalOutcomes {
+		baseState.SetProposalOutcome(proposalID, outcome)
+	}
+
 	for _, validatorDiffs := range d.caminoDiff.deferredStakerDiffs.validatorDiffs {
 		for _, validatorDiff := range validatorDiffs {
 			switch validatorDiff.validatorStatus {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
@@ -66,9 +67,67 @@ func (cs *caminoState) GetMultisigAlias(id ids.ShortID) (*multisig.AliasWithNonc
 	return msigAlias, nil
 }
 
+// Returns IDs of multisig aliases that have [member] as their owner,
+// directly or through nested multisig aliases.
+func (cs *caminoState) GetMultisigAliasesByMember(member ids.ShortID) ([]ids.ShortID, error) {
+	var aliasIDs []ids.ShortID
+	visited := set.NewSet[ids.ShortID](1)
+	visited.Add(member)
+	members := []ids.ShortID{member}
+	for len(members) > 0 {
+		member := members[len(members)-1]
+		members = members[:len(members)-1]
+
+		parentAliasIDs, err := cs.getMultisigAliasesByDirectMember(member)
+		if err != nil {
+			return nil, err
+		}
+		for _, aliasID := range parentAliasIDs {
+			if visited.Contains(aliasID) {
+				continue
+			}
+			visited.Add(aliasID)
+			aliasIDs = append(aliasIDs, aliasID)
+			members = append(members, aliasID)
+		}
+	}
+	return aliasIDs, nil
+}
+
+func (cs *caminoState) getMultisigAliasesByDirectMember(member ids.ShortID) ([]ids.ShortID, error) {
+	aliasesIterator := cs.multisigAliasesByMemberDB.NewIteratorWithPrefix(member[:])
+	defer aliasesIterator.Release()
+
+	var aliasIDs []ids.ShortID
+	for aliasesIterator.Next() {
+		aliasID, err := ids.ToShortID(aliasesIterator.Key()[len(member):])
+		if err != nil {
+			return nil, err
+		}
+		aliasIDs = append(aliasIDs, aliasID)
+	}
+
+	if err := aliasesIterator.Error(); err != nil {
+		return nil, err
+	}
+
+	return aliasIDs, nil
+}
+
 func (cs *caminoState) writeMultisigAliases() error {
 	for key, alias := range cs.modifiedMultisigAliases {
 		delete(cs.modifiedMultisigAliases, key)
+
+		oldMembers, err := cs.getMultisigAliasMembersFromDB(key)
+		if err != nil {
+			return err
+		}
+
+		newMembers := set.Set[ids.ShortID]{}
+		if alias != nil {
+			newMembers = multisigAliasMembers(alias.Owners)
+		}
+
 		if alias == nil {
 			if err := cs.multisigAliasesDB.Delete(key[:]); err != nil {
 				return err
@@ -87,6 +146,98 @@ func (cs *caminoState) writeMultisigAliases() error {
 				return err
 			}
 		}
+
+		if err := cs.writeMultisigAliasMembers(key, oldMembers, newMembers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns members of multisig alias as it is currently stored in db
+func (cs *caminoState) getMultisigAliasMembersFromDB(aliasID ids.ShortID) (set.Set[ids.ShortID], error) {
+	maBytes, err := cs.multisigAliasesDB.Get(aliasID[:])
+	if err == database.ErrNotFound {
+		return set.Set[ids.ShortID]{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	dbMultisigAlias := &msigAlias{}
+	if _, err := blocks.GenesisCodec.Unmarshal(maBytes, dbMultisigAlias); err != nil {
+		return nil, err
+	}
+
+	return multisigAliasMembers(dbMultisigAlias.Owners), nil
+}
+
+func (cs *caminoState) writeMultisigAliasMembers(aliasID ids.ShortID, oldMembers, newMembers set.Set[ids.ShortID]) error {
+	for member := range oldMembers {
+		if !newMembers.Contains(member) {
+			if err := cs.multisigAliasesByMemberDB.Delete(multisigAliasMemberKey(member, aliasID)); err != nil {
+				return err
+			}
+		}
+	}
+	for member := range newMembers {
+		if !oldMembers.Contains(member) {
+			if err := cs.multisigAliasesByMemberDB.Put(multisigAliasMemberKey(member, aliasID), nil); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
+
+// Builds multisig aliases members index for aliases, that were created
+// before the index was introduced. Index is built only once and then persisted.
+func (cs *caminoState) indexMultisigAliasMembers() error {
+	indexed, err := database.GetBool(cs.caminoDB, multisigAliasMembersIndexedKey)
+	if err != nil && err != database.ErrNotFound {
+		return err
+	}
+	if indexed {
+		return nil
+	}
+
+	aliasesIterator := cs.multisigAliasesDB.NewIterator()
+	defer aliasesIterator.Release()
+
+	for aliasesIterator.Next() {
+		aliasID, err := ids.ToShortID(aliasesIterator.Key())
+		if err != nil {
+			return err
+		}
+
+		dbMultisigAlias := &msigAlias{}
+		if _, err := blocks.GenesisCodec.Unmarshal(aliasesIterator.Value(), dbMultisigAlias); err != nil {
+			return err
+		}
+
+		members := multisigAliasMembers(dbMultisigAlias.Owners)
+		if err := cs.writeMultisigAliasMembers(aliasID, set.Set[ids.ShortID]{}, members); err != nil {
+			return err
+		}
+	}
+	if err := aliasesIterator.Error(); err != nil {
+		return err
+	}
+
+	return database.PutBool(cs.caminoDB, multisigAliasMembersIndexedKey, true)
+}
+
+func multisigAliasMembers(owners verify.State) set.Set[ids.ShortID] {
+	if addressesOwners, ok := owners.(interface {
+		AddressesSet() set.Set[ids.ShortID]
+	}); ok {
+		return addressesOwners.AddressesSet()
+	}
+	return set.Set[ids.ShortID]{}
+}
+
+func multisigAliasMemberKey(member, aliasID ids.ShortID) []byte {
+	key := make([]byte, len(member)+len(aliasID))
+	copy(key, member[:])
+	copy(key[len(member):], aliasID[:])
+	return key
+}
@@ -9,11 +9,13 @@ import (
 
 	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 )
 
@@ -233,10 +235,27 @@ func TestSetMultisigAlias(t *testing.T) {
 }
 
 func TestWriteMultisigAliases(t *testing.T) {
-	multisigAlias1 := &multisig.AliasWithNonce{Alias: multisig.Alias{ID: ids.ShortID{1}, Owners: &secp256k1fx.OutputOwners{}}}
-	multisigAlias2 := &multisig.AliasWithNonce{Alias: multisig.Alias{ID: ids.ShortID{2}, Owners: &secp256k1fx.OutputOwners{}}}
+	member1 := ids.ShortID{11}
+	member2 := ids.ShortID{12}
+	member3 := ids.ShortID{13}
+	multisigAlias1 := &multisig.AliasWithNonce{Alias: multisig.Alias{ID: ids.ShortID{1}, Owners: &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{member1, member2},
+	}}}
+	oldMultisigAlias1 := &multisig.AliasWithNonce{Alias: multisig.Alias{ID: multisigAlias1.ID, Owners: &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{member2, member3},
+	}}}
+	multisigAlias2 := &multisig.AliasWithNonce{Alias: multisig.Alias{ID: ids.ShortID{2}, Owners: &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{member1},
+	}}}
 	multisigAliasBytes1, err := blocks.GenesisCodec.Marshal(blocks.Version, &msigAlias{Owners: multisigAlias1.Owners})
 	require.NoError(t, err)
+	oldMultisigAliasBytes1, err := blocks.GenesisCodec.Marshal(blocks.Version, &msigAlias{Owners: oldMultisigAlias1.Owners})
+	require.NoError(t, err)
+	multisigAliasBytes2, err := blocks.GenesisCodec.Marshal(blocks.Version, &msigAlias{Owners: multisigAlias2.Owners})
+	require.NoError(t, err)
 	testError := errors.New("test error")
 
 	tests := map[string]struct {
@@ -247,6 +266,7 @@ func TestWriteMultisigAliases(t *testing.T) {
 		"Fail: db errored on modifiedMultisigAliases Put": {
 			caminoState: func(c *gomock.Controller) *caminoState {
 				multisigAliasesDB := database.NewMockDatabase(c)
+				multisigAliasesDB.EXPECT().Get(multisigAlias1.ID[:]).Return(nil, database.ErrNotFound)
 				multisigAliasesDB.EXPECT().Put(multisigAlias1.ID[:], multisigAliasBytes1).Return(testError)
 				return &caminoState{
 					multisigAliasesDB: multisigAliasesDB,
@@ -270,6 +290,7 @@ func TestWriteMultisigAliases(t *testing.T) {
 		"Fail: db errored on modifiedMultisigAliases Delete": {
 			caminoState: func(c *gomock.Controller) *caminoState {
 				multisigAliasesDB := database.NewMockDatabase(c)
+				multisigAliasesDB.EXPECT().Get(multisigAlias1.ID[:]).Return(oldMultisigAliasBytes1, nil)
 				multisigAliasesDB.EXPECT().Delete(multisigAlias1.ID[:]).Return(testError)
 				return &caminoState{
 					caminoDiff: &caminoDiff{
@@ -290,13 +311,48 @@ func TestWriteMultisigAliases(t *testing.T) {
 			},
 			expectedErr: testError,
 		},
+		"Fail: db errored on multisig aliases members index Put": {
+			caminoState: func(c *gomock.Controller) *caminoState {
+				multisigAliasesDB := database.NewMockDatabase(c)
+				multisigAliasesDB.EXPECT().Get(multisigAlias2.ID[:]).Return(nil, database.ErrNotFound)
+				multisigAliasesDB.EXPECT().Put(multisigAlias2.ID[:], multisigAliasBytes2).Return(nil)
+				multisigAliasesByMemberDB := database.NewMockDatabase(c)
+				multisigAliasesByMemberDB.EXPECT().Put(multisigAliasMemberKey(member1, multisigAlias2.ID), nil).Return(testError)
+				return &caminoState{
+					multisigAliasesDB:         multisigAliasesDB,
+					multisigAliasesByMemberDB: multisigAliasesByMemberDB,
+					caminoDiff: &caminoDiff{
+						modifiedMultisigAliases: map[ids.ShortID]*multisig.AliasWithNonce{
+							multisigAlias2.ID: multisigAlias2,
+						},
+					},
+				}
+			},
+			expectedCaminoState: func(actualState *caminoState) *caminoState {
+				return &caminoState{
+					multisigAliasesDB:         actualState.multisigAliasesDB,
+					multisigAliasesByMemberDB: actualState.multisigAliasesByMemberDB,
+					caminoDiff: &caminoDiff{
+						modifiedMultisigAliases: map[ids.ShortID]*multisig.AliasWithNonce{},
+					},
+				}
+			},
+			expectedErr: testError,
+		},
 		"OK": {
 			caminoState: func(c *gomock.Controller) *caminoState {
 				multisigAliasesDB := database.NewMockDatabase(c)
+				multisigAliasesDB.EXPECT().Get(multisigAlias1.ID[:]).Return(oldMultisigAliasBytes1, nil)
 				multisigAliasesDB.EXPECT().Put(multisigAlias1.ID[:], multisigAliasBytes1).Return(nil)
+				multisigAliasesDB.EXPECT().Get(multisigAlias2.ID[:]).Return(multisigAliasBytes2, nil)
 				multisigAliasesDB.EXPECT().Delete(multisigAlias2.ID[:]).Return(nil)
+				multisigAliasesByMemberDB := database.NewMockDatabase(c)
+				multisigAliasesByMemberDB.EXPECT().Delete(multisigAliasMemberKey(member3, multisigAlias1.ID)).Return(nil)
+				multisigAliasesByMemberDB.EXPECT().Put(multisigAliasMemberKey(member1, multisigAlias1.ID), nil).Return(nil)
+				multisigAliasesByMemberDB.EXPECT().Delete(multisigAliasMemberKey(member1, multisigAlias2.ID)).Return(nil)
 				return &caminoState{
-					multisigAliasesDB: multisigAliasesDB,
+					multisigAliasesDB:         multisigAliasesDB,
+					multisigAliasesByMemberDB: multisigAliasesByMemberDB,
 					caminoDiff: &caminoDiff{
 						modifiedMultisigAliases: map[ids.ShortID]*multisig.AliasWithNonce{
 							multisigAlias1.ID: multisigAlias1,
@@ -307,7 +363,8 @@ func TestWriteMultisigAliases(t *testing.T) {
 			},
 			expectedCaminoState: func(actualState *caminoState) *caminoState {
 				return &caminoState{
-					multisigAliasesDB: actualState.multisigAliasesDB,
+					multisigAliasesDB:         actualState.multisigAliasesDB,
+					multisigAliasesByMemberDB: actualState.multisigAliasesByMemberDB,
 					caminoDiff: &caminoDiff{
 						modifiedMultisigAliases: map[ids.ShortID]*multisig.AliasWithNonce{},
 					},
@@ -325,3 +382,104 @@ func TestWriteMultisigAliases(t *testing.T) {
 		})
 	}
 }
+
+func TestGetMultisigAliasesByMember(t *testing.T) {
+	require := require.New(t)
+	member1 := ids.ShortID{11}
+	member2 := ids.ShortID{12}
+	aliasID1 := ids.ShortID{1}
+	aliasID2 := ids.ShortID{2}
+	aliasID3 := ids.ShortID{3}
+	alias1 := &multisig.AliasWithNonce{Alias: multisig.Alias{ID: aliasID1, Owners: &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{member1, member2},
+	}}}
+	alias2 := &multisig.AliasWithNonce{Alias: multisig.Alias{ID: aliasID2, Owners: &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{aliasID1, member2},
+	}}}
+	alias3 := &multisig.AliasWithNonce{Alias: multisig.Alias{ID: aliasID3, Owners: &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{member2},
+	}}}
+
+	db := memdb.New()
+	cs, err := newCaminoState(db, db, prometheus.NewRegistry())
+	require.NoError(err)
+
+	cs.SetMultisigAlias(alias1)
+	cs.SetMultisigAlias(alias2)
+	cs.SetMultisigAlias(alias3)
+	require.NoError(cs.writeMultisigAliases())
+
+	aliasIDs, err := cs.GetMultisigAliasesByMember(member1)
+	require.NoError(err)
+	require.Equal([]ids.ShortID{aliasID1, aliasID2}, aliasIDs)
+
+	aliasIDs, err = cs.GetMultisigAliasesByMember(member2)
+	require.NoError(err)
+	require.ElementsMatch([]ids.ShortID{aliasID1, aliasID2, aliasID3}, aliasIDs)
+
+	// member1 is removed from alias1, so it isn't member of nested alias2 anymore
+	cs.SetMultisigAlias(&multisig.AliasWithNonce{Alias: multisig.Alias{ID: aliasID1, Owners: &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{member2},
+	}}})
+	require.NoError(cs.writeMultisigAliases())
+
+	aliasIDs, err = cs.GetMultisigAliasesByMember(member1)
+	require.NoError(err)
+	require.Empty(aliasIDs)
+
+	aliasIDs, err = cs.GetMultisigAliasesByMember(aliasID1)
+	require.NoError(err)
+	require.Equal([]ids.ShortID{aliasID2}, aliasIDs)
+}
+
+func TestIndexMultisigAliasMembers(t *testing.T) {
+	require := require.New(t)
+	member := ids.ShortID{11}
+	aliasID1 := ids.ShortID{1}
+	aliasID2 := ids.ShortID{2}
+	alias1 := &multisig.AliasWithNonce{Alias: multisig.Alias{ID: aliasID1, Owners: &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{member},
+	}}}
+	alias2 := &multisig.AliasWithNonce{Alias: multisig.Alias{ID: aliasID2, Owners: &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{aliasID1},
+	}}}
+
+	db := memdb.New()
+	cs, err := newCaminoState(db, db, prometheus.NewRegistry())
+	require.NoError(err)
+
+	// aliases, that were written before members index was introduced
+
+	cs.SetMultisigAlias(alias1)
+	cs.SetMultisigAlias(alias2)
+	require.NoError(cs.writeMultisigAliases())
+	require.NoError(cs.multisigAliasesByMemberDB.Delete(multisigAliasMemberKey(member, aliasID1)))
+	require.NoError(cs.multisigAliasesByMemberDB.Delete(multisigAliasMemberKey(aliasID1, aliasID2)))
+
+	aliasIDs, err := cs.GetMultisigAliasesByMember(member)
+	require.NoError(err)
+	require.Empty(aliasIDs)
+
+	// index is built
+
+	require.NoError(cs.indexMultisigAliasMembers())
+
+	aliasIDs, err = cs.GetMultisigAliasesByMember(member)
+	require.NoError(err)
+	require.Equal([]ids.ShortID{aliasID1, aliasID2}, aliasIDs)
+
+	// index is built only once
+
+	require.NoError(cs.multisigAliasesByMemberDB.Delete(multisigAliasMemberKey(aliasID1, aliasID2)))
+	require.NoError(cs.indexMultisigAliasMembers())
+
+	aliasIDs, err = cs.GetMultisigAliasesByMember(member)
+	require.NoError(err)
+	require.Equal([]ids.ShortID{aliasID1}, aliasIDs)
+}
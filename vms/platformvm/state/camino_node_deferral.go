@@ -0,0 +1,124 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+)
+
+// NodeDeferral is info about deferral of consortium member node
+type NodeDeferral struct {
+	// ID of address state tx, that deferred node
+	TxID ids.ID `serialize:"true"`
+	// Chain time, when node was deferred
+	Start uint64 `serialize:"true"`
+	// Chain time, when node will be resumed automatically.
+	// Zero means, that node will be deferred until it's resumed with address state tx.
+	End uint64 `serialize:"true"`
+}
+
+// Set node deferral of consortium member address, nil removes deferral
+func (cs *caminoState) SetNodeDeferral(address ids.ShortID, deferral *NodeDeferral) {
+	cs.modifiedNodeDeferrals[address] = deferral
+}
+
+// Returns node deferral of consortium member address or database.ErrNotFound, if node isn't deferred
+func (cs *caminoState) GetNodeDeferral(address ids.ShortID) (*NodeDeferral, error) {
+	if deferral, ok := cs.modifiedNodeDeferrals[address]; ok {
+		if deferral == nil {
+			return nil, database.ErrNotFound
+		}
+		return deferral, nil
+	}
+
+	deferralBytes, err := cs.nodeDeferralsDB.Get(address[:])
+	if err != nil {
+		return nil, err
+	}
+
+	deferral := &NodeDeferral{}
+	if _, err := blocks.GenesisCodec.Unmarshal(deferralBytes, deferral); err != nil {
+		return nil, err
+	}
+	return deferral, nil
+}
+
+// Returns consortium member addresses with the earliest node deferral end and that end time.
+// Addresses from [excludedAddresses] are ignored.
+func (cs *caminoState) GetNextNodeDeferralEndAddressesAndTime(excludedAddresses set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	excluded := set.NewSet[ids.ShortID](excludedAddresses.Len() + len(cs.modifiedNodeDeferrals))
+	excluded.Union(excludedAddresses)
+	for address := range cs.modifiedNodeDeferrals {
+		excluded.Add(address)
+	}
+
+	nextAddresses, nextTime, err := getNextAddressesAndTimeFromDB(cs.nodeDeferralEndsByTimeDB, excluded)
+	if err != nil && err != database.ErrNotFound {
+		return nil, time.Time{}, err
+	}
+
+	return nextAddressesAndTime(nodeDeferralEnds(cs.modifiedNodeDeferrals), excludedAddresses, nextAddresses, nextTime)
+}
+
+func (cs *caminoState) writeNodeDeferrals() error {
+	for address, deferral := range cs.modifiedNodeDeferrals {
+		delete(cs.modifiedNodeDeferrals, address)
+
+		oldDeferralBytes, err := cs.nodeDeferralsDB.Get(address[:])
+		switch {
+		case err == nil:
+			oldDeferral := &NodeDeferral{}
+			if _, err := blocks.GenesisCodec.Unmarshal(oldDeferralBytes, oldDeferral); err != nil {
+				return err
+			}
+			if oldDeferral.End != 0 {
+				if err := cs.nodeDeferralEndsByTimeDB.Delete(addressByTimeKey(address, oldDeferral.End)); err != nil {
+					return err
+				}
+			}
+		case err != database.ErrNotFound:
+			return err
+		}
+
+		if deferral == nil {
+			if err := cs.nodeDeferralsDB.Delete(address[:]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		deferralBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, deferral)
+		if err != nil {
+			return fmt.Errorf("failed to serialize node deferral: %w", err)
+		}
+		if err := cs.nodeDeferralsDB.Put(address[:], deferralBytes); err != nil {
+			return err
+		}
+		if deferral.End != 0 {
+			if err := cs.nodeDeferralEndsByTimeDB.Put(addressByTimeKey(address, deferral.End), nil); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Returns deferral end times of [deferrals], removed deferrals have zero end time
+func nodeDeferralEnds(deferrals map[ids.ShortID]*NodeDeferral) map[ids.ShortID]uint64 {
+	ends := make(map[ids.ShortID]uint64, len(deferrals))
+	for address, deferral := range deferrals {
+		if deferral != nil {
+			ends[address] = deferral.End
+		} else {
+			ends[address] = 0
+		}
+	}
+	return ends
+}
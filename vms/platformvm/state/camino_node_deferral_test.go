@@ -0,0 +1,77 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+func TestNodeDeferrals(t *testing.T) {
+	require := require.New(t)
+	addr1 := ids.ShortID{1}
+	addr2 := ids.ShortID{2}
+	addr3 := ids.ShortID{3}
+
+	s := newEmptyState(t)
+
+	requireNextDeferralEnd := func(excluded set.Set[ids.ShortID], expectedAddrs []ids.ShortID, expectedTime uint64) {
+		addrs, nextTime, err := s.GetNextNodeDeferralEndAddressesAndTime(excluded)
+		if expectedAddrs == nil {
+			require.ErrorIs(err, database.ErrNotFound)
+			return
+		}
+		require.NoError(err)
+		require.Equal(expectedAddrs, addrs)
+		require.Equal(time.Unix(int64(expectedTime), 0), nextTime)
+	}
+
+	requireNextDeferralEnd(nil, nil, 0)
+	_, err := s.GetNodeDeferral(addr1)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	// not written deferrals
+
+	deferral1 := &NodeDeferral{TxID: ids.ID{1}, Start: 1, End: 10}
+	deferral2 := &NodeDeferral{TxID: ids.ID{2}, Start: 2, End: 10}
+	deferral3 := &NodeDeferral{TxID: ids.ID{3}, Start: 3, End: 20}
+	s.SetNodeDeferral(addr2, deferral2)
+	s.SetNodeDeferral(addr1, deferral1)
+	s.SetNodeDeferral(addr3, deferral3)
+	requireNextDeferralEnd(nil, []ids.ShortID{addr1, addr2}, 10)
+
+	// written deferrals
+
+	require.NoError(s.write(false, 0))
+	deferral, err := s.GetNodeDeferral(addr1)
+	require.NoError(err)
+	require.Equal(deferral1, deferral)
+	requireNextDeferralEnd(nil, []ids.ShortID{addr1, addr2}, 10)
+	requireNextDeferralEnd(set.Set[ids.ShortID]{addr1: struct{}{}}, []ids.ShortID{addr2}, 10)
+	requireNextDeferralEnd(set.Set[ids.ShortID]{addr1: struct{}{}, addr2: struct{}{}}, []ids.ShortID{addr3}, 20)
+
+	// modified deferrals override written ones
+
+	deferral1 = &NodeDeferral{TxID: ids.ID{4}, Start: 4, End: 30}
+	deferral2 = &NodeDeferral{TxID: ids.ID{5}, Start: 5}
+	s.SetNodeDeferral(addr1, deferral1)
+	s.SetNodeDeferral(addr2, deferral2)
+	s.SetNodeDeferral(addr3, nil)
+	requireNextDeferralEnd(nil, []ids.ShortID{addr1}, 30)
+
+	require.NoError(s.write(false, 0))
+	deferral, err = s.GetNodeDeferral(addr2)
+	require.NoError(err)
+	require.Equal(deferral2, deferral)
+	_, err = s.GetNodeDeferral(addr3)
+	require.ErrorIs(err, database.ErrNotFound)
+	requireNextDeferralEnd(nil, []ids.ShortID{addr1}, 30)
+	requireNextDeferralEnd(set.Set[ids.ShortID]{addr1: struct{}{}}, nil, 0)
+}
@@ -0,0 +1,257 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+)
+
+// ProposalOutcome is the result of finished proposal
+type ProposalOutcome struct {
+	// ID of finish proposals tx, that finished proposal
+	TxID ids.ID `serialize:"true"`
+	// Index of winning option. Only meaningful, if proposal is successful
+	Option uint32 `serialize:"true"`
+	// True, if proposal was successful
+	Successful bool `serialize:"true"`
+}
+
+type proposalDiff struct {
+	*dao.ProposalState
+	added, removed bool
+}
+
+func (cs *caminoState) AddProposal(proposalID ids.ID, proposal *dao.ProposalState) {
+	cs.modifiedProposals[proposalID] = &proposalDiff{ProposalState: proposal, added: true}
+	cs.proposalsCache.Evict(proposalID)
+}
+
+func (cs *caminoState) ModifyProposal(proposalID ids.ID, proposal *dao.ProposalState) {
+	if proposalDiff, ok := cs.modifiedProposals[proposalID]; ok && proposalDiff.added {
+		// proposal isn't written yet, so it must stay added
+		proposalDiff.ProposalState = proposal
+		return
+	}
+	cs.modifiedProposals[proposalID] = &proposalDiff{ProposalState: proposal}
+	cs.proposalsCache.Evict(proposalID)
+}
+
+func (cs *caminoState) RemoveProposal(proposalID ids.ID, proposal *dao.ProposalState) {
+	cs.modifiedProposals[proposalID] = &proposalDiff{ProposalState: proposal, removed: true}
+	cs.proposalsCache.Evict(proposalID)
+}
+
+func (cs *caminoState) GetProposal(proposalID ids.ID) (*dao.ProposalState, error) {
+	if proposalDiff, ok := cs.modifiedProposals[proposalID]; ok {
+		if proposalDiff.removed {
+			return nil, database.ErrNotFound
+		}
+		return proposalDiff.ProposalState, nil
+	}
+
+	if proposal, ok := cs.proposalsCache.Get(proposalID); ok {
+		if proposal == nil {
+			return nil, database.ErrNotFound
+		}
+		return proposal, nil
+	}
+
+	proposalBytes, err := cs.proposalsDB.Get(proposalID[:])
+	if err == database.ErrNotFound {
+		cs.proposalsCache.Put(proposalID, nil)
+		return nil, err
+	} else if err != nil {
+		return nil, err
+	}
+
+	proposal := &dao.ProposalState{}
+	if _, err := blocks.GenesisCodec.Unmarshal(proposalBytes, proposal); err != nil {
+		return nil, err
+	}
+
+	cs.proposalsCache.Put(proposalID, proposal)
+
+	return proposal, nil
+}
+
+func (cs *caminoState) SetProposalOutcome(proposalID ids.ID, outcome *ProposalOutcome) {
+	cs.modifiedProposalOutcomes[proposalID] = outcome
+}
+
+func (cs *caminoState) GetProposalOutcome(proposalID ids.ID) (*ProposalOutcome, error) {
+	if outcome, ok := cs.modifiedProposalOutcomes[proposalID]; ok {
+		return outcome, nil
+	}
+
+	outcomeBytes, err := cs.proposalOutcomesDB.Get(proposalID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	outcome := &ProposalOutcome{}
+	if _, err := blocks.GenesisCodec.Unmarshal(outcomeBytes, outcome); err != nil {
+		return nil, err
+	}
+	return outcome, nil
+}
+
+func (cs *caminoState) GetNextProposalExpirationTime(removedProposalIDs set.Set[ids.ID]) (time.Time, error) {
+	if cs.proposalsNextExpirationTime == nil {
+		return mockable.MaxTime, database.ErrNotFound
+	}
+
+	for _, proposalID := range cs.proposalsNextToExpireIDs {
+		if !removedProposalIDs.Contains(proposalID) {
+			return *cs.proposalsNextExpirationTime, nil
+		}
+	}
+
+	_, nextExpirationTime, err := cs.getNextToExpireProposalIDsAndTimeFromDB(removedProposalIDs)
+	return nextExpirationTime, err
+}
+
+func (cs *caminoState) GetNextToExpireProposalIDsAndTime(removedProposalIDs set.Set[ids.ID]) ([]ids.ID, time.Time, error) {
+	if cs.proposalsNextExpirationTime == nil {
+		return nil, mockable.MaxTime, database.ErrNotFound
+	}
+
+	var nextProposalIDs []ids.ID
+	for _, proposalID := range cs.proposalsNextToExpireIDs {
+		if !removedProposalIDs.Contains(proposalID) {
+			nextProposalIDs = append(nextProposalIDs, proposalID)
+		}
+	}
+	if len(nextProposalIDs) > 0 {
+		return nextProposalIDs, *cs.proposalsNextExpirationTime, nil
+	}
+
+	return cs.getNextToExpireProposalIDsAndTimeFromDB(removedProposalIDs)
+}
+
+func (cs *caminoState) writeProposals() error {
+	if len(cs.modifiedProposals) == 0 {
+		return nil
+	}
+
+	for proposalID, proposalDiff := range cs.modifiedProposals {
+		delete(cs.modifiedProposals, proposalID)
+		if proposalDiff.removed {
+			if err := cs.proposalsDB.Delete(proposalID[:]); err != nil {
+				return err
+			}
+			if err := cs.proposalIDsByEndtimeDB.Delete(proposalToKey(proposalID[:], proposalDiff.ProposalState)); err != nil {
+				return err
+			}
+		} else {
+			proposalBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, proposalDiff.ProposalState)
+			if err != nil {
+				return fmt.Errorf("failed to serialize proposal: %w", err)
+			}
+			if err := cs.proposalsDB.Put(proposalID[:], proposalBytes); err != nil {
+				return err
+			}
+			if proposalDiff.added {
+				if err := cs.proposalIDsByEndtimeDB.Put(proposalToKey(proposalID[:], proposalDiff.ProposalState), nil); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// proposals end time index is small and sorted, so we can simply re-read earliest proposals from db
+	return cs.loadProposals()
+}
+
+func (cs *caminoState) writeProposalOutcomes() error {
+	for proposalID, outcome := range cs.modifiedProposalOutcomes {
+		delete(cs.modifiedProposalOutcomes, proposalID)
+		outcomeBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, outcome)
+		if err != nil {
+			return fmt.Errorf("failed to serialize proposal outcome: %w", err)
+		}
+		if err := cs.proposalOutcomesDB.Put(proposalID[:], outcomeBytes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cs *caminoState) loadProposals() error {
+	cs.proposalsNextToExpireIDs = nil
+	cs.proposalsNextExpirationTime = nil
+	proposalsNextToExpireIDs, proposalsNextExpirationTime, err := cs.getNextToExpireProposalIDsAndTimeFromDB(nil)
+	if err == database.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	cs.proposalsNextToExpireIDs = proposalsNextToExpireIDs
+	cs.proposalsNextExpirationTime = &proposalsNextExpirationTime
+	return nil
+}
+
+func (cs *caminoState) getNextToExpireProposalIDsAndTimeFromDB(removedProposalIDs set.Set[ids.ID]) ([]ids.ID, time.Time, error) {
+	proposalsIterator := cs.proposalIDsByEndtimeDB.NewIterator()
+	defer proposalsIterator.Release()
+
+	var nextProposalIDs []ids.ID
+	nextProposalsEndTimestamp := uint64(math.MaxUint64)
+
+	for proposalsIterator.Next() {
+		proposalID, proposalEndtime, err := bytesToProposalIDAndEndtime(proposalsIterator.Key())
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+
+		if removedProposalIDs.Contains(proposalID) {
+			continue
+		}
+
+		// we expect values to be sorted by endtime in ascending order
+		if proposalEndtime > nextProposalsEndTimestamp {
+			break
+		}
+		if proposalEndtime < nextProposalsEndTimestamp {
+			nextProposalsEndTimestamp = proposalEndtime
+		}
+		nextProposalIDs = append(nextProposalIDs, proposalID)
+	}
+
+	if err := proposalsIterator.Error(); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	if len(nextProposalIDs) == 0 {
+		return nil, mockable.MaxTime, database.ErrNotFound
+	}
+
+	return nextProposalIDs, time.Unix(int64(nextProposalsEndTimestamp), 0), nil
+}
+
+// proposalID must be ids.ID 32 bytes
+func proposalToKey(proposalID []byte, proposal *dao.ProposalState) []byte {
+	proposalSortKey := make([]byte, 8+32)
+	binary.BigEndian.PutUint64(proposalSortKey, proposal.End)
+	copy(proposalSortKey[8:], proposalID)
+	return proposalSortKey
+}
+
+// proposalID must be ids.ID 32 bytes
+func bytesToProposalIDAndEndtime(proposalSortKeyBytes []byte) (ids.ID, uint64, error) {
+	proposalID, err := ids.ToID(proposalSortKeyBytes[8:])
+	if err != nil {
+		return ids.Empty, 0, err
+	}
+	return proposalID, binary.BigEndian.Uint64(proposalSortKeyBytes[:8]), nil
+}
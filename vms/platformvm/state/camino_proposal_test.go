@@ -0,0 +1,157 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposals(t *testing.T) {
+	require := require.New(t)
+
+	proposalID1 := ids.ID{1}
+	proposalID2 := ids.ID{2}
+	proposalID3 := ids.ID{3}
+	proposal1 := dao.NewProposalState(&dao.Proposal{
+		Start:   100,
+		End:     200,
+		Options: []types.JSONByteSlice{{1}, {2}},
+		Memo:    types.JSONByteSlice{1},
+	}, ids.ShortID{1})
+	proposal2 := dao.NewProposalState(&dao.Proposal{
+		Start:   100,
+		End:     200,
+		Options: []types.JSONByteSlice{{1}, {2}, {3}},
+	}, ids.ShortID{2})
+	proposal3 := dao.NewProposalState(&dao.Proposal{
+		Start:   150,
+		End:     300,
+		Options: []types.JSONByteSlice{{1}, {2}},
+	}, ids.ShortID{3})
+
+	db := memdb.New()
+	cs, err := newCaminoState(db, db, prometheus.NewRegistry())
+	require.NoError(err)
+	require.NoError(cs.loadProposals())
+
+	// empty state
+
+	_, err = cs.GetProposal(proposalID1)
+	require.ErrorIs(err, database.ErrNotFound)
+	nextExpirationTime, err := cs.GetNextProposalExpirationTime(nil)
+	require.ErrorIs(err, database.ErrNotFound)
+	require.Equal(mockable.MaxTime, nextExpirationTime)
+
+	// add proposals
+
+	cs.AddProposal(proposalID3, proposal3)
+	cs.AddProposal(proposalID2, proposal2)
+	cs.AddProposal(proposalID1, proposal1)
+
+	// vote for not yet written proposal
+	votedProposal1, err := proposal1.AddVote(ids.ShortID{10}, 1)
+	require.NoError(err)
+	cs.ModifyProposal(proposalID1, votedProposal1)
+
+	require.NoError(cs.writeProposals())
+	require.Empty(cs.modifiedProposals)
+
+	actualProposal, err := cs.GetProposal(proposalID1)
+	require.NoError(err)
+	require.Equal(votedProposal1, actualProposal)
+
+	nextProposalIDs, nextExpirationTime, err := cs.GetNextToExpireProposalIDsAndTime(nil)
+	require.NoError(err)
+	require.Equal([]ids.ID{proposalID1, proposalID2}, nextProposalIDs)
+	require.Equal(proposal1.EndTime(), nextExpirationTime)
+
+	nextProposalIDs, nextExpirationTime, err = cs.GetNextToExpireProposalIDsAndTime(set.Set[ids.ID]{proposalID1: struct{}{}})
+	require.NoError(err)
+	require.Equal([]ids.ID{proposalID2}, nextProposalIDs)
+	require.Equal(proposal1.EndTime(), nextExpirationTime)
+
+	nextExpirationTime, err = cs.GetNextProposalExpirationTime(set.Set[ids.ID]{proposalID1: struct{}{}, proposalID2: struct{}{}})
+	require.NoError(err)
+	require.Equal(proposal3.EndTime(), nextExpirationTime)
+
+	// reload from db
+
+	cs, err = newCaminoState(db, db, prometheus.NewRegistry())
+	require.NoError(err)
+	require.NoError(cs.loadProposals())
+
+	actualProposal, err = cs.GetProposal(proposalID1)
+	require.NoError(err)
+	require.Equal(votedProposal1, actualProposal)
+
+	nextProposalIDs, nextExpirationTime, err = cs.GetNextToExpireProposalIDsAndTime(nil)
+	require.NoError(err)
+	require.Equal([]ids.ID{proposalID1, proposalID2}, nextProposalIDs)
+	require.Equal(proposal1.EndTime(), nextExpirationTime)
+
+	// remove proposals
+
+	cs.RemoveProposal(proposalID1, votedProposal1)
+	cs.RemoveProposal(proposalID2, proposal2)
+	require.NoError(cs.writeProposals())
+
+	_, err = cs.GetProposal(proposalID1)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	nextProposalIDs, nextExpirationTime, err = cs.GetNextToExpireProposalIDsAndTime(nil)
+	require.NoError(err)
+	require.Equal([]ids.ID{proposalID3}, nextProposalIDs)
+	require.Equal(proposal3.EndTime(), nextExpirationTime)
+}
+
+func TestProposalOutcomes(t *testing.T) {
+	require := require.New(t)
+
+	proposalID1 := ids.ID{1}
+	proposalID2 := ids.ID{2}
+	outcome1 := &ProposalOutcome{TxID: ids.ID{11}, Option: 1, Successful: true}
+	outcome2 := &ProposalOutcome{TxID: ids.ID{12}}
+
+	db := memdb.New()
+	cs, err := newCaminoState(db, db, prometheus.NewRegistry())
+	require.NoError(err)
+
+	_, err = cs.GetProposalOutcome(proposalID1)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	// not written outcomes
+
+	cs.SetProposalOutcome(proposalID1, outcome1)
+	outcome, err := cs.GetProposalOutcome(proposalID1)
+	require.NoError(err)
+	require.Equal(outcome1, outcome)
+
+	// written outcomes
+
+	cs.SetProposalOutcome(proposalID2, outcome2)
+	require.NoError(cs.writeProposalOutcomes())
+	require.Empty(cs.modifiedProposalOutcomes)
+
+	// reload from db
+
+	cs, err = newCaminoState(db, db, prometheus.NewRegistry())
+	require.NoError(err)
+
+	outcome, err = cs.GetProposalOutcome(proposalID1)
+	require.NoError(err)
+	require.Equal(outcome1, outcome)
+	outcome, err = cs.GetProposalOutcome(proposalID2)
+	require.NoError(err)
+	require.Equal(outcome2, outcome)
+}
@@ -12,6 +12,11 @@ type ShortLinkKey [12]byte
 
 var ShortLinkKeyRegisterNode = ShortLinkKey{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
 
+type ShortIDLink struct {
+	ID   ids.ShortID
+	Link ids.ShortID
+}
+
 func (cs *caminoState) writeShortLinks() error {
 	for nodeID, addr := range cs.modifiedShortLinks {
 		delete(cs.modifiedShortLinks, nodeID)
@@ -68,6 +73,68 @@ func (cs *caminoState) GetShortIDLink(id ids.ShortID, key ShortLinkKey) (ids.Sho
 	return linkedShortID, nil
 }
 
+// Returns all links with [key] sorted by id
+func (cs *caminoState) GetShortIDLinks(key ShortLinkKey) ([]ShortIDLink, error) {
+	linksIterator := cs.shortLinksDB.NewIteratorWithPrefix(key[:])
+	defer linksIterator.Release()
+
+	var links []ShortIDLink
+	for linksIterator.Next() {
+		fullKey, err := ids.ToID(linksIterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		link, err := ids.ToShortID(linksIterator.Value())
+		if err != nil {
+			return nil, err
+		}
+		id, _ := fromShortLinkKey(fullKey)
+		links = append(links, ShortIDLink{ID: id, Link: link})
+	}
+
+	if err := linksIterator.Error(); err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
+
+func (cs *caminoState) SetNodeRegistrationTxID(nodeID ids.NodeID, txID *ids.ID) {
+	cs.modifiedNodeRegistrationTxIDs[nodeID] = txID
+}
+
+func (cs *caminoState) GetNodeRegistrationTxID(nodeID ids.NodeID) (ids.ID, error) {
+	if txID, ok := cs.modifiedNodeRegistrationTxIDs[nodeID]; ok {
+		if txID == nil {
+			return ids.Empty, database.ErrNotFound
+		}
+		return *txID, nil
+	}
+
+	txIDBytes, err := cs.nodeRegistrationsDB.Get(nodeID[:])
+	if err != nil {
+		return ids.Empty, err
+	}
+
+	return ids.ToID(txIDBytes)
+}
+
+func (cs *caminoState) writeNodeRegistrations() error {
+	for nodeID, txID := range cs.modifiedNodeRegistrationTxIDs {
+		delete(cs.modifiedNodeRegistrationTxIDs, nodeID)
+		if txID == nil {
+			if err := cs.nodeRegistrationsDB.Delete(nodeID[:]); err != nil {
+				return err
+			}
+		} else {
+			if err := cs.nodeRegistrationsDB.Put(nodeID[:], txID[:]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func toShortLinkKey(id ids.ShortID, key ShortLinkKey) ids.ID {
 	fullKey, _ := ids.ToID(append(key[:], id[:]...))
 	return fullKey
@@ -349,3 +349,36 @@ func TestWriteShortLinks(t *testing.T) {
 		})
 	}
 }
+
+func TestGetShortIDLinks(t *testing.T) {
+	require := require.New(t)
+	s := newEmptyState(t)
+
+	nodeID := ids.NodeID{1}
+	nodeShortID := ids.ShortID(nodeID)
+	consortiumMemberAddr := ids.ShortID{2}
+	registrationTxID := ids.ID{3}
+
+	s.SetShortIDLink(nodeShortID, ShortLinkKeyRegisterNode, &consortiumMemberAddr)
+	s.SetShortIDLink(consortiumMemberAddr, ShortLinkKeyRegisterNode, &nodeShortID)
+	s.SetNodeRegistrationTxID(nodeID, &registrationTxID)
+	require.NoError(s.write(false, 0))
+
+	links, err := s.GetShortIDLinks(ShortLinkKeyRegisterNode)
+	require.NoError(err)
+	require.Equal([]ShortIDLink{
+		{ID: nodeShortID, Link: consortiumMemberAddr},
+		{ID: consortiumMemberAddr, Link: nodeShortID},
+	}, links)
+
+	txID, err := s.GetNodeRegistrationTxID(nodeID)
+	require.NoError(err)
+	require.Equal(registrationTxID, txID)
+
+	s.SetNodeRegistrationTxID(nodeID, nil)
+	_, err = s.GetNodeRegistrationTxID(nodeID)
+	require.ErrorIs(err, database.ErrNotFound)
+	require.NoError(s.write(false, 0))
+	_, err = s.GetNodeRegistrationTxID(nodeID)
+	require.ErrorIs(err, database.ErrNotFound)
+}
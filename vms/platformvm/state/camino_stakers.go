@@ -102,3 +102,21 @@ func writeDeferredDiff(
 	}
 	return nil
 }
+
+// GetValidatorBondTxIDs returns ids of txs, that bonded tokens of validator created by [validatorTxID] tx:
+// [validatorTxID] itself, ids of previous extend validator txs and id of add validator tx.
+func GetValidatorBondTxIDs(chain Chain, validatorTxID ids.ID) ([]ids.ID, error) {
+	bondTxIDs := []ids.ID{}
+	for {
+		bondTxIDs = append(bondTxIDs, validatorTxID)
+		validatorTx, _, err := chain.GetTx(validatorTxID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get validator tx %s: %w", validatorTxID, err)
+		}
+		extendValidatorTx, ok := validatorTx.Unsigned.(*txs.ExtendValidatorTx)
+		if !ok {
+			return bondTxIDs, nil
+		}
+		validatorTxID = extendValidatorTx.ValidatorTxID
+	}
+}
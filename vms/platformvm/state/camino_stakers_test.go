@@ -0,0 +1,144 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+func TestBaseStakersReplaceValidator(t *testing.T) {
+	require := require.New(t)
+	staker := newTestStaker()
+	replacingStaker := *staker
+	replacingStaker.TxID = ids.GenerateTestID()
+	replacingStaker.Weight++
+
+	v := newBaseStakers()
+	v.DeleteValidator(staker)
+	v.PutValidator(&replacingStaker)
+
+	validator, err := v.GetValidator(staker.SubnetID, staker.NodeID)
+	require.NoError(err)
+	require.Equal(&replacingStaker, validator)
+
+	validatorDiff := v.validatorDiffs[staker.SubnetID][staker.NodeID]
+	require.Equal(added, validatorDiff.validatorStatus)
+	require.Equal(&replacingStaker, validatorDiff.validator)
+	require.Equal(staker, validatorDiff.replacedValidator)
+
+	// removing replacing validator results in removal of replaced one
+	v.DeleteValidator(&replacingStaker)
+
+	_, err = v.GetValidator(staker.SubnetID, staker.NodeID)
+	require.ErrorIs(err, database.ErrNotFound)
+	require.Equal(deleted, validatorDiff.validatorStatus)
+	require.Equal(staker, validatorDiff.validator)
+	require.Nil(validatorDiff.replacedValidator)
+}
+
+func TestDiffStakersReplaceValidator(t *testing.T) {
+	require := require.New(t)
+	staker := newTestStaker()
+	replacingStaker := *staker
+	replacingStaker.TxID = ids.GenerateTestID()
+	replacingStaker.Weight++
+
+	v := diffStakers{}
+	v.DeleteValidator(staker)
+	v.PutValidator(&replacingStaker)
+
+	validator, validatorStatus := v.GetValidator(staker.SubnetID, staker.NodeID)
+	require.Equal(added, validatorStatus)
+	require.Equal(&replacingStaker, validator)
+
+	validatorDiff := v.validatorDiffs[staker.SubnetID][staker.NodeID]
+	require.Equal(added, validatorDiff.validatorStatus)
+	require.Equal(staker, validatorDiff.replacedValidator)
+
+	stakerIterator := v.GetStakerIterator(NewSliceIterator(staker))
+	assertIteratorsEqual(t, NewSliceIterator(&replacingStaker), stakerIterator)
+
+	// removing replacing validator results in removal of replaced one
+	v.DeleteValidator(&replacingStaker)
+
+	validator, validatorStatus = v.GetValidator(staker.SubnetID, staker.NodeID)
+	require.Equal(deleted, validatorStatus)
+	require.Nil(validator)
+	require.Equal(deleted, validatorDiff.validatorStatus)
+	require.Equal(staker, validatorDiff.validator)
+	require.Nil(validatorDiff.replacedValidator)
+
+	stakerIterator = v.GetStakerIterator(NewSliceIterator(staker))
+	assertIteratorsEqual(t, EmptyIterator, stakerIterator)
+}
+
+func TestGetValidatorBondTxIDs(t *testing.T) {
+	addValidatorTxID := ids.ID{1}
+	extendValidatorTxID1 := ids.ID{2}
+	extendValidatorTxID2 := ids.ID{3}
+	testErr := errors.New("test error")
+
+	tests := map[string]struct {
+		chain             func(*gomock.Controller) Chain
+		validatorTxID     ids.ID
+		expectedBondTxIDs []ids.ID
+		expectedErr       error
+	}{
+		"Fail: tx not found": {
+			chain: func(c *gomock.Controller) Chain {
+				s := NewMockChain(c)
+				s.EXPECT().GetTx(extendValidatorTxID1).Return(&txs.Tx{Unsigned: &txs.ExtendValidatorTx{
+					ValidatorTxID: addValidatorTxID,
+				}}, status.Committed, nil)
+				s.EXPECT().GetTx(addValidatorTxID).Return(nil, status.Unknown, testErr)
+				return s
+			},
+			validatorTxID: extendValidatorTxID1,
+			expectedErr:   testErr,
+		},
+		"OK: not extended": {
+			chain: func(c *gomock.Controller) Chain {
+				s := NewMockChain(c)
+				s.EXPECT().GetTx(addValidatorTxID).Return(&txs.Tx{Unsigned: &txs.CaminoAddValidatorTx{}}, status.Committed, nil)
+				return s
+			},
+			validatorTxID:     addValidatorTxID,
+			expectedBondTxIDs: []ids.ID{addValidatorTxID},
+		},
+		"OK: extended twice": {
+			chain: func(c *gomock.Controller) Chain {
+				s := NewMockChain(c)
+				s.EXPECT().GetTx(extendValidatorTxID2).Return(&txs.Tx{Unsigned: &txs.ExtendValidatorTx{
+					ValidatorTxID: extendValidatorTxID1,
+				}}, status.Committed, nil)
+				s.EXPECT().GetTx(extendValidatorTxID1).Return(&txs.Tx{Unsigned: &txs.ExtendValidatorTx{
+					ValidatorTxID: addValidatorTxID,
+				}}, status.Committed, nil)
+				s.EXPECT().GetTx(addValidatorTxID).Return(&txs.Tx{Unsigned: &txs.CaminoAddValidatorTx{}}, status.Committed, nil)
+				return s
+			},
+			validatorTxID:     extendValidatorTxID2,
+			expectedBondTxIDs: []ids.ID{extendValidatorTxID2, extendValidatorTxID1, addValidatorTxID},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			bondTxIDs, err := GetValidatorBondTxIDs(tt.chain(ctrl), tt.validatorTxID)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expectedBondTxIDs, bondTxIDs)
+		})
+	}
+}
@@ -7,13 +7,17 @@ import (
 	"math"
 	"time"
 
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
 
@@ -41,6 +45,46 @@ func (s *state) LockedUTXOs(txIDs set.Set[ids.ID], addresses set.Set[ids.ShortID
 	return retUtxos, nil
 }
 
+// Updates deposits owners index with owners of added or deleted deposited utxos.
+// Must be called before modified utxos are written.
+func (s *state) writeDepositedUTXOsOwners() error {
+	for utxoID, utxo := range s.modifiedUTXOs {
+		added := utxo != nil
+		if !added {
+			deletedUTXO, err := s.utxoState.GetUTXO(utxoID)
+			if err == database.ErrNotFound {
+				continue
+			} else if err != nil {
+				return err
+			}
+			utxo = deletedUTXO
+		}
+
+		lockedOut, ok := utxo.Out.(*locked.Out)
+		if !ok || lockedOut.DepositTxID == ids.Empty {
+			continue
+		}
+
+		owned, ok := lockedOut.TransferableOut.(fx.Owned)
+		if !ok {
+			continue
+		}
+
+		if err := s.caminoState.updateDepositOwners(
+			lockedOut.DepositTxID,
+			ownerAddresses(owned.Owners()),
+			added,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *state) GetDepositIDsByOwner(owner ids.ShortID, startDepositTxID ids.ID, limit int) ([]ids.ID, error) {
+	return s.caminoState.GetDepositIDsByOwner(owner, startDepositTxID, limit)
+}
+
 func (s *state) Config() (*config.Config, error) {
 	return s.cfg, nil
 }
@@ -57,6 +101,38 @@ func (s *state) GetAddressStates(address ids.ShortID) (txs.AddressState, error)
 	return s.caminoState.GetAddressStates(address)
 }
 
+func (s *state) SetKYCExpiration(address ids.ShortID, expiration uint64) {
+	s.caminoState.SetKYCExpiration(address, expiration)
+}
+
+func (s *state) GetKYCExpiration(address ids.ShortID) (uint64, error) {
+	return s.caminoState.GetKYCExpiration(address)
+}
+
+func (s *state) AddAddressStateChange(change *AddressStateChange) {
+	s.caminoState.AddAddressStateChange(change)
+}
+
+func (s *state) GetAddressStateChanges(address ids.ShortID, startTime uint64, startTxID ids.ID, limit int) ([]*AddressStateChange, error) {
+	return s.caminoState.GetAddressStateChanges(address, startTime, startTxID, limit)
+}
+
+func (s *state) GetNextKYCExpirationAddressesAndTime(excludedAddresses set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	return s.caminoState.GetNextKYCExpirationAddressesAndTime(excludedAddresses)
+}
+
+func (s *state) SetNodeDeferral(address ids.ShortID, deferral *NodeDeferral) {
+	s.caminoState.SetNodeDeferral(address, deferral)
+}
+
+func (s *state) GetNodeDeferral(address ids.ShortID) (*NodeDeferral, error) {
+	return s.caminoState.GetNodeDeferral(address)
+}
+
+func (s *state) GetNextNodeDeferralEndAddressesAndTime(excludedAddresses set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	return s.caminoState.GetNextNodeDeferralEndAddressesAndTime(excludedAddresses)
+}
+
 func (s *state) SetDepositOffer(offer *deposit.Offer) {
 	s.caminoState.SetDepositOffer(offer)
 }
@@ -69,6 +145,18 @@ func (s *state) GetAllDepositOffers() ([]*deposit.Offer, error) {
 	return s.caminoState.GetAllDepositOffers()
 }
 
+func (s *state) SetDepositOfferAllowedAddress(offerID ids.ID, address ids.ShortID, allowed bool) {
+	s.caminoState.SetDepositOfferAllowedAddress(offerID, address, allowed)
+}
+
+func (s *state) IsDepositOfferAllowedAddress(offerID ids.ID, address ids.ShortID) (bool, error) {
+	return s.caminoState.IsDepositOfferAllowedAddress(offerID, address)
+}
+
+func (s *state) GetDepositOfferAllowList(offerID ids.ID) ([]ids.ShortID, error) {
+	return s.caminoState.GetDepositOfferAllowList(offerID)
+}
+
 func (s *state) AddDeposit(depositTxID ids.ID, deposit *deposit.Deposit) {
 	s.caminoState.AddDeposit(depositTxID, deposit)
 }
@@ -101,6 +189,10 @@ func (s *state) GetMultisigAlias(alias ids.ShortID) (*multisig.AliasWithNonce, e
 	return s.caminoState.GetMultisigAlias(alias)
 }
 
+func (s *state) GetMultisigAliasesByMember(member ids.ShortID) ([]ids.ShortID, error) {
+	return s.caminoState.GetMultisigAliasesByMember(member)
+}
+
 func (s *state) SetShortIDLink(id ids.ShortID, key ShortLinkKey, link *ids.ShortID) {
 	s.caminoState.SetShortIDLink(id, key, link)
 }
@@ -109,6 +201,18 @@ func (s *state) GetShortIDLink(id ids.ShortID, key ShortLinkKey) (ids.ShortID, e
 	return s.caminoState.GetShortIDLink(id, key)
 }
 
+func (s *state) GetShortIDLinks(key ShortLinkKey) ([]ShortIDLink, error) {
+	return s.caminoState.GetShortIDLinks(key)
+}
+
+func (s *state) SetNodeRegistrationTxID(nodeID ids.NodeID, txID *ids.ID) {
+	s.caminoState.SetNodeRegistrationTxID(nodeID, txID)
+}
+
+func (s *state) GetNodeRegistrationTxID(nodeID ids.NodeID) (ids.ID, error) {
+	return s.caminoState.GetNodeRegistrationTxID(nodeID)
+}
+
 func (s *state) SetClaimable(ownerID ids.ID, claimable *Claimable) {
 	s.caminoState.SetClaimable(ownerID, claimable)
 }
@@ -125,6 +229,70 @@ func (s *state) GetNotDistributedValidatorReward() (uint64, error) {
 	return s.caminoState.GetNotDistributedValidatorReward()
 }
 
+func (s *state) SetRewardsImportProgress(progress *RewardsImportProgress) {
+	s.caminoState.SetRewardsImportProgress(progress)
+}
+
+func (s *state) GetRewardsImportProgress() (*RewardsImportProgress, error) {
+	return s.caminoState.GetRewardsImportProgress()
+}
+
+func (s *state) AddValidatorRewardsDistribution(distribution *ValidatorRewardsDistribution) {
+	s.caminoState.AddValidatorRewardsDistribution(distribution)
+}
+
+func (s *state) GetValidatorRewardsDistributions(startTime uint64, startTxID ids.ID, endTime uint64, limit int) ([]*ValidatorRewardsDistribution, error) {
+	return s.caminoState.GetValidatorRewardsDistributions(startTime, startTxID, endTime, limit)
+}
+
+func (s *state) SetTreasuryConfig(config *treasury.Config) {
+	s.caminoState.SetTreasuryConfig(config)
+}
+
+func (s *state) GetTreasuryConfig() (*treasury.Config, error) {
+	return s.caminoState.GetTreasuryConfig()
+}
+
+func (s *state) SetTreasurySpending(spending *treasury.Spending) {
+	s.caminoState.SetTreasurySpending(spending)
+}
+
+func (s *state) GetTreasurySpending() (*treasury.Spending, error) {
+	return s.caminoState.GetTreasurySpending()
+}
+
+func (s *state) AddProposal(proposalID ids.ID, proposal *dao.ProposalState) {
+	s.caminoState.AddProposal(proposalID, proposal)
+}
+
+func (s *state) ModifyProposal(proposalID ids.ID, proposal *dao.ProposalState) {
+	s.caminoState.ModifyProposal(proposalID, proposal)
+}
+
+func (s *state) RemoveProposal(proposalID ids.ID, proposal *dao.ProposalState) {
+	s.caminoState.RemoveProposal(proposalID, proposal)
+}
+
+func (s *state) GetProposal(proposalID ids.ID) (*dao.ProposalState, error) {
+	return s.caminoState.GetProposal(proposalID)
+}
+
+func (s *state) SetProposalOutcome(proposalID ids.ID, outcome *ProposalOutcome) {
+	s.caminoState.SetProposalOutcome(proposalID, outcome)
+}
+
+func (s *state) GetProposalOutcome(proposalID ids.ID) (*ProposalOutcome, error) {
+	return s.caminoState.GetProposalOutcome(proposalID)
+}
+
+func (s *state) GetNextProposalExpirationTime(removedProposalIDs set.Set[ids.ID]) (time.Time, error) {
+	return s.caminoState.GetNextProposalExpirationTime(removedProposalIDs)
+}
+
+func (s *state) GetNextToExpireProposalIDsAndTime(removedProposalIDs set.Set[ids.ID]) ([]ids.ID, time.Time, error) {
+	return s.caminoState.GetNextToExpireProposalIDsAndTime(removedProposalIDs)
+}
+
 func (s *state) GetDeferredValidator(subnetID ids.ID, nodeID ids.NodeID) (*Staker, error) {
 	return s.caminoState.GetDeferredValidator(subnetID, nodeID)
 }
@@ -8,10 +8,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/prefixdb"
 	"github.com/ava-labs/avalanchego/database/versiondb"
 	root_genesis "github.com/ava-labs/avalanchego/genesis"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/version"
@@ -305,6 +307,37 @@ func TestSyncGenesis(t *testing.T) {
 	}
 }
 
+func TestSyncGenesisDeferredNodeAndOfferLimits(t *testing.T) {
+	require := require.New(t)
+
+	genesisConfig := testGenesisConfig(true, true, true)
+	allocation := &genesisConfig.Camino.Allocations[0]
+	allocation.AddressStates.NodeDeferred = true
+	platformAllocation := allocation.PlatformAllocations[0]
+	genesisConfig.Camino.DepositOffers[0].TotalMaxAmount = 2 * platformAllocation.Amount
+
+	genBytes, _, err := root_genesis.FromConfig(genesisConfig)
+	require.NoError(err)
+
+	state := newEmptyState(t)
+	require.NoError(state.sync(genBytes))
+
+	_, err = state.GetCurrentValidator(constants.PrimaryNetworkID, platformAllocation.NodeID)
+	require.ErrorIs(err, database.ErrNotFound)
+	_, err = state.GetDeferredValidator(constants.PrimaryNetworkID, platformAllocation.NodeID)
+	require.NoError(err)
+
+	nodeDeferral, err := state.GetNodeDeferral(allocation.AVAXAddr)
+	require.NoError(err)
+	require.Equal(&NodeDeferral{Start: genesisConfig.StartTime}, nodeDeferral)
+
+	offer, err := root_genesis.DepositOfferFromConfig(genesisConfig.Camino.DepositOffers[0])
+	require.NoError(err)
+	offer, err = state.GetDepositOffer(offer.ID)
+	require.NoError(err)
+	require.Equal(platformAllocation.Amount, offer.DepositedAmount)
+}
+
 func testGenesisConfig(lockModeBondDeposit bool, validator, deposit bool) *root_genesis.Config {
 	var (
 		defaultMinValidatorStake = 5 * units.MilliAvax
@@ -0,0 +1,92 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
+)
+
+func (cs *caminoState) SetTreasuryConfig(config *treasury.Config) {
+	cs.modifiedTreasuryConfig = config
+}
+
+// Returns database.ErrNotFound, if treasury isn't configured
+func (cs *caminoState) GetTreasuryConfig() (*treasury.Config, error) {
+	if cs.modifiedTreasuryConfig != nil {
+		return cs.modifiedTreasuryConfig, nil
+	}
+	if cs.treasuryConfig == nil {
+		return nil, database.ErrNotFound
+	}
+	return cs.treasuryConfig, nil
+}
+
+func (cs *caminoState) SetTreasurySpending(spending *treasury.Spending) {
+	cs.modifiedTreasurySpending = spending
+}
+
+func (cs *caminoState) GetTreasurySpending() (*treasury.Spending, error) {
+	if cs.modifiedTreasurySpending != nil {
+		return cs.modifiedTreasurySpending, nil
+	}
+	return cs.treasurySpending, nil
+}
+
+func (cs *caminoState) writeTreasury() error {
+	if cs.modifiedTreasuryConfig != nil {
+		configBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, cs.modifiedTreasuryConfig)
+		if err != nil {
+			return fmt.Errorf("failed to serialize treasury config: %w", err)
+		}
+		if err := cs.caminoDB.Put(treasuryConfigKey, configBytes); err != nil {
+			return fmt.Errorf("failed to write treasury config: %w", err)
+		}
+		cs.treasuryConfig = cs.modifiedTreasuryConfig
+		cs.modifiedTreasuryConfig = nil
+	}
+
+	if cs.modifiedTreasurySpending != nil {
+		spendingBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, cs.modifiedTreasurySpending)
+		if err != nil {
+			return fmt.Errorf("failed to serialize treasury spending: %w", err)
+		}
+		if err := cs.caminoDB.Put(treasurySpendingKey, spendingBytes); err != nil {
+			return fmt.Errorf("failed to write treasury spending: %w", err)
+		}
+		cs.treasurySpending = cs.modifiedTreasurySpending
+		cs.modifiedTreasurySpending = nil
+	}
+	return nil
+}
+
+func (cs *caminoState) loadTreasury() error {
+	cs.treasuryConfig = nil
+	configBytes, err := cs.caminoDB.Get(treasuryConfigKey)
+	switch {
+	case err == nil:
+		config := &treasury.Config{}
+		if _, err := blocks.GenesisCodec.Unmarshal(configBytes, config); err != nil {
+			return err
+		}
+		cs.treasuryConfig = config
+	case err != database.ErrNotFound:
+		return err
+	}
+
+	cs.treasurySpending = &treasury.Spending{}
+	spendingBytes, err := cs.caminoDB.Get(treasurySpendingKey)
+	switch {
+	case err == nil:
+		if _, err := blocks.GenesisCodec.Unmarshal(spendingBytes, cs.treasurySpending); err != nil {
+			return err
+		}
+	case err != database.ErrNotFound:
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestTreasury(t *testing.T) {
+	require := require.New(t)
+	s := newEmptyState(t)
+
+	_, err := s.GetTreasuryConfig()
+	require.ErrorIs(err, database.ErrNotFound)
+	spending, err := s.GetTreasurySpending()
+	require.NoError(err)
+	require.Equal(&treasury.Spending{}, spending)
+
+	config := &treasury.Config{
+		Owner: secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{{1}},
+		},
+		SpendingPeriod: 100,
+		SpendingCap:    1000,
+	}
+	newSpending := &treasury.Spending{PeriodStart: 100, Amount: 10}
+
+	// not written
+	s.SetTreasuryConfig(config)
+	s.SetTreasurySpending(newSpending)
+	actualConfig, err := s.GetTreasuryConfig()
+	require.NoError(err)
+	require.Equal(config, actualConfig)
+	spending, err = s.GetTreasurySpending()
+	require.NoError(err)
+	require.Equal(newSpending, spending)
+
+	// written and loaded
+	require.NoError(s.write(false, 0))
+	require.NoError(s.caminoState.(*caminoState).loadTreasury())
+	actualConfig, err = s.GetTreasuryConfig()
+	require.NoError(err)
+	require.Equal(config, actualConfig)
+	spending, err = s.GetTreasurySpending()
+	require.NoError(err)
+	require.Equal(newSpending, spending)
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/blocks"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+// ValidatorReward is a reward, that validator got in validator rewards distribution
+type ValidatorReward struct {
+	NodeID      ids.NodeID                `serialize:"true"`
+	RewardOwner *secp256k1fx.OutputOwners `serialize:"true"`
+	Amount      uint64                    `serialize:"true"`
+}
+
+// ValidatorRewardsDistribution is a record of validator rewards distribution made by rewards import tx
+type ValidatorRewardsDistribution struct {
+	// ID of rewards import tx, that made this distribution
+	TxID ids.ID `serialize:"true"`
+	// Chain time, when distribution was made
+	Timestamp uint64 `serialize:"true"`
+	// Amount imported by rewards import tx
+	ImportedAmount uint64 `serialize:"true"`
+	// Amount that wasn't distributed by previous distribution and was distributed with imported amount
+	PreviouslyNotDistributedAmount uint64 `serialize:"true"`
+	// Amount that wasn't distributed by this distribution and will be distributed by the next one
+	NotDistributedAmount uint64 `serialize:"true"`
+	// Rewards of active validators, sorted in current stakers order
+	Rewards []*ValidatorReward `serialize:"true"`
+}
+
+func (cs *caminoState) AddValidatorRewardsDistribution(distribution *ValidatorRewardsDistribution) {
+	cs.addedValidatorRewardsDistributions = append(cs.addedValidatorRewardsDistributions, distribution)
+}
+
+// Returns validator rewards distributions made in [startTime, endTime] time range,
+// sorted by time and tx id. If [startTxID] isn't empty, distributions made at [startTime]
+// with tx id less or equal to [startTxID] are skipped. Returns not more than [limit] distributions.
+func (cs *caminoState) GetValidatorRewardsDistributions(startTime uint64, startTxID ids.ID, endTime uint64, limit int) ([]*ValidatorRewardsDistribution, error) {
+	startKey := validatorRewardsDistributionKey(startTime, startTxID)
+	distributionsIterator := cs.validatorRewardsHistoryDB.NewIteratorWithStart(startKey)
+	defer distributionsIterator.Release()
+
+	var distributions []*ValidatorRewardsDistribution
+	for len(distributions) < limit && distributionsIterator.Next() {
+		key := distributionsIterator.Key()
+		if bytes.Equal(key, startKey) {
+			continue
+		}
+		if binary.BigEndian.Uint64(key[:8]) > endTime {
+			break
+		}
+
+		distribution := &ValidatorRewardsDistribution{}
+		if _, err := blocks.GenesisCodec.Unmarshal(distributionsIterator.Value(), distribution); err != nil {
+			return nil, err
+		}
+		distributions = append(distributions, distribution)
+	}
+
+	if err := distributionsIterator.Error(); err != nil {
+		return nil, err
+	}
+
+	return distributions, nil
+}
+
+func (cs *caminoState) writeValidatorRewardsHistory() error {
+	for _, distribution := range cs.addedValidatorRewardsDistributions {
+		distributionBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, distribution)
+		if err != nil {
+			return fmt.Errorf("failed to serialize validator rewards distribution: %w", err)
+		}
+		key := validatorRewardsDistributionKey(distribution.Timestamp, distribution.TxID)
+		if err := cs.validatorRewardsHistoryDB.Put(key, distributionBytes); err != nil {
+			return err
+		}
+	}
+	cs.addedValidatorRewardsDistributions = nil
+	return nil
+}
+
+func validatorRewardsDistributionKey(timestamp uint64, txID ids.ID) []byte {
+	key := make([]byte, 8+len(txID))
+	binary.BigEndian.PutUint64(key, timestamp)
+	copy(key[8:], txID[:])
+	return key
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestGetValidatorRewardsDistributions(t *testing.T) {
+	require := require.New(t)
+	s := newEmptyState(t)
+
+	rewardOwner := &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{1}}}
+	distribution := func(txID ids.ID, timestamp uint64) *ValidatorRewardsDistribution {
+		return &ValidatorRewardsDistribution{
+			TxID:                           txID,
+			Timestamp:                      timestamp,
+			ImportedAmount:                 10,
+			PreviouslyNotDistributedAmount: 1,
+			NotDistributedAmount:           2,
+			Rewards: []*ValidatorReward{
+				{NodeID: ids.NodeID{1}, RewardOwner: rewardOwner, Amount: 3},
+				{NodeID: ids.NodeID{2}, RewardOwner: rewardOwner, Amount: 3},
+				{NodeID: ids.NodeID{3}, RewardOwner: rewardOwner, Amount: 3},
+			},
+		}
+	}
+	distribution1 := distribution(ids.ID{2}, 10)
+	distribution2 := distribution(ids.ID{1}, 20)
+	distribution3 := distribution(ids.ID{3}, 20)
+	distribution4 := distribution(ids.ID{4}, 30)
+
+	s.AddValidatorRewardsDistribution(distribution3)
+	s.AddValidatorRewardsDistribution(distribution1)
+	s.AddValidatorRewardsDistribution(distribution4)
+	s.AddValidatorRewardsDistribution(distribution2)
+	require.NoError(s.write(false, 0))
+
+	tests := map[string]struct {
+		startTime             uint64
+		startTxID             ids.ID
+		endTime               uint64
+		limit                 int
+		expectedDistributions []*ValidatorRewardsDistribution
+	}{
+		"All": {
+			endTime:               math.MaxUint64,
+			limit:                 10,
+			expectedDistributions: []*ValidatorRewardsDistribution{distribution1, distribution2, distribution3, distribution4},
+		},
+		"Time range": {
+			startTime:             11,
+			endTime:               20,
+			limit:                 10,
+			expectedDistributions: []*ValidatorRewardsDistribution{distribution2, distribution3},
+		},
+		"Limit": {
+			endTime:               math.MaxUint64,
+			limit:                 2,
+			expectedDistributions: []*ValidatorRewardsDistribution{distribution1, distribution2},
+		},
+		"Start tx id": {
+			startTime:             20,
+			startTxID:             distribution2.TxID,
+			endTime:               math.MaxUint64,
+			limit:                 10,
+			expectedDistributions: []*ValidatorRewardsDistribution{distribution3, distribution4},
+		},
+		"Empty time range": {
+			startTime: 21,
+			endTime:   29,
+			limit:     10,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			distributions, err := s.GetValidatorRewardsDistributions(tt.startTime, tt.startTxID, tt.endTime, tt.limit)
+			require.NoError(err)
+			require.Equal(tt.expectedDistributions, distributions)
+		})
+	}
+}
@@ -454,6 +454,9 @@ func (d *diff) Apply(baseState State) {
 		for _, validatorDiff := range subnetValidatorDiffs {
 			switch validatorDiff.validatorStatus {
 			case added:
+				if validatorDiff.replacedValidator != nil {
+					baseState.DeleteCurrentValidator(validatorDiff.replacedValidator)
+				}
 				baseState.PutCurrentValidator(validatorDiff.validator)
 			case deleted:
 				baseState.DeleteCurrentValidator(validatorDiff.validator)
@@ -16,9 +16,11 @@ import (
 	avax "github.com/ava-labs/avalanchego/vms/components/avax"
 	multisig "github.com/ava-labs/avalanchego/vms/components/multisig"
 	config "github.com/ava-labs/avalanchego/vms/platformvm/config"
+	dao "github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	deposit "github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	locked "github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	status "github.com/ava-labs/avalanchego/vms/platformvm/status"
+	treasury "github.com/ava-labs/avalanchego/vms/platformvm/treasury"
 	txs "github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -58,6 +60,21 @@ func (mr *MockChainMockRecorder) AddChain(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChain", reflect.TypeOf((*MockChain)(nil).AddChain), arg0)
 }
 
+// GetProposalOutcome mocks base method.
+func (m *MockChain) GetProposalOutcome(arg0 ids.ID) (*ProposalOutcome, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposalOutcome", arg0)
+	ret0, _ := ret[0].(*ProposalOutcome)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposalOutcome indicates an expected call of GetProposalOutcome.
+func (mr *MockChainMockRecorder) GetProposalOutcome(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposalOutcome", reflect.TypeOf((*MockChain)(nil).GetProposalOutcome), arg0)
+}
+
 // SetDepositOffer mocks base method.
 func (m *MockChain) SetDepositOffer(arg0 *deposit.Offer) {
 	m.ctrl.T.Helper()
@@ -771,6 +788,18 @@ func (mr *MockChainMockRecorder) SetNotDistributedValidatorReward(arg0 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNotDistributedValidatorReward", reflect.TypeOf((*MockChain)(nil).SetNotDistributedValidatorReward), arg0)
 }
 
+// SetProposalOutcome mocks base method.
+func (m *MockChain) SetProposalOutcome(arg0 ids.ID, arg1 *ProposalOutcome) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetProposalOutcome", arg0, arg1)
+}
+
+// SetProposalOutcome indicates an expected call of SetProposalOutcome.
+func (mr *MockChainMockRecorder) SetProposalOutcome(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProposalOutcome", reflect.TypeOf((*MockChain)(nil).SetProposalOutcome), arg0, arg1)
+}
+
 // SetShortIDLink mocks base method.
 func (m *MockChain) SetShortIDLink(arg0 ids.ShortID, arg1 ShortLinkKey, arg2 *ids.ShortID) {
 	m.ctrl.T.Helper()
@@ -830,3 +859,330 @@ func (mr *MockChainMockRecorder) RemoveDeposit(arg0, arg1 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDeposit", reflect.TypeOf((*MockChain)(nil).RemoveDeposit), arg0, arg1)
 }
+
+// AddProposal mocks base method.
+func (m *MockChain) AddProposal(arg0 ids.ID, arg1 *dao.ProposalState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddProposal", arg0, arg1)
+}
+
+// AddProposal indicates an expected call of AddProposal.
+func (mr *MockChainMockRecorder) AddProposal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProposal", reflect.TypeOf((*MockChain)(nil).AddProposal), arg0, arg1)
+}
+
+// ModifyProposal mocks base method.
+func (m *MockChain) ModifyProposal(arg0 ids.ID, arg1 *dao.ProposalState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ModifyProposal", arg0, arg1)
+}
+
+// ModifyProposal indicates an expected call of ModifyProposal.
+func (mr *MockChainMockRecorder) ModifyProposal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyProposal", reflect.TypeOf((*MockChain)(nil).ModifyProposal), arg0, arg1)
+}
+
+// RemoveProposal mocks base method.
+func (m *MockChain) RemoveProposal(arg0 ids.ID, arg1 *dao.ProposalState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveProposal", arg0, arg1)
+}
+
+// RemoveProposal indicates an expected call of RemoveProposal.
+func (mr *MockChainMockRecorder) RemoveProposal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProposal", reflect.TypeOf((*MockChain)(nil).RemoveProposal), arg0, arg1)
+}
+
+// GetProposal mocks base method.
+func (m *MockChain) GetProposal(arg0 ids.ID) (*dao.ProposalState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposal", arg0)
+	ret0, _ := ret[0].(*dao.ProposalState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposal indicates an expected call of GetProposal.
+func (mr *MockChainMockRecorder) GetProposal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposal", reflect.TypeOf((*MockChain)(nil).GetProposal), arg0)
+}
+
+// GetNextProposalExpirationTime mocks base method.
+func (m *MockChain) GetNextProposalExpirationTime(arg0 set.Set[ids.ID]) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextProposalExpirationTime", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextProposalExpirationTime indicates an expected call of GetNextProposalExpirationTime.
+func (mr *MockChainMockRecorder) GetNextProposalExpirationTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextProposalExpirationTime", reflect.TypeOf((*MockChain)(nil).GetNextProposalExpirationTime), arg0)
+}
+
+// GetNextToExpireProposalIDsAndTime mocks base method.
+func (m *MockChain) GetNextToExpireProposalIDsAndTime(arg0 set.Set[ids.ID]) ([]ids.ID, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextToExpireProposalIDsAndTime", arg0)
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNextToExpireProposalIDsAndTime indicates an expected call of GetNextToExpireProposalIDsAndTime.
+func (mr *MockChainMockRecorder) GetNextToExpireProposalIDsAndTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextToExpireProposalIDsAndTime", reflect.TypeOf((*MockChain)(nil).GetNextToExpireProposalIDsAndTime), arg0)
+}
+
+// SetKYCExpiration mocks base method.
+func (m *MockChain) SetKYCExpiration(arg0 ids.ShortID, arg1 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetKYCExpiration", arg0, arg1)
+}
+
+// SetKYCExpiration indicates an expected call of SetKYCExpiration.
+func (mr *MockChainMockRecorder) SetKYCExpiration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKYCExpiration", reflect.TypeOf((*MockChain)(nil).SetKYCExpiration), arg0, arg1)
+}
+
+// GetKYCExpiration mocks base method.
+func (m *MockChain) GetKYCExpiration(arg0 ids.ShortID) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKYCExpiration", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKYCExpiration indicates an expected call of GetKYCExpiration.
+func (mr *MockChainMockRecorder) GetKYCExpiration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKYCExpiration", reflect.TypeOf((*MockChain)(nil).GetKYCExpiration), arg0)
+}
+
+// GetNextKYCExpirationAddressesAndTime mocks base method.
+func (m *MockChain) GetNextKYCExpirationAddressesAndTime(arg0 set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextKYCExpirationAddressesAndTime", arg0)
+	ret0, _ := ret[0].([]ids.ShortID)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNextKYCExpirationAddressesAndTime indicates an expected call of GetNextKYCExpirationAddressesAndTime.
+func (mr *MockChainMockRecorder) GetNextKYCExpirationAddressesAndTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextKYCExpirationAddressesAndTime", reflect.TypeOf((*MockChain)(nil).GetNextKYCExpirationAddressesAndTime), arg0)
+}
+
+// SetTreasuryConfig mocks base method.
+func (m *MockChain) SetTreasuryConfig(arg0 *treasury.Config) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTreasuryConfig", arg0)
+}
+
+// SetTreasuryConfig indicates an expected call of SetTreasuryConfig.
+func (mr *MockChainMockRecorder) SetTreasuryConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTreasuryConfig", reflect.TypeOf((*MockChain)(nil).SetTreasuryConfig), arg0)
+}
+
+// GetTreasuryConfig mocks base method.
+func (m *MockChain) GetTreasuryConfig() (*treasury.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTreasuryConfig")
+	ret0, _ := ret[0].(*treasury.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTreasuryConfig indicates an expected call of GetTreasuryConfig.
+func (mr *MockChainMockRecorder) GetTreasuryConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTreasuryConfig", reflect.TypeOf((*MockChain)(nil).GetTreasuryConfig))
+}
+
+// SetTreasurySpending mocks base method.
+func (m *MockChain) SetTreasurySpending(arg0 *treasury.Spending) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTreasurySpending", arg0)
+}
+
+// SetTreasurySpending indicates an expected call of SetTreasurySpending.
+func (mr *MockChainMockRecorder) SetTreasurySpending(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTreasurySpending", reflect.TypeOf((*MockChain)(nil).SetTreasurySpending), arg0)
+}
+
+// GetTreasurySpending mocks base method.
+func (m *MockChain) GetTreasurySpending() (*treasury.Spending, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTreasurySpending")
+	ret0, _ := ret[0].(*treasury.Spending)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTreasurySpending indicates an expected call of GetTreasurySpending.
+func (mr *MockChainMockRecorder) GetTreasurySpending() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTreasurySpending", reflect.TypeOf((*MockChain)(nil).GetTreasurySpending))
+}
+
+// SetRewardsImportProgress mocks base method.
+func (m *MockChain) SetRewardsImportProgress(arg0 *RewardsImportProgress) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetRewardsImportProgress", arg0)
+}
+
+// SetRewardsImportProgress indicates an expected call of SetRewardsImportProgress.
+func (mr *MockChainMockRecorder) SetRewardsImportProgress(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRewardsImportProgress", reflect.TypeOf((*MockChain)(nil).SetRewardsImportProgress), arg0)
+}
+
+// GetRewardsImportProgress mocks base method.
+func (m *MockChain) GetRewardsImportProgress() (*RewardsImportProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardsImportProgress")
+	ret0, _ := ret[0].(*RewardsImportProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRewardsImportProgress indicates an expected call of GetRewardsImportProgress.
+func (mr *MockChainMockRecorder) GetRewardsImportProgress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardsImportProgress", reflect.TypeOf((*MockChain)(nil).GetRewardsImportProgress))
+}
+
+// AddValidatorRewardsDistribution mocks base method.
+func (m *MockChain) AddValidatorRewardsDistribution(arg0 *ValidatorRewardsDistribution) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddValidatorRewardsDistribution", arg0)
+}
+
+// AddValidatorRewardsDistribution indicates an expected call of AddValidatorRewardsDistribution.
+func (mr *MockChainMockRecorder) AddValidatorRewardsDistribution(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddValidatorRewardsDistribution", reflect.TypeOf((*MockChain)(nil).AddValidatorRewardsDistribution), arg0)
+}
+
+// SetNodeRegistrationTxID mocks base method.
+func (m *MockChain) SetNodeRegistrationTxID(arg0 ids.NodeID, arg1 *ids.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetNodeRegistrationTxID", arg0, arg1)
+}
+
+// SetNodeRegistrationTxID indicates an expected call of SetNodeRegistrationTxID.
+func (mr *MockChainMockRecorder) SetNodeRegistrationTxID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNodeRegistrationTxID", reflect.TypeOf((*MockChain)(nil).SetNodeRegistrationTxID), arg0, arg1)
+}
+
+// GetNodeRegistrationTxID mocks base method.
+func (m *MockChain) GetNodeRegistrationTxID(arg0 ids.NodeID) (ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodeRegistrationTxID", arg0)
+	ret0, _ := ret[0].(ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNodeRegistrationTxID indicates an expected call of GetNodeRegistrationTxID.
+func (mr *MockChainMockRecorder) GetNodeRegistrationTxID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeRegistrationTxID", reflect.TypeOf((*MockChain)(nil).GetNodeRegistrationTxID), arg0)
+}
+
+// SetNodeDeferral mocks base method.
+func (m *MockChain) SetNodeDeferral(arg0 ids.ShortID, arg1 *NodeDeferral) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetNodeDeferral", arg0, arg1)
+}
+
+// SetNodeDeferral indicates an expected call of SetNodeDeferral.
+func (mr *MockChainMockRecorder) SetNodeDeferral(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNodeDeferral", reflect.TypeOf((*MockChain)(nil).SetNodeDeferral), arg0, arg1)
+}
+
+// GetNodeDeferral mocks base method.
+func (m *MockChain) GetNodeDeferral(arg0 ids.ShortID) (*NodeDeferral, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodeDeferral", arg0)
+	ret0, _ := ret[0].(*NodeDeferral)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNodeDeferral indicates an expected call of GetNodeDeferral.
+func (mr *MockChainMockRecorder) GetNodeDeferral(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeDeferral", reflect.TypeOf((*MockChain)(nil).GetNodeDeferral), arg0)
+}
+
+// GetNextNodeDeferralEndAddressesAndTime mocks base method.
+func (m *MockChain) GetNextNodeDeferralEndAddressesAndTime(arg0 set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextNodeDeferralEndAddressesAndTime", arg0)
+	ret0, _ := ret[0].([]ids.ShortID)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNextNodeDeferralEndAddressesAndTime indicates an expected call of GetNextNodeDeferralEndAddressesAndTime.
+func (mr *MockChainMockRecorder) GetNextNodeDeferralEndAddressesAndTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextNodeDeferralEndAddressesAndTime", reflect.TypeOf((*MockChain)(nil).GetNextNodeDeferralEndAddressesAndTime), arg0)
+}
+
+// SetDepositOfferAllowedAddress mocks base method.
+func (m *MockChain) SetDepositOfferAllowedAddress(arg0 ids.ID, arg1 ids.ShortID, arg2 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDepositOfferAllowedAddress", arg0, arg1, arg2)
+}
+
+// SetDepositOfferAllowedAddress indicates an expected call of SetDepositOfferAllowedAddress.
+func (mr *MockChainMockRecorder) SetDepositOfferAllowedAddress(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDepositOfferAllowedAddress", reflect.TypeOf((*MockChain)(nil).SetDepositOfferAllowedAddress), arg0, arg1, arg2)
+}
+
+// IsDepositOfferAllowedAddress mocks base method.
+func (m *MockChain) IsDepositOfferAllowedAddress(arg0 ids.ID, arg1 ids.ShortID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDepositOfferAllowedAddress", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsDepositOfferAllowedAddress indicates an expected call of IsDepositOfferAllowedAddress.
+func (mr *MockChainMockRecorder) IsDepositOfferAllowedAddress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDepositOfferAllowedAddress", reflect.TypeOf((*MockChain)(nil).IsDepositOfferAllowedAddress), arg0, arg1)
+}
+
+// AddAddressStateChange mocks base method.
+func (m *MockChain) AddAddressStateChange(arg0 *AddressStateChange) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddAddressStateChange", arg0)
+}
+
+// AddAddressStateChange indicates an expected call of AddAddressStateChange.
+func (mr *MockChainMockRecorder) AddAddressStateChange(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAddressStateChange", reflect.TypeOf((*MockChain)(nil).AddAddressStateChange), arg0)
+}
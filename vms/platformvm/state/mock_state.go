@@ -21,9 +21,11 @@ import (
 	multisig "github.com/ava-labs/avalanchego/vms/components/multisig"
 	blocks "github.com/ava-labs/avalanchego/vms/platformvm/blocks"
 	config "github.com/ava-labs/avalanchego/vms/platformvm/config"
+	dao "github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	deposit "github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	locked "github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	status "github.com/ava-labs/avalanchego/vms/platformvm/status"
+	treasury "github.com/ava-labs/avalanchego/vms/platformvm/treasury"
 	txs "github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -75,6 +77,21 @@ func (mr *MockStateMockRecorder) AddChain(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChain", reflect.TypeOf((*MockState)(nil).AddChain), arg0)
 }
 
+// GetProposalOutcome mocks base method.
+func (m *MockState) GetProposalOutcome(arg0 ids.ID) (*ProposalOutcome, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposalOutcome", arg0)
+	ret0, _ := ret[0].(*ProposalOutcome)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposalOutcome indicates an expected call of GetProposalOutcome.
+func (mr *MockStateMockRecorder) GetProposalOutcome(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposalOutcome", reflect.TypeOf((*MockState)(nil).GetProposalOutcome), arg0)
+}
+
 // SetDepositOffer mocks base method.
 func (m *MockState) SetDepositOffer(arg0 *deposit.Offer) {
 	m.ctrl.T.Helper()
@@ -947,6 +964,18 @@ func (mr *MockStateMockRecorder) SetNotDistributedValidatorReward(arg0 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNotDistributedValidatorReward", reflect.TypeOf((*MockState)(nil).SetNotDistributedValidatorReward), arg0)
 }
 
+// SetProposalOutcome mocks base method.
+func (m *MockState) SetProposalOutcome(arg0 ids.ID, arg1 *ProposalOutcome) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetProposalOutcome", arg0, arg1)
+}
+
+// SetProposalOutcome indicates an expected call of SetProposalOutcome.
+func (mr *MockStateMockRecorder) SetProposalOutcome(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProposalOutcome", reflect.TypeOf((*MockState)(nil).SetProposalOutcome), arg0, arg1)
+}
+
 // SetShortIDLink mocks base method.
 func (m *MockState) SetShortIDLink(arg0 ids.ShortID, arg1 ShortLinkKey, arg2 *ids.ShortID) {
 	m.ctrl.T.Helper()
@@ -1049,3 +1078,420 @@ func (mr *MockStateMockRecorder) RemoveDeposit(arg0, arg1 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDeposit", reflect.TypeOf((*MockState)(nil).RemoveDeposit), arg0, arg1)
 }
+
+// AddProposal mocks base method.
+func (m *MockState) AddProposal(arg0 ids.ID, arg1 *dao.ProposalState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddProposal", arg0, arg1)
+}
+
+// AddProposal indicates an expected call of AddProposal.
+func (mr *MockStateMockRecorder) AddProposal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProposal", reflect.TypeOf((*MockState)(nil).AddProposal), arg0, arg1)
+}
+
+// ModifyProposal mocks base method.
+func (m *MockState) ModifyProposal(arg0 ids.ID, arg1 *dao.ProposalState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ModifyProposal", arg0, arg1)
+}
+
+// ModifyProposal indicates an expected call of ModifyProposal.
+func (mr *MockStateMockRecorder) ModifyProposal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyProposal", reflect.TypeOf((*MockState)(nil).ModifyProposal), arg0, arg1)
+}
+
+// RemoveProposal mocks base method.
+func (m *MockState) RemoveProposal(arg0 ids.ID, arg1 *dao.ProposalState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveProposal", arg0, arg1)
+}
+
+// RemoveProposal indicates an expected call of RemoveProposal.
+func (mr *MockStateMockRecorder) RemoveProposal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProposal", reflect.TypeOf((*MockState)(nil).RemoveProposal), arg0, arg1)
+}
+
+// GetProposal mocks base method.
+func (m *MockState) GetProposal(arg0 ids.ID) (*dao.ProposalState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposal", arg0)
+	ret0, _ := ret[0].(*dao.ProposalState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposal indicates an expected call of GetProposal.
+func (mr *MockStateMockRecorder) GetProposal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposal", reflect.TypeOf((*MockState)(nil).GetProposal), arg0)
+}
+
+// GetNextProposalExpirationTime mocks base method.
+func (m *MockState) GetNextProposalExpirationTime(arg0 set.Set[ids.ID]) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextProposalExpirationTime", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextProposalExpirationTime indicates an expected call of GetNextProposalExpirationTime.
+func (mr *MockStateMockRecorder) GetNextProposalExpirationTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextProposalExpirationTime", reflect.TypeOf((*MockState)(nil).GetNextProposalExpirationTime), arg0)
+}
+
+// GetNextToExpireProposalIDsAndTime mocks base method.
+func (m *MockState) GetNextToExpireProposalIDsAndTime(arg0 set.Set[ids.ID]) ([]ids.ID, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextToExpireProposalIDsAndTime", arg0)
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNextToExpireProposalIDsAndTime indicates an expected call of GetNextToExpireProposalIDsAndTime.
+func (mr *MockStateMockRecorder) GetNextToExpireProposalIDsAndTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextToExpireProposalIDsAndTime", reflect.TypeOf((*MockState)(nil).GetNextToExpireProposalIDsAndTime), arg0)
+}
+
+// GetDepositIDsByOwner mocks base method.
+func (m *MockState) GetDepositIDsByOwner(arg0 ids.ShortID, arg1 ids.ID, arg2 int) ([]ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDepositIDsByOwner", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDepositIDsByOwner indicates an expected call of GetDepositIDsByOwner.
+func (mr *MockStateMockRecorder) GetDepositIDsByOwner(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepositIDsByOwner", reflect.TypeOf((*MockState)(nil).GetDepositIDsByOwner), arg0, arg1, arg2)
+}
+
+// GetMultisigAliasesByMember mocks base method.
+func (m *MockState) GetMultisigAliasesByMember(arg0 ids.ShortID) ([]ids.ShortID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMultisigAliasesByMember", arg0)
+	ret0, _ := ret[0].([]ids.ShortID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultisigAliasesByMember indicates an expected call of GetMultisigAliasesByMember.
+func (mr *MockStateMockRecorder) GetMultisigAliasesByMember(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultisigAliasesByMember", reflect.TypeOf((*MockState)(nil).GetMultisigAliasesByMember), arg0)
+}
+
+// SetKYCExpiration mocks base method.
+func (m *MockState) SetKYCExpiration(arg0 ids.ShortID, arg1 uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetKYCExpiration", arg0, arg1)
+}
+
+// SetKYCExpiration indicates an expected call of SetKYCExpiration.
+func (mr *MockStateMockRecorder) SetKYCExpiration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKYCExpiration", reflect.TypeOf((*MockState)(nil).SetKYCExpiration), arg0, arg1)
+}
+
+// GetKYCExpiration mocks base method.
+func (m *MockState) GetKYCExpiration(arg0 ids.ShortID) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKYCExpiration", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKYCExpiration indicates an expected call of GetKYCExpiration.
+func (mr *MockStateMockRecorder) GetKYCExpiration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKYCExpiration", reflect.TypeOf((*MockState)(nil).GetKYCExpiration), arg0)
+}
+
+// GetNextKYCExpirationAddressesAndTime mocks base method.
+func (m *MockState) GetNextKYCExpirationAddressesAndTime(arg0 set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextKYCExpirationAddressesAndTime", arg0)
+	ret0, _ := ret[0].([]ids.ShortID)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNextKYCExpirationAddressesAndTime indicates an expected call of GetNextKYCExpirationAddressesAndTime.
+func (mr *MockStateMockRecorder) GetNextKYCExpirationAddressesAndTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextKYCExpirationAddressesAndTime", reflect.TypeOf((*MockState)(nil).GetNextKYCExpirationAddressesAndTime), arg0)
+}
+
+// SetTreasuryConfig mocks base method.
+func (m *MockState) SetTreasuryConfig(arg0 *treasury.Config) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTreasuryConfig", arg0)
+}
+
+// SetTreasuryConfig indicates an expected call of SetTreasuryConfig.
+func (mr *MockStateMockRecorder) SetTreasuryConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTreasuryConfig", reflect.TypeOf((*MockState)(nil).SetTreasuryConfig), arg0)
+}
+
+// GetTreasuryConfig mocks base method.
+func (m *MockState) GetTreasuryConfig() (*treasury.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTreasuryConfig")
+	ret0, _ := ret[0].(*treasury.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTreasuryConfig indicates an expected call of GetTreasuryConfig.
+func (mr *MockStateMockRecorder) GetTreasuryConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTreasuryConfig", reflect.TypeOf((*MockState)(nil).GetTreasuryConfig))
+}
+
+// SetTreasurySpending mocks base method.
+func (m *MockState) SetTreasurySpending(arg0 *treasury.Spending) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTreasurySpending", arg0)
+}
+
+// SetTreasurySpending indicates an expected call of SetTreasurySpending.
+func (mr *MockStateMockRecorder) SetTreasurySpending(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTreasurySpending", reflect.TypeOf((*MockState)(nil).SetTreasurySpending), arg0)
+}
+
+// GetTreasurySpending mocks base method.
+func (m *MockState) GetTreasurySpending() (*treasury.Spending, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTreasurySpending")
+	ret0, _ := ret[0].(*treasury.Spending)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTreasurySpending indicates an expected call of GetTreasurySpending.
+func (mr *MockStateMockRecorder) GetTreasurySpending() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTreasurySpending", reflect.TypeOf((*MockState)(nil).GetTreasurySpending))
+}
+
+// SetRewardsImportProgress mocks base method.
+func (m *MockState) SetRewardsImportProgress(arg0 *RewardsImportProgress) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetRewardsImportProgress", arg0)
+}
+
+// SetRewardsImportProgress indicates an expected call of SetRewardsImportProgress.
+func (mr *MockStateMockRecorder) SetRewardsImportProgress(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRewardsImportProgress", reflect.TypeOf((*MockState)(nil).SetRewardsImportProgress), arg0)
+}
+
+// GetRewardsImportProgress mocks base method.
+func (m *MockState) GetRewardsImportProgress() (*RewardsImportProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardsImportProgress")
+	ret0, _ := ret[0].(*RewardsImportProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRewardsImportProgress indicates an expected call of GetRewardsImportProgress.
+func (mr *MockStateMockRecorder) GetRewardsImportProgress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardsImportProgress", reflect.TypeOf((*MockState)(nil).GetRewardsImportProgress))
+}
+
+// AddValidatorRewardsDistribution mocks base method.
+func (m *MockState) AddValidatorRewardsDistribution(arg0 *ValidatorRewardsDistribution) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddValidatorRewardsDistribution", arg0)
+}
+
+// AddValidatorRewardsDistribution indicates an expected call of AddValidatorRewardsDistribution.
+func (mr *MockStateMockRecorder) AddValidatorRewardsDistribution(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddValidatorRewardsDistribution", reflect.TypeOf((*MockState)(nil).AddValidatorRewardsDistribution), arg0)
+}
+
+// GetValidatorRewardsDistributions mocks base method.
+func (m *MockState) GetValidatorRewardsDistributions(arg0 uint64, arg1 ids.ID, arg2 uint64, arg3 int) ([]*ValidatorRewardsDistribution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorRewardsDistributions", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*ValidatorRewardsDistribution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorRewardsDistributions indicates an expected call of GetValidatorRewardsDistributions.
+func (mr *MockStateMockRecorder) GetValidatorRewardsDistributions(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorRewardsDistributions", reflect.TypeOf((*MockState)(nil).GetValidatorRewardsDistributions), arg0, arg1, arg2, arg3)
+}
+
+// SetNodeRegistrationTxID mocks base method.
+func (m *MockState) SetNodeRegistrationTxID(arg0 ids.NodeID, arg1 *ids.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetNodeRegistrationTxID", arg0, arg1)
+}
+
+// SetNodeRegistrationTxID indicates an expected call of SetNodeRegistrationTxID.
+func (mr *MockStateMockRecorder) SetNodeRegistrationTxID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNodeRegistrationTxID", reflect.TypeOf((*MockState)(nil).SetNodeRegistrationTxID), arg0, arg1)
+}
+
+// GetNodeRegistrationTxID mocks base method.
+func (m *MockState) GetNodeRegistrationTxID(arg0 ids.NodeID) (ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodeRegistrationTxID", arg0)
+	ret0, _ := ret[0].(ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNodeRegistrationTxID indicates an expected call of GetNodeRegistrationTxID.
+func (mr *MockStateMockRecorder) GetNodeRegistrationTxID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeRegistrationTxID", reflect.TypeOf((*MockState)(nil).GetNodeRegistrationTxID), arg0)
+}
+
+// GetShortIDLinks mocks base method.
+func (m *MockState) GetShortIDLinks(arg0 ShortLinkKey) ([]ShortIDLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShortIDLinks", arg0)
+	ret0, _ := ret[0].([]ShortIDLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShortIDLinks indicates an expected call of GetShortIDLinks.
+func (mr *MockStateMockRecorder) GetShortIDLinks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShortIDLinks", reflect.TypeOf((*MockState)(nil).GetShortIDLinks), arg0)
+}
+
+// SetNodeDeferral mocks base method.
+func (m *MockState) SetNodeDeferral(arg0 ids.ShortID, arg1 *NodeDeferral) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetNodeDeferral", arg0, arg1)
+}
+
+// SetNodeDeferral indicates an expected call of SetNodeDeferral.
+func (mr *MockStateMockRecorder) SetNodeDeferral(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNodeDeferral", reflect.TypeOf((*MockState)(nil).SetNodeDeferral), arg0, arg1)
+}
+
+// GetNodeDeferral mocks base method.
+func (m *MockState) GetNodeDeferral(arg0 ids.ShortID) (*NodeDeferral, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodeDeferral", arg0)
+	ret0, _ := ret[0].(*NodeDeferral)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNodeDeferral indicates an expected call of GetNodeDeferral.
+func (mr *MockStateMockRecorder) GetNodeDeferral(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeDeferral", reflect.TypeOf((*MockState)(nil).GetNodeDeferral), arg0)
+}
+
+// GetNextNodeDeferralEndAddressesAndTime mocks base method.
+func (m *MockState) GetNextNodeDeferralEndAddressesAndTime(arg0 set.Set[ids.ShortID]) ([]ids.ShortID, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextNodeDeferralEndAddressesAndTime", arg0)
+	ret0, _ := ret[0].([]ids.ShortID)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNextNodeDeferralEndAddressesAndTime indicates an expected call of GetNextNodeDeferralEndAddressesAndTime.
+func (mr *MockStateMockRecorder) GetNextNodeDeferralEndAddressesAndTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextNodeDeferralEndAddressesAndTime", reflect.TypeOf((*MockState)(nil).GetNextNodeDeferralEndAddressesAndTime), arg0)
+}
+
+// SetDepositOfferAllowedAddress mocks base method.
+func (m *MockState) SetDepositOfferAllowedAddress(arg0 ids.ID, arg1 ids.ShortID, arg2 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDepositOfferAllowedAddress", arg0, arg1, arg2)
+}
+
+// SetDepositOfferAllowedAddress indicates an expected call of SetDepositOfferAllowedAddress.
+func (mr *MockStateMockRecorder) SetDepositOfferAllowedAddress(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDepositOfferAllowedAddress", reflect.TypeOf((*MockState)(nil).SetDepositOfferAllowedAddress), arg0, arg1, arg2)
+}
+
+// IsDepositOfferAllowedAddress mocks base method.
+func (m *MockState) IsDepositOfferAllowedAddress(arg0 ids.ID, arg1 ids.ShortID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDepositOfferAllowedAddress", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsDepositOfferAllowedAddress indicates an expected call of IsDepositOfferAllowedAddress.
+func (mr *MockStateMockRecorder) IsDepositOfferAllowedAddress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDepositOfferAllowedAddress", reflect.TypeOf((*MockState)(nil).IsDepositOfferAllowedAddress), arg0, arg1)
+}
+
+// GetDepositOfferAllowList mocks base method.
+func (m *MockState) GetDepositOfferAllowList(arg0 ids.ID) ([]ids.ShortID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDepositOfferAllowList", arg0)
+	ret0, _ := ret[0].([]ids.ShortID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDepositOfferAllowList indicates an expected call of GetDepositOfferAllowList.
+func (mr *MockStateMockRecorder) GetDepositOfferAllowList(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepositOfferAllowList", reflect.TypeOf((*MockState)(nil).GetDepositOfferAllowList), arg0)
+}
+
+// AddAddressStateChange mocks base method.
+func (m *MockState) AddAddressStateChange(arg0 *AddressStateChange) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddAddressStateChange", arg0)
+}
+
+// AddAddressStateChange indicates an expected call of AddAddressStateChange.
+func (mr *MockStateMockRecorder) AddAddressStateChange(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAddressStateChange", reflect.TypeOf((*MockState)(nil).AddAddressStateChange), arg0)
+}
+
+// GetAddressStateChanges mocks base method.
+func (m *MockState) GetAddressStateChanges(arg0 ids.ShortID, arg1 uint64, arg2 ids.ID, arg3 int) ([]*AddressStateChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddressStateChanges", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*AddressStateChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddressStateChanges indicates an expected call of GetAddressStateChanges.
+func (mr *MockStateMockRecorder) GetAddressStateChanges(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddressStateChanges", reflect.TypeOf((*MockState)(nil).GetAddressStateChanges), arg0, arg1, arg2, arg3)
+}
@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -128,6 +138,10 @@ func (v *baseStakers) PutValidator(staker *Staker) {
 	validator.validator = staker
 
 	validatorDiff := v.getOrCreateValidatorDiff(staker.SubnetID, staker.NodeID)
+	if validatorDiff.validatorStatus == deleted {
+		// This validator replaces the one, that was removed in this diff.
+		validatorDiff.replacedValidator = validatorDiff.validator
+	}
 	validatorDiff.validatorStatus = added
 	validatorDiff.validator = staker
 
@@ -142,6 +156,11 @@ func (v *baseStakers) DeleteValidator(staker *Staker) {
 	validatorDiff := v.getOrCreateValidatorDiff(staker.SubnetID, staker.NodeID)
 	validatorDiff.validatorStatus = deleted
 	validatorDiff.validator = staker
+	if validatorDiff.replacedValidator != nil {
+		// Replacing validator is removed, so the replaced one is removed.
+		validatorDiff.validator = validatorDiff.replacedValidator
+		validatorDiff.replacedValidator = nil
+	}
 
 	v.stakers.Delete(staker)
 }
@@ -255,6 +274,10 @@ type diffValidator struct {
 	// mean that diffValidator hasn't change, since delegators may have changed.
 	validatorStatus diffValidatorStatus
 	validator       *Staker
+	// replacedValidator is the validator, that was removed in this diff
+	// before [validator] was added in its place. It's only set, if
+	// validatorStatus is added.
+	replacedValidator *Staker
 
 	addedDelegators   *btree.BTreeG[*Staker]
 	deletedDelegators map[ids.ID]*Staker
@@ -282,6 +305,10 @@ func (s *diffStakers) GetValidator(subnetID ids.ID, nodeID ids.NodeID) (*Staker,
 
 func (s *diffStakers) PutValidator(staker *Staker) {
 	validatorDiff := s.getOrCreateDiff(staker.SubnetID, staker.NodeID)
+	if validatorDiff.validatorStatus == deleted {
+		// This validator replaces the one, that was removed in this diff.
+		validatorDiff.replacedValidator = validatorDiff.validator
+	}
 	validatorDiff.validatorStatus = added
 	validatorDiff.validator = staker
 
@@ -293,13 +320,21 @@ func (s *diffStakers) PutValidator(staker *Staker) {
 
 func (s *diffStakers) DeleteValidator(staker *Staker) {
 	validatorDiff := s.getOrCreateDiff(staker.SubnetID, staker.NodeID)
-	if validatorDiff.validatorStatus == added {
+	switch {
+	case validatorDiff.validatorStatus == added && validatorDiff.replacedValidator != nil:
+		// This validator replaced the removed one and was immediately removed
+		// in this diff. We treat it as if only the replaced one was removed.
+		validatorDiff.validatorStatus = deleted
+		s.addedStakers.Delete(validatorDiff.validator)
+		validatorDiff.validator = validatorDiff.replacedValidator
+		validatorDiff.replacedValidator = nil
+	case validatorDiff.validatorStatus == added:
 		// This validator was added and immediately removed in this diff. We
 		// treat it as if it was never added.
 		validatorDiff.validatorStatus = unmodified
 		s.addedStakers.Delete(validatorDiff.validator)
 		validatorDiff.validator = nil
-	} else {
+	default:
 		validatorDiff.validatorStatus = deleted
 		validatorDiff.validator = staker
 		if s.deletedStakers == nil {
@@ -133,6 +133,31 @@ type State interface {
 	GetStatelessBlock(blockID ids.ID) (blocks.Block, choices.Status, error)
 	AddStatelessBlock(block blocks.Block, status choices.Status)
 
+	// Returns deposit tx IDs of deposits, that have [owner] in their reward owner
+	// or have deposited utxos owned by [owner]. Deposit tx IDs are sorted and start
+	// after [startDepositTxID]. Returns not more than [limit] IDs.
+	GetDepositIDsByOwner(owner ids.ShortID, startDepositTxID ids.ID, limit int) ([]ids.ID, error)
+
+	// Returns IDs of multisig aliases, that have [member] as their owner
+	// directly or through nested multisig aliases.
+	GetMultisigAliasesByMember(member ids.ShortID) ([]ids.ShortID, error)
+
+	// Returns all short id links with [key], sorted by id.
+	GetShortIDLinks(key ShortLinkKey) ([]ShortIDLink, error)
+
+	// Returns addresses from allow-list of deposit offer with [offerID], sorted by address.
+	GetDepositOfferAllowList(offerID ids.ID) ([]ids.ShortID, error)
+
+	// Returns validator rewards distributions made in [startTime, endTime] time range,
+	// sorted by time and tx id. If [startTxID] isn't empty, distributions made at [startTime]
+	// with tx id less or equal to [startTxID] are skipped. Returns not more than [limit] distributions.
+	GetValidatorRewardsDistributions(startTime uint64, startTxID ids.ID, endTime uint64, limit int) ([]*ValidatorRewardsDistribution, error)
+
+	// Returns address state changes of [address] made since [startTime], sorted by time and tx id.
+	// If [startTxID] isn't empty, changes made at [startTime] with tx id less or equal to [startTxID]
+	// are skipped. Returns not more than [limit] changes.
+	GetAddressStateChanges(address ids.ShortID, startTime uint64, startTxID ids.ID, limit int) ([]*AddressStateChange, error)
+
 	// ValidatorSet adds all the validators and delegators of [subnetID] into
 	// [vdrs].
 	ValidatorSet(subnetID ids.ID, vdrs validators.Set) error
@@ -1407,6 +1432,7 @@ func (s *state) write(updateValidators bool, height uint64) error {
 		s.WriteUptimes(s.currentValidatorList, s.currentSubnetValidatorList), // Must be called after writeCurrentStakers
 		s.writeTXs(),
 		s.writeRewardUTXOs(),
+		s.writeDepositedUTXOsOwners(), // Must be called before writeUTXOs
 		s.writeUTXOs(),
 		s.writeSubnets(),
 		s.writeTransformedSubnets(),
@@ -1648,6 +1674,27 @@ func (s *state) writeCurrentStakers(updateValidators bool, height uint64) error
 					PotentialReward: staker.PotentialReward,
 				}
 
+				if replacedStaker := validatorDiff.replacedValidator; replacedStaker != nil {
+					// The validator replaces the removed one, so it keeps its
+					// uptime and only the change in weight is recorded.
+					vdr.UpDuration, vdr.lastUpdated, err = s.validatorUptimes.GetUptime(nodeID, subnetID)
+					if err != nil {
+						return fmt.Errorf("failed to get replaced validator uptime: %w", err)
+					}
+					vdr.LastUpdated = uint64(vdr.lastUpdated.Unix())
+
+					if staker.Weight < replacedStaker.Weight {
+						weightDiff.Decrease = true
+						weightDiff.Amount = replacedStaker.Weight - staker.Weight
+					} else {
+						weightDiff.Amount = staker.Weight - replacedStaker.Weight
+					}
+
+					if err := validatorDB.Delete(replacedStaker.TxID[:]); err != nil {
+						return fmt.Errorf("failed to delete replaced current staker: %w", err)
+					}
+				}
+
 				vdrBytes, err := blocks.GenesisCodec.Marshal(blocks.Version, vdr)
 				if err != nil {
 					return fmt.Errorf("failed to serialize current validator: %w", err)
@@ -1718,7 +1765,7 @@ func (s *state) writeCurrentStakers(updateValidators bool, height uint64) error
 			if weightDiff.Decrease {
 				err = validators.RemoveWeight(s.cfg.Validators, subnetID, nodeID, weightDiff.Amount)
 			} else {
-				if validatorDiff.validatorStatus == added {
+				if validatorDiff.validatorStatus == added && validatorDiff.replacedValidator == nil {
 					staker := validatorDiff.validator
 					err = validators.Add(
 						s.cfg.Validators,
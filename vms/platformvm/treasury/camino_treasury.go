@@ -4,7 +4,11 @@
 package treasury
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
@@ -25,4 +29,66 @@ var (
 		Threshold: 1,
 		Addrs:     []ids.ShortID{Addr},
 	}
+
+	errTreasuryAddrInOwner  = errors.New("treasury owner must not contain treasury address")
+	errCapWithoutPeriod     = errors.New("treasury spending cap is set without spending period")
+	errInvalidTreasuryOwner = errors.New("invalid treasury owner")
 )
+
+// IsTreasuryOwner returns true if [owner] is owned only by treasury address.
+// Owner locktime isn't checked.
+func IsTreasuryOwner(owner *secp256k1fx.OutputOwners) bool {
+	return owner.Threshold == 1 && len(owner.Addrs) == 1 && owner.Addrs[0] == Addr
+}
+
+// Config defines who can spend treasury funds and how much can be spent per spending period
+type Config struct {
+	// Owner, that can spend treasury funds. Could reference multisig aliases.
+	// Must not contain treasury address
+	Owner secp256k1fx.OutputOwners `serialize:"true" json:"owner"`
+	// Duration of spending period in seconds. Zero means that spending isn't capped
+	SpendingPeriod uint64 `serialize:"true" json:"spendingPeriod"`
+	// Max amount, that can be spent from treasury during one spending period
+	SpendingCap uint64 `serialize:"true" json:"spendingCap"`
+}
+
+func (c *Config) InitCtx(ctx *snow.Context) {
+	c.Owner.InitCtx(ctx)
+}
+
+func (c *Config) Verify() error {
+	if c.SpendingPeriod == 0 && c.SpendingCap != 0 {
+		return errCapWithoutPeriod
+	}
+	for _, addr := range c.Owner.Addrs {
+		if addr == Addr {
+			return errTreasuryAddrInOwner
+		}
+	}
+	if err := c.Owner.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errInvalidTreasuryOwner, err)
+	}
+	return nil
+}
+
+// IsCapped returns true if treasury spending is limited by spending cap
+func (c *Config) IsCapped() bool {
+	return c.SpendingPeriod != 0
+}
+
+// PeriodStart returns start of spending period, that contains [timestamp].
+// Spending periods are aligned to unix epoch.
+func (c *Config) PeriodStart(timestamp uint64) uint64 {
+	if c.SpendingPeriod == 0 {
+		return 0
+	}
+	return timestamp - timestamp%c.SpendingPeriod
+}
+
+// Spending is amount, that was spent from treasury during spending period
+type Spending struct {
+	// Start of spending period
+	PeriodStart uint64 `serialize:"true" json:"periodStart"`
+	// Amount spent during spending period
+	Amount uint64 `serialize:"true" json:"amount"`
+}
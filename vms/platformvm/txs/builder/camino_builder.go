@@ -6,14 +6,22 @@ package builder
 import (
 	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
@@ -56,6 +64,15 @@ type CaminoTxBuilder interface {
 		changeAddr ids.ShortID,
 	) (*txs.Tx, error)
 
+	NewExtendValidatorTx(
+		nodeID ids.NodeID,
+		endTime uint64,
+		bondAmount uint64,
+		nodeOwnerAddress ids.ShortID,
+		keys []*secp256k1.PrivateKey,
+		change *secp256k1fx.OutputOwners,
+	) (*txs.Tx, error)
+
 	NewAddressStateTx(
 		address ids.ShortID,
 		remove bool,
@@ -105,6 +122,26 @@ type CaminoTxBuilder interface {
 
 	NewSystemUnlockDepositTx(
 		depositTxIDs []ids.ID,
+		chainTime time.Time,
+	) (*txs.Tx, error)
+
+	NewAddProposalTx(
+		proposal *dao.Proposal,
+		proposerAddress ids.ShortID,
+		keys []*secp256k1.PrivateKey,
+		change *secp256k1fx.OutputOwners,
+	) (*txs.Tx, error)
+
+	NewAddVoteTx(
+		proposalID ids.ID,
+		option uint32,
+		voterAddress ids.ShortID,
+		keys []*secp256k1.PrivateKey,
+		change *secp256k1fx.OutputOwners,
+	) (*txs.Tx, error)
+
+	NewSystemFinishProposalsTx(
+		proposalIDs []ids.ID,
 	) (*txs.Tx, error)
 }
 
@@ -132,6 +169,19 @@ func NewCamino(
 
 type caminoBuilder struct {
 	builder
+
+	rewardsImportLock sync.Mutex
+	// position in shared memory index, where the next rewards import tx
+	// will continue to read treasury utxos
+	rewardsImportCursor *treasuryUTXOsCursor
+}
+
+// treasuryUTXOsCursor points to the last read utxo in the shared memory index
+// of treasury utxos.
+type treasuryUTXOsCursor struct {
+	trait    []byte
+	key      []byte
+	utxoHash ids.ID
 }
 
 func (b *caminoBuilder) NewCaminoAddValidatorTx(
@@ -225,6 +275,82 @@ func (b *caminoBuilder) NewCaminoAddValidatorTx(
 	return tx, tx.SyntacticVerify(b.ctx)
 }
 
+func (b *caminoBuilder) NewExtendValidatorTx(
+	nodeID ids.NodeID,
+	endTime uint64,
+	bondAmount uint64,
+	nodeOwnerAddress ids.ShortID,
+	keys []*secp256k1.PrivateKey,
+	change *secp256k1fx.OutputOwners,
+) (*txs.Tx, error) {
+	caminoGenesis, err := b.state.CaminoConfig()
+	if err != nil {
+		return nil, err
+	}
+	if !caminoGenesis.LockModeBondDeposit {
+		return nil, errWrongLockMode
+	}
+
+	currentValidator, err := b.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get current validator: %w", err)
+	}
+
+	currentValidatorTx, _, err := b.state.GetTx(currentValidator.TxID)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get current validator tx: %w", err)
+	}
+	currentValidatorStakerTx, ok := currentValidatorTx.Unsigned.(txs.ValidatorTx)
+	if !ok {
+		return nil, fmt.Errorf("current validator tx isn't validator tx: %T", currentValidatorTx.Unsigned)
+	}
+
+	ins, outs, signers, _, err := b.Lock(b.state, keys, bondAmount, b.cfg.TxFee, locked.StateBonded, nil, change, 0)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	kc := secp256k1fx.NewKeychain(keys...)
+	nodeOwnerInput, nodeOwnerSigners, err := kc.SpendMultiSig(
+		&secp256k1fx.TransferOutput{
+			OutputOwners: secp256k1fx.OutputOwners{
+				Addrs:     []ids.ShortID{nodeOwnerAddress},
+				Threshold: 1,
+			},
+		},
+		0,
+		b.state,
+	)
+	if err != nil {
+		return nil, err
+	}
+	signers = append(signers, nodeOwnerSigners)
+
+	utx := &txs.ExtendValidatorTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		ValidatorTxID: currentValidator.TxID,
+		Validator: txs.Validator{
+			NodeID: nodeID,
+			Start:  uint64(currentValidator.StartTime.Unix()),
+			End:    endTime,
+			Wght:   currentValidator.Weight + bondAmount,
+		},
+		RewardsOwner:  currentValidatorStakerTx.ValidationRewardsOwner(),
+		NodeOwnerAuth: &nodeOwnerInput.(*secp256k1fx.TransferInput).Input,
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
 func (b *caminoBuilder) NewAddSubnetValidatorTx(
 	weight,
 	startTime,
@@ -272,7 +398,12 @@ func (b *caminoBuilder) NewRewardValidatorTx(txID ids.ID) (*txs.Tx, error) {
 		return b.builder.NewRewardValidatorTx(txID)
 	}
 
-	ins, outs, err := b.Unlock(b.state, []ids.ID{txID}, locked.StateBonded)
+	bondTxIDs, err := state.GetValidatorBondTxIDs(b.state, txID)
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, err := b.Unlock(b.state, bondTxIDs, locked.StateBonded)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
@@ -596,28 +727,11 @@ func (b *caminoBuilder) NewRewardsImportTx() (*txs.Tx, error) {
 		return nil, errWrongLockMode
 	}
 
-	allUTXOsBytes, _, _, err := b.ctx.SharedMemory.Indexed(
-		b.ctx.CChainID,
-		treasury.AddrTraitsBytes,
-		ids.ShortEmpty[:], ids.Empty[:], MaxPageSize,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error fetching atomic UTXOs: %w", err)
-	}
-
 	now := b.clk.Unix()
 
-	utxos := []*avax.UTXO{}
-	for _, utxoBytes := range allUTXOsBytes {
-		utxo := &avax.TimedUTXO{}
-		if _, err := txs.Codec.Unmarshal(utxoBytes, utxo); err != nil {
-			// that means that this could be simple, not-timed utxo
-			continue
-		}
-
-		if utxo.Timestamp <= now-atomic.SharedMemorySyncBound {
-			utxos = append(utxos, &utxo.UTXO)
-		}
+	utxos, err := b.getImportableTreasuryUTXOs(now)
+	if err != nil {
+		return nil, err
 	}
 
 	if len(utxos) == 0 {
@@ -659,14 +773,183 @@ func (b *caminoBuilder) NewRewardsImportTx() (*txs.Tx, error) {
 	return tx, tx.SyntacticVerify(b.ctx)
 }
 
+// getImportableTreasuryUTXOs returns up to [MaxPageSize] treasury utxos, that are old enough
+// to be imported at [now]. The rest of utxos will be imported by next txs.
+//
+// Shared memory is read starting from the cursor, where the previous call stopped, so utxos
+// included into previously built txs aren't read again. If there are no importable utxos
+// after the cursor, shared memory is read again from the start of the index.
+func (b *caminoBuilder) getImportableTreasuryUTXOs(now uint64) ([]*avax.UTXO, error) {
+	b.rewardsImportLock.Lock()
+	defer b.rewardsImportLock.Unlock()
+
+	utxos := []*avax.UTXO{}
+	collectUTXO := func(utxo *avax.TimedUTXO) bool {
+		if utxo.Timestamp <= now-atomic.SharedMemorySyncBound {
+			utxos = append(utxos, &utxo.UTXO)
+		}
+		return len(utxos) < MaxPageSize
+	}
+
+	cursor, err := readTreasuryTimedUTXOs(b.ctx.SharedMemory, b.ctx.CChainID, b.rewardsImportCursor, collectUTXO)
+	if err == nil && len(utxos) == 0 && b.rewardsImportCursor != nil {
+		cursor, err = readTreasuryTimedUTXOs(b.ctx.SharedMemory, b.ctx.CChainID, nil, collectUTXO)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	b.rewardsImportCursor = cursor
+	return utxos, nil
+}
+
+// GetTreasuryTimedUTXOs returns all timed treasury utxos exported from c-chain to p-chain.
+// Shared memory is read page by page, so number of returned utxos isn't limited by [MaxPageSize].
+func GetTreasuryTimedUTXOs(sharedMemory atomic.SharedMemory, cChainID ids.ID) ([]*avax.TimedUTXO, error) {
+	utxos := []*avax.TimedUTXO{}
+	_, err := readTreasuryTimedUTXOs(sharedMemory, cChainID, nil, func(utxo *avax.TimedUTXO) bool {
+		utxos = append(utxos, utxo)
+		return true
+	})
+	return utxos, err
+}
+
+// readTreasuryTimedUTXOs reads timed treasury utxos exported from c-chain to p-chain page by page,
+// starting after [cursor] or from the start of shared memory index, if [cursor] is nil.
+// Each read utxo is passed to [next], reading stops when [next] returns false or when the end
+// of index is reached. Returns cursor pointing to the last read utxo or nil, if the end of index was reached.
+func readTreasuryTimedUTXOs(
+	sharedMemory atomic.SharedMemory,
+	cChainID ids.ID,
+	cursor *treasuryUTXOsCursor,
+	next func(*avax.TimedUTXO) bool,
+) (*treasuryUTXOsCursor, error) {
+	seenUTXOs := set.Set[ids.ID]{}
+	lastTrait := ids.ShortEmpty[:]
+	lastKey := ids.Empty[:]
+	if cursor != nil {
+		// utxo under cursor was already read by previous call. If it was removed
+		// from shared memory since then, reading starts from the start of index
+		seenUTXOs.Add(cursor.utxoHash)
+		lastTrait = cursor.trait
+		lastKey = cursor.key
+	}
+
+	for {
+		utxosBytes, nextTrait, nextKey, err := sharedMemory.Indexed(
+			cChainID,
+			treasury.AddrTraitsBytes,
+			lastTrait, lastKey, MaxPageSize,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching atomic UTXOs: %w", err)
+		}
+
+		// next page starts with the last utxo of the previous page
+		newUTXOs := 0
+		for _, utxoBytes := range utxosBytes {
+			utxoHash := hashing.ComputeHash256Array(utxoBytes)
+			if seenUTXOs.Contains(utxoHash) {
+				continue
+			}
+			seenUTXOs.Add(utxoHash)
+			newUTXOs++
+
+			utxo := &avax.TimedUTXO{}
+			if _, err := txs.Codec.Unmarshal(utxoBytes, utxo); err != nil {
+				// that means that this could be simple, not-timed utxo
+				continue
+			}
+			if !next(utxo) {
+				utxoID := utxo.InputID()
+				return &treasuryUTXOsCursor{
+					trait:    nextTrait,
+					key:      utxoID[:],
+					utxoHash: utxoHash,
+				}, nil
+			}
+		}
+
+		if len(utxosBytes) < MaxPageSize || newUTXOs == 0 {
+			return nil, nil
+		}
+		lastTrait = nextTrait
+		lastKey = nextKey
+	}
+}
+
+// NewSystemUnlockDepositTx builds tx that unlocks expired deposits at [chainTime],
+// which must be the timestamp of the block that will contain this tx.
+//
+// Tx can unlock and roll over only one rollover deposit. Other expired rollover deposits
+// are left in state and will be unlocked by the next system txs: they remain the next
+// deposits to unlock, so next blocks will have the same timestamp and will unlock them
+// one by one. Rollover deposits are unlocked as regular ones before BerlinPhase.
 func (b *caminoBuilder) NewSystemUnlockDepositTx(
 	depositTxIDs []ids.ID,
+	chainTime time.Time,
 ) (*txs.Tx, error) {
-	ins, outs, err := b.Unlock(b.state, depositTxIDs, locked.StateDeposited)
+	berlinPhase := b.cfg.IsBerlinPhaseActivated(chainTime)
+	unlockDepositTxIDs := make([]ids.ID, 0, len(depositTxIDs))
+	rolloverDepositTxID := ids.Empty
+	var rolloverDeposit *deposit.Deposit
+	for _, depositTxID := range depositTxIDs {
+		deposit, err := b.state.GetDeposit(depositTxID)
+		if err != nil {
+			return nil, err
+		}
+		if !berlinPhase || !deposit.IsRollover() {
+			unlockDepositTxIDs = append(unlockDepositTxIDs, depositTxID)
+		} else if rolloverDeposit == nil {
+			rolloverDepositTxID = depositTxID
+			rolloverDeposit = deposit
+		}
+	}
+
+	ins, outs, err := b.Unlock(b.state, unlockDepositTxIDs, locked.StateDeposited)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
 	}
 
+	if rolloverDeposit != nil {
+		rolloverIns, rolloverOuts, err := b.Unlock(b.state, []ids.ID{rolloverDepositTxID}, locked.StateDeposited)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+		}
+
+		newDeposit, rolledOverReward, err := b.depositRollover(rolloverDeposit, uint64(chainTime.Unix()))
+		if err != nil {
+			return nil, err
+		}
+
+		if newDeposit != nil {
+			for i, out := range rolloverOuts {
+				rolloverOuts[i] = redepositOut(out)
+			}
+			if rolledOverReward > 0 {
+				rewardOwner, ok := rolloverDeposit.RewardOwner.(*secp256k1fx.OutputOwners)
+				if !ok {
+					return nil, errNotSECPOwner
+				}
+				rolloverOuts = append(rolloverOuts, &avax.TransferableOutput{
+					Asset: avax.Asset{ID: b.ctx.AVAXAssetID},
+					Out: &locked.Out{
+						IDs: locked.IDs{DepositTxID: locked.ThisTxID},
+						TransferableOut: &secp256k1fx.TransferOutput{
+							Amt:          rolledOverReward,
+							OutputOwners: *rewardOwner,
+						},
+					},
+				})
+			}
+		}
+
+		ins = append(ins, rolloverIns...)
+		outs = append(outs, rolloverOuts...)
+		avax.SortTransferableInputs(ins)
+		avax.SortTransferableOutputs(outs, txs.Codec)
+	}
+
 	utx := &txs.UnlockDepositTx{
 		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
 			NetworkID:    b.ctx.NetworkID,
@@ -683,6 +966,210 @@ func (b *caminoBuilder) NewSystemUnlockDepositTx(
 	return tx, tx.SyntacticVerify(b.ctx)
 }
 
+// Returns deposit that expired rollover [deposit] will be re-deposited into
+// and amount of [deposit] reward that will be re-deposited.
+// Returns nil deposit, if [deposit] can't be rolled over at [timestamp].
+func (b *caminoBuilder) depositRollover(
+	deposit *deposit.Deposit,
+	timestamp uint64,
+) (*deposit.Deposit, uint64, error) {
+	offer, err := b.state.GetDepositOffer(deposit.DepositOfferID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	successorOffer := offer
+	if offer.RolloverOfferID() != offer.ID {
+		successorOffer, err = b.state.GetDepositOffer(offer.RolloverOfferID())
+		if err == database.ErrNotFound {
+			return nil, 0, nil
+		} else if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	currentSupply, err := b.state.GetCurrentSupply(constants.PrimaryNetworkID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	newDeposit, rolledOverReward, ok := deposit.Rollover(
+		offer,
+		successorOffer,
+		timestamp,
+		b.cfg.RewardConfig.SupplyCap-currentSupply,
+	)
+	if !ok {
+		return nil, 0, nil
+	}
+	return newDeposit, rolledOverReward, nil
+}
+
+// Returns copy of unlocked [out] that is deposited by tx that will contain it
+func redepositOut(out *avax.TransferableOutput) *avax.TransferableOutput {
+	lockedOut, ok := out.Out.(*locked.Out)
+	if !ok {
+		return &avax.TransferableOutput{
+			Asset: out.Asset,
+			Out: &locked.Out{
+				IDs:             locked.IDs{DepositTxID: locked.ThisTxID},
+				TransferableOut: out.Out,
+			},
+		}
+	}
+	return &avax.TransferableOutput{
+		Asset: out.Asset,
+		Out: &locked.Out{
+			IDs: locked.IDs{
+				DepositTxID: locked.ThisTxID,
+				BondTxID:    lockedOut.BondTxID,
+			},
+			TransferableOut: lockedOut.TransferableOut,
+		},
+	}
+}
+
+func (b *caminoBuilder) NewAddProposalTx(
+	proposal *dao.Proposal,
+	proposerAddress ids.ShortID,
+	keys []*secp256k1.PrivateKey,
+	change *secp256k1fx.OutputOwners,
+) (*txs.Tx, error) {
+	caminoGenesis, err := b.state.CaminoConfig()
+	if err != nil {
+		return nil, err
+	}
+	if !caminoGenesis.LockModeBondDeposit {
+		return nil, errWrongLockMode
+	}
+
+	ins, outs, signers, _, err := b.Lock(b.state, keys, b.cfg.CaminoConfig.DaoProposalBondAmount, b.cfg.TxFee, locked.StateBonded, nil, change, 0)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	kc := secp256k1fx.NewKeychain(keys...)
+	in, proposerSigners, err := kc.SpendMultiSig(
+		&secp256k1fx.TransferOutput{
+			OutputOwners: secp256k1fx.OutputOwners{
+				Addrs:     []ids.ShortID{proposerAddress},
+				Threshold: 1,
+			},
+		},
+		0,
+		b.state,
+	)
+	if err != nil {
+		return nil, err
+	}
+	signers = append(signers, proposerSigners)
+
+	utx := &txs.AddProposalTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		Proposal:        proposal,
+		ProposerAddress: proposerAddress,
+		ProposerAuth:    &in.(*secp256k1fx.TransferInput).Input,
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *caminoBuilder) NewAddVoteTx(
+	proposalID ids.ID,
+	option uint32,
+	voterAddress ids.ShortID,
+	keys []*secp256k1.PrivateKey,
+	change *secp256k1fx.OutputOwners,
+) (*txs.Tx, error) {
+	ins, outs, signers, _, err := b.Lock(b.state, keys, 0, b.cfg.TxFee, locked.StateUnlocked, nil, change, 0)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	kc := secp256k1fx.NewKeychain(keys...)
+	in, voterSigners, err := kc.SpendMultiSig(
+		&secp256k1fx.TransferOutput{
+			OutputOwners: secp256k1fx.OutputOwners{
+				Addrs:     []ids.ShortID{voterAddress},
+				Threshold: 1,
+			},
+		},
+		0,
+		b.state,
+	)
+	if err != nil {
+		return nil, err
+	}
+	signers = append(signers, voterSigners)
+
+	utx := &txs.AddVoteTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		ProposalID:   proposalID,
+		Option:       option,
+		VoterAddress: voterAddress,
+		VoterAuth:    &in.(*secp256k1fx.TransferInput).Input,
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *caminoBuilder) NewSystemFinishProposalsTx(
+	proposalIDs []ids.ID,
+) (*txs.Tx, error) {
+	ins, outs, err := b.Unlock(b.state, proposalIDs, locked.StateBonded)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	finishedProposals := make([]txs.FinishedProposal, len(proposalIDs))
+	for i, proposalID := range proposalIDs {
+		proposal, err := b.state.GetProposal(proposalID)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get proposal %s: %w", proposalID, err)
+		}
+		option, successful := proposal.Outcome()
+		finishedProposals[i] = txs.FinishedProposal{
+			ProposalID: proposalID,
+			Option:     option,
+			Successful: successful,
+		}
+	}
+
+	utx := &txs.FinishProposalsTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		FinishedProposals: finishedProposals,
+	}
+
+	tx, err := txs.NewSigned(utx, txs.Codec, nil)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
 func getSigner(
 	keys []*secp256k1.PrivateKey,
 	address ids.ShortID,
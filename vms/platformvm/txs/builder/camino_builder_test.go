@@ -11,13 +11,19 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/utils/nodeid"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/api"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
@@ -754,12 +760,59 @@ func TestNewRewardsImportTx(t *testing.T) {
 	ctx, _ := defaultCtx(nil)
 	blockTime := time.Unix(1000, 0)
 
+	toBytes := func(t *testing.T, utxos []*avax.TimedUTXO) [][]byte {
+		utxosBytes := make([][]byte, len(utxos))
+		for i, utxo := range utxos {
+			utxoBytes, err := txs.Codec.Marshal(txs.Version, utxo)
+			require.NoError(t, err)
+			utxosBytes[i] = utxoBytes
+		}
+		return utxosBytes
+	}
+	toCursor := func(t *testing.T, utxo *avax.TimedUTXO) *treasuryUTXOsCursor {
+		utxoID := utxo.InputID()
+		return &treasuryUTXOsCursor{
+			trait:    treasury.Addr[:],
+			key:      utxoID[:],
+			utxoHash: hashing.ComputeHash256Array(toBytes(t, []*avax.TimedUTXO{utxo})[0]),
+		}
+	}
+	importableUTXOs := func(count int) []*avax.TimedUTXO {
+		utxos := make([]*avax.TimedUTXO, count)
+		for i := range utxos {
+			utxos[i] = &avax.TimedUTXO{
+				UTXO:      *generateTestUTXO(ids.ID{1, byte(i), byte(i >> 8)}, ctx.AVAXAssetID, 1, *treasury.Owner, ids.Empty, ids.Empty),
+				Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound,
+			}
+		}
+		return utxos
+	}
+	rewardsImportTx := func(t *testing.T, utxos []*avax.TimedUTXO) *txs.Tx {
+		ins := make([]*avax.TransferableInput, len(utxos))
+		for i, utxo := range utxos {
+			ins[i] = generateTestInFromUTXO(&utxo.UTXO, []uint32{0}, false)
+		}
+		avax.SortTransferableInputs(ins)
+		tx, err := txs.NewSigned(&txs.RewardsImportTx{BaseTx: txs.BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+				Ins:          ins,
+			},
+			SyntacticallyVerified: true,
+		}}, txs.Codec, nil)
+		require.NoError(t, err)
+		return tx
+	}
+
 	tests := map[string]struct {
-		state        func(*gomock.Controller) state.State
-		sharedMemory func(*gomock.Controller, []*avax.TimedUTXO) atomic.SharedMemory
-		utxos        []*avax.TimedUTXO
-		expectedTx   func(*testing.T, []*avax.TimedUTXO) *txs.Tx
-		expectedErr  error
+		state          func(*gomock.Controller) state.State
+		sharedMemory   func(*gomock.Controller, []*avax.TimedUTXO) atomic.SharedMemory
+		utxos          []*avax.TimedUTXO
+		cursor         func(*testing.T, []*avax.TimedUTXO) *treasuryUTXOsCursor
+		expectedTx     func(*testing.T, []*avax.TimedUTXO) *txs.Tx
+		expectedCursor func(*testing.T, []*avax.TimedUTXO) *treasuryUTXOsCursor
+		expectedErr    error
 	}{
 		"OK": {
 			state: func(ctrl *gomock.Controller) state.State {
@@ -819,6 +872,135 @@ func TestNewRewardsImportTx(t *testing.T) {
 				return tx
 			},
 		},
+		"OK: multiple shared memory pages": {
+			state: func(ctrl *gomock.Controller) state.State {
+				s := state.NewMockState(ctrl)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				return s
+			},
+			sharedMemory: func(c *gomock.Controller, utxos []*avax.TimedUTXO) atomic.SharedMemory {
+				shm := atomic.NewMockSharedMemory(c)
+				utxosBytes := make([][]byte, len(utxos))
+				for i, utxo := range utxos {
+					utxoBytes, err := txs.Codec.Marshal(txs.Version, utxo)
+					require.NoError(t, err)
+					utxosBytes[i] = utxoBytes
+				}
+				lastUTXOID := utxos[MaxPageSize-1].InputID()
+				shm.EXPECT().Indexed(ctx.CChainID, treasury.AddrTraitsBytes,
+					ids.ShortEmpty[:], ids.Empty[:], MaxPageSize).
+					Return(utxosBytes[:MaxPageSize], treasury.Addr[:], lastUTXOID[:], nil)
+				shm.EXPECT().Indexed(ctx.CChainID, treasury.AddrTraitsBytes,
+					treasury.Addr[:], lastUTXOID[:], MaxPageSize).
+					Return(utxosBytes[MaxPageSize-1:], nil, nil, nil)
+				return shm
+			},
+			utxos: func() []*avax.TimedUTXO {
+				// first page is full of utxos, that aren't old enough yet
+				utxos := make([]*avax.TimedUTXO, MaxPageSize+1)
+				for i := 0; i < MaxPageSize; i++ {
+					utxos[i] = &avax.TimedUTXO{
+						UTXO:      *generateTestUTXO(ids.ID{1, byte(i), byte(i >> 8)}, ctx.AVAXAssetID, 1, *treasury.Owner, ids.Empty, ids.Empty),
+						Timestamp: uint64(blockTime.Unix()),
+					}
+				}
+				utxos[MaxPageSize] = &avax.TimedUTXO{
+					UTXO:      *generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, 10, *treasury.Owner, ids.Empty, ids.Empty),
+					Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound,
+				}
+				return utxos
+			}(),
+			expectedTx: func(t *testing.T, utxos []*avax.TimedUTXO) *txs.Tx {
+				tx, err := txs.NewSigned(&txs.RewardsImportTx{BaseTx: txs.BaseTx{
+					BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins: []*avax.TransferableInput{
+							generateTestInFromUTXO(&utxos[MaxPageSize].UTXO, []uint32{0}, false),
+						},
+					},
+					SyntacticallyVerified: true,
+				}}, txs.Codec, nil)
+				require.NoError(t, err)
+				return tx
+			},
+		},
+		"OK: more utxos than fit into tx": {
+			state: func(ctrl *gomock.Controller) state.State {
+				s := state.NewMockState(ctrl)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				return s
+			},
+			sharedMemory: func(c *gomock.Controller, utxos []*avax.TimedUTXO) atomic.SharedMemory {
+				shm := atomic.NewMockSharedMemory(c)
+				lastUTXOID := utxos[MaxPageSize-1].InputID()
+				shm.EXPECT().Indexed(ctx.CChainID, treasury.AddrTraitsBytes,
+					ids.ShortEmpty[:], ids.Empty[:], MaxPageSize).
+					Return(toBytes(t, utxos[:MaxPageSize]), treasury.Addr[:], lastUTXOID[:], nil)
+				return shm
+			},
+			utxos: importableUTXOs(MaxPageSize + 1),
+			expectedTx: func(t *testing.T, utxos []*avax.TimedUTXO) *txs.Tx {
+				tx := rewardsImportTx(t, utxos[:MaxPageSize])
+				// input id of the utxo under cursor is cached by builder
+				for _, in := range tx.Unsigned.(*txs.RewardsImportTx).Ins {
+					if in.TxID == utxos[MaxPageSize-1].TxID {
+						in.InputID()
+					}
+				}
+				return tx
+			},
+			expectedCursor: func(t *testing.T, utxos []*avax.TimedUTXO) *treasuryUTXOsCursor {
+				return toCursor(t, utxos[MaxPageSize-1])
+			},
+		},
+		"OK: continue from cursor": {
+			state: func(ctrl *gomock.Controller) state.State {
+				s := state.NewMockState(ctrl)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				return s
+			},
+			sharedMemory: func(c *gomock.Controller, utxos []*avax.TimedUTXO) atomic.SharedMemory {
+				shm := atomic.NewMockSharedMemory(c)
+				cursorUTXOID := utxos[1].InputID()
+				shm.EXPECT().Indexed(ctx.CChainID, treasury.AddrTraitsBytes,
+					treasury.Addr[:], cursorUTXOID[:], MaxPageSize).
+					Return(toBytes(t, utxos[1:]), nil, nil, nil)
+				return shm
+			},
+			utxos: importableUTXOs(3),
+			cursor: func(t *testing.T, utxos []*avax.TimedUTXO) *treasuryUTXOsCursor {
+				return toCursor(t, utxos[1])
+			},
+			expectedTx: func(t *testing.T, utxos []*avax.TimedUTXO) *txs.Tx {
+				return rewardsImportTx(t, utxos[2:])
+			},
+		},
+		"OK: no utxos after cursor": {
+			state: func(ctrl *gomock.Controller) state.State {
+				s := state.NewMockState(ctrl)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				return s
+			},
+			sharedMemory: func(c *gomock.Controller, utxos []*avax.TimedUTXO) atomic.SharedMemory {
+				shm := atomic.NewMockSharedMemory(c)
+				cursorUTXOID := utxos[1].InputID()
+				shm.EXPECT().Indexed(ctx.CChainID, treasury.AddrTraitsBytes,
+					treasury.Addr[:], cursorUTXOID[:], MaxPageSize).
+					Return(toBytes(t, utxos[1:]), nil, nil, nil)
+				shm.EXPECT().Indexed(ctx.CChainID, treasury.AddrTraitsBytes,
+					ids.ShortEmpty[:], ids.Empty[:], MaxPageSize).
+					Return(toBytes(t, utxos), nil, nil, nil)
+				return shm
+			},
+			utxos: importableUTXOs(2),
+			cursor: func(t *testing.T, utxos []*avax.TimedUTXO) *treasuryUTXOsCursor {
+				return toCursor(t, utxos[1])
+			},
+			expectedTx: func(t *testing.T, utxos []*avax.TimedUTXO) *txs.Tx {
+				return rewardsImportTx(t, utxos)
+			},
+		},
 		"No utxos": {
 			state: func(ctrl *gomock.Controller) state.State {
 				s := state.NewMockState(ctrl)
@@ -844,6 +1026,9 @@ func TestNewRewardsImportTx(t *testing.T) {
 				ctrl.Finish()
 			}()
 			b.clk.Set(blockTime)
+			if tt.cursor != nil {
+				b.rewardsImportCursor = tt.cursor(t, tt.utxos)
+			}
 
 			tx, err := b.NewRewardsImportTx()
 			require.ErrorIs(err, tt.expectedErr)
@@ -852,6 +1037,162 @@ func TestNewRewardsImportTx(t *testing.T) {
 			} else {
 				require.Nil(tx)
 			}
+			if tt.expectedCursor != nil {
+				require.Equal(tt.expectedCursor(t, tt.utxos), b.rewardsImportCursor)
+			} else {
+				require.Nil(b.rewardsImportCursor)
+			}
+		})
+	}
+}
+
+func TestNewSystemUnlockDepositTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	chainTime := time.Unix(1000, 0)
+	_, ownerAddr, owner := generateKeyAndOwner()
+
+	offer := &deposits.Offer{
+		ID:          ids.ID{1},
+		End:         uint64(chainTime.Unix()) + 100,
+		MinAmount:   1,
+		MinDuration: 100,
+		MaxDuration: 100,
+	}
+	expiredDeposit := func(flags deposits.Flag) *deposits.Deposit {
+		deposit := &deposits.Deposit{
+			DepositOfferID: offer.ID,
+			Duration:       100,
+			Amount:         10,
+			Start:          uint64(chainTime.Unix()) - 100,
+			RewardOwner:    &owner,
+		}
+		if flags != deposits.FlagNone {
+			deposit.UpgradeVersionID = codec.UpgradeVersion1
+			deposit.Flags = flags
+		}
+		return deposit
+	}
+
+	depositTxID := ids.ID{2}
+	rolloverDepositTxID1 := ids.ID{3}
+	rolloverDepositTxID2 := ids.ID{4}
+	expiredDeposits := map[ids.ID]*deposits.Deposit{
+		depositTxID:          expiredDeposit(deposits.FlagNone),
+		rolloverDepositTxID1: expiredDeposit(deposits.FlagRollover),
+		rolloverDepositTxID2: expiredDeposit(deposits.FlagRollover),
+	}
+	depositUTXOs := map[ids.ID]*avax.UTXO{
+		depositTxID:          generateTestUTXO(ids.ID{5}, ctx.AVAXAssetID, 10, owner, depositTxID, ids.Empty),
+		rolloverDepositTxID1: generateTestUTXO(ids.ID{6}, ctx.AVAXAssetID, 10, owner, rolloverDepositTxID1, ids.Empty),
+		rolloverDepositTxID2: generateTestUTXO(ids.ID{7}, ctx.AVAXAssetID, 10, owner, rolloverDepositTxID2, ids.Empty),
+	}
+
+	expectUnlock := func(s *state.MockState, depositTxIDs ...ids.ID) {
+		lockTxIDs := set.NewSet[ids.ID](len(depositTxIDs))
+		utxos := make([]*avax.UTXO, len(depositTxIDs))
+		for i, depositTxID := range depositTxIDs {
+			lockTxIDs.Add(depositTxID)
+			utxos[i] = depositUTXOs[depositTxID]
+			s.EXPECT().GetTx(depositTxID).Return(&txs.Tx{Unsigned: &txs.DepositTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{Outs: []*avax.TransferableOutput{{
+					Asset: avax.Asset{ID: ctx.AVAXAssetID},
+					Out: &locked.Out{
+						IDs:             locked.IDs{DepositTxID: locked.ThisTxID},
+						TransferableOut: &secp256k1fx.TransferOutput{Amt: 10, OutputOwners: owner},
+					},
+				}}}},
+			}}, status.Committed, nil)
+		}
+		s.EXPECT().LockedUTXOs(lockTxIDs, set.Set[ids.ShortID]{ownerAddr: struct{}{}}, locked.StateDeposited).
+			Return(utxos, nil)
+	}
+	unlockedOut := &avax.TransferableOutput{
+		Asset: avax.Asset{ID: ctx.AVAXAssetID},
+		Out:   &secp256k1fx.TransferOutput{Amt: 10, OutputOwners: owner},
+	}
+	redepositedOut := &avax.TransferableOutput{
+		Asset: avax.Asset{ID: ctx.AVAXAssetID},
+		Out: &locked.Out{
+			IDs:             locked.IDs{DepositTxID: locked.ThisTxID},
+			TransferableOut: &secp256k1fx.TransferOutput{Amt: 10, OutputOwners: owner},
+		},
+	}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller) state.State
+		berlinPhase bool
+		expectedIns []*avax.TransferableInput
+		expectedOut []*avax.TransferableOutput
+	}{
+		"OK: rollover deposits are unlocked as regular ones before BerlinPhase": {
+			state: func(c *gomock.Controller) state.State {
+				s := state.NewMockState(c)
+				for depositTxID, deposit := range expiredDeposits {
+					s.EXPECT().GetDeposit(depositTxID).Return(deposit, nil)
+				}
+				expectUnlock(s, depositTxID, rolloverDepositTxID1, rolloverDepositTxID2)
+				return s
+			},
+			expectedIns: []*avax.TransferableInput{
+				generateTestInFromUTXO(depositUTXOs[depositTxID], []uint32{}, false),
+				generateTestInFromUTXO(depositUTXOs[rolloverDepositTxID1], []uint32{}, false),
+				generateTestInFromUTXO(depositUTXOs[rolloverDepositTxID2], []uint32{}, false),
+			},
+			expectedOut: []*avax.TransferableOutput{unlockedOut, unlockedOut, unlockedOut},
+		},
+		"OK: only one rollover deposit is rolled over, other one is deferred to next tx": {
+			state: func(c *gomock.Controller) state.State {
+				s := state.NewMockState(c)
+				for depositTxID, deposit := range expiredDeposits {
+					s.EXPECT().GetDeposit(depositTxID).Return(deposit, nil)
+				}
+				expectUnlock(s, depositTxID)
+				expectUnlock(s, rolloverDepositTxID1)
+				s.EXPECT().GetDepositOffer(offer.ID).Return(offer, nil)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(uint64(0), nil)
+				return s
+			},
+			berlinPhase: true,
+			expectedIns: []*avax.TransferableInput{
+				generateTestInFromUTXO(depositUTXOs[depositTxID], []uint32{}, false),
+				generateTestInFromUTXO(depositUTXOs[rolloverDepositTxID1], []uint32{}, false),
+			},
+			expectedOut: []*avax.TransferableOutput{unlockedOut, redepositedOut},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			b, db := newCaminoBuilderWithMocks(true, tt.state(ctrl), nil)
+			defer func() {
+				require.NoError(db.Close())
+				ctrl.Finish()
+			}()
+			b.cfg.BerlinPhaseTime = chainTime.Add(time.Second)
+			if tt.berlinPhase {
+				b.cfg.BerlinPhaseTime = chainTime
+			}
+
+			tx, err := b.NewSystemUnlockDepositTx(
+				[]ids.ID{depositTxID, rolloverDepositTxID1, rolloverDepositTxID2},
+				chainTime,
+			)
+			require.NoError(err)
+
+			avax.SortTransferableInputs(tt.expectedIns)
+			avax.SortTransferableOutputs(tt.expectedOut, txs.Codec)
+			expectedTx, err := txs.NewSigned(&txs.UnlockDepositTx{BaseTx: txs.BaseTx{
+				BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins:          tt.expectedIns,
+					Outs:         tt.expectedOut,
+				},
+				SyntacticallyVerified: true,
+			}}, txs.Codec, nil)
+			require.NoError(err)
+			require.Equal(expectedTx, tx)
 		})
 	}
 }
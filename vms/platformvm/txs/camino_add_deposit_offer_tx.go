@@ -21,6 +21,7 @@ var (
 	errBadDepositOfferCreatorAuth      = errors.New("bad deposit offer creator auth")
 	errEmptyDepositOfferCreatorAddress = errors.New("deposit offer creator address is empty")
 	errWrongDepositOfferVersion        = errors.New("wrong deposit offer version")
+	errWrongRequiredAddressState       = errors.New("deposit offer required address state has invalid bits")
 )
 
 // AddDepositOfferTx is an unsigned depositTx
@@ -46,6 +47,8 @@ func (tx *AddDepositOfferTx) SyntacticVerify(ctx *snow.Context) error {
 		return errEmptyDepositOfferCreatorAddress
 	case tx.DepositOffer.UpgradeVersionID.Version() == 0:
 		return errWrongDepositOfferVersion
+	case AddressState(tx.DepositOffer.RequiredAddressState)&^AddressStateValidBits != 0:
+		return errWrongRequiredAddressState
 	}
 
 	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
@@ -57,6 +57,51 @@ func TestAddDepositOfferTxSyntacticVerify(t *testing.T) {
 			},
 			expectedErr: errBadDepositOffer,
 		},
+		"Bad deposit offer early unlock penalty rate": {
+			tx: &AddDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferCreatorAddress: creatorAddress,
+				DepositOffer: &deposit.Offer{
+					UpgradeVersionID:                codec.UpgradeVersion2,
+					End:                             1,
+					MinDuration:                     1,
+					MaxDuration:                     1,
+					MinAmount:                       deposit.OfferMinDepositAmount,
+					EarlyUnlockPenaltyRateNominator: deposit.EarlyUnlockPenaltyRateDenominator + 1,
+				},
+			},
+			expectedErr: errBadDepositOffer,
+		},
+		"Bad deposit offer: allow-list without owner": {
+			tx: &AddDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferCreatorAddress: creatorAddress,
+				DepositOffer: &deposit.Offer{
+					UpgradeVersionID: codec.UpgradeVersion3,
+					End:              1,
+					MinDuration:      1,
+					MaxDuration:      1,
+					MinAmount:        deposit.OfferMinDepositAmount,
+					AllowListEnabled: true,
+				},
+			},
+			expectedErr: errBadDepositOffer,
+		},
+		"Wrong deposit offer required address state": {
+			tx: &AddDepositOfferTx{
+				BaseTx:                     baseTx,
+				DepositOfferCreatorAddress: creatorAddress,
+				DepositOffer: &deposit.Offer{
+					UpgradeVersionID:     codec.UpgradeVersion3,
+					End:                  1,
+					MinDuration:          1,
+					MaxDuration:          1,
+					MinAmount:            deposit.OfferMinDepositAmount,
+					RequiredAddressState: uint64(AddressStateKYCVerified) | 1<<AddressStateBitMax,
+				},
+			},
+			expectedErr: errWrongRequiredAddressState,
+		},
 		"Bad deposit offer creator auth": {
 			tx: &AddDepositOfferTx{
 				BaseTx:                     baseTx,
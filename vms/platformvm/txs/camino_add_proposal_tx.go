@@ -0,0 +1,110 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var (
+	_ UnsignedTx = (*AddProposalTx)(nil)
+
+	errBadProposal              = errors.New("bad proposal")
+	errBadProposerAuth          = errors.New("bad proposer auth")
+	errEmptyProposerAddress     = errors.New("proposer address is empty")
+	errTooBigBond               = errors.New("too big bond")
+	errProposalBondAssetNotAVAX = errors.New("proposal bond must be AVAX")
+)
+
+// AddProposalTx is an unsigned addProposalTx
+type AddProposalTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// Proposal that will be added
+	Proposal *dao.Proposal `serialize:"true" json:"proposal"`
+	// Address that creates this proposal
+	ProposerAddress ids.ShortID `serialize:"true" json:"proposerAddress"`
+	// Auth that will be used to verify credential for proposer
+	ProposerAuth verify.Verifiable `serialize:"true" json:"proposerAuth"`
+
+	bondAmount *uint64
+}
+
+// Returns amount of tokens that are bonded by this tx for proposal
+func (tx *AddProposalTx) BondAmount() uint64 {
+	if tx.bondAmount == nil {
+		bondAmount := uint64(0)
+		for _, out := range tx.Outs {
+			if lockedOut, ok := out.Out.(*locked.Out); ok && lockedOut.IsNewlyLockedWith(locked.StateBonded) {
+				bondAmount += lockedOut.Amount()
+			}
+		}
+		tx.bondAmount = &bondAmount
+	}
+	return *tx.bondAmount
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *AddProposalTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.ProposerAddress == ids.ShortEmpty:
+		return errEmptyProposerAddress
+	case tx.Proposal == nil:
+		return errBadProposal
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	if err := tx.Proposal.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadProposal, err)
+	}
+
+	if tx.ProposerAuth == nil {
+		return errBadProposerAuth
+	}
+	if err := tx.ProposerAuth.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadProposerAuth, err)
+	}
+
+	if err := locked.VerifyLockMode(tx.Ins, tx.Outs, true); err != nil {
+		return err
+	}
+
+	bondAmount := uint64(0)
+	for _, out := range tx.Outs {
+		if lockedOut, ok := out.Out.(*locked.Out); ok && lockedOut.IsNewlyLockedWith(locked.StateBonded) {
+			if out.AssetID() != ctx.AVAXAssetID {
+				return errProposalBondAssetNotAVAX
+			}
+			newBondAmount, err := math.Add64(bondAmount, lockedOut.Amount())
+			if err != nil {
+				return fmt.Errorf("%w: %s", errTooBigBond, err)
+			}
+			bondAmount = newBondAmount
+		}
+	}
+	tx.bondAmount = &bondAmount
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *AddProposalTx) Visit(visitor Visitor) error {
+	return visitor.AddProposalTx(tx)
+}
@@ -0,0 +1,172 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/vms/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddProposalTxSyntacticVerify(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.GenerateTestID()
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+	depositTxID := ids.ID{0, 1}
+	proposerAddress := ids.ShortID{1}
+
+	proposal := &dao.Proposal{
+		Start:   100,
+		End:     100 + dao.MinProposalDuration,
+		Options: []types.JSONByteSlice{{1}, {2}},
+	}
+
+	baseTx := BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+	}}
+
+	tests := map[string]struct {
+		tx          *AddProposalTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			expectedErr: ErrNilTx,
+		},
+		"Empty proposer address": {
+			tx: &AddProposalTx{
+				BaseTx:   baseTx,
+				Proposal: proposal,
+			},
+			expectedErr: errEmptyProposerAddress,
+		},
+		"Nil proposal": {
+			tx: &AddProposalTx{
+				BaseTx:          baseTx,
+				ProposerAddress: proposerAddress,
+			},
+			expectedErr: errBadProposal,
+		},
+		"Bad proposal": {
+			tx: &AddProposalTx{
+				BaseTx:          baseTx,
+				ProposerAddress: proposerAddress,
+				Proposal:        &dao.Proposal{Start: 100, End: 101, Options: proposal.Options},
+			},
+			expectedErr: errBadProposal,
+		},
+		"Nil proposer auth": {
+			tx: &AddProposalTx{
+				BaseTx:          baseTx,
+				ProposerAddress: proposerAddress,
+				Proposal:        proposal,
+			},
+			expectedErr: errBadProposerAuth,
+		},
+		"Bad proposer auth": {
+			tx: &AddProposalTx{
+				BaseTx:          baseTx,
+				ProposerAddress: proposerAddress,
+				Proposal:        proposal,
+				ProposerAuth:    (*secp256k1fx.Input)(nil),
+			},
+			expectedErr: errBadProposerAuth,
+		},
+		"Stakable base tx output": {
+			tx: &AddProposalTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestStakeableOut(ctx.AVAXAssetID, 1, 1, owner1),
+					},
+				}},
+				ProposerAddress: proposerAddress,
+				Proposal:        proposal,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: locked.ErrWrongOutType,
+		},
+		"Stakable base tx input": {
+			tx: &AddProposalTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestStakeableIn(ctx.AVAXAssetID, 1, 1, []uint32{0}),
+					},
+				}},
+				ProposerAddress: proposerAddress,
+				Proposal:        proposal,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: locked.ErrWrongInType,
+		},
+		"Bond isn't AVAX": {
+			tx: &AddProposalTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ids.GenerateTestID(), 1, owner1, ids.Empty, locked.ThisTxID),
+					},
+				}},
+				ProposerAddress: proposerAddress,
+				Proposal:        proposal,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: errProposalBondAssetNotAVAX,
+		},
+		"OK": {
+			tx: &AddProposalTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestIn(ctx.AVAXAssetID, 1, depositTxID, ids.Empty, []uint32{0}),
+					},
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, owner1, depositTxID, locked.ThisTxID),
+					},
+				}},
+				ProposerAddress: proposerAddress,
+				Proposal:        proposal,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx.SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
+
+func TestAddProposalTxBondAmount(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.GenerateTestID()
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+	depositTxID := ids.ID{0, 1}
+
+	tx := &AddProposalTx{
+		BaseTx: BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    ctx.NetworkID,
+			BlockchainID: ctx.ChainID,
+			Outs: []*avax.TransferableOutput{
+				generateTestOut(ctx.AVAXAssetID, 1, owner1, ids.Empty, ids.Empty),
+				generateTestOut(ctx.AVAXAssetID, 10, owner1, ids.Empty, locked.ThisTxID),
+				generateTestOut(ctx.AVAXAssetID, 100, owner1, depositTxID, locked.ThisTxID),
+				generateTestOut(ctx.AVAXAssetID, 1000, owner1, depositTxID, ids.Empty),
+			},
+		}},
+	}
+	require.Equal(t, uint64(110), tx.BondAmount())
+}
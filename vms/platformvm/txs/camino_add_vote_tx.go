@@ -0,0 +1,73 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var (
+	_ UnsignedTx = (*AddVoteTx)(nil)
+
+	errEmptyProposalID   = errors.New("proposal id is empty")
+	errEmptyVoterAddress = errors.New("voter address is empty")
+	errBadVoterAuth      = errors.New("bad voter auth")
+)
+
+// AddVoteTx is an unsigned addVoteTx
+type AddVoteTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of proposal that is voted for
+	ProposalID ids.ID `serialize:"true" json:"proposalID"`
+	// Index of proposal option that is voted for
+	Option uint32 `serialize:"true" json:"option"`
+	// Address of consortium member that votes
+	VoterAddress ids.ShortID `serialize:"true" json:"voterAddress"`
+	// Auth that will be used to verify credential for voter
+	VoterAuth verify.Verifiable `serialize:"true" json:"voterAuth"`
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *AddVoteTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.ProposalID == ids.Empty:
+		return errEmptyProposalID
+	case tx.VoterAddress == ids.ShortEmpty:
+		return errEmptyVoterAddress
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	if tx.VoterAuth == nil {
+		return errBadVoterAuth
+	}
+	if err := tx.VoterAuth.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadVoterAuth, err)
+	}
+
+	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *AddVoteTx) Visit(visitor Visitor) error {
+	return visitor.AddVoteTx(tx)
+}
@@ -62,6 +62,9 @@ var (
 
 	ErrEmptyAddress = errors.New("address is empty")
 	ErrInvalidState = errors.New("invalid state")
+
+	errNotKYCVerifiedExpiration = errors.New("kyc expiration can only be set with kyc verified state")
+	errNotNodeDeferredEnd       = errors.New("node deferral end can only be set with node deferred state")
 )
 
 // AddressStateTx is an unsigned AddressStateTx
@@ -80,6 +83,12 @@ type AddressStateTx struct {
 	Executor ids.ShortID `serialize:"true" json:"executor" upgradeVersion:"1"`
 	// Signature(s) to authenticate executor
 	ExecutorAuth verify.Verifiable `serialize:"true" json:"executorAuth" upgradeVersion:"1"`
+	// Optional timestamp, when kyc verification will expire.
+	// Could only be set, when kyc verified state is added. Zero means no expiration.
+	KYCExpiration uint64 `serialize:"true" json:"kycExpiration" upgradeVersion:"2"`
+	// Optional timestamp, when deferred node will be resumed automatically.
+	// Could only be set, when node deferred state is added. Zero means no automatic resume.
+	NodeDeferralEnd uint64 `serialize:"true" json:"nodeDeferralEnd" upgradeVersion:"3"`
 }
 
 // SyntacticVerify returns nil if [tx] is valid
@@ -104,6 +113,16 @@ func (tx *AddressStateTx) SyntacticVerify(ctx *snow.Context) error {
 		}
 	}
 
+	if tx.UpgradeVersionID.Version() >= codec.UpgradeVersion2.Version() && tx.KYCExpiration != 0 &&
+		(tx.State != AddressStateBitKYCVerified || tx.Remove) {
+		return errNotKYCVerifiedExpiration
+	}
+
+	if tx.UpgradeVersionID.Version() >= codec.UpgradeVersion3.Version() && tx.NodeDeferralEnd != 0 &&
+		(tx.State != AddressStateBitNodeDeferred || tx.Remove) {
+		return errNotNodeDeferredEnd
+	}
+
 	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
 		return err
 	}
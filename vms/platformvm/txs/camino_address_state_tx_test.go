@@ -189,4 +189,53 @@ func TestAddressStateTxSyntacticVerify(t *testing.T) {
 	require.NoError(err)
 	err = stx.SyntacticVerify(ctx)
 	require.NoError(err)
+
+	// Upgraded v2 / kyc expiration with not kyc verified state
+	addressStateTxUpgraded.SyntacticallyVerified = false
+	addressStateTxUpgraded.UpgradeVersionID = codec.UpgradeVersion2
+	addressStateTxUpgraded.KYCExpiration = 100
+	stx, err = NewSigned(addressStateTxUpgraded, Codec, signers)
+	require.NoError(err)
+	err = stx.SyntacticVerify(ctx)
+	require.ErrorIs(err, errNotKYCVerifiedExpiration)
+
+	// Upgraded v2 / kyc expiration with kyc verified state removal
+	addressStateTxUpgraded.State = AddressStateBitKYCVerified
+	addressStateTxUpgraded.Remove = true
+	stx, err = NewSigned(addressStateTxUpgraded, Codec, signers)
+	require.NoError(err)
+	err = stx.SyntacticVerify(ctx)
+	require.ErrorIs(err, errNotKYCVerifiedExpiration)
+
+	// Upgraded v2 / Ok
+	addressStateTxUpgraded.Remove = false
+	stx, err = NewSigned(addressStateTxUpgraded, Codec, signers)
+	require.NoError(err)
+	err = stx.SyntacticVerify(ctx)
+	require.NoError(err)
+
+	// Upgraded v3 / node deferral end with not node deferred state
+	addressStateTxUpgraded.SyntacticallyVerified = false
+	addressStateTxUpgraded.UpgradeVersionID = codec.UpgradeVersion3
+	addressStateTxUpgraded.KYCExpiration = 0
+	addressStateTxUpgraded.NodeDeferralEnd = 100
+	stx, err = NewSigned(addressStateTxUpgraded, Codec, signers)
+	require.NoError(err)
+	err = stx.SyntacticVerify(ctx)
+	require.ErrorIs(err, errNotNodeDeferredEnd)
+
+	// Upgraded v3 / node deferral end with node deferred state removal
+	addressStateTxUpgraded.State = AddressStateBitNodeDeferred
+	addressStateTxUpgraded.Remove = true
+	stx, err = NewSigned(addressStateTxUpgraded, Codec, signers)
+	require.NoError(err)
+	err = stx.SyntacticVerify(ctx)
+	require.ErrorIs(err, errNotNodeDeferredEnd)
+
+	// Upgraded v3 / Ok
+	addressStateTxUpgraded.Remove = false
+	stx, err = NewSigned(addressStateTxUpgraded, Codec, signers)
+	require.NoError(err)
+	err = stx.SyntacticVerify(ctx)
+	require.NoError(err)
 }
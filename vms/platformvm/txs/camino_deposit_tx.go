@@ -12,6 +12,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 )
@@ -23,6 +24,7 @@ var (
 	errInvalidRewardOwner    = errors.New("invalid reward owner")
 	errBadOfferOwnerAuth     = errors.New("bad offer owner auth")
 	errBadDepositCreatorAuth = errors.New("bad deposit creator auth")
+	errWrongDepositFlags     = errors.New("wrong deposit flags")
 )
 
 // DepositTx is an unsigned depositTx
@@ -44,6 +46,9 @@ type DepositTx struct {
 	// Auth for deposit offer owner
 	DepositOfferOwnerAuth verify.Verifiable `serialize:"true" json:"ownerAuth" upgradeVersion:"1"`
 
+	// Deposit flags, e.g. rollover
+	DepositFlags deposit.Flag `serialize:"true" json:"depositFlags" upgradeVersion:"2"`
+
 	depositAmount *uint64
 }
 
@@ -105,6 +110,13 @@ func (tx *DepositTx) SyntacticVerify(ctx *snow.Context) error {
 		}
 	}
 
+	if tx.UpgradeVersionID.Version() >= codec.UpgradeVersion2.Version() {
+		if tx.DepositFlags&^(deposit.FlagRollover|deposit.FlagRolloverRewards) != 0 ||
+			tx.DepositFlags&deposit.FlagRolloverRewards != 0 && tx.DepositFlags&deposit.FlagRollover == 0 {
+			return errWrongDepositFlags
+		}
+	}
+
 	// cache that this is valid
 	tx.SyntacticallyVerified = true
 	return nil
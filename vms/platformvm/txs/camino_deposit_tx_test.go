@@ -10,6 +10,7 @@ import (
 	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/stretchr/testify/require"
@@ -75,6 +76,34 @@ func TestDepositTxSyntacticVerify(t *testing.T) {
 			},
 			expectedErr: errBadOfferOwnerAuth,
 		},
+		"V2, unknown deposit flag": {
+			tx: &DepositTx{
+				UpgradeVersionID: codec.UpgradeVersion2,
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+				}},
+				RewardsOwner:          &secp256k1fx.OutputOwners{},
+				DepositCreatorAuth:    &secp256k1fx.Input{},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+				DepositFlags:          0b100,
+			},
+			expectedErr: errWrongDepositFlags,
+		},
+		"V2, rollover rewards without rollover": {
+			tx: &DepositTx{
+				UpgradeVersionID: codec.UpgradeVersion2,
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+				}},
+				RewardsOwner:          &secp256k1fx.OutputOwners{},
+				DepositCreatorAuth:    &secp256k1fx.Input{},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+				DepositFlags:          deposit.FlagRolloverRewards,
+			},
+			expectedErr: errWrongDepositFlags,
+		},
 		"OK: v0": {
 			tx: &DepositTx{
 				BaseTx: BaseTx{BaseTx: avax.BaseTx{
@@ -109,6 +138,19 @@ func TestDepositTxSyntacticVerify(t *testing.T) {
 				DepositOfferOwnerAuth: &secp256k1fx.Input{},
 			},
 		},
+		"OK: v2, rollover with rewards": {
+			tx: &DepositTx{
+				UpgradeVersionID: codec.UpgradeVersion2,
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+				}},
+				RewardsOwner:          &secp256k1fx.OutputOwners{},
+				DepositCreatorAuth:    &secp256k1fx.Input{},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{},
+				DepositFlags:          deposit.FlagRollover | deposit.FlagRolloverRewards,
+			},
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,141 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var (
+	_ ValidatorTx = (*ExtendValidatorTx)(nil)
+
+	errEmptyValidatorTxID = errors.New("validator tx id cannot be empty")
+)
+
+// ExtendValidatorTx is an unsigned extendValidatorTx.
+// It replaces current primary network validator with the one, that has later end time
+// and/or bigger weight, without removing validator from current validator set.
+type ExtendValidatorTx struct {
+	// Metadata, inputs and outputs.
+	// Outputs newly bonded with this tx are added to validator weight.
+	BaseTx `serialize:"true"`
+	// ID of tx, that created current validator: add validator tx or previous extend validator tx
+	ValidatorTxID ids.ID `serialize:"true" json:"validatorTxID"`
+	// Describes extended validator. Node ID and start time must be the same as current validator has,
+	// end time and weight must be not less than current validator has.
+	Validator `serialize:"true" json:"validator"`
+	// Where to send staking rewards. Must be the same as current validator has.
+	RewardsOwner fx.Owner `serialize:"true" json:"rewardsOwner"`
+	// Auth that will be used to verify credential for node owner.
+	// If node owner address is msig-alias, auth must match real signatures.
+	NodeOwnerAuth verify.Verifiable `serialize:"true" json:"nodeOwnerAuth"`
+}
+
+// InitCtx sets the FxID fields in the inputs and outputs of this
+// [ExtendValidatorTx]. Also sets the [ctx] to the given [vm.ctx] so that
+// the addresses can be json marshalled into human readable format
+func (tx *ExtendValidatorTx) InitCtx(ctx *snow.Context) {
+	tx.BaseTx.InitCtx(ctx)
+	tx.RewardsOwner.InitCtx(ctx)
+}
+
+func (*ExtendValidatorTx) SubnetID() ids.ID {
+	return constants.PrimaryNetworkID
+}
+
+func (tx *ExtendValidatorTx) NodeID() ids.NodeID {
+	return tx.Validator.NodeID
+}
+
+func (*ExtendValidatorTx) PublicKey() (*bls.PublicKey, bool, error) {
+	return nil, false, nil
+}
+
+func (*ExtendValidatorTx) PendingPriority() Priority {
+	return PrimaryNetworkValidatorPendingPriority
+}
+
+func (*ExtendValidatorTx) CurrentPriority() Priority {
+	return PrimaryNetworkValidatorCurrentPriority
+}
+
+// Stake returns outputs newly bonded with this tx
+func (tx *ExtendValidatorTx) Stake() []*avax.TransferableOutput {
+	var stake []*avax.TransferableOutput
+	for _, out := range tx.Outs {
+		if lockedOut, ok := out.Out.(*locked.Out); ok && lockedOut.IsNewlyLockedWith(locked.StateBonded) {
+			stake = append(stake, out)
+		}
+	}
+	return stake
+}
+
+func (tx *ExtendValidatorTx) ValidationRewardsOwner() fx.Owner {
+	return tx.RewardsOwner
+}
+
+func (tx *ExtendValidatorTx) DelegationRewardsOwner() fx.Owner {
+	return tx.RewardsOwner
+}
+
+func (*ExtendValidatorTx) Shares() uint32 {
+	return 0
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *ExtendValidatorTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.ValidatorTxID == ids.Empty:
+		return errEmptyValidatorTxID
+	case tx.Validator.NodeID == ids.EmptyNodeID:
+		return errEmptyNodeID
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+	if err := verify.All(&tx.Validator, tx.RewardsOwner, tx.NodeOwnerAuth); err != nil {
+		return fmt.Errorf("failed to verify validator, rewards owner or node owner auth: %w", err)
+	}
+
+	bondedAmount := uint64(0)
+	for _, out := range tx.Stake() {
+		newBondedAmount, err := math.Add64(bondedAmount, out.Out.Amount())
+		if err != nil {
+			return err
+		}
+		bondedAmount = newBondedAmount
+
+		if out.AssetID() != ctx.AVAXAssetID {
+			return errAssetNotAVAX
+		}
+	}
+
+	if bondedAmount > tx.Validator.Wght {
+		return fmt.Errorf("%w: weight %d < newly bonded %d", errValidatorWeightMismatch, tx.Validator.Wght, bondedAmount)
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *ExtendValidatorTx) Visit(visitor Visitor) error {
+	return visitor.ExtendValidatorTx(tx)
+}
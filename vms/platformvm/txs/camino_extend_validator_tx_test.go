@@ -0,0 +1,126 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestExtendValidatorTxSyntacticVerify(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.ID{1}
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+
+	baseTx := BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+	}}
+	validator := Validator{NodeID: ids.NodeID{1}, Start: 10, End: 20, Wght: 5}
+	bondedOut := generateTestOut(ctx.AVAXAssetID, 5, owner1, ids.Empty, locked.ThisTxID)
+
+	tests := map[string]struct {
+		tx          *ExtendValidatorTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			expectedErr: ErrNilTx,
+		},
+		"Empty validator tx id": {
+			tx: &ExtendValidatorTx{
+				BaseTx:        baseTx,
+				Validator:     validator,
+				RewardsOwner:  &owner1,
+				NodeOwnerAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errEmptyValidatorTxID,
+		},
+		"Empty node id": {
+			tx: &ExtendValidatorTx{
+				BaseTx:        baseTx,
+				ValidatorTxID: ids.ID{1},
+				Validator:     Validator{Start: 10, End: 20, Wght: 5},
+				RewardsOwner:  &owner1,
+				NodeOwnerAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errEmptyNodeID,
+		},
+		"Zero weight": {
+			tx: &ExtendValidatorTx{
+				BaseTx:        baseTx,
+				ValidatorTxID: ids.ID{1},
+				Validator:     Validator{NodeID: ids.NodeID{1}, Start: 10, End: 20},
+				RewardsOwner:  &owner1,
+				NodeOwnerAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: ErrWeightTooSmall,
+		},
+		"Not avax bonded output": {
+			tx: &ExtendValidatorTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ids.ID{2}, 5, owner1, ids.Empty, locked.ThisTxID),
+					},
+				}},
+				ValidatorTxID: ids.ID{1},
+				Validator:     validator,
+				RewardsOwner:  &owner1,
+				NodeOwnerAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errAssetNotAVAX,
+		},
+		"Bonded more than weight": {
+			tx: &ExtendValidatorTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 6, owner1, ids.Empty, locked.ThisTxID),
+					},
+				}},
+				ValidatorTxID: ids.ID{1},
+				Validator:     validator,
+				RewardsOwner:  &owner1,
+				NodeOwnerAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errValidatorWeightMismatch,
+		},
+		"OK: only end time extension": {
+			tx: &ExtendValidatorTx{
+				BaseTx:        baseTx,
+				ValidatorTxID: ids.ID{1},
+				Validator:     validator,
+				RewardsOwner:  &owner1,
+				NodeOwnerAuth: &secp256k1fx.Input{},
+			},
+		},
+		"OK": {
+			tx: &ExtendValidatorTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs:         []*avax.TransferableOutput{bondedOut},
+				}},
+				ValidatorTxID: ids.ID{1},
+				Validator:     validator,
+				RewardsOwner:  &owner1,
+				NodeOwnerAuth: &secp256k1fx.Input{},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx.SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var (
+	_ UnsignedTx = (*FinishProposalsTx)(nil)
+
+	errNoFinishedProposals              = errors.New("no finished proposals")
+	errFinishedProposalsNotSortedUnique = errors.New("finished proposals not sorted and unique")
+)
+
+// FinishedProposal is a voting result of proposal
+type FinishedProposal struct {
+	// ID of finished proposal
+	ProposalID ids.ID `serialize:"true" json:"proposalID"`
+	// Most voted proposal option
+	Option uint32 `serialize:"true" json:"option"`
+	// True, if most voted option was voted by absolute majority of voters
+	Successful bool `serialize:"true" json:"successful"`
+}
+
+// FinishProposalsTx is an unsigned finishProposalsTx.
+// It is system tx, that is issued by block builder, when proposals voting period ends.
+type FinishProposalsTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// Voting results of finished proposals, sorted by proposal id
+	FinishedProposals []FinishedProposal `serialize:"true" json:"finishedProposals"`
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *FinishProposalsTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case len(tx.FinishedProposals) == 0:
+		return errNoFinishedProposals
+	}
+
+	for i := 1; i < len(tx.FinishedProposals); i++ {
+		if bytes.Compare(tx.FinishedProposals[i-1].ProposalID[:], tx.FinishedProposals[i].ProposalID[:]) >= 0 {
+			return errFinishedProposalsNotSortedUnique
+		}
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	if err := locked.VerifyLockMode(tx.Ins, tx.Outs, true); err != nil {
+		return err
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+// Returns ids of finished proposals
+func (tx *FinishProposalsTx) ProposalIDs() []ids.ID {
+	proposalIDs := make([]ids.ID, len(tx.FinishedProposals))
+	for i := range tx.FinishedProposals {
+		proposalIDs[i] = tx.FinishedProposals[i].ProposalID
+	}
+	return proposalIDs
+}
+
+func (tx *FinishProposalsTx) Visit(visitor Visitor) error {
+	return visitor.FinishProposalsTx(tx)
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinishProposalsTxSyntacticVerify(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.GenerateTestID()
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+	proposalID1 := ids.ID{1}
+	proposalID2 := ids.ID{2}
+
+	baseTx := BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+	}}
+
+	tests := map[string]struct {
+		tx          *FinishProposalsTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			expectedErr: ErrNilTx,
+		},
+		"No finished proposals": {
+			tx: &FinishProposalsTx{
+				BaseTx: baseTx,
+			},
+			expectedErr: errNoFinishedProposals,
+		},
+		"Not sorted finished proposals": {
+			tx: &FinishProposalsTx{
+				BaseTx: baseTx,
+				FinishedProposals: []FinishedProposal{
+					{ProposalID: proposalID2},
+					{ProposalID: proposalID1},
+				},
+			},
+			expectedErr: errFinishedProposalsNotSortedUnique,
+		},
+		"Not unique finished proposals": {
+			tx: &FinishProposalsTx{
+				BaseTx: baseTx,
+				FinishedProposals: []FinishedProposal{
+					{ProposalID: proposalID1},
+					{ProposalID: proposalID1},
+				},
+			},
+			expectedErr: errFinishedProposalsNotSortedUnique,
+		},
+		"Stakable base tx output": {
+			tx: &FinishProposalsTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestStakeableOut(ctx.AVAXAssetID, 1, 1, owner1),
+					},
+				}},
+				FinishedProposals: []FinishedProposal{{ProposalID: proposalID1}},
+			},
+			expectedErr: locked.ErrWrongOutType,
+		},
+		"OK": {
+			tx: &FinishProposalsTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestIn(ctx.AVAXAssetID, 1, ids.Empty, proposalID1, []uint32{}),
+					},
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, owner1, ids.Empty, ids.Empty),
+					},
+				}},
+				FinishedProposals: []FinishedProposal{
+					{ProposalID: proposalID1, Option: 1, Successful: true},
+					{ProposalID: proposalID2},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx.SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
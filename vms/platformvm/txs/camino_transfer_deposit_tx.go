@@ -0,0 +1,104 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var (
+	_ UnsignedTx = (*TransferDepositTx)(nil)
+
+	errEmptyDepositTxID       = errors.New("deposit tx id is empty")
+	errBadRewardOwnerAuth     = errors.New("bad reward owner auth")
+	errNotTransferredDeposit  = errors.New("locked input or output isn't deposited with transferred deposit")
+	errNewlyLockedTransferOut = errors.New("transfer deposit tx can't lock new outputs")
+)
+
+// TransferDepositTx is an unsigned transferDepositTx.
+// It moves deposited utxos of existing deposit to new owners and/or changes deposit reward owner.
+// Deposit offer, start, duration and already claimed or unlocked amounts stay the same.
+type TransferDepositTx struct {
+	// Metadata, inputs and outputs.
+	// Locked inputs and outputs must be deposited with transferred deposit,
+	// their lockIDs stay the same. Other inputs and outputs are used to pay fee.
+	BaseTx `serialize:"true"`
+	// ID of deposit that will be transferred
+	DepositTxID ids.ID `serialize:"true" json:"depositTxID"`
+	// New deposit reward owner, could be the same as the current one
+	NewRewardOwner fx.Owner `serialize:"true" json:"newRewardOwner"`
+	// Auth that will be used to verify credential for current deposit reward owner,
+	// if reward owner is changed
+	RewardOwnerAuth verify.Verifiable `serialize:"true" json:"rewardOwnerAuth"`
+}
+
+// InitCtx sets the FxID fields in the inputs and outputs of this
+// [TransferDepositTx]. Also sets the [ctx] to the given [vm.ctx] so that
+// the addresses can be json marshalled into human readable format
+func (tx *TransferDepositTx) InitCtx(ctx *snow.Context) {
+	tx.BaseTx.InitCtx(ctx)
+	tx.NewRewardOwner.InitCtx(ctx)
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *TransferDepositTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.DepositTxID == ids.Empty:
+		return errEmptyDepositTxID
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	if err := tx.NewRewardOwner.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errInvalidRewardOwner, err)
+	}
+
+	if err := tx.RewardOwnerAuth.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadRewardOwnerAuth, err)
+	}
+
+	if err := locked.VerifyLockMode(tx.Ins, tx.Outs, true); err != nil {
+		return err
+	}
+
+	for _, in := range tx.Ins {
+		if lockedIn, ok := in.In.(*locked.In); ok && lockedIn.DepositTxID != tx.DepositTxID {
+			return errNotTransferredDeposit
+		}
+	}
+
+	for _, out := range tx.Outs {
+		lockedOut, ok := out.Out.(*locked.Out)
+		if !ok {
+			continue
+		}
+		if lockedOut.DepositTxID != tx.DepositTxID {
+			return errNotTransferredDeposit
+		}
+		if lockedOut.BondTxID == locked.ThisTxID {
+			return errNewlyLockedTransferOut
+		}
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *TransferDepositTx) Visit(visitor Visitor) error {
+	return visitor.TransferDepositTx(tx)
+}
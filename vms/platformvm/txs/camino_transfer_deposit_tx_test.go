@@ -0,0 +1,148 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferDepositTxSyntacticVerify(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.GenerateTestID()
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+	depositTxID := ids.ID{0, 1}
+	otherDepositTxID := ids.ID{0, 2}
+	bondTxID := ids.ID{0, 3}
+
+	baseTx := BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+	}}
+
+	tests := map[string]struct {
+		tx          *TransferDepositTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			expectedErr: ErrNilTx,
+		},
+		"Empty deposit tx id": {
+			tx: &TransferDepositTx{
+				BaseTx:          baseTx,
+				NewRewardOwner:  &owner1,
+				RewardOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: errEmptyDepositTxID,
+		},
+		"Bad new reward owner": {
+			tx: &TransferDepositTx{
+				BaseTx:          baseTx,
+				DepositTxID:     depositTxID,
+				NewRewardOwner:  &secp256k1fx.OutputOwners{Threshold: 2, Addrs: []ids.ShortID{{1}}},
+				RewardOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: errInvalidRewardOwner,
+		},
+		"Bad reward owner auth": {
+			tx: &TransferDepositTx{
+				BaseTx:          baseTx,
+				DepositTxID:     depositTxID,
+				NewRewardOwner:  &owner1,
+				RewardOwnerAuth: (*secp256k1fx.Input)(nil),
+			},
+			expectedErr: errBadRewardOwnerAuth,
+		},
+		"Input deposited with other deposit": {
+			tx: &TransferDepositTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestIn(ctx.AVAXAssetID, 1, otherDepositTxID, ids.Empty, []uint32{0}),
+					},
+				}},
+				DepositTxID:     depositTxID,
+				NewRewardOwner:  &owner1,
+				RewardOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: errNotTransferredDeposit,
+		},
+		"Bonded input": {
+			tx: &TransferDepositTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestIn(ctx.AVAXAssetID, 1, ids.Empty, bondTxID, []uint32{0}),
+					},
+				}},
+				DepositTxID:     depositTxID,
+				NewRewardOwner:  &owner1,
+				RewardOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: errNotTransferredDeposit,
+		},
+		"Output deposited with other deposit": {
+			tx: &TransferDepositTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, owner1, otherDepositTxID, ids.Empty),
+					},
+				}},
+				DepositTxID:     depositTxID,
+				NewRewardOwner:  &owner1,
+				RewardOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: errNotTransferredDeposit,
+		},
+		"Newly bonded output": {
+			tx: &TransferDepositTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, owner1, depositTxID, locked.ThisTxID),
+					},
+				}},
+				DepositTxID:     depositTxID,
+				NewRewardOwner:  &owner1,
+				RewardOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: errNewlyLockedTransferOut,
+		},
+		"OK": {
+			tx: &TransferDepositTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestIn(ctx.AVAXAssetID, 1, depositTxID, bondTxID, []uint32{0}),
+						generateTestIn(ctx.AVAXAssetID, 2, ids.Empty, ids.Empty, []uint32{0}),
+					},
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, owner1, ids.Empty, ids.Empty),
+						generateTestOut(ctx.AVAXAssetID, 1, owner1, depositTxID, bondTxID),
+					},
+				}},
+				DepositTxID:     depositTxID,
+				NewRewardOwner:  &owner1,
+				RewardOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx.SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
+)
+
+var (
+	_ UnsignedTx = (*TreasuryConfigTx)(nil)
+
+	errBadTreasuryConfig = errors.New("bad treasury config")
+	errBadExecutorAuth   = errors.New("bad executor auth")
+)
+
+// TreasuryConfigTx is an unsigned treasuryConfigTx.
+// It sets treasury owner and treasury spending cap. Can only be issued by admin.
+type TreasuryConfigTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// New treasury config
+	TreasuryConfig treasury.Config `serialize:"true" json:"treasuryConfig"`
+	// Address of admin, that changes treasury config
+	Executor ids.ShortID `serialize:"true" json:"executor"`
+	// Auth that will be used to verify credential for executor
+	ExecutorAuth verify.Verifiable `serialize:"true" json:"executorAuth"`
+}
+
+// InitCtx sets the FxID fields in the inputs and outputs of this
+// [TreasuryConfigTx]. Also sets the [ctx] to the given [vm.ctx] so that
+// the addresses can be json marshalled into human readable format
+func (tx *TreasuryConfigTx) InitCtx(ctx *snow.Context) {
+	tx.BaseTx.InitCtx(ctx)
+	tx.TreasuryConfig.InitCtx(ctx)
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *TreasuryConfigTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.Executor == ids.ShortEmpty:
+		return ErrEmptyAddress
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	if err := tx.TreasuryConfig.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadTreasuryConfig, err)
+	}
+
+	if err := tx.ExecutorAuth.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadExecutorAuth, err)
+	}
+
+	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *TreasuryConfigTx) Visit(visitor Visitor) error {
+	return visitor.TreasuryConfigTx(tx)
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestTreasuryConfigTxSyntacticVerify(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.GenerateTestID()
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+
+	baseTx := BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+	}}
+	treasuryConfig := treasury.Config{
+		Owner:          owner1,
+		SpendingPeriod: 100,
+		SpendingCap:    1000,
+	}
+
+	tests := map[string]struct {
+		tx          *TreasuryConfigTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			expectedErr: ErrNilTx,
+		},
+		"Empty executor": {
+			tx: &TreasuryConfigTx{
+				BaseTx:         baseTx,
+				TreasuryConfig: treasuryConfig,
+				ExecutorAuth:   &secp256k1fx.Input{},
+			},
+			expectedErr: ErrEmptyAddress,
+		},
+		"Treasury address in treasury owner": {
+			tx: &TreasuryConfigTx{
+				BaseTx: baseTx,
+				TreasuryConfig: treasury.Config{
+					Owner: secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{treasury.Addr}},
+				},
+				Executor:     ids.ShortID{1},
+				ExecutorAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errBadTreasuryConfig,
+		},
+		"Spending cap without spending period": {
+			tx: &TreasuryConfigTx{
+				BaseTx: baseTx,
+				TreasuryConfig: treasury.Config{
+					Owner:       owner1,
+					SpendingCap: 1,
+				},
+				Executor:     ids.ShortID{1},
+				ExecutorAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errBadTreasuryConfig,
+		},
+		"Bad executor auth": {
+			tx: &TreasuryConfigTx{
+				BaseTx:         baseTx,
+				TreasuryConfig: treasuryConfig,
+				Executor:       ids.ShortID{1},
+				ExecutorAuth:   (*secp256k1fx.Input)(nil),
+			},
+			expectedErr: errBadExecutorAuth,
+		},
+		"Locked input": {
+			tx: &TreasuryConfigTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestIn(ctx.AVAXAssetID, 1, ids.ID{1}, ids.Empty, []uint32{0}),
+					},
+				}},
+				TreasuryConfig: treasuryConfig,
+				Executor:       ids.ShortID{1},
+				ExecutorAuth:   &secp256k1fx.Input{},
+			},
+			expectedErr: locked.ErrWrongInType,
+		},
+		"OK": {
+			tx: &TreasuryConfigTx{
+				BaseTx:         baseTx,
+				TreasuryConfig: treasuryConfig,
+				Executor:       ids.ShortID{1},
+				ExecutorAuth:   &secp256k1fx.Input{},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx.SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
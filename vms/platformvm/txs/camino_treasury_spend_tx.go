@@ -0,0 +1,121 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	_ UnsignedTx = (*TreasurySpendTx)(nil)
+
+	errNoTreasuryIns        = errors.New("no treasury inputs")
+	errBadTreasuryIns       = errors.New("bad treasury inputs")
+	errBadTreasuryAuth      = errors.New("bad treasury auth")
+	errLockedTreasuryInput  = errors.New("treasury input can't be locked")
+	errDuplicatedTreasuryIn = errors.New("treasury input is also used as base tx input")
+)
+
+// TreasurySpendTx is an unsigned treasurySpendTx.
+// It spends treasury utxos with permission of configured treasury owner.
+type TreasurySpendTx struct {
+	// Metadata, inputs and outputs.
+	// Inputs are used to pay fee. Outputs contain fee change, spent treasury funds
+	// and treasury change, which must be owned by treasury.
+	BaseTx `serialize:"true"`
+	// Treasury utxos, that will be spent
+	TreasuryIns []*avax.TransferableInput `serialize:"true" json:"treasuryInputs"`
+	// Auth that will be used to verify credential for configured treasury owner
+	TreasuryAuth verify.Verifiable `serialize:"true" json:"treasuryAuth"`
+}
+
+// InitCtx sets the FxID fields in the inputs and outputs of this
+// [TreasurySpendTx]. Also sets the [ctx] to the given [vm.ctx] so that
+// the addresses can be json marshalled into human readable format
+func (tx *TreasurySpendTx) InitCtx(ctx *snow.Context) {
+	tx.BaseTx.InitCtx(ctx)
+	for _, in := range tx.TreasuryIns {
+		in.FxID = secp256k1fx.ID
+	}
+}
+
+// TreasuryInputIDs returns the UTXOIDs of the spent treasury funds
+func (tx *TreasurySpendTx) TreasuryInputIDs() set.Set[ids.ID] {
+	set := set.NewSet[ids.ID](len(tx.TreasuryIns))
+	for _, in := range tx.TreasuryIns {
+		set.Add(in.InputID())
+	}
+	return set
+}
+
+func (tx *TreasurySpendTx) InputIDs() set.Set[ids.ID] {
+	inputs := tx.BaseTx.InputIDs()
+	inputs.Union(tx.TreasuryInputIDs())
+	return inputs
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *TreasurySpendTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case len(tx.TreasuryIns) == 0:
+		return errNoTreasuryIns
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	if tx.TreasuryAuth == nil {
+		return errBadTreasuryAuth
+	}
+	if err := tx.TreasuryAuth.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadTreasuryAuth, err)
+	}
+
+	for _, in := range tx.TreasuryIns {
+		if err := in.Verify(); err != nil {
+			return fmt.Errorf("%w: %s", errBadTreasuryIns, err)
+		}
+		if in.AssetID() != ctx.AVAXAssetID {
+			return fmt.Errorf("%w: %s", errBadTreasuryIns, errNotAVAXAsset)
+		}
+		if _, ok := in.In.(*locked.In); ok {
+			return errLockedTreasuryInput
+		}
+	}
+	if !utils.IsSortedAndUniqueSortable(tx.TreasuryIns) {
+		return fmt.Errorf("%w: %s", errBadTreasuryIns, errInputsNotSortedUnique)
+	}
+
+	baseInputIDs := tx.BaseTx.InputIDs()
+	if baseInputIDs.Overlaps(tx.TreasuryInputIDs()) {
+		return errDuplicatedTreasuryIn
+	}
+
+	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *TreasurySpendTx) Visit(visitor Visitor) error {
+	return visitor.TreasurySpendTx(tx)
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestTreasurySpendTxSyntacticVerify(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.GenerateTestID()
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+
+	baseTx := BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+	}}
+	treasuryIn := generateTestIn(ctx.AVAXAssetID, 10, ids.Empty, ids.Empty, []uint32{0})
+
+	tests := map[string]struct {
+		tx          *TreasurySpendTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			expectedErr: ErrNilTx,
+		},
+		"No treasury inputs": {
+			tx: &TreasurySpendTx{
+				BaseTx:       baseTx,
+				TreasuryAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errNoTreasuryIns,
+		},
+		"Nil treasury auth": {
+			tx: &TreasurySpendTx{
+				BaseTx:      baseTx,
+				TreasuryIns: []*avax.TransferableInput{treasuryIn},
+			},
+			expectedErr: errBadTreasuryAuth,
+		},
+		"Bad treasury auth": {
+			tx: &TreasurySpendTx{
+				BaseTx:       baseTx,
+				TreasuryIns:  []*avax.TransferableInput{treasuryIn},
+				TreasuryAuth: (*secp256k1fx.Input)(nil),
+			},
+			expectedErr: errBadTreasuryAuth,
+		},
+		"Not avax treasury input": {
+			tx: &TreasurySpendTx{
+				BaseTx: baseTx,
+				TreasuryIns: []*avax.TransferableInput{
+					generateTestIn(ids.GenerateTestID(), 10, ids.Empty, ids.Empty, []uint32{0}),
+				},
+				TreasuryAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errBadTreasuryIns,
+		},
+		"Locked treasury input": {
+			tx: &TreasurySpendTx{
+				BaseTx: baseTx,
+				TreasuryIns: []*avax.TransferableInput{
+					generateTestIn(ctx.AVAXAssetID, 10, ids.ID{1}, ids.Empty, []uint32{0}),
+				},
+				TreasuryAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errLockedTreasuryInput,
+		},
+		"Not unique treasury inputs": {
+			tx: &TreasurySpendTx{
+				BaseTx:       baseTx,
+				TreasuryIns:  []*avax.TransferableInput{treasuryIn, treasuryIn},
+				TreasuryAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errBadTreasuryIns,
+		},
+		"Treasury input is also base input": {
+			tx: &TreasurySpendTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins:          []*avax.TransferableInput{treasuryIn},
+				}},
+				TreasuryIns:  []*avax.TransferableInput{treasuryIn},
+				TreasuryAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: errDuplicatedTreasuryIn,
+		},
+		"Locked output": {
+			tx: &TreasurySpendTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 10, owner1, ids.ID{1}, ids.Empty),
+					},
+				}},
+				TreasuryIns:  []*avax.TransferableInput{treasuryIn},
+				TreasuryAuth: &secp256k1fx.Input{},
+			},
+			expectedErr: locked.ErrWrongOutType,
+		},
+		"OK": {
+			tx: &TreasurySpendTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 10, *treasury.Owner, ids.Empty, ids.Empty),
+					},
+				}},
+				TreasuryIns:  []*avax.TransferableInput{treasuryIn},
+				TreasuryAuth: &secp256k1fx.Input{},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx.SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
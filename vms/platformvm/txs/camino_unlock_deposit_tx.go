@@ -4,18 +4,31 @@
 package txs
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 )
 
-var _ UnsignedTx = (*UnlockDepositTx)(nil)
+var (
+	_ UnsignedTx = (*UnlockDepositTx)(nil)
+
+	errPenaltyOutputIndicesNotSupported = errors.New("penalty output indices aren't supported by this tx version")
+	errBadPenaltyOutputIndices          = errors.New("penalty output indices must be sorted, unique and point to tx outputs")
+)
 
 // UnlockDepositTx is an unsigned unlockDepositTx
 type UnlockDepositTx struct {
+	// We upgrade this struct beginning with early unlock
+	UpgradeVersionID codec.UpgradeVersionID
 	// Metadata, inputs and outputs
 	BaseTx `serialize:"true"`
+	// Indices of outputs that pay early unlock penalty to treasury.
+	// Other outputs are never counted as paid penalty, even if they are owned by treasury.
+	PenaltyOutputIndices []uint32 `serialize:"true" json:"penaltyOutputIndices" upgradeVersion:"1"`
 }
 
 // SyntacticVerify returns nil if [tx] is valid
@@ -25,6 +38,11 @@ func (tx *UnlockDepositTx) SyntacticVerify(ctx *snow.Context) error {
 		return ErrNilTx
 	case tx.SyntacticallyVerified: // already passed syntactic verification
 		return nil
+	case tx.UpgradeVersionID.Version() < codec.UpgradeVersion1.Version() && len(tx.PenaltyOutputIndices) > 0:
+		return errPenaltyOutputIndicesNotSupported
+	case !utils.IsSortedAndUniqueOrdered(tx.PenaltyOutputIndices) ||
+		len(tx.PenaltyOutputIndices) > 0 && int(tx.PenaltyOutputIndices[len(tx.PenaltyOutputIndices)-1]) >= len(tx.Outs):
+		return errBadPenaltyOutputIndices
 	}
 
 	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
@@ -6,6 +6,7 @@ package txs
 import (
 	"testing"
 
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
@@ -31,6 +32,64 @@ func TestUnlockDepositTxSyntacticVerify(t *testing.T) {
 				Outs: []*avax.TransferableOutput{},
 			}}},
 		},
+		"OK: penalty output indices": {
+			tx: &UnlockDepositTx{
+				UpgradeVersionID: codec.UpgradeVersion1,
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestIn(ctx.AVAXAssetID, 2, ids.ID{1}, ids.Empty, []uint32{}),
+					},
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, secp256k1fx.OutputOwners{}, ids.Empty, ids.Empty),
+						generateTestOut(ctx.AVAXAssetID, 1, secp256k1fx.OutputOwners{}, ids.Empty, ids.Empty),
+					},
+				}},
+				PenaltyOutputIndices: []uint32{1},
+			},
+		},
+		"Penalty output indices in tx v0": {
+			tx: &UnlockDepositTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, secp256k1fx.OutputOwners{}, ids.Empty, ids.Empty),
+					},
+				}},
+				PenaltyOutputIndices: []uint32{0},
+			},
+			expectedErr: errPenaltyOutputIndicesNotSupported,
+		},
+		"Not unique penalty output indices": {
+			tx: &UnlockDepositTx{
+				UpgradeVersionID: codec.UpgradeVersion1,
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, secp256k1fx.OutputOwners{}, ids.Empty, ids.Empty),
+					},
+				}},
+				PenaltyOutputIndices: []uint32{0, 0},
+			},
+			expectedErr: errBadPenaltyOutputIndices,
+		},
+		"Penalty output index out of range": {
+			tx: &UnlockDepositTx{
+				UpgradeVersionID: codec.UpgradeVersion1,
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, secp256k1fx.OutputOwners{}, ids.Empty, ids.Empty),
+					},
+				}},
+				PenaltyOutputIndices: []uint32{1},
+			},
+			expectedErr: errBadPenaltyOutputIndices,
+		},
 		"Nil tx": {
 			expectedErr: ErrNilTx,
 		},
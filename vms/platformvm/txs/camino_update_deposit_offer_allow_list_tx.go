@@ -0,0 +1,85 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var (
+	_ UnsignedTx = (*UpdateDepositOfferAllowListTx)(nil)
+
+	errNoAllowListChanges          = errors.New("no allow-list changes")
+	errAllowListAddressesNotSorted = errors.New("allow-list addresses not sorted and unique")
+	errAllowListAddressesOverlap   = errors.New("address is both added to and removed from allow-list")
+	errBadDepositOfferOwnerAuth    = errors.New("bad deposit offer owner auth")
+)
+
+// UpdateDepositOfferAllowListTx is an unsigned updateDepositOfferAllowListTx.
+// It adds addresses to and removes addresses from allow-list of existing deposit offer.
+type UpdateDepositOfferAllowListTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of deposit offer which allow-list will be updated
+	DepositOfferID ids.ID `serialize:"true" json:"depositOfferID"`
+	// Addresses that will be added to offer allow-list, must be sorted and unique
+	AddedAddresses []ids.ShortID `serialize:"true" json:"addedAddresses"`
+	// Addresses that will be removed from offer allow-list, must be sorted and unique
+	RemovedAddresses []ids.ShortID `serialize:"true" json:"removedAddresses"`
+	// Auth that will be used to verify credential for deposit offer owner
+	DepositOfferOwnerAuth verify.Verifiable `serialize:"true" json:"depositOfferOwnerAuth"`
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *UpdateDepositOfferAllowListTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.DepositOfferID == ids.Empty:
+		return errEmptyDepositOfferID
+	case len(tx.AddedAddresses) == 0 && len(tx.RemovedAddresses) == 0:
+		return errNoAllowListChanges
+	case !utils.IsSortedAndUniqueSortable(tx.AddedAddresses) ||
+		!utils.IsSortedAndUniqueSortable(tx.RemovedAddresses):
+		return errAllowListAddressesNotSorted
+	}
+
+	addedAddresses := set.NewSet[ids.ShortID](len(tx.AddedAddresses))
+	addedAddresses.Add(tx.AddedAddresses...)
+	for _, addr := range tx.RemovedAddresses {
+		if addedAddresses.Contains(addr) {
+			return fmt.Errorf("%w: %s", errAllowListAddressesOverlap, addr)
+		}
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	if err := tx.DepositOfferOwnerAuth.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadDepositOfferOwnerAuth, err)
+	}
+
+	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *UpdateDepositOfferAllowListTx) Visit(visitor Visitor) error {
+	return visitor.UpdateDepositOfferAllowListTx(tx)
+}
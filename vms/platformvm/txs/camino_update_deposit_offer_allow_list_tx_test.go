@@ -0,0 +1,132 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestUpdateDepositOfferAllowListTxSyntacticVerify(t *testing.T) {
+	ctx := snow.DefaultContextTest()
+	ctx.AVAXAssetID = ids.ID{1}
+	owner1 := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{0, 0, 1}}}
+	depositTxID := ids.ID{0, 1}
+	offerID := ids.ID{1}
+	addr1 := ids.ShortID{1}
+	addr2 := ids.ShortID{2}
+
+	baseTx := BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+	}}
+
+	tests := map[string]struct {
+		tx          *UpdateDepositOfferAllowListTx
+		expectedErr error
+	}{
+		"Nil tx": {
+			expectedErr: ErrNilTx,
+		},
+		"Empty deposit offer id": {
+			tx: &UpdateDepositOfferAllowListTx{
+				BaseTx:         baseTx,
+				AddedAddresses: []ids.ShortID{addr1},
+			},
+			expectedErr: errEmptyDepositOfferID,
+		},
+		"No changes": {
+			tx: &UpdateDepositOfferAllowListTx{
+				BaseTx:         baseTx,
+				DepositOfferID: offerID,
+			},
+			expectedErr: errNoAllowListChanges,
+		},
+		"Added addresses not sorted": {
+			tx: &UpdateDepositOfferAllowListTx{
+				BaseTx:         baseTx,
+				DepositOfferID: offerID,
+				AddedAddresses: []ids.ShortID{addr2, addr1},
+			},
+			expectedErr: errAllowListAddressesNotSorted,
+		},
+		"Removed addresses not unique": {
+			tx: &UpdateDepositOfferAllowListTx{
+				BaseTx:           baseTx,
+				DepositOfferID:   offerID,
+				RemovedAddresses: []ids.ShortID{addr1, addr1},
+			},
+			expectedErr: errAllowListAddressesNotSorted,
+		},
+		"Address is added and removed": {
+			tx: &UpdateDepositOfferAllowListTx{
+				BaseTx:           baseTx,
+				DepositOfferID:   offerID,
+				AddedAddresses:   []ids.ShortID{addr1},
+				RemovedAddresses: []ids.ShortID{addr1, addr2},
+			},
+			expectedErr: errAllowListAddressesOverlap,
+		},
+		"Bad deposit offer owner auth": {
+			tx: &UpdateDepositOfferAllowListTx{
+				BaseTx:                baseTx,
+				DepositOfferID:        offerID,
+				AddedAddresses:        []ids.ShortID{addr1},
+				DepositOfferOwnerAuth: (*secp256k1fx.Input)(nil),
+			},
+			expectedErr: errBadDepositOfferOwnerAuth,
+		},
+		"Locked base tx input": {
+			tx: &UpdateDepositOfferAllowListTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestIn(ctx.AVAXAssetID, 1, depositTxID, ids.Empty, []uint32{0}),
+					},
+				}},
+				DepositOfferID:        offerID,
+				AddedAddresses:        []ids.ShortID{addr1},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: locked.ErrWrongInType,
+		},
+		"Locked base tx output": {
+			tx: &UpdateDepositOfferAllowListTx{
+				BaseTx: BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 1, owner1, depositTxID, ids.Empty),
+					},
+				}},
+				DepositOfferID:        offerID,
+				AddedAddresses:        []ids.ShortID{addr1},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			expectedErr: locked.ErrWrongOutType,
+		},
+		"OK": {
+			tx: &UpdateDepositOfferAllowListTx{
+				BaseTx:                baseTx,
+				DepositOfferID:        offerID,
+				AddedAddresses:        []ids.ShortID{addr1},
+				RemovedAddresses:      []ids.ShortID{addr2},
+				DepositOfferOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.tx.SyntacticVerify(ctx), tt.expectedErr)
+		})
+	}
+}
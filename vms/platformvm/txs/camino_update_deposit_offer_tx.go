@@ -0,0 +1,89 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+)
+
+var (
+	_ UnsignedTx = (*UpdateDepositOfferTx)(nil)
+
+	errEmptyDepositOfferID             = errors.New("deposit offer id is empty")
+	errEmptyDepositOfferUpdaterAddress = errors.New("deposit offer updater address is empty")
+	errBadDepositOfferUpdaterAuth      = errors.New("bad deposit offer updater auth")
+	errWrongDepositOfferFlags          = errors.New("wrong deposit offer flags")
+	errWrongDepositOfferLimits         = errors.New("can only use either TotalMaxAmount or TotalMaxRewardAmount")
+)
+
+// UpdateDepositOfferTx is an unsigned updateDepositOfferTx.
+// It replaces mutable fields of existing deposit offer with new values.
+type UpdateDepositOfferTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of deposit offer that will be updated
+	DepositOfferID ids.ID `serialize:"true" json:"depositOfferID"`
+	// New offer flags, only OfferFlagLocked could be set or unset
+	Flags deposit.OfferFlag `serialize:"true" json:"flags"`
+	// New offer end time, can't be after current offer end time
+	End uint64 `serialize:"true" json:"end"`
+	// New offer total max amount, can't be more than current one. Zero means no limit,
+	// limited offer can't be made unlimited and unlimited offer can't be limited
+	TotalMaxAmount uint64 `serialize:"true" json:"totalMaxAmount"`
+	// New offer total max reward amount, can't be more than current one. Zero means no limit,
+	// limited offer can't be made unlimited and unlimited offer can't be limited
+	TotalMaxRewardAmount uint64 `serialize:"true" json:"totalMaxRewardAmount"`
+	// Address that is either offer owner or has "offers admin" role
+	DepositOfferUpdaterAddress ids.ShortID `serialize:"true" json:"depositOfferUpdaterAddress"`
+	// Auth that will be used to verify credential for deposit offer updater
+	DepositOfferUpdaterAuth verify.Verifiable `serialize:"true" json:"depositOfferUpdaterAuth"`
+}
+
+// SyntacticVerify returns nil if [tx] is valid
+func (tx *UpdateDepositOfferTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified: // already passed syntactic verification
+		return nil
+	case tx.DepositOfferID == ids.Empty:
+		return errEmptyDepositOfferID
+	case tx.DepositOfferUpdaterAddress == ids.ShortEmpty:
+		return errEmptyDepositOfferUpdaterAddress
+	case tx.Flags&^deposit.OfferFlagLocked != 0:
+		return errWrongDepositOfferFlags
+	case tx.TotalMaxAmount != 0 && tx.TotalMaxRewardAmount != 0:
+		return errWrongDepositOfferLimits
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return fmt.Errorf("failed to verify BaseTx: %w", err)
+	}
+
+	if tx.DepositOfferUpdaterAuth == nil {
+		return errBadDepositOfferUpdaterAuth
+	}
+	if err := tx.DepositOfferUpdaterAuth.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadDepositOfferUpdaterAuth, err)
+	}
+
+	if err := locked.VerifyNoLocks(tx.Ins, tx.Outs); err != nil {
+		return err
+	}
+
+	// cache that this is valid
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *UpdateDepositOfferTx) Visit(visitor Visitor) error {
+	return visitor.UpdateDepositOfferTx(tx)
+}
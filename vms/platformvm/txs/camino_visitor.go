@@ -13,4 +13,13 @@ type CaminoVisitor interface {
 	BaseTx(*BaseTx) error
 	MultisigAliasTx(*MultisigAliasTx) error
 	AddDepositOfferTx(*AddDepositOfferTx) error
+	AddProposalTx(*AddProposalTx) error
+	AddVoteTx(*AddVoteTx) error
+	FinishProposalsTx(*FinishProposalsTx) error
+	UpdateDepositOfferTx(*UpdateDepositOfferTx) error
+	TransferDepositTx(*TransferDepositTx) error
+	TreasuryConfigTx(*TreasuryConfigTx) error
+	TreasurySpendTx(*TreasurySpendTx) error
+	ExtendValidatorTx(*ExtendValidatorTx) error
+	UpdateDepositOfferAllowListTx(*UpdateDepositOfferAllowListTx) error
 }
@@ -122,6 +122,16 @@ func RegisterUnsignedTxsTypes(targetCodec codec.CaminoRegistry) error {
 		targetCodec.RegisterCustomType(&multisig.AliasWithNonce{}),
 		targetCodec.RegisterCustomType(&secp256k1fx.CrossTransferOutput{}),
 		targetCodec.RegisterCustomType(&AddDepositOfferTx{}),
+		targetCodec.RegisterCustomType(&AddProposalTx{}),
+		targetCodec.RegisterCustomType(&AddVoteTx{}),
+		targetCodec.RegisterCustomType(&FinishProposalsTx{}),
+		targetCodec.RegisterCustomType(&UpdateDepositOfferTx{}),
+		targetCodec.RegisterCustomType(&TransferDepositTx{}),
+		targetCodec.RegisterCustomType(&secp256k1fx.WeightedOutputOwners{}),
+		targetCodec.RegisterCustomType(&TreasuryConfigTx{}),
+		targetCodec.RegisterCustomType(&TreasurySpendTx{}),
+		targetCodec.RegisterCustomType(&ExtendValidatorTx{}),
+		targetCodec.RegisterCustomType(&UpdateDepositOfferAllowListTx{}),
 	)
 	return errs.Err
 }
@@ -4,17 +4,22 @@
 package executor
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/nodeid"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/platformvm/api"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
@@ -316,6 +321,275 @@ func TestDeferredStakers(t *testing.T) {
 	}
 }
 
+func TestAdvanceTimeToKYCExpiration(t *testing.T) {
+	addr1 := ids.ShortID{1}
+	addr2 := ids.ShortID{2}
+	addr3 := ids.ShortID{3}
+	newChainTime := time.Unix(100, 0)
+	testErr := errors.New("test err")
+
+	autoChange := func(address ids.ShortID, bit txs.AddressStateBit, remove bool) *state.AddressStateChange {
+		return &state.AddressStateChange{
+			Address:   address,
+			TxID:      state.AutomaticAddressStateChangeID(bit),
+			Timestamp: uint64(newChainTime.Unix()),
+			Bit:       bit,
+			Remove:    remove,
+		}
+	}
+
+	tests := map[string]struct {
+		beforeBerlinPhase           bool
+		parentState                 func(*gomock.Controller) state.Chain
+		expectedChanges             map[ids.ShortID]txs.AddressState
+		expectedAddressStateChanges []*state.AddressStateChange
+		expectedErr                 error
+	}{
+		"No kyc expirations": {
+			parentState: func(c *gomock.Controller) state.Chain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetNextKYCExpirationAddressesAndTime(set.Set[ids.ShortID]{}).
+					Return(nil, time.Time{}, database.ErrNotFound)
+				s.EXPECT().GetNextNodeDeferralEndAddressesAndTime(set.Set[ids.ShortID]{}).
+					Return(nil, time.Time{}, database.ErrNotFound)
+				return s
+			},
+		},
+		"Before BerlinPhase": {
+			beforeBerlinPhase: true,
+			parentState: func(c *gomock.Controller) state.Chain {
+				return state.NewMockChain(c)
+			},
+		},
+		"Next kyc expiration is after new chain time": {
+			parentState: func(c *gomock.Controller) state.Chain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetNextKYCExpirationAddressesAndTime(set.Set[ids.ShortID]{}).
+					Return([]ids.ShortID{addr1}, newChainTime.Add(time.Second), nil)
+				s.EXPECT().GetNextNodeDeferralEndAddressesAndTime(set.Set[ids.ShortID]{}).
+					Return(nil, time.Time{}, database.ErrNotFound)
+				return s
+			},
+		},
+		"Fail to get next kyc expiration": {
+			parentState: func(c *gomock.Controller) state.Chain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetNextKYCExpirationAddressesAndTime(set.Set[ids.ShortID]{}).
+					Return(nil, time.Time{}, testErr)
+				return s
+			},
+			expectedErr: testErr,
+		},
+		"OK": {
+			parentState: func(c *gomock.Controller) state.Chain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetNextKYCExpirationAddressesAndTime(set.Set[ids.ShortID]{}).
+					Return([]ids.ShortID{addr1, addr2}, newChainTime.Add(-time.Second), nil)
+				s.EXPECT().GetAddressStates(addr1).
+					Return(txs.AddressStateKYCVerified|txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetAddressStates(addr2).
+					Return(txs.AddressStateKYCVerified, nil)
+				s.EXPECT().GetNextKYCExpirationAddressesAndTime(set.Set[ids.ShortID]{addr1: struct{}{}, addr2: struct{}{}}).
+					Return([]ids.ShortID{addr3}, newChainTime, nil)
+				s.EXPECT().GetAddressStates(addr3).
+					Return(txs.AddressStateKYCVerified|txs.AddressStateKYCExpired, nil)
+				s.EXPECT().GetNextKYCExpirationAddressesAndTime(set.Set[ids.ShortID]{addr1: struct{}{}, addr2: struct{}{}, addr3: struct{}{}}).
+					Return(nil, time.Time{}, database.ErrNotFound)
+				s.EXPECT().GetNextNodeDeferralEndAddressesAndTime(set.Set[ids.ShortID]{}).
+					Return(nil, time.Time{}, database.ErrNotFound)
+				return s
+			},
+			expectedChanges: map[ids.ShortID]txs.AddressState{
+				addr1: txs.AddressStateKYCExpired | txs.AddressStateConsortiumMember,
+				addr2: txs.AddressStateKYCExpired,
+				addr3: txs.AddressStateKYCExpired,
+			},
+			expectedAddressStateChanges: []*state.AddressStateChange{
+				autoChange(addr1, txs.AddressStateBitKYCVerified, true),
+				autoChange(addr1, txs.AddressStateBitKYCExpired, false),
+				autoChange(addr2, txs.AddressStateBitKYCVerified, true),
+				autoChange(addr2, txs.AddressStateBitKYCExpired, false),
+				autoChange(addr3, txs.AddressStateBitKYCVerified, true),
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cfg := &config.Config{}
+			if tt.beforeBerlinPhase {
+				cfg.BerlinPhaseTime = newChainTime.Add(time.Second)
+			}
+
+			changes := &stateChanges{}
+			err := caminoAdvanceTimeTo(&Backend{Config: cfg}, tt.parentState(ctrl), newChainTime, changes)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expectedChanges, changes.updatedAddressStates)
+			require.Equal(t, tt.expectedAddressStateChanges, changes.addressStateChanges)
+			require.Equal(t, len(tt.expectedChanges), changes.Len())
+
+			stateDiff := state.NewMockDiff(ctrl)
+			for address, states := range tt.expectedChanges {
+				stateDiff.EXPECT().SetAddressStates(address, states)
+				stateDiff.EXPECT().SetKYCExpiration(address, uint64(0))
+			}
+			for _, change := range tt.expectedAddressStateChanges {
+				stateDiff.EXPECT().AddAddressStateChange(change)
+			}
+			changes.caminoStateChanges.Apply(stateDiff)
+		})
+	}
+}
+
+func TestAdvanceTimeToNodeDeferralEnd(t *testing.T) {
+	addr1 := ids.ShortID{1}
+	addr2 := ids.ShortID{2}
+	addr3 := ids.ShortID{3}
+	nodeID1 := ids.NodeID{1}
+	nodeID2 := ids.NodeID{2}
+	validator1 := &state.Staker{TxID: ids.ID{1}, NodeID: nodeID1, SubnetID: constants.PrimaryNetworkID}
+	newChainTime := time.Unix(100, 0)
+	testErr := errors.New("test err")
+
+	autoChange := func(address ids.ShortID, bit txs.AddressStateBit, remove bool) *state.AddressStateChange {
+		return &state.AddressStateChange{
+			Address:   address,
+			TxID:      state.AutomaticAddressStateChangeID(bit),
+			Timestamp: uint64(newChainTime.Unix()),
+			Bit:       bit,
+			Remove:    remove,
+		}
+	}
+
+	tests := map[string]struct {
+		beforeBerlinPhase           bool
+		parentState                 func(*gomock.Controller) state.Chain
+		expectedAddressStates       map[ids.ShortID]txs.AddressState
+		expectedEndedAddresses      []ids.ShortID
+		expectedResumedValidators   []*state.Staker
+		expectedAddressStateChanges []*state.AddressStateChange
+		expectedErr                 error
+	}{
+		"Before BerlinPhase": {
+			beforeBerlinPhase: true,
+			parentState: func(c *gomock.Controller) state.Chain {
+				return state.NewMockChain(c)
+			},
+		},
+		"Next node deferral end is after new chain time": {
+			parentState: func(c *gomock.Controller) state.Chain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetNextKYCExpirationAddressesAndTime(set.Set[ids.ShortID]{}).
+					Return(nil, time.Time{}, database.ErrNotFound)
+				s.EXPECT().GetNextNodeDeferralEndAddressesAndTime(set.Set[ids.ShortID]{}).
+					Return([]ids.ShortID{addr1}, newChainTime.Add(time.Second), nil)
+				return s
+			},
+		},
+		"Fail to get next node deferral end": {
+			parentState: func(c *gomock.Controller) state.Chain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetNextKYCExpirationAddressesAndTime(set.Set[ids.ShortID]{}).
+					Return(nil, time.Time{}, database.ErrNotFound)
+				s.EXPECT().GetNextNodeDeferralEndAddressesAndTime(set.Set[ids.ShortID]{}).
+					Return(nil, time.Time{}, testErr)
+				return s
+			},
+			expectedErr: testErr,
+		},
+		"OK": {
+			parentState: func(c *gomock.Controller) state.Chain {
+				s := state.NewMockChain(c)
+				s.EXPECT().GetNextKYCExpirationAddressesAndTime(set.Set[ids.ShortID]{}).
+					Return([]ids.ShortID{addr1}, newChainTime, nil)
+				s.EXPECT().GetAddressStates(addr1).
+					Return(txs.AddressStateKYCVerified|txs.AddressStateConsortiumMember|txs.AddressStateNodeDeferred, nil)
+				s.EXPECT().GetNextKYCExpirationAddressesAndTime(set.Set[ids.ShortID]{addr1: struct{}{}}).
+					Return(nil, time.Time{}, database.ErrNotFound)
+				s.EXPECT().GetNextNodeDeferralEndAddressesAndTime(set.Set[ids.ShortID]{}).
+					Return([]ids.ShortID{addr1, addr2}, newChainTime.Add(-time.Second), nil)
+				s.EXPECT().GetShortIDLink(addr1, state.ShortLinkKeyRegisterNode).
+					Return(ids.ShortID(nodeID1), nil)
+				s.EXPECT().GetDeferredValidator(constants.PrimaryNetworkID, nodeID1).
+					Return(validator1, nil)
+				s.EXPECT().GetAddressStates(addr2).
+					Return(txs.AddressStateConsortiumMember|txs.AddressStateNodeDeferred, nil)
+				s.EXPECT().GetShortIDLink(addr2, state.ShortLinkKeyRegisterNode).
+					Return(ids.ShortID(nodeID2), nil)
+				s.EXPECT().GetDeferredValidator(constants.PrimaryNetworkID, nodeID2).
+					Return(nil, database.ErrNotFound)
+				s.EXPECT().GetNextNodeDeferralEndAddressesAndTime(set.Set[ids.ShortID]{addr1: struct{}{}, addr2: struct{}{}}).
+					Return([]ids.ShortID{addr3}, newChainTime, nil)
+				s.EXPECT().GetAddressStates(addr3).
+					Return(txs.AddressStateNodeDeferred, nil)
+				s.EXPECT().GetShortIDLink(addr3, state.ShortLinkKeyRegisterNode).
+					Return(ids.ShortEmpty, database.ErrNotFound)
+				s.EXPECT().GetNextNodeDeferralEndAddressesAndTime(set.Set[ids.ShortID]{addr1: struct{}{}, addr2: struct{}{}, addr3: struct{}{}}).
+					Return(nil, time.Time{}, database.ErrNotFound)
+				return s
+			},
+			expectedAddressStates: map[ids.ShortID]txs.AddressState{
+				addr1: txs.AddressStateKYCExpired | txs.AddressStateConsortiumMember,
+				addr2: txs.AddressStateConsortiumMember,
+				addr3: 0,
+			},
+			expectedEndedAddresses:    []ids.ShortID{addr1, addr2, addr3},
+			expectedResumedValidators: []*state.Staker{validator1},
+			expectedAddressStateChanges: []*state.AddressStateChange{
+				autoChange(addr1, txs.AddressStateBitKYCVerified, true),
+				autoChange(addr1, txs.AddressStateBitKYCExpired, false),
+				autoChange(addr1, txs.AddressStateBitNodeDeferred, true),
+				autoChange(addr2, txs.AddressStateBitNodeDeferred, true),
+				autoChange(addr3, txs.AddressStateBitNodeDeferred, true),
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cfg := &config.Config{}
+			if tt.beforeBerlinPhase {
+				cfg.BerlinPhaseTime = newChainTime.Add(time.Second)
+			}
+
+			changes := &stateChanges{}
+			err := caminoAdvanceTimeTo(&Backend{Config: cfg}, tt.parentState(ctrl), newChainTime, changes)
+			require.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+			require.Equal(t, tt.expectedAddressStates, changes.updatedAddressStates)
+			require.Equal(t, tt.expectedEndedAddresses, changes.nodeDeferralEndedAddresses)
+			require.Equal(t, tt.expectedResumedValidators, changes.resumedValidators)
+			require.Equal(t, tt.expectedAddressStateChanges, changes.addressStateChanges)
+			require.Equal(t, len(tt.expectedAddressStates)+len(tt.expectedResumedValidators), changes.Len())
+
+			stateDiff := state.NewMockDiff(ctrl)
+			for address, states := range tt.expectedAddressStates {
+				stateDiff.EXPECT().SetAddressStates(address, states)
+			}
+			for _, address := range changes.kycExpiredAddresses {
+				stateDiff.EXPECT().SetKYCExpiration(address, uint64(0))
+			}
+			for _, address := range tt.expectedEndedAddresses {
+				stateDiff.EXPECT().SetNodeDeferral(address, nil)
+			}
+			for _, validator := range tt.expectedResumedValidators {
+				stateDiff.EXPECT().DeleteDeferredValidator(validator)
+				stateDiff.EXPECT().PutCurrentValidator(validator)
+			}
+			for _, change := range tt.expectedAddressStateChanges {
+				stateDiff.EXPECT().AddAddressStateChange(change)
+			}
+			changes.caminoStateChanges.Apply(stateDiff)
+		})
+	}
+}
+
 func addCaminoPendingValidator(
 	env *caminoEnvironment,
 	startTime time.Time,
@@ -11,7 +11,7 @@ import (
 )
 
 // GetNextChainEventTime returns the next chain event time
-// For example: stakers set changed, deposit expired
+// For example: stakers set changed, deposit expired, proposal voting ended, kyc verification expired, node deferral ended
 func GetNextChainEventTime(state state.Chain, stakerChangeTime time.Time) (time.Time, error) {
 	earliestTime := stakerChangeTime
 	nextDeferredStakerEndTime, err := getNextDeferredStakerEndTime(state)
@@ -32,6 +32,33 @@ func GetNextChainEventTime(state state.Chain, stakerChangeTime time.Time) (time.
 		earliestTime = depositUnlockTime
 	}
 
+	proposalExpirationTime, err := state.GetNextProposalExpirationTime(nil)
+	if err != nil && err != database.ErrNotFound {
+		return time.Time{}, err
+	}
+
+	if err != database.ErrNotFound && proposalExpirationTime.Before(earliestTime) {
+		earliestTime = proposalExpirationTime
+	}
+
+	_, kycExpirationTime, err := state.GetNextKYCExpirationAddressesAndTime(nil)
+	if err != nil && err != database.ErrNotFound {
+		return time.Time{}, err
+	}
+
+	if err != database.ErrNotFound && kycExpirationTime.Before(earliestTime) {
+		earliestTime = kycExpirationTime
+	}
+
+	_, nodeDeferralEndTime, err := state.GetNextNodeDeferralEndAddressesAndTime(nil)
+	if err != nil && err != database.ErrNotFound {
+		return time.Time{}, err
+	}
+
+	if err != database.ErrNotFound && nodeDeferralEndTime.Before(earliestTime) {
+		earliestTime = nodeDeferralEndTime
+	}
+
 	return earliestTime, nil
 }
 
@@ -6,23 +6,158 @@ package executor
 import (
 	"time"
 
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
 
-type caminoStateChanges struct{}
+type caminoStateChanges struct {
+	// address -> new address states of addresses, which kyc verification expired or node deferral ended
+	updatedAddressStates map[ids.ShortID]txs.AddressState
+	// addresses, which kyc verification expired
+	kycExpiredAddresses []ids.ShortID
+	// consortium member addresses, which node deferral ended
+	nodeDeferralEndedAddresses []ids.ShortID
+	// deferred validators, which will be moved back to current stakers set
+	resumedValidators []*state.Staker
+	// address state bit changes caused by kyc expiration or node deferral end
+	addressStateChanges []*state.AddressStateChange
+}
 
-func (*caminoStateChanges) Apply(_ state.Diff) {
+func (cs *caminoStateChanges) Apply(stateDiff state.Diff) {
+	for address, states := range cs.updatedAddressStates {
+		stateDiff.SetAddressStates(address, states)
+	}
+	for _, address := range cs.kycExpiredAddresses {
+		stateDiff.SetKYCExpiration(address, 0)
+	}
+	for _, address := range cs.nodeDeferralEndedAddresses {
+		stateDiff.SetNodeDeferral(address, nil)
+	}
+	for _, validator := range cs.resumedValidators {
+		stateDiff.DeleteDeferredValidator(validator)
+		stateDiff.PutCurrentValidator(validator)
+	}
+	for _, change := range cs.addressStateChanges {
+		stateDiff.AddAddressStateChange(change)
+	}
 }
 
-func (*caminoStateChanges) Len() int {
-	return 0
+func (cs *caminoStateChanges) Len() int {
+	return len(cs.updatedAddressStates) + len(cs.resumedValidators)
 }
 
 func caminoAdvanceTimeTo(
-	_ *Backend,
-	_ state.Chain,
-	_ time.Time,
-	_ *stateChanges,
+	backend *Backend,
+	parentState state.Chain,
+	newChainTime time.Time,
+	changes *stateChanges,
 ) error {
+	// kyc expiration and node deferral end were introduced with BerlinPhase
+	if !backend.Config.IsBerlinPhaseActivated(newChainTime) {
+		return nil
+	}
+
+	// Replace kyc verified state with kyc expired state for addresses,
+	// which kyc verification expires not later than new chain time
+	expiredAddresses := set.Set[ids.ShortID]{}
+	for {
+		addresses, expirationTime, err := parentState.GetNextKYCExpirationAddressesAndTime(expiredAddresses)
+		if err == database.ErrNotFound {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if expirationTime.After(newChainTime) {
+			break
+		}
+
+		for _, address := range addresses {
+			states, err := changes.getAddressStates(parentState, address)
+			if err != nil {
+				return err
+			}
+			changes.updatedAddressStates[address] = states&^txs.AddressStateKYCVerified | txs.AddressStateKYCExpired
+			if states&txs.AddressStateKYCVerified != 0 {
+				changes.addAddressStateChange(address, newChainTime, txs.AddressStateBitKYCVerified, true)
+			}
+			if states&txs.AddressStateKYCExpired == 0 {
+				changes.addAddressStateChange(address, newChainTime, txs.AddressStateBitKYCExpired, false)
+			}
+			changes.kycExpiredAddresses = append(changes.kycExpiredAddresses, address)
+			expiredAddresses.Add(address)
+		}
+	}
+
+	// Resume deferred nodes of consortium members,
+	// which node deferral ends not later than new chain time
+	deferralEndedAddresses := set.Set[ids.ShortID]{}
+	for {
+		addresses, deferralEndTime, err := parentState.GetNextNodeDeferralEndAddressesAndTime(deferralEndedAddresses)
+		if err == database.ErrNotFound {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if deferralEndTime.After(newChainTime) {
+			break
+		}
+
+		for _, address := range addresses {
+			states, err := changes.getAddressStates(parentState, address)
+			if err != nil {
+				return err
+			}
+			changes.updatedAddressStates[address] = states &^ txs.AddressStateNodeDeferred
+			if states&txs.AddressStateNodeDeferred != 0 {
+				changes.addAddressStateChange(address, newChainTime, txs.AddressStateBitNodeDeferred, true)
+			}
+			changes.nodeDeferralEndedAddresses = append(changes.nodeDeferralEndedAddresses, address)
+			deferralEndedAddresses.Add(address)
+
+			nodeShortID, err := parentState.GetShortIDLink(address, state.ShortLinkKeyRegisterNode)
+			if err == database.ErrNotFound {
+				continue
+			} else if err != nil {
+				return err
+			}
+
+			validator, err := parentState.GetDeferredValidator(constants.PrimaryNetworkID, ids.NodeID(nodeShortID))
+			if err == database.ErrNotFound {
+				continue
+			} else if err != nil {
+				return err
+			}
+			changes.resumedValidators = append(changes.resumedValidators, validator)
+		}
+	}
+
 	return nil
 }
+
+// Returns address states of [address] updated by previous changes or from [parentState]
+func (cs *caminoStateChanges) getAddressStates(parentState state.Chain, address ids.ShortID) (txs.AddressState, error) {
+	if states, ok := cs.updatedAddressStates[address]; ok {
+		return states, nil
+	}
+	if cs.updatedAddressStates == nil {
+		cs.updatedAddressStates = make(map[ids.ShortID]txs.AddressState)
+	}
+	return parentState.GetAddressStates(address)
+}
+
+// Records automatic change of address state [bit] made at [chainTime]
+func (cs *caminoStateChanges) addAddressStateChange(address ids.ShortID, chainTime time.Time, bit txs.AddressStateBit, remove bool) {
+	cs.addressStateChanges = append(cs.addressStateChanges, &state.AddressStateChange{
+		Address:   address,
+		TxID:      state.AutomaticAddressStateChangeID(bit),
+		Timestamp: uint64(chainTime.Unix()),
+		Bit:       bit,
+		Remove:    remove,
+	})
+}
@@ -4,19 +4,26 @@
 package executor
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
@@ -62,22 +69,66 @@ var (
 	errImportedUTXOMismatch              = errors.New("imported input doesn't match expected utxo")
 	errInputAmountMismatch               = errors.New("utxo amount doesn't match input amount")
 	errInputsUTXOSMismatch               = errors.New("number of inputs is different from number of utxos")
+	errTooManyImportedInputs             = errors.New("too many imported inputs")
+	errImportedUTXOTooNew                = errors.New("imported utxo isn't old enough to be imported")
 	errWrongClaimedAmount                = errors.New("claiming more than was available to claim")
 	errNoUnlock                          = errors.New("no tokens unlocked")
 	errAliasCredentialMismatch           = errors.New("alias credential isn't matching")
 	errAliasNotFound                     = errors.New("alias not found on state")
 	errUnlockedMoreThanAvailable         = errors.New("unlocked more deposited tokens than was available for unlock")
+	errEarlyUnlockNotFull                = errors.New("early unlock must unlock whole remaining deposit amount")
+	errWrongEarlyUnlockPenalty           = errors.New("wrong early unlock penalty amount")
+	errMultipleRolloverDeposits          = errors.New("tx unlocks more than one expired rollover deposit")
+	errWrongRolloverAmount               = errors.New("wrong amount re-deposited by rollover")
 	errMixedDeposits                     = errors.New("tx has expired deposit input and active-deposit/unlocked input")
 	errExpiredDepositNotFullyUnlocked    = errors.New("unlocked only part of expired deposit")
 	errBurnedDepositUnlock               = errors.New("burned undeposited tokens")
 	errAdminCannotBeDeleted              = errors.New("admin cannot be deleted")
 	errNotAthensPhase                    = errors.New("not allowed before AthensPhase")
+	errNotBerlinPhase                    = errors.New("not allowed before BerlinPhase")
+	errKYCExpirationInPast               = errors.New("kyc expiration must be after chain time")
+	errNodeDeferralEndInPast             = errors.New("node deferral end must be after chain time")
 	errOfferCreatorCredentialMismatch    = errors.New("offer creator credential isn't matching")
 	errNotOfferCreator                   = errors.New("address isn't allowed to create deposit offers")
 	errDepositCreatorCredentialMismatch  = errors.New("deposit creator credential isn't matching")
 	errOfferPermissionCredentialMismatch = errors.New("offer-usage permission credential isn't matching")
-	errEmptyDepositCreatorAddress        = errors.New("empty deposit creator address, while offer owner isn't empty")
+	errEmptyDepositCreatorAddress        = errors.New("empty deposit creator address, while offer owner or eligibility rules aren't empty")
+	errDepositCreatorNotEligible         = errors.New("deposit creator isn't eligible for this offer")
+	errOfferWithoutAllowList             = errors.New("offer doesn't have allow-list")
+	errOfferOwnerCredentialMismatch      = errors.New("offer owner credential isn't matching")
 	errWrongTxUpgradeVersion             = errors.New("wrong tx upgrade version")
+	errProposerCredentialMismatch        = errors.New("proposer credential isn't matching")
+	errWrongProposalBondAmount           = errors.New("wrong proposal bond amount")
+	errProposalStartTooEarly             = errors.New("proposal start time is too early")
+	errProposalNotFound                  = errors.New("proposal not found")
+	errVoterCredentialMismatch           = errors.New("voter credential isn't matching")
+	errFinishProposalsTooEarly           = errors.New("attempting to finish proposals before their end time")
+	errDepositOfferNotFound              = errors.New("deposit offer not found")
+	errNotOfferOwnerOrAdmin              = errors.New("address isn't offer owner or offers admin")
+	errOfferUpdaterCredentialMismatch    = errors.New("offer updater credential isn't matching")
+	errOfferEndExtended                  = errors.New("new offer end time is after current offer end time")
+	errOfferEndInPast                    = errors.New("new offer end time is before current chain time")
+	errOfferLimitIncreased               = errors.New("new offer limit is greater than current offer limit")
+	errOfferLimitBelowUsed               = errors.New("new offer limit is less than already used amount")
+	errUnlimitedOfferLimited             = errors.New("unlimited offer can't be limited")
+	errBadUpdatedOffer                   = errors.New("updated offer is invalid")
+	errDepositExpired                    = errors.New("deposit is expired")
+	errRewardOwnerCredentialMismatch     = errors.New("reward owner credential isn't matching")
+	errTransferredUTXOMismatch           = errors.New("transferred input doesn't match deposited utxo")
+	errWrongTransferredAmount            = errors.New("transferred deposited amount isn't matching produced amount")
+	errNothingTransferred                = errors.New("neither deposited utxos nor reward owner are transferred")
+	errExecutorCredentialMismatch        = errors.New("executor credential isn't matching")
+	errTreasuryNotConfigured             = errors.New("treasury isn't configured")
+	errTreasuryCredentialMismatch        = errors.New("treasury owner credential isn't matching")
+	errNotTreasuryUTXO                   = errors.New("treasury input doesn't spend treasury utxo")
+	errTreasurySpendingCapExceeded       = errors.New("treasury spending cap exceeded")
+	errWrongValidatorTxID                = errors.New("validator tx id doesn't match current validator")
+	errValidatorStartTimeChanged         = errors.New("validator start time doesn't match current validator")
+	errValidatorShortened                = errors.New("validator end time is before current validator end time")
+	errValidatorWeightDecreased          = errors.New("validator weight is less than current validator weight")
+	errValidatorNotExtended              = errors.New("neither validator end time nor weight is increased")
+	errWrongBondedAmount                 = errors.New("newly bonded amount doesn't match validator weight increase")
+	errRewardsOwnerChanged               = errors.New("rewards owner doesn't match current validator rewards owner")
 )
 
 type CaminoStandardTxExecutor struct {
@@ -478,7 +529,12 @@ func (e *CaminoProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx)
 		return errWrongCredentialsNumber
 	}
 
-	ins, outs, err := e.FlowChecker.Unlock(e.OnCommitState, []ids.ID{tx.TxID}, locked.StateBonded)
+	bondTxIDs, err := state.GetValidatorBondTxIDs(e.OnCommitState, tx.TxID)
+	if err != nil {
+		return err
+	}
+
+	ins, outs, err := e.FlowChecker.Unlock(e.OnCommitState, bondTxIDs, locked.StateBonded)
 	if err != nil {
 		return err
 	}
@@ -578,6 +634,7 @@ func (e *CaminoProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx)
 			return err
 		}
 		e.OnCommitState.SetAddressStates(nodeOwnerAddressOnCommit, nodeOwnerAddressStateOnCommit&^txs.AddressStateNodeDeferred)
+		e.OnCommitState.SetNodeDeferral(nodeOwnerAddressOnCommit, nil)
 
 		// Reset deferred bit on node owner address for onAbortState
 		nodeOwnerAddressOnAbort, err := e.OnAbortState.GetShortIDLink(
@@ -592,6 +649,7 @@ func (e *CaminoProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx)
 			return err
 		}
 		e.OnCommitState.SetAddressStates(nodeOwnerAddressOnAbort, nodeOwnerAddressStateOnAbort&^txs.AddressStateNodeDeferred)
+		e.OnAbortState.SetNodeDeferral(nodeOwnerAddressOnAbort, nil)
 	}
 
 	txID := e.Tx.ID()
@@ -631,40 +689,32 @@ func (e *CaminoStandardTxExecutor) DepositTx(tx *txs.DepositTx) error {
 	chainTime := e.State.GetTimestamp()
 	athensPhase := e.Config.IsAthensPhaseActivated(chainTime)
 
-	switch {
-	case !depositOffer.IsActiveAt(uint64(chainTime.Unix())):
-		return errDepositOfferInactive
-	case tx.DepositDuration < depositOffer.MinDuration:
-		return errDepositDurationTooSmall
-	case tx.DepositDuration > depositOffer.MaxDuration:
-		return errDepositDurationTooBig
-	case depositAmount < depositOffer.MinAmount:
-		return errDepositTooSmall
-	case depositOffer.TotalMaxAmount > 0 && depositAmount > depositOffer.RemainingAmount():
-		return errDepositTooBig
-	case !athensPhase && depositOffer.TotalMaxRewardAmount > 0:
-		return errNotAthensPhase
-	}
-
-	deposit := &deposits.Deposit{
-		DepositOfferID: tx.DepositOfferID,
-		Duration:       tx.DepositDuration,
-		Amount:         depositAmount,
-		Start:          uint64(chainTime.Unix()),
-		RewardOwner:    tx.RewardsOwner,
+	// deposit flags were introduced with BerlinPhase
+	if tx.UpgradeVersionID.Version() >= codec.UpgradeVersion2.Version() && !e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
 	}
-	potentialReward := deposit.TotalReward(depositOffer)
 
-	if depositOffer.TotalMaxRewardAmount > 0 && potentialReward > depositOffer.RemainingReward() {
-		return errDepositTooBig
+	deposit := NewDeposit(tx.DepositOfferID, depositAmount, tx.DepositDuration, tx.DepositFlags, tx.RewardsOwner, chainTime)
+	potentialReward, err := VerifyNewDeposit(e.Config, chainTime, depositOffer, deposit)
+	if err != nil {
+		return err
 	}
 
+	// Offers with owner require offer owner permission for deposit creator,
+	// offers with eligibility rules require deposit creator to satisfy them.
+	// Both are required, if offer has owner and eligibility rules.
 	baseTxCreds := e.Tx.Creds
-	if depositOffer.OwnerAddress != ids.ShortEmpty {
+	hasOwner := depositOffer.OwnerAddress != ids.ShortEmpty
+	if hasOwner || depositOffer.HasEligibilityRules() {
 		if !athensPhase {
 			return errNotAthensPhase
 		}
 
+		// eligibility rules were introduced with BerlinPhase
+		if depositOffer.HasEligibilityRules() && !e.Config.IsBerlinPhaseActivated(chainTime) {
+			return errNotBerlinPhase
+		}
+
 		if tx.UpgradeVersionID.Version() == 0 {
 			return errWrongTxUpgradeVersion
 		}
@@ -673,27 +723,34 @@ func (e *CaminoStandardTxExecutor) DepositTx(tx *txs.DepositTx) error {
 			return errEmptyDepositCreatorAddress
 		}
 
-		if len(e.Tx.Creds) < 3 {
+		depositCreatorCredIndex := len(e.Tx.Creds) - 1
+		if hasOwner {
+			depositCreatorCredIndex--
+		}
+
+		if depositCreatorCredIndex < 1 {
 			return errWrongCredentialsNumber
 		}
 
-		if err := e.Fx.VerifyMultisigMessage(
-			depositOffer.PermissionMsg(tx.DepositCreatorAddress),
-			tx.DepositOfferOwnerAuth,
-			e.Tx.Creds[len(e.Tx.Creds)-1], // offer usage permission credential created by offer owner
-			&secp256k1fx.OutputOwners{
-				Threshold: 1,
-				Addrs:     []ids.ShortID{depositOffer.OwnerAddress},
-			},
-			e.State,
-		); err != nil {
-			return fmt.Errorf("%w: %s", errOfferPermissionCredentialMismatch, err)
+		if hasOwner {
+			if err := e.Fx.VerifyMultisigMessage(
+				depositOffer.PermissionMsg(tx.DepositCreatorAddress),
+				tx.DepositOfferOwnerAuth,
+				e.Tx.Creds[len(e.Tx.Creds)-1], // offer usage permission credential created by offer owner
+				&secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{depositOffer.OwnerAddress},
+				},
+				e.State,
+			); err != nil {
+				return fmt.Errorf("%w: %s", errOfferPermissionCredentialMismatch, err)
+			}
 		}
 
 		if err := e.Fx.VerifyMultisigPermission(
 			tx,
 			tx.DepositCreatorAuth,
-			e.Tx.Creds[len(e.Tx.Creds)-2], // deposit creator credential
+			e.Tx.Creds[depositCreatorCredIndex], // deposit creator credential
 			&secp256k1fx.OutputOwners{
 				Threshold: 1,
 				Addrs:     []ids.ShortID{tx.DepositCreatorAddress},
@@ -703,7 +760,11 @@ func (e *CaminoStandardTxExecutor) DepositTx(tx *txs.DepositTx) error {
 			return fmt.Errorf("%w: %s", errDepositCreatorCredentialMismatch, err)
 		}
 
-		baseTxCreds = e.Tx.Creds[:len(e.Tx.Creds)-2]
+		if err := VerifyDepositCreatorEligibility(e.State, depositOffer, tx.DepositCreatorAddress); err != nil {
+			return err
+		}
+
+		baseTxCreds = e.Tx.Creds[:depositCreatorCredIndex]
 	}
 
 	rewardOwner, ok := tx.RewardsOwner.(*secp256k1fx.OutputOwners)
@@ -735,16 +796,11 @@ func (e *CaminoStandardTxExecutor) DepositTx(tx *txs.DepositTx) error {
 
 	txID := e.Tx.ID()
 
-	currentSupply, err := e.State.GetCurrentSupply(constants.PrimaryNetworkID)
+	currentSupply, newSupply, err := VerifyDepositRewardSupply(e.Config, e.State, potentialReward)
 	if err != nil {
 		return err
 	}
 
-	newSupply, err := math.Add64(currentSupply, potentialReward)
-	if err != nil || newSupply > e.Config.RewardConfig.SupplyCap {
-		return errSupplyOverflow
-	}
-
 	if depositOffer.TotalMaxAmount > 0 {
 		updatedOffer := *depositOffer
 		updatedOffer.DepositedAmount += depositAmount
@@ -782,12 +838,22 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 		return err
 	}
 
-	chainTimestamp := uint64(e.State.GetTimestamp().Unix())
+	chainTime := e.State.GetTimestamp()
+	berlinPhase := e.Config.IsBerlinPhaseActivated(chainTime)
+
+	// early unlock penalty outputs were introduced with BerlinPhase
+	if !berlinPhase && tx.UpgradeVersionID.Version() >= codec.UpgradeVersion1.Version() {
+		return errNotBerlinPhase
+	}
+
+	chainTimestamp := uint64(chainTime.Unix())
 	consumedDepositedAmounts := make(map[ids.ID]uint64)
 	producedDepositedAmounts := make(map[ids.ID]uint64)
 	hasExpiredDeposits := false
 	hasActiveDepositsOrUnlockedIns := false
 	consumed := uint64(0)
+	rolloverDepositTxID := ids.Empty
+	var rolloverDeposit *deposits.Deposit
 
 	for _, input := range tx.Ins {
 		lockedIn, ok := input.In.(*locked.In)
@@ -807,6 +873,14 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 
 				hasExpiredDeposits = isExpired
 				hasActiveDepositsOrUnlockedIns = !isExpired
+
+				if isExpired && berlinPhase && deposit.IsRollover() {
+					if rolloverDeposit != nil {
+						return errMultipleRolloverDeposits
+					}
+					rolloverDepositTxID = lockedIn.DepositTxID
+					rolloverDeposit = deposit
+				}
 			}
 
 			consumedDepositedAmounts[lockedIn.DepositTxID], err = math.Add64(consumedDepositedAmounts[lockedIn.DepositTxID], lockedIn.Amount())
@@ -825,8 +899,34 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 		}
 	}
 
+	// expired rollover deposit is re-deposited by this tx, if its offer allows it
+	var (
+		newDeposit       *deposits.Deposit
+		newDepositOffer  *deposits.Offer
+		rolledOverReward uint64
+		rewardOwner      *secp256k1fx.OutputOwners
+	)
+	if rolloverDeposit != nil {
+		newDeposit, newDepositOffer, rolledOverReward, err = e.depositRollover(rolloverDeposit, chainTimestamp)
+		if err != nil {
+			return err
+		}
+		if rolledOverReward > 0 {
+			var ok bool
+			if rewardOwner, ok = rolloverDeposit.RewardOwner.(*secp256k1fx.OutputOwners); !ok {
+				return errWrongOwnerType
+			}
+		}
+	}
+
 	produced := uint64(0)
-	for _, output := range tx.Outs {
+	paidPenalty := uint64(0)
+	remainingRolledOverReward := rolledOverReward
+	penaltyOutIndices := set.NewSet[uint32](len(tx.PenaltyOutputIndices))
+	penaltyOutIndices.Add(tx.PenaltyOutputIndices...)
+	// outs without early unlock penalty outs and rolled over reward, they are checked separately
+	outs := make([]*avax.TransferableOutput, 0, len(tx.Outs))
+	for i, output := range tx.Outs {
 		if lockedOut, ok := output.Out.(*locked.Out); ok && lockedOut.DepositTxID != ids.Empty {
 			producedDepositedAmounts[lockedOut.DepositTxID], err = math.Add64(producedDepositedAmounts[lockedOut.DepositTxID], lockedOut.Amount())
 			if err != nil {
@@ -837,9 +937,63 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 		if err != nil {
 			return err
 		}
+		if penaltyOutIndices.Contains(uint32(i)) {
+			out, ok := output.Out.(*secp256k1fx.TransferOutput)
+			if !ok || !out.OutputOwners.Equals(treasury.Owner) || output.AssetID() != e.Ctx.AVAXAssetID {
+				return fmt.Errorf("%w: penalty output %d isn't unlocked avax output owned by treasury",
+					errWrongEarlyUnlockPenalty, i)
+			}
+			paidPenalty, err = math.Add64(paidPenalty, out.Amount())
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if remainingRolledOverReward > 0 {
+			// rolled over reward isn't consumed by this tx, so it's excluded from flow check
+			if lockedOut, ok := output.Out.(*locked.Out); ok &&
+				lockedOut.DepositTxID == locked.ThisTxID && lockedOut.BondTxID == ids.Empty {
+				if innerOut, ok := lockedOut.TransferableOut.(*secp256k1fx.TransferOutput); ok &&
+					innerOut.OutputOwners.Equals(rewardOwner) {
+					rewardAmount := math.Min(remainingRolledOverReward, innerOut.Amt)
+					remainingRolledOverReward -= rewardAmount
+					if rewardAmount == innerOut.Amt {
+						continue
+					}
+					output = &avax.TransferableOutput{
+						Asset: output.Asset,
+						Out: &locked.Out{
+							IDs: lockedOut.IDs,
+							TransferableOut: &secp256k1fx.TransferOutput{
+								Amt:          innerOut.Amt - rewardAmount,
+								OutputOwners: innerOut.OutputOwners,
+							},
+						},
+					}
+				}
+			}
+		}
+		outs = append(outs, output)
+	}
+
+	if remainingRolledOverReward > 0 {
+		return errWrongRolloverAmount
+	}
+
+	newDepositAmount := uint64(0)
+	if newDeposit != nil {
+		newDepositAmount = newDeposit.Amount
+	}
+	if producedDepositedAmounts[locked.ThisTxID] != newDepositAmount {
+		return errWrongRolloverAmount
+	}
+
+	consumedWithReward, err := math.Add64(consumed, rolledOverReward)
+	if err != nil {
+		return err
 	}
 
-	if hasExpiredDeposits && consumed != produced {
+	if hasExpiredDeposits && consumedWithReward != produced {
 		return errBurnedDepositUnlock
 	}
 
@@ -852,7 +1006,7 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 		e.State,
 		tx,
 		tx.Ins,
-		tx.Outs,
+		outs,
 		e.Tx.Creds,
 		amountToBurn,
 		e.Ctx.AVAXAssetID,
@@ -861,6 +1015,16 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
 	}
 
+	// penalty outs aren't checked by flow checker, so we must ensure that
+	// they are paid from deposited tokens that weren't produced back
+	if producedWithFee, err := math.Add64(produced, amountToBurn); err != nil || producedWithFee > consumedWithReward {
+		return errFlowCheckFailed
+	}
+
+	earlyUnlockPenalty := uint64(0)
+	forfeitedReward := uint64(0)
+	offersWithForfeitedReward := map[ids.ID]*deposits.Offer{}
+
 	for depositTxID, consumedDepositedAmount := range consumedDepositedAmounts {
 		deposit, err := e.State.GetDeposit(depositTxID)
 		if err != nil {
@@ -887,7 +1051,12 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 				return err
 			}
 
-			if remainingReward := deposit.TotalReward(offer) - deposit.ClaimedRewardAmount; remainingReward > 0 {
+			remainingReward := deposit.TotalReward(offer) - deposit.ClaimedRewardAmount
+			if depositTxID == rolloverDepositTxID {
+				remainingReward -= rolledOverReward
+			}
+
+			if remainingReward > 0 {
 				claimableOwnerID, err := txs.GetOwnerID(deposit.RewardOwner)
 				if err != nil {
 					return err
@@ -926,10 +1095,44 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 			}
 
 			if unlockableAmount := deposit.UnlockableAmount(offer, chainTimestamp); unlockableAmount < newTotalUnlockedAmount {
-				return errUnlockedMoreThanAvailable
+				if !berlinPhase || !offer.AllowsEarlyUnlock() {
+					return errUnlockedMoreThanAvailable
+				}
+
+				// early unlock: whole deposit must be unlocked, penalty is paid to treasury
+				// and remaining not claimed rewards are forfeited
+				if newTotalUnlockedAmount != deposit.Amount {
+					return errEarlyUnlockNotFull
+				}
+
+				earlyUnlockPenalty, err = math.Add64(earlyUnlockPenalty, deposit.EarlyUnlockPenalty(offer, chainTimestamp))
+				if err != nil {
+					return err
+				}
+
+				depositForfeitedReward := deposit.TotalReward(offer) - deposit.ClaimedRewardAmount
+				forfeitedReward, err = math.Add64(forfeitedReward, depositForfeitedReward)
+				if err != nil {
+					return err
+				}
+
+				// forfeited reward won't be issued, so it's not counted toward offer reward limit anymore
+				if offer.TotalMaxRewardAmount > 0 && depositForfeitedReward > 0 {
+					updatedOffer, ok := offersWithForfeitedReward[offer.ID]
+					if !ok {
+						offerCopy := *offer
+						updatedOffer = &offerCopy
+						offersWithForfeitedReward[offer.ID] = updatedOffer
+					}
+					updatedOffer.RewardedAmount -= math.Min(updatedOffer.RewardedAmount, depositForfeitedReward)
+				}
+
+				e.State.RemoveDeposit(depositTxID, deposit)
+				continue
 			}
 
 			e.State.ModifyDeposit(depositTxID, &deposits.Deposit{
+				UpgradeVersionID:    deposit.UpgradeVersionID,
 				DepositOfferID:      deposit.DepositOfferID,
 				UnlockedAmount:      newTotalUnlockedAmount,
 				ClaimedRewardAmount: deposit.ClaimedRewardAmount,
@@ -937,18 +1140,102 @@ func (e *CaminoStandardTxExecutor) UnlockDepositTx(tx *txs.UnlockDepositTx) erro
 				Start:               deposit.Start,
 				Duration:            deposit.Duration,
 				RewardOwner:         deposit.RewardOwner,
+				Flags:               deposit.Flags,
 			})
 		}
 	}
 
+	if paidPenalty != earlyUnlockPenalty {
+		return fmt.Errorf("%w: expected %d, but got %d", errWrongEarlyUnlockPenalty, earlyUnlockPenalty, paidPenalty)
+	}
+
 	txID := e.Tx.ID()
 
+	if forfeitedReward > 0 {
+		currentSupply, err := e.State.GetCurrentSupply(constants.PrimaryNetworkID)
+		if err != nil {
+			return err
+		}
+		e.State.SetCurrentSupply(constants.PrimaryNetworkID, currentSupply-forfeitedReward)
+	}
+
+	for _, updatedOffer := range offersWithForfeitedReward {
+		e.State.SetDepositOffer(updatedOffer)
+	}
+
+	if newDeposit != nil {
+		potentialReward := newDeposit.TotalReward(newDepositOffer)
+
+		if newDepositOffer.TotalMaxAmount > 0 {
+			updatedOffer := *newDepositOffer
+			updatedOffer.DepositedAmount += newDeposit.Amount
+			e.State.SetDepositOffer(&updatedOffer)
+		} else if newDepositOffer.TotalMaxRewardAmount > 0 {
+			updatedOffer := *newDepositOffer
+			updatedOffer.RewardedAmount += potentialReward
+			e.State.SetDepositOffer(&updatedOffer)
+		}
+
+		if potentialReward > 0 {
+			currentSupply, err := e.State.GetCurrentSupply(constants.PrimaryNetworkID)
+			if err != nil {
+				return err
+			}
+			// supply overflow is checked by depositRollover
+			e.State.SetCurrentSupply(constants.PrimaryNetworkID, currentSupply+potentialReward)
+		}
+
+		e.State.AddDeposit(txID, newDeposit)
+	}
+
 	avax.Consume(e.State, tx.Ins)
-	avax.Produce(e.State, txID, tx.Outs)
+	if err := utxo.ProduceLocked(e.State, txID, tx.Outs, locked.StateDeposited); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// Returns deposit that expired rollover [deposit] will be re-deposited into, offer that will be used
+// for this new deposit and amount of [deposit] reward that will be re-deposited.
+// Returns nil deposit, if [deposit] can't be rolled over at [chainTimestamp].
+func (e *CaminoStandardTxExecutor) depositRollover(
+	deposit *deposits.Deposit,
+	chainTimestamp uint64,
+) (*deposits.Deposit, *deposits.Offer, uint64, error) {
+	offer, err := e.State.GetDepositOffer(deposit.DepositOfferID)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	successorOffer := offer
+	if offer.RolloverOfferID() != offer.ID {
+		successorOffer, err = e.State.GetDepositOffer(offer.RolloverOfferID())
+		if err == database.ErrNotFound {
+			return nil, nil, 0, nil
+		} else if err != nil {
+			return nil, nil, 0, err
+		}
+	}
+
+	currentSupply, err := e.State.GetCurrentSupply(constants.PrimaryNetworkID)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	newDeposit, rolledOverReward, ok := deposit.Rollover(
+		offer,
+		successorOffer,
+		chainTimestamp,
+		e.Config.RewardConfig.SupplyCap-currentSupply,
+	)
+	if !ok {
+		return nil, nil, 0, nil
+	}
+
+	return newDeposit, successorOffer, rolledOverReward, nil
+}
+
 func (e *CaminoStandardTxExecutor) ClaimTx(tx *txs.ClaimTx) error {
 	// Basic checks
 
@@ -970,6 +1257,8 @@ func (e *CaminoStandardTxExecutor) ClaimTx(tx *txs.ClaimTx) error {
 	currentTimestamp := uint64(e.State.GetTimestamp().Unix())
 	txID := e.Tx.ID()
 	claimedAmount := uint64(0)
+	claimedTreasuryAmount := uint64(0)
+	var treasuryConfig *treasury.Config
 	claimableCreds := e.Tx.Creds[len(e.Tx.Creds)-len(tx.Claimables):]
 
 	for i, txClaimable := range tx.Claimables {
@@ -1012,6 +1301,7 @@ func (e *CaminoStandardTxExecutor) ClaimTx(tx *txs.ClaimTx) error {
 			// Updating deposit
 
 			e.State.ModifyDeposit(txClaimable.ID, &deposits.Deposit{
+				UpgradeVersionID:    deposit.UpgradeVersionID,
 				DepositOfferID:      deposit.DepositOfferID,
 				UnlockedAmount:      deposit.UnlockedAmount,
 				ClaimedRewardAmount: deposit.ClaimedRewardAmount + txClaimable.Amount,
@@ -1019,6 +1309,7 @@ func (e *CaminoStandardTxExecutor) ClaimTx(tx *txs.ClaimTx) error {
 				Duration:            deposit.Duration,
 				Amount:              deposit.Amount,
 				RewardOwner:         deposit.RewardOwner,
+				Flags:               deposit.Flags,
 			})
 
 		case txs.ClaimTypeExpiredDepositReward, txs.ClaimTypeValidatorReward, txs.ClaimTypeAllTreasury:
@@ -1032,11 +1323,28 @@ func (e *CaminoStandardTxExecutor) ClaimTx(tx *txs.ClaimTx) error {
 				return fmt.Errorf("couldn't get claimable: %w", err)
 			}
 
+			// Treasury claimables are spent by configured treasury owner and count towards treasury spending cap
+
+			claimableOwner := treasuryClaimable.Owner
+			isTreasuryClaimable := false
+			if treasury.IsTreasuryOwner(treasuryClaimable.Owner) {
+				if treasuryConfig == nil {
+					treasuryConfig, err = e.State.GetTreasuryConfig()
+					if err != nil && err != database.ErrNotFound {
+						return err
+					}
+				}
+				if treasuryConfig != nil {
+					claimableOwner = &treasuryConfig.Owner
+					isTreasuryClaimable = true
+				}
+			}
+
 			if err := e.Fx.VerifyMultisigPermission(
 				tx,
 				txClaimable.OwnerAuth,
 				claimableCreds[i],
-				treasuryClaimable.Owner,
+				claimableOwner,
 				e.State,
 			); err != nil {
 				return fmt.Errorf("%w: %s", errClaimableCredentialMismatch, err)
@@ -1077,6 +1385,13 @@ func (e *CaminoStandardTxExecutor) ClaimTx(tx *txs.ClaimTx) error {
 				return fmt.Errorf("couldn't calculate total claimedAmount: %w", err)
 			}
 
+			if isTreasuryClaimable {
+				claimedTreasuryAmount, err = math.Add64(claimedTreasuryAmount, txClaimable.Amount)
+				if err != nil {
+					return fmt.Errorf("couldn't calculate total claimedTreasuryAmount: %w", err)
+				}
+			}
+
 			// Updating claimable
 
 			var newClaimable *state.Claimable
@@ -1107,6 +1422,14 @@ func (e *CaminoStandardTxExecutor) ClaimTx(tx *txs.ClaimTx) error {
 		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
 	}
 
+	// Treasury spending cap check
+
+	if claimedTreasuryAmount > 0 {
+		if err := e.spendTreasury(treasuryConfig, currentTimestamp, claimedTreasuryAmount); err != nil {
+			return err
+		}
+	}
+
 	avax.Consume(e.State, tx.Ins)
 	avax.Produce(e.State, txID, tx.Outs)
 
@@ -1222,6 +1545,7 @@ func (e *CaminoStandardTxExecutor) RegisterNodeTx(tx *txs.RegisterNodeTx) error
 	if !oldNodeIDEmpty {
 		e.State.SetShortIDLink(ids.ShortID(tx.OldNodeID), state.ShortLinkKeyRegisterNode, nil)
 		e.State.SetShortIDLink(tx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode, nil)
+		e.State.SetNodeRegistrationTxID(tx.OldNodeID, nil)
 	}
 
 	if !newNodeIDEmpty {
@@ -1234,6 +1558,7 @@ func (e *CaminoStandardTxExecutor) RegisterNodeTx(tx *txs.RegisterNodeTx) error
 			state.ShortLinkKeyRegisterNode,
 			&link,
 		)
+		e.State.SetNodeRegistrationTxID(tx.NewNodeID, &txID)
 	}
 
 	return nil
@@ -1253,45 +1578,26 @@ func (e *CaminoStandardTxExecutor) RewardsImportTx(tx *txs.RewardsImportTx) erro
 		return err
 	}
 
-	if e.Bootstrapped.Get() {
-		// Getting all treasury utxos exported from c-chain, collecting ones that are old enough
+	chainTime := e.State.GetTimestamp()
+	chainTimestamp := uint64(chainTime.Unix())
 
-		allUTXOBytes, _, _, err := e.Ctx.SharedMemory.Indexed(
-			e.Ctx.CChainID,
-			treasury.AddrTraitsBytes,
-			ids.ShortEmpty[:], ids.Empty[:], maxPageSize,
-		)
-		if err != nil {
-			return fmt.Errorf("error fetching atomic UTXOs: %w", err)
+	if e.Bootstrapped.Get() {
+		var utxos []*avax.UTXO
+		if e.Config.IsBerlinPhaseActivated(chainTime) {
+			utxos, err = e.getImportedTreasuryUTXOs(tx, chainTimestamp)
+		} else {
+			utxos, err = e.getAllImportableTreasuryUTXOs(tx, chainTimestamp)
 		}
-
-		chainTimestamp := uint64(e.State.GetTimestamp().Unix())
-
-		utxos := []*avax.UTXO{}
-		for _, utxoBytes := range allUTXOBytes {
-			utxo := &avax.TimedUTXO{}
-			if _, err := txs.Codec.Unmarshal(utxoBytes, utxo); err != nil {
-				// that means that this could be simple, not-timed utxo
-				continue
-			}
-
-			if utxo.Timestamp <= chainTimestamp-atomic.SharedMemorySyncBound {
-				utxos = append(utxos, &utxo.UTXO)
-			}
+		if err != nil {
+			return err
 		}
 
 		// Verifying that utxos match inputs
 
-		if len(tx.Ins) != len(utxos) {
-			return fmt.Errorf("there are %d inputs and %d utxos: %w", len(tx.Ins), len(utxos), errInputsUTXOSMismatch)
-		}
-
-		avax.SortTransferableUTXOs(utxos)
-
 		for i, in := range tx.Ins {
 			utxo := utxos[i]
 
-			if utxo.InputID() != in.InputID() {
+			if utxo.InputID() != in.InputID() || utxo.AssetID() != in.AssetID() {
 				return errImportedUTXOMismatch
 			}
 
@@ -1301,6 +1607,10 @@ func (e *CaminoStandardTxExecutor) RewardsImportTx(tx *txs.RewardsImportTx) erro
 				return locked.ErrWrongOutType
 			}
 
+			if addrs := out.AddressesSet(); !addrs.Contains(treasury.Addr) {
+				return errImportedUTXOMismatch
+			}
+
 			if out.Amt != in.In.Amount() {
 				return fmt.Errorf("utxo.Amt %d, input.Amt %d: %w", out.Amt, in.In.Amount(), errInputAmountMismatch)
 			}
@@ -1321,21 +1631,22 @@ func (e *CaminoStandardTxExecutor) RewardsImportTx(tx *txs.RewardsImportTx) erro
 		fractions uint64
 	}
 	rewardOwners := map[ids.ID]*reward{}
+	validatorRewards := []*state.ValidatorReward{}
 	for currentStakerIterator.Next() {
 		staker := currentStakerIterator.Value()
 		if staker.SubnetID != constants.PrimaryNetworkID {
 			continue
 		}
 
-		addValidatorTx, _, err := e.State.GetTx(staker.TxID)
+		validatorTx, _, err := e.State.GetTx(staker.TxID)
 		if err != nil {
 			return err
 		}
-		unsignedAddValidatorTx, ok := addValidatorTx.Unsigned.(*txs.CaminoAddValidatorTx)
+		unsignedValidatorTx, ok := validatorTx.Unsigned.(txs.ValidatorTx)
 		if !ok {
 			return errWrongTxType
 		}
-		txRewardOwner, ok := unsignedAddValidatorTx.RewardsOwner.(*secp256k1fx.OutputOwners)
+		txRewardOwner, ok := unsignedValidatorTx.ValidationRewardsOwner().(*secp256k1fx.OutputOwners)
 		if !ok {
 			return errWrongOwnerType
 		}
@@ -1352,6 +1663,10 @@ func (e *CaminoStandardTxExecutor) RewardsImportTx(tx *txs.RewardsImportTx) erro
 		}
 		rewardOwner.fractions++
 		totalRewardFractions++
+		validatorRewards = append(validatorRewards, &state.ValidatorReward{
+			NodeID:      staker.NodeID,
+			RewardOwner: txRewardOwner,
+		})
 	}
 
 	// Set not distributed validator reward
@@ -1369,6 +1684,25 @@ func (e *CaminoStandardTxExecutor) RewardsImportTx(tx *txs.RewardsImportTx) erro
 		}
 	}
 
+	// Update import progress
+
+	importProgress, err := e.State.GetRewardsImportProgress()
+	if err != nil {
+		return err
+	}
+
+	newImportProgress := &state.RewardsImportProgress{
+		ImportedUTXOs:  importProgress.ImportedUTXOs + uint64(len(tx.Ins)),
+		LastImportTxID: e.Tx.ID(),
+		LastImportTime: chainTimestamp,
+	}
+	newImportProgress.ImportedAmount, err = math.Add64(importProgress.ImportedAmount, importedAmount)
+	if err != nil {
+		return err
+	}
+
+	e.State.SetRewardsImportProgress(newImportProgress)
+
 	amountToDistribute, err := math.Add64(importedAmount, notDistributedAmount)
 	if err != nil {
 		return err
@@ -1381,6 +1715,21 @@ func (e *CaminoStandardTxExecutor) RewardsImportTx(tx *txs.RewardsImportTx) erro
 		e.State.SetNotDistributedValidatorReward(newNotDistributedAmount)
 	}
 
+	// Add distribution record to validator rewards history
+
+	for _, validatorReward := range validatorRewards {
+		validatorReward.Amount = addedReward
+	}
+
+	e.State.AddValidatorRewardsDistribution(&state.ValidatorRewardsDistribution{
+		TxID:                           e.Tx.ID(),
+		Timestamp:                      chainTimestamp,
+		ImportedAmount:                 importedAmount,
+		PreviouslyNotDistributedAmount: notDistributedAmount,
+		NotDistributedAmount:           newNotDistributedAmount,
+		Rewards:                        validatorRewards,
+	})
+
 	// Set claimables
 
 	if addedReward != 0 {
@@ -1431,6 +1780,75 @@ func (e *CaminoStandardTxExecutor) RewardsImportTx(tx *txs.RewardsImportTx) erro
 	return nil
 }
 
+// getImportedTreasuryUTXOs returns utxos imported by [tx] and verifies that they are
+// treasury utxos exported from c-chain, that are old enough to be imported.
+// Tx could import only part of such utxos, others will be imported by next txs.
+func (e *CaminoStandardTxExecutor) getImportedTreasuryUTXOs(tx *txs.RewardsImportTx, chainTimestamp uint64) ([]*avax.UTXO, error) {
+	if len(tx.Ins) > maxPageSize {
+		return nil, fmt.Errorf("%w: %d inputs, max %d", errTooManyImportedInputs, len(tx.Ins), maxPageSize)
+	}
+
+	utxoIDs := make([][]byte, len(tx.Ins))
+	for i := range tx.Ins {
+		utxoID := tx.Ins[i].InputID()
+		utxoIDs[i] = utxoID[:]
+	}
+
+	allUTXOBytes, err := e.Ctx.SharedMemory.Get(e.Ctx.CChainID, utxoIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching atomic UTXOs: %w", err)
+	}
+
+	utxos := make([]*avax.UTXO, len(allUTXOBytes))
+	for i, utxoBytes := range allUTXOBytes {
+		utxo := &avax.TimedUTXO{}
+		if _, err := txs.Codec.Unmarshal(utxoBytes, utxo); err != nil {
+			// that means that this could be simple, not-timed utxo
+			return nil, fmt.Errorf("%w: %s", errImportedUTXOMismatch, err)
+		}
+
+		if utxo.Timestamp > chainTimestamp-atomic.SharedMemorySyncBound {
+			return nil, errImportedUTXOTooNew
+		}
+
+		utxos[i] = &utxo.UTXO
+	}
+	return utxos, nil
+}
+
+// getAllImportableTreasuryUTXOs returns all treasury utxos exported from c-chain, that are old enough
+// to be imported, and verifies that [tx] imports exactly them. Used before BerlinPhase.
+func (e *CaminoStandardTxExecutor) getAllImportableTreasuryUTXOs(tx *txs.RewardsImportTx, chainTimestamp uint64) ([]*avax.UTXO, error) {
+	allUTXOBytes, _, _, err := e.Ctx.SharedMemory.Indexed(
+		e.Ctx.CChainID,
+		treasury.AddrTraitsBytes,
+		ids.ShortEmpty[:], ids.Empty[:], maxPageSize,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching atomic UTXOs: %w", err)
+	}
+
+	utxos := []*avax.UTXO{}
+	for _, utxoBytes := range allUTXOBytes {
+		utxo := &avax.TimedUTXO{}
+		if _, err := txs.Codec.Unmarshal(utxoBytes, utxo); err != nil {
+			// that means that this could be simple, not-timed utxo
+			continue
+		}
+
+		if utxo.Timestamp <= chainTimestamp-atomic.SharedMemorySyncBound {
+			utxos = append(utxos, &utxo.UTXO)
+		}
+	}
+
+	if len(tx.Ins) != len(utxos) {
+		return nil, fmt.Errorf("there are %d inputs and %d utxos: %w", len(tx.Ins), len(utxos), errInputsUTXOSMismatch)
+	}
+
+	avax.SortTransferableUTXOs(utxos)
+	return utxos, nil
+}
+
 func (e *CaminoStandardTxExecutor) BaseTx(tx *txs.BaseTx) error {
 	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
 		return err
@@ -1467,6 +1885,12 @@ func (e *CaminoStandardTxExecutor) MultisigAliasTx(tx *txs.MultisigAliasTx) erro
 		return err
 	}
 
+	// weighted owners were introduced with BerlinPhase
+	if _, ok := tx.MultisigAlias.Owners.(*secp256k1fx.WeightedOutputOwners); ok &&
+		!e.Config.IsBerlinPhaseActivated(e.State.GetTimestamp()) {
+		return errNotBerlinPhase
+	}
+
 	baseCreds := e.Tx.Creds[:len(e.Tx.Creds)]
 
 	var aliasID ids.ShortID
@@ -1522,14 +1946,36 @@ func (e *CaminoStandardTxExecutor) MultisigAliasTx(tx *txs.MultisigAliasTx) erro
 
 	// update state
 
-	e.State.SetMultisigAlias(&multisig.AliasWithNonce{
+	alias := &multisig.AliasWithNonce{
 		Alias: multisig.Alias{
 			ID:     aliasID,
 			Memo:   tx.MultisigAlias.Memo,
 			Owners: tx.MultisigAlias.Owners,
 		},
 		Nonce: nonce,
-	})
+	}
+	e.State.SetMultisigAlias(alias)
+
+	// Publish alias definition for X-chain, so it won't use outdated one.
+	// Aliases, that weren't changed since BerlinPhase activation, aren't
+	// published. Their owners must re-register them with unchanged
+	// definition, if X-chain needs them without importing any utxos.
+
+	if e.Config.IsBerlinPhaseActivated(e.State.GetTimestamp()) {
+		aliasBytes, err := txs.Codec.Marshal(txs.Version, alias)
+		if err != nil {
+			return err
+		}
+		aliasKey := multisig.AliasUpdateKey(aliasID, nonce)
+		e.AtomicRequests = map[ids.ID]*atomic.Requests{
+			e.Ctx.XChainID: {
+				PutRequests: []*atomic.Element{{
+					Key:   aliasKey[:],
+					Value: aliasBytes,
+				}},
+			},
+		}
+	}
 
 	// Consume the UTXOS
 	avax.Consume(e.State, tx.Ins)
@@ -1550,6 +1996,12 @@ func (e *CaminoStandardTxExecutor) AddDepositOfferTx(tx *txs.AddDepositOfferTx)
 		return errNotAthensPhase
 	}
 
+	// offers with early unlock penalty and later fields were introduced with BerlinPhase
+	if tx.DepositOffer.UpgradeVersionID.Version() >= codec.UpgradeVersion2.Version() &&
+		!e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
+	}
+
 	if len(e.Tx.Creds) < 2 {
 		return errWrongCredentialsNumber
 	}
@@ -1596,29 +2048,11 @@ func (e *CaminoStandardTxExecutor) AddDepositOfferTx(tx *txs.AddDepositOfferTx)
 
 	// validate offer
 
-	currentSupply, err := e.State.GetCurrentSupply(constants.PrimaryNetworkID)
-	if err != nil {
-		return err
-	}
-
-	allOffers, err := e.State.GetAllDepositOffers()
+	availableSupply, err := e.availableRewardsSupply(uint64(chainTime.Unix()))
 	if err != nil {
 		return err
 	}
 
-	availableSupply := e.Config.RewardConfig.SupplyCap - currentSupply
-	chainTimestamp := uint64(chainTime.Unix())
-
-	for _, offer := range allOffers {
-		if offer.IsActiveAt(chainTimestamp) {
-			if offer.TotalMaxAmount != 0 {
-				availableSupply -= offer.MaxRemainingRewardByTotalMaxAmount()
-			} else if offer.TotalMaxRewardAmount != 0 {
-				availableSupply -= offer.RemainingReward()
-			}
-		}
-	}
-
 	if tx.DepositOffer.TotalMaxRewardAmount > availableSupply {
 		return errSupplyOverflow
 	}
@@ -1636,11 +2070,692 @@ func (e *CaminoStandardTxExecutor) AddDepositOfferTx(tx *txs.AddDepositOfferTx)
 	return nil
 }
 
-func removeCreds(tx *txs.Tx, num int) []verify.Verifiable {
-	newCredsLen := len(tx.Creds) - num
-	removedCreds := tx.Creds[newCredsLen:len(tx.Creds)]
-	tx.Creds = tx.Creds[:newCredsLen]
-	return removedCreds
+func (e *CaminoStandardTxExecutor) UpdateDepositOfferTx(tx *txs.UpdateDepositOfferTx) error {
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	chainTime := e.State.GetTimestamp()
+
+	if !e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
+	}
+
+	if len(e.Tx.Creds) < 2 {
+		return errWrongCredentialsNumber
+	}
+
+	offer, err := e.State.GetDepositOffer(tx.DepositOfferID)
+	if err == database.ErrNotFound {
+		return errDepositOfferNotFound
+	} else if err != nil {
+		return err
+	}
+
+	// check permission
+
+	if offer.OwnerAddress == ids.ShortEmpty || tx.DepositOfferUpdaterAddress != offer.OwnerAddress {
+		updaterAddressState, err := e.State.GetAddressStates(tx.DepositOfferUpdaterAddress)
+		if err != nil {
+			return err
+		}
+
+		if updaterAddressState&txs.AddressStateRoleOffersAdmin == 0 {
+			return errNotOfferOwnerOrAdmin
+		}
+	}
+
+	if err := e.Backend.Fx.VerifyMultisigPermission(
+		e.Tx.Unsigned,
+		tx.DepositOfferUpdaterAuth,
+		e.Tx.Creds[len(e.Tx.Creds)-1], // offer updater credential
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{tx.DepositOfferUpdaterAddress},
+		},
+		e.State,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errOfferUpdaterCredentialMismatch, err)
+	}
+
+	// validate offer update
+
+	chainTimestamp := uint64(chainTime.Unix())
+
+	switch {
+	case tx.End > offer.End:
+		return errOfferEndExtended
+	case tx.End < chainTimestamp:
+		return errOfferEndInPast
+	case offerLimitIncreased(offer.TotalMaxAmount, tx.TotalMaxAmount) ||
+		offerLimitIncreased(offer.TotalMaxRewardAmount, tx.TotalMaxRewardAmount):
+		return errOfferLimitIncreased
+	case offer.TotalMaxAmount == 0 && tx.TotalMaxAmount != 0 ||
+		offer.TotalMaxRewardAmount == 0 && tx.TotalMaxRewardAmount != 0:
+		return errUnlimitedOfferLimited
+	case tx.TotalMaxAmount != 0 && tx.TotalMaxAmount < offer.DepositedAmount ||
+		tx.TotalMaxRewardAmount != 0 && tx.TotalMaxRewardAmount < offer.RewardedAmount:
+		return errOfferLimitBelowUsed
+	}
+
+	updatedOffer := *offer
+	updatedOffer.Flags = tx.Flags
+	updatedOffer.End = tx.End
+	updatedOffer.TotalMaxAmount = tx.TotalMaxAmount
+	updatedOffer.TotalMaxRewardAmount = tx.TotalMaxRewardAmount
+
+	if err := updatedOffer.Verify(); err != nil {
+		return fmt.Errorf("%w: %s", errBadUpdatedOffer, err)
+	}
+
+	// unlocked offer can issue new rewards again, so we need to be sure that supply is enough
+	if offer.Flags&deposits.OfferFlagLocked != 0 && updatedOffer.IsActiveAt(chainTimestamp) {
+		availableSupply, err := e.availableRewardsSupply(chainTimestamp)
+		if err != nil {
+			return err
+		}
+
+		offerRemainingReward := updatedOffer.RemainingReward()
+		if updatedOffer.TotalMaxAmount != 0 {
+			offerRemainingReward = updatedOffer.MaxRemainingRewardByTotalMaxAmount()
+		}
+
+		if offerRemainingReward > availableSupply {
+			return errSupplyOverflow
+		}
+	}
+
+	// verify the flowcheck
+
+	if err := e.FlowChecker.VerifyLock(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		e.Tx.Creds[:len(e.Tx.Creds)-1], // base tx credentials
+		0,
+		e.Config.TxFee,
+		e.Ctx.AVAXAssetID,
+		locked.StateUnlocked,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	// update state
+
+	e.State.SetDepositOffer(&updatedOffer)
+
+	avax.Consume(e.State, tx.Ins)
+	avax.Produce(e.State, e.Tx.ID(), tx.Outs)
+
+	return nil
+}
+
+// Returns true, if [newLimit] allows more than [oldLimit].
+// Zero limit means that offer isn't limited.
+func offerLimitIncreased(oldLimit, newLimit uint64) bool {
+	return oldLimit != 0 && (newLimit == 0 || newLimit > oldLimit)
+}
+
+func (e *CaminoStandardTxExecutor) UpdateDepositOfferAllowListTx(tx *txs.UpdateDepositOfferAllowListTx) error {
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	if !e.Config.IsBerlinPhaseActivated(e.State.GetTimestamp()) {
+		return errNotBerlinPhase
+	}
+
+	if len(e.Tx.Creds) < 2 {
+		return errWrongCredentialsNumber
+	}
+
+	offer, err := e.State.GetDepositOffer(tx.DepositOfferID)
+	if err == database.ErrNotFound {
+		return errDepositOfferNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if !offer.AllowListEnabled {
+		return errOfferWithoutAllowList
+	}
+
+	// check permission
+
+	if err := e.Backend.Fx.VerifyMultisigPermission(
+		e.Tx.Unsigned,
+		tx.DepositOfferOwnerAuth,
+		e.Tx.Creds[len(e.Tx.Creds)-1], // offer owner credential
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{offer.OwnerAddress},
+		},
+		e.State,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errOfferOwnerCredentialMismatch, err)
+	}
+
+	// verify the flowcheck
+
+	if err := e.FlowChecker.VerifyLock(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		e.Tx.Creds[:len(e.Tx.Creds)-1], // base tx credentials
+		0,
+		e.Config.TxFee,
+		e.Ctx.AVAXAssetID,
+		locked.StateUnlocked,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	// update state
+
+	for _, addr := range tx.AddedAddresses {
+		e.State.SetDepositOfferAllowedAddress(offer.ID, addr, true)
+	}
+	for _, addr := range tx.RemovedAddresses {
+		e.State.SetDepositOfferAllowedAddress(offer.ID, addr, false)
+	}
+
+	avax.Consume(e.State, tx.Ins)
+	avax.Produce(e.State, e.Tx.ID(), tx.Outs)
+
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) TransferDepositTx(tx *txs.TransferDepositTx) error {
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	chainTime := e.State.GetTimestamp()
+
+	if !e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
+	}
+
+	if len(e.Tx.Creds) != len(tx.Ins)+1 {
+		return errWrongCredentialsNumber
+	}
+
+	deposit, err := e.State.GetDeposit(tx.DepositTxID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errDepositNotFound, err)
+	}
+
+	if uint64(chainTime.Unix()) >= uint64(deposit.EndTime().Unix()) {
+		return errDepositExpired
+	}
+
+	// check reward owner
+
+	oldRewardOwnerID, err := txs.GetOwnerID(deposit.RewardOwner)
+	if err != nil {
+		return err
+	}
+
+	newRewardOwnerID, err := txs.GetOwnerID(tx.NewRewardOwner)
+	if err != nil {
+		return err
+	}
+
+	rewardOwnerChanged := oldRewardOwnerID != newRewardOwnerID
+	if rewardOwnerChanged {
+		if err := e.Fx.VerifyMultisigPermission(
+			tx,
+			tx.RewardOwnerAuth,
+			e.Tx.Creds[len(e.Tx.Creds)-1], // reward owner credential
+			deposit.RewardOwner,
+			e.State,
+		); err != nil {
+			return fmt.Errorf("%w: %s", errRewardOwnerCredentialMismatch, err)
+		}
+
+		newRewardOwner, ok := tx.NewRewardOwner.(*secp256k1fx.OutputOwners)
+		if !ok {
+			return errWrongOwnerType
+		}
+
+		if err := e.Fx.VerifyMultisigOwner(
+			&secp256k1fx.TransferOutput{
+				OutputOwners: *newRewardOwner,
+			}, e.State,
+		); err != nil {
+			return err
+		}
+	}
+
+	// check transferred deposited utxos
+
+	baseIns := make([]*avax.TransferableInput, 0, len(tx.Ins))
+	baseCreds := make([]verify.Verifiable, 0, len(tx.Ins))
+	baseOuts := make([]*avax.TransferableOutput, 0, len(tx.Outs))
+	transferredAmounts := map[locked.IDs]uint64{}
+
+	for i, in := range tx.Ins {
+		lockedIn, ok := in.In.(*locked.In)
+		if !ok {
+			baseIns = append(baseIns, in)
+			baseCreds = append(baseCreds, e.Tx.Creds[i])
+			continue
+		}
+
+		utxo, err := e.State.GetUTXO(in.InputID())
+		if err != nil {
+			return fmt.Errorf("failed to read consumed UTXO %s due to: %w", &in.UTXOID, err)
+		}
+
+		lockedOut, ok := utxo.Out.(*locked.Out)
+		if !ok || lockedOut.IDs != lockedIn.IDs {
+			return errTransferredUTXOMismatch
+		}
+
+		if utxo.AssetID() != e.Ctx.AVAXAssetID || in.AssetID() != e.Ctx.AVAXAssetID {
+			return errTransferredUTXOMismatch
+		}
+
+		if err := e.Fx.VerifyMultisigTransfer(
+			tx,
+			lockedIn.TransferableIn,
+			e.Tx.Creds[i],
+			lockedOut.TransferableOut,
+			e.State,
+		); err != nil {
+			return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+		}
+
+		transferredAmount, err := math.Add64(transferredAmounts[lockedIn.IDs], lockedIn.Amount())
+		if err != nil {
+			return err
+		}
+		transferredAmounts[lockedIn.IDs] = transferredAmount
+	}
+
+	if !rewardOwnerChanged && len(transferredAmounts) == 0 {
+		return errNothingTransferred
+	}
+
+	for _, out := range tx.Outs {
+		lockedOut, ok := out.Out.(*locked.Out)
+		if !ok {
+			baseOuts = append(baseOuts, out)
+			continue
+		}
+
+		if out.AssetID() != e.Ctx.AVAXAssetID {
+			return errWrongTransferredAmount
+		}
+
+		if err := e.Fx.VerifyMultisigOwner(lockedOut.TransferableOut, e.State); err != nil {
+			return err
+		}
+
+		transferredAmount := transferredAmounts[lockedOut.IDs]
+		if transferredAmount < lockedOut.Amount() {
+			return errWrongTransferredAmount
+		}
+		transferredAmounts[lockedOut.IDs] = transferredAmount - lockedOut.Amount()
+	}
+
+	for _, notProducedAmount := range transferredAmounts {
+		if notProducedAmount != 0 {
+			return errWrongTransferredAmount
+		}
+	}
+
+	// verify the flowcheck
+
+	if err := e.FlowChecker.VerifyLock(
+		tx,
+		e.State,
+		baseIns,
+		baseOuts,
+		baseCreds,
+		0,
+		e.Config.TxFee,
+		e.Ctx.AVAXAssetID,
+		locked.StateUnlocked,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	// update state
+
+	if rewardOwnerChanged {
+		e.State.ModifyDeposit(tx.DepositTxID, &deposits.Deposit{
+			UpgradeVersionID:    deposit.UpgradeVersionID,
+			DepositOfferID:      deposit.DepositOfferID,
+			UnlockedAmount:      deposit.UnlockedAmount,
+			ClaimedRewardAmount: deposit.ClaimedRewardAmount,
+			Start:               deposit.Start,
+			Duration:            deposit.Duration,
+			Amount:              deposit.Amount,
+			RewardOwner:         tx.NewRewardOwner,
+			Flags:               deposit.Flags,
+		})
+	}
+
+	avax.Consume(e.State, tx.Ins)
+	avax.Produce(e.State, e.Tx.ID(), tx.Outs)
+
+	return nil
+}
+
+// Returns supply that is not yet promised as reward by active deposit offers
+func (e *CaminoStandardTxExecutor) availableRewardsSupply(chainTimestamp uint64) (uint64, error) {
+	currentSupply, err := e.State.GetCurrentSupply(constants.PrimaryNetworkID)
+	if err != nil {
+		return 0, err
+	}
+
+	allOffers, err := e.State.GetAllDepositOffers()
+	if err != nil {
+		return 0, err
+	}
+
+	availableSupply := e.Config.RewardConfig.SupplyCap - currentSupply
+
+	for _, offer := range allOffers {
+		if offer.IsActiveAt(chainTimestamp) {
+			if offer.TotalMaxAmount != 0 {
+				availableSupply -= offer.MaxRemainingRewardByTotalMaxAmount()
+			} else if offer.TotalMaxRewardAmount != 0 {
+				availableSupply -= offer.RemainingReward()
+			}
+		}
+	}
+
+	return availableSupply, nil
+}
+
+func (e *CaminoStandardTxExecutor) AddProposalTx(tx *txs.AddProposalTx) error {
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	chainTime := e.State.GetTimestamp()
+
+	if !e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
+	}
+
+	if len(e.Tx.Creds) < 2 {
+		return errWrongCredentialsNumber
+	}
+
+	// verify proposal
+
+	if tx.Proposal.StartTime().Before(chainTime) {
+		return errProposalStartTooEarly
+	}
+
+	if tx.BondAmount() != e.Config.CaminoConfig.DaoProposalBondAmount {
+		return errWrongProposalBondAmount
+	}
+
+	// verify proposer
+
+	proposerAddressState, err := e.State.GetAddressStates(tx.ProposerAddress)
+	if err != nil {
+		return err
+	}
+
+	if proposerAddressState&txs.AddressStateConsortiumMember == 0 {
+		return errNotConsortiumMember
+	}
+
+	if err := e.Backend.Fx.VerifyMultisigPermission(
+		e.Tx.Unsigned,
+		tx.ProposerAuth,
+		e.Tx.Creds[len(e.Tx.Creds)-1], // proposer credential
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{tx.ProposerAddress},
+		},
+		e.State,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errProposerCredentialMismatch, err)
+	}
+
+	// verify the flowcheck
+
+	if err := e.FlowChecker.VerifyLock(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		e.Tx.Creds[:len(e.Tx.Creds)-1], // base tx credentials
+		0,
+		e.Config.TxFee,
+		e.Ctx.AVAXAssetID,
+		locked.StateBonded,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	// update state
+
+	txID := e.Tx.ID()
+
+	e.State.AddProposal(txID, dao.NewProposalState(tx.Proposal, tx.ProposerAddress))
+
+	avax.Consume(e.State, tx.Ins)
+	if err := utxo.ProduceLocked(e.State, txID, tx.Outs, locked.StateBonded); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) AddVoteTx(tx *txs.AddVoteTx) error {
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	chainTime := e.State.GetTimestamp()
+
+	if !e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
+	}
+
+	if len(e.Tx.Creds) < 2 {
+		return errWrongCredentialsNumber
+	}
+
+	// verify voter
+
+	voterAddressState, err := e.State.GetAddressStates(tx.VoterAddress)
+	if err != nil {
+		return err
+	}
+
+	if voterAddressState&txs.AddressStateConsortiumMember == 0 {
+		return errNotConsortiumMember
+	}
+
+	if err := e.Backend.Fx.VerifyMultisigPermission(
+		e.Tx.Unsigned,
+		tx.VoterAuth,
+		e.Tx.Creds[len(e.Tx.Creds)-1], // voter credential
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{tx.VoterAddress},
+		},
+		e.State,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errVoterCredentialMismatch, err)
+	}
+
+	// verify vote
+
+	proposal, err := e.State.GetProposal(tx.ProposalID)
+	if err == database.ErrNotFound {
+		return errProposalNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if !proposal.IsActiveAt(uint64(chainTime.Unix())) {
+		return dao.ErrProposalInactive
+	}
+
+	updatedProposal, err := proposal.AddVote(tx.VoterAddress, tx.Option)
+	if err != nil {
+		return err
+	}
+
+	// verify the flowcheck
+
+	if err := e.FlowChecker.VerifyLock(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		e.Tx.Creds[:len(e.Tx.Creds)-1], // base tx credentials
+		0,
+		e.Config.TxFee,
+		e.Ctx.AVAXAssetID,
+		locked.StateUnlocked,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	// update state
+
+	e.State.ModifyProposal(tx.ProposalID, updatedProposal)
+
+	avax.Consume(e.State, tx.Ins)
+	avax.Produce(e.State, e.Tx.ID(), tx.Outs)
+
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) FinishProposalsTx(tx *txs.FinishProposalsTx) error {
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	if !e.Config.IsBerlinPhaseActivated(e.State.GetTimestamp()) {
+		return errNotBerlinPhase
+	}
+
+	if len(e.Tx.Creds) != 0 {
+		return errWrongCredentialsNumber
+	}
+
+	// verify that tx finishes exactly those proposals, which voting ends now
+
+	proposalIDs, nextExpirationTime, err := e.State.GetNextToExpireProposalIDsAndTime(nil)
+	if err != nil {
+		return err
+	}
+
+	if !nextExpirationTime.Equal(e.State.GetTimestamp()) {
+		return errFinishProposalsTooEarly
+	}
+
+	finishedProposals := make([]txs.FinishedProposal, len(proposalIDs))
+	proposals := make([]*dao.ProposalState, len(proposalIDs))
+	for i, proposalID := range proposalIDs {
+		proposal, err := e.State.GetProposal(proposalID)
+		if err != nil {
+			return err
+		}
+		option, successful := proposal.Outcome()
+		finishedProposals[i] = txs.FinishedProposal{
+			ProposalID: proposalID,
+			Option:     option,
+			Successful: successful,
+		}
+		proposals[i] = proposal
+	}
+
+	ins, outs, err := e.FlowChecker.Unlock(e.State, proposalIDs, locked.StateBonded)
+	if err != nil {
+		return err
+	}
+
+	// comparing bytes, cause ins could have cached input ids
+	var expectedTx txs.UnsignedTx = &txs.FinishProposalsTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    e.Ctx.NetworkID,
+			BlockchainID: e.Ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		FinishedProposals: finishedProposals,
+	}
+	expectedTxBytes, err := txs.Codec.Marshal(txs.Version, &expectedTx)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(tx.Bytes(), expectedTxBytes) {
+		return errInvalidSystemTxBody
+	}
+
+	// update state
+
+	for i, proposalID := range proposalIDs {
+		e.State.SetProposalOutcome(proposalID, &state.ProposalOutcome{
+			TxID:       e.Tx.ID(),
+			Option:     finishedProposals[i].Option,
+			Successful: finishedProposals[i].Successful,
+		})
+		e.State.RemoveProposal(proposalID, proposals[i])
+	}
+
+	avax.Consume(e.State, tx.Ins)
+	avax.Produce(e.State, e.Tx.ID(), tx.Outs)
+
+	return nil
+}
+
+func removeCreds(tx *txs.Tx, num int) []verify.Verifiable {
+	newCredsLen := len(tx.Creds) - num
+	removedCreds := tx.Creds[newCredsLen:len(tx.Creds)]
+	tx.Creds = tx.Creds[:newCredsLen]
+	return removedCreds
 }
 
 func addCreds(tx *txs.Tx, creds []verify.Verifiable) {
@@ -1655,129 +2770,527 @@ func (e *CaminoStandardTxExecutor) AddressStateTx(tx *txs.AddressStateTx) error
 
 	roles := txs.AddressStateEmpty
 	creds := e.Tx.Creds
+	var executors []ids.ShortID
 
 	if tx.UpgradeVersionID.Version() > 0 {
 		if !e.Config.IsAthensPhaseActivated(e.State.GetTimestamp()) {
 			return errNotAthensPhase
 		}
-		if err = e.Backend.Fx.VerifyMultisigPermission(
-			e.Tx.Unsigned,
-			tx.ExecutorAuth,
-			e.Tx.Creds[len(e.Tx.Creds)-1], // executor cred
-			&secp256k1fx.OutputOwners{
-				Threshold: 1,
-				Addrs:     []ids.ShortID{tx.Executor},
-			},
-			e.State,
-		); err != nil {
-			return fmt.Errorf("%w: %s", errSignatureMissing, err)
+		// kyc expiration and node deferral end were introduced with BerlinPhase
+		if tx.UpgradeVersionID.Version() >= codec.UpgradeVersion2.Version() &&
+			!e.Config.IsBerlinPhaseActivated(e.State.GetTimestamp()) {
+			return errNotBerlinPhase
+		}
+		if err = e.Backend.Fx.VerifyMultisigPermission(
+			e.Tx.Unsigned,
+			tx.ExecutorAuth,
+			e.Tx.Creds[len(e.Tx.Creds)-1], // executor cred
+			&secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{tx.Executor},
+			},
+			e.State,
+		); err != nil {
+			return fmt.Errorf("%w: %s", errSignatureMissing, err)
+		}
+		creds = e.Tx.Creds[:len(e.Tx.Creds)-1]
+
+		roles, err = e.State.GetAddressStates(tx.Executor)
+		if err != nil {
+			return err
+		}
+		if tx.Remove && tx.State == txs.AddressStateBitRoleAdmin && tx.Address == tx.Executor {
+			return errAdminCannotBeDeleted
+		}
+		executors = []ids.ShortID{tx.Executor}
+	} else {
+		addresses, err := e.Fx.RecoverAddresses(tx.Bytes(), e.Tx.Creds)
+		if err != nil {
+			return fmt.Errorf("%w: %s", errRecoverAddresses, err)
+		}
+
+		if len(addresses) == 0 {
+			return errWrongNumberOfCredentials
+		}
+
+		// Accumulate roles over all signers
+		checkSelfRemove := tx.Remove && tx.State == txs.AddressStateBitRoleAdmin
+		for address := range addresses {
+			states, err := e.State.GetAddressStates(address)
+			if err != nil {
+				return err
+			}
+			if checkSelfRemove && address == tx.Address {
+				return errAdminCannotBeDeleted
+			}
+			roles |= states
+			executors = append(executors, address)
+		}
+		utils.Sort(executors)
+	}
+	statesBit := txs.AddressState(1) << tx.State
+
+	// Check for AthensPhase Bits if we time has not passed yet
+	if (statesBit&txs.AddressStateAthensPhaseBits) != 0 &&
+		!e.Config.IsAthensPhaseActivated(e.State.GetTimestamp()) {
+		return errAddrStateNotPermitted
+	}
+
+	// Verify that roles are allowed to modify tx.State
+	if !verifyAccess(roles, statesBit) {
+		return fmt.Errorf("%w (addr: %s, bit: %b)", errAddrStateNotPermitted, tx.Address, tx.State)
+	}
+
+	// Get the current state
+	states, err := e.State.GetAddressStates(tx.Address)
+	if err != nil {
+		return err
+	}
+	// Calculate new states
+	newStates := states
+	if tx.Remove && (states&statesBit) != 0 {
+		newStates ^= statesBit
+	} else if !tx.Remove {
+		newStates |= statesBit
+	}
+
+	// Calculate new kyc expiration
+	kycExpiration, err := e.State.GetKYCExpiration(tx.Address)
+	if err != nil {
+		return err
+	}
+	newKYCExpiration := kycExpiration
+	if tx.State == txs.AddressStateBitKYCVerified {
+		switch {
+		case tx.Remove:
+			newKYCExpiration = 0
+		case tx.UpgradeVersionID.Version() >= codec.UpgradeVersion2.Version():
+			if tx.KYCExpiration != 0 && tx.KYCExpiration <= uint64(e.State.GetTimestamp().Unix()) {
+				return errKYCExpirationInPast
+			}
+			newKYCExpiration = tx.KYCExpiration
+			newStates &^= txs.AddressStateKYCExpired
+		}
+	}
+
+	if tx.UpgradeVersionID.Version() >= codec.UpgradeVersion3.Version() && tx.NodeDeferralEnd != 0 &&
+		tx.NodeDeferralEnd <= uint64(e.State.GetTimestamp().Unix()) {
+		return errNodeDeferralEndInPast
+	}
+
+	// Verify the flowcheck
+	if err := e.FlowChecker.VerifySpend(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		creds,
+		map[ids.ID]uint64{
+			e.Ctx.AVAXAssetID: e.Config.TxFee,
+		},
+	); err != nil {
+		return err
+	}
+
+	txID := e.Tx.ID()
+
+	if tx.State == txs.AddressStateBitNodeDeferred {
+		nodeShortID, err := e.State.GetShortIDLink(tx.Address, state.ShortLinkKeyRegisterNode)
+		if err != nil {
+			return fmt.Errorf("couldn't get consortium member registered nodeID: %w", err)
+		}
+		nodeID := ids.NodeID(nodeShortID)
+		if tx.Remove {
+			// transfer staker to from deferred to current stakers set
+			stakerToReactivate, err := e.State.GetDeferredValidator(constants.PrimaryNetworkID, nodeID)
+			if err != nil {
+				return fmt.Errorf("validator with nodeID %s, does not exist in deferred stakers set: %w", nodeID, errValidatorNotFound)
+			}
+			e.State.DeleteDeferredValidator(stakerToReactivate)
+			e.State.PutCurrentValidator(stakerToReactivate)
+			e.State.SetNodeDeferral(tx.Address, nil)
+		} else {
+			// transfer staker to from current to deferred stakers set
+			stakerToDefer, err := e.State.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+			if err != nil {
+				return fmt.Errorf("validator with nodeID %s, does not exist in current stakers set: %w", nodeID, errValidatorNotFound)
+			}
+			e.State.DeleteCurrentValidator(stakerToDefer)
+			e.State.PutDeferredValidator(stakerToDefer)
+			e.State.SetNodeDeferral(tx.Address, &state.NodeDeferral{
+				TxID:  txID,
+				Start: uint64(e.State.GetTimestamp().Unix()),
+				End:   tx.NodeDeferralEnd,
+			})
+		}
+	}
+
+	// Consume the UTXOS
+	avax.Consume(e.State, tx.Ins)
+	// Produce the UTXOS
+	avax.Produce(e.State, txID, tx.Outs)
+	// Set the new states if changed
+	if states != newStates {
+		e.State.SetAddressStates(tx.Address, newStates)
+	}
+	if kycExpiration != newKYCExpiration {
+		e.State.SetKYCExpiration(tx.Address, newKYCExpiration)
+	}
+	e.State.AddAddressStateChange(&state.AddressStateChange{
+		Address:   tx.Address,
+		TxID:      txID,
+		Timestamp: uint64(e.State.GetTimestamp().Unix()),
+		Bit:       tx.State,
+		Remove:    tx.Remove,
+		Executors: executors,
+	})
+
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) TreasuryConfigTx(tx *txs.TreasuryConfigTx) error {
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	if !e.Config.IsBerlinPhaseActivated(e.State.GetTimestamp()) {
+		return errNotBerlinPhase
+	}
+
+	if len(e.Tx.Creds) != len(tx.Ins)+1 {
+		return errWrongCredentialsNumber
+	}
+
+	// verify executor
+
+	if err := e.Backend.Fx.VerifyMultisigPermission(
+		e.Tx.Unsigned,
+		tx.ExecutorAuth,
+		e.Tx.Creds[len(e.Tx.Creds)-1], // executor credential
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{tx.Executor},
+		},
+		e.State,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errExecutorCredentialMismatch, err)
+	}
+
+	executorAddressState, err := e.State.GetAddressStates(tx.Executor)
+	if err != nil {
+		return err
+	}
+
+	if executorAddressState&txs.AddressStateRoleAdmin == 0 {
+		return errAddrStateNotPermitted
+	}
+
+	// verify the flowcheck
+
+	if err := e.FlowChecker.VerifySpend(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		e.Tx.Creds[:len(e.Tx.Creds)-1], // base tx credentials
+		map[ids.ID]uint64{
+			e.Ctx.AVAXAssetID: e.Config.TxFee,
+		},
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	// update state
+
+	newConfig := tx.TreasuryConfig
+	e.State.SetTreasuryConfig(&newConfig)
+
+	avax.Consume(e.State, tx.Ins)
+	avax.Produce(e.State, e.Tx.ID(), tx.Outs)
+
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) TreasurySpendTx(tx *txs.TreasurySpendTx) error {
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	chainTime := e.State.GetTimestamp()
+
+	if !e.Config.IsBerlinPhaseActivated(chainTime) {
+		return errNotBerlinPhase
+	}
+
+	if len(e.Tx.Creds) != len(tx.Ins)+1 {
+		return errWrongCredentialsNumber
+	}
+
+	treasuryConfig, err := e.State.GetTreasuryConfig()
+	if err == database.ErrNotFound {
+		return errTreasuryNotConfigured
+	} else if err != nil {
+		return err
+	}
+
+	// verify treasury owner
+
+	if err := e.Backend.Fx.VerifyMultisigPermission(
+		e.Tx.Unsigned,
+		tx.TreasuryAuth,
+		e.Tx.Creds[len(e.Tx.Creds)-1], // treasury owner credential
+		&treasuryConfig.Owner,
+		e.State,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errTreasuryCredentialMismatch, err)
+	}
+
+	// verify treasury inputs
+
+	treasuryInsAmount := uint64(0)
+	for _, in := range tx.TreasuryIns {
+		utxo, err := e.State.GetUTXO(in.InputID())
+		if err != nil {
+			return fmt.Errorf("failed to get treasury utxo %s: %w", in.InputID(), err)
+		}
+
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok || !treasury.IsTreasuryOwner(&out.OutputOwners) || utxo.AssetID() != e.Ctx.AVAXAssetID {
+			return errNotTreasuryUTXO
 		}
-		creds = e.Tx.Creds[:len(e.Tx.Creds)-1]
 
-		roles, err = e.State.GetAddressStates(tx.Executor)
-		if err != nil {
-			return err
+		if out.Locktime > uint64(chainTime.Unix()) {
+			return fmt.Errorf("%w: treasury utxo %s is time-locked", errNotTreasuryUTXO, in.InputID())
 		}
-		if tx.Remove && tx.State == txs.AddressStateBitRoleAdmin && tx.Address == tx.Executor {
-			return errAdminCannotBeDeleted
+
+		if out.Amt != in.In.Amount() {
+			return fmt.Errorf("utxo.Amt %d, input.Amt %d: %w", out.Amt, in.In.Amount(), errInputAmountMismatch)
 		}
-	} else {
-		addresses, err := e.Fx.RecoverAddresses(tx.Bytes(), e.Tx.Creds)
+
+		treasuryInsAmount, err = math.Add64(treasuryInsAmount, out.Amt)
 		if err != nil {
-			return fmt.Errorf("%w: %s", errRecoverAddresses, err)
+			return err
 		}
+	}
 
-		if len(addresses) == 0 {
-			return errWrongNumberOfCredentials
+	// verify the flowcheck, treasury inputs are treated as minted tokens
+
+	if err := e.FlowChecker.VerifyLock(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		e.Tx.Creds[:len(e.Tx.Creds)-1], // base tx credentials
+		treasuryInsAmount,
+		e.Config.TxFee,
+		e.Ctx.AVAXAssetID,
+		locked.StateUnlocked,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
+	}
+
+	// verify spending cap
+
+	if treasuryConfig.IsCapped() {
+		returnedAmount := uint64(0)
+		for _, out := range tx.Outs {
+			if secpOut, ok := out.Out.(*secp256k1fx.TransferOutput); ok && treasury.IsTreasuryOwner(&secpOut.OutputOwners) {
+				returnedAmount, err = math.Add64(returnedAmount, secpOut.Amt)
+				if err != nil {
+					return err
+				}
+			}
 		}
 
-		// Accumulate roles over all signers
-		checkSelfRemove := tx.Remove && tx.State == txs.AddressStateBitRoleAdmin
-		for address := range addresses {
-			states, err := e.State.GetAddressStates(address)
-			if err != nil {
+		if treasuryInsAmount > returnedAmount {
+			if err := e.spendTreasury(treasuryConfig, uint64(chainTime.Unix()), treasuryInsAmount-returnedAmount); err != nil {
 				return err
 			}
-			if checkSelfRemove && address == tx.Address {
-				return errAdminCannotBeDeleted
-			}
-			roles |= states
 		}
 	}
-	statesBit := txs.AddressState(1) << tx.State
 
-	// Check for AthensPhase Bits if we time has not passed yet
-	if (statesBit&txs.AddressStateAthensPhaseBits) != 0 &&
-		!e.Config.IsAthensPhaseActivated(e.State.GetTimestamp()) {
-		return errAddrStateNotPermitted
+	// update state
+
+	avax.Consume(e.State, tx.Ins)
+	avax.Consume(e.State, tx.TreasuryIns)
+	avax.Produce(e.State, e.Tx.ID(), tx.Outs)
+
+	return nil
+}
+
+// spendTreasury adds [amount] to treasury spending of the current spending period
+// and returns error, if treasury spending cap is exceeded.
+func (e *CaminoStandardTxExecutor) spendTreasury(treasuryConfig *treasury.Config, chainTime, amount uint64) error {
+	if !treasuryConfig.IsCapped() {
+		return nil
 	}
 
-	// Verify that roles are allowed to modify tx.State
-	if !verifyAccess(roles, statesBit) {
-		return fmt.Errorf("%w (addr: %s, bit: %b)", errAddrStateNotPermitted, tx.Address, tx.State)
+	spending, err := e.State.GetTreasurySpending()
+	if err != nil {
+		return err
 	}
 
-	// Get the current state
-	states, err := e.State.GetAddressStates(tx.Address)
+	newSpending := &treasury.Spending{
+		PeriodStart: treasuryConfig.PeriodStart(chainTime),
+	}
+	if spending.PeriodStart == newSpending.PeriodStart {
+		newSpending.Amount = spending.Amount
+	}
+
+	newSpending.Amount, err = math.Add64(newSpending.Amount, amount)
 	if err != nil {
 		return err
 	}
-	// Calculate new states
-	newStates := states
-	if tx.Remove && (states&statesBit) != 0 {
-		newStates ^= statesBit
-	} else if !tx.Remove {
-		newStates |= statesBit
+
+	if newSpending.Amount > treasuryConfig.SpendingCap {
+		return fmt.Errorf("%w: spent %d, cap %d", errTreasurySpendingCapExceeded, newSpending.Amount, treasuryConfig.SpendingCap)
 	}
 
-	// Verify the flowcheck
-	if err := e.FlowChecker.VerifySpend(
+	e.State.SetTreasurySpending(newSpending)
+	return nil
+}
+
+func (e *CaminoStandardTxExecutor) ExtendValidatorTx(tx *txs.ExtendValidatorTx) error {
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	if !e.Config.IsBerlinPhaseActivated(e.State.GetTimestamp()) {
+		return errNotBerlinPhase
+	}
+
+	caminoConfig, err := e.State.CaminoConfig()
+	if err != nil {
+		return err
+	}
+
+	if !caminoConfig.LockModeBondDeposit {
+		return errWrongLockMode
+	}
+
+	if len(e.Tx.Creds) < 2 {
+		return errWrongCredentialsNumber
+	}
+
+	// verify that node owned by consortium member
+
+	consortiumMemberAddress, err := e.State.GetShortIDLink(
+		ids.ShortID(tx.NodeID()),
+		state.ShortLinkKeyRegisterNode,
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errNodeNotRegistered, err)
+	}
+
+	if err := e.Backend.Fx.VerifyMultisigPermission(
+		e.Tx.Unsigned,
+		tx.NodeOwnerAuth,
+		e.Tx.Creds[len(e.Tx.Creds)-1], // consortium member cred
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{consortiumMemberAddress},
+		},
+		e.State,
+	); err != nil {
+		return fmt.Errorf("%w: %s", errSignatureMissing, err)
+	}
+
+	// verify extended validator against current one
+
+	currentValidator, err := e.State.GetCurrentValidator(constants.PrimaryNetworkID, tx.NodeID())
+	if err == database.ErrNotFound {
+		return fmt.Errorf("%w: %s", errValidatorNotFound, tx.NodeID())
+	} else if err != nil {
+		return err
+	}
+
+	endTime := tx.EndTime()
+
+	switch {
+	case currentValidator.TxID != tx.ValidatorTxID:
+		return errWrongValidatorTxID
+	case !currentValidator.StartTime.Equal(tx.StartTime()):
+		return errValidatorStartTimeChanged
+	case endTime.Before(currentValidator.EndTime):
+		return errValidatorShortened
+	case tx.Validator.Wght < currentValidator.Weight:
+		return errValidatorWeightDecreased
+	case endTime.Equal(currentValidator.EndTime) && tx.Validator.Wght == currentValidator.Weight:
+		return errValidatorNotExtended
+	case tx.Validator.Wght > e.Config.MaxValidatorStake:
+		return errWeightTooLarge
+	case tx.Validator.Duration() > e.Config.MaxStakeDuration:
+		return errStakeTooLong
+	}
+
+	bondedAmount := uint64(0)
+	for _, out := range tx.Stake() {
+		bondedAmount += out.Out.Amount() // overflow is checked by syntactic verification
+	}
+	if bondedAmount != tx.Validator.Wght-currentValidator.Weight {
+		return fmt.Errorf("%w: bonded %d, weight increase %d",
+			errWrongBondedAmount, bondedAmount, tx.Validator.Wght-currentValidator.Weight)
+	}
+
+	currentValidatorTx, _, err := e.State.GetTx(currentValidator.TxID)
+	if err != nil {
+		return err
+	}
+	currentValidatorStakerTx, ok := currentValidatorTx.Unsigned.(txs.ValidatorTx)
+	if !ok {
+		return errWrongTxType
+	}
+	currentRewardsOwner, ok := currentValidatorStakerTx.ValidationRewardsOwner().(*secp256k1fx.OutputOwners)
+	if !ok {
+		return errWrongOwnerType
+	}
+	rewardsOwner, ok := tx.RewardsOwner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return errWrongOwnerType
+	}
+	currentRewardsOwnerID, err := txs.GetOwnerID(currentRewardsOwner)
+	if err != nil {
+		return err
+	}
+	rewardsOwnerID, err := txs.GetOwnerID(rewardsOwner)
+	if err != nil {
+		return err
+	}
+	if rewardsOwnerID != currentRewardsOwnerID {
+		return errRewardsOwnerChanged
+	}
+
+	// verify the flowcheck
+
+	if err := e.Backend.FlowChecker.VerifyLock(
 		tx,
 		e.State,
 		tx.Ins,
 		tx.Outs,
-		creds,
-		map[ids.ID]uint64{
-			e.Ctx.AVAXAssetID: e.Config.TxFee,
-		},
+		e.Tx.Creds[:len(e.Tx.Creds)-1],
+		0,
+		e.Config.TxFee,
+		e.Ctx.AVAXAssetID,
+		locked.StateBonded,
 	); err != nil {
-		return err
+		return fmt.Errorf("%w: %s", errFlowCheckFailed, err)
 	}
 
+	// update state
+
 	txID := e.Tx.ID()
 
-	if tx.State == txs.AddressStateBitNodeDeferred {
-		nodeShortID, err := e.State.GetShortIDLink(tx.Address, state.ShortLinkKeyRegisterNode)
-		if err != nil {
-			return fmt.Errorf("couldn't get consortium member registered nodeID: %w", err)
-		}
-		nodeID := ids.NodeID(nodeShortID)
-		if tx.Remove {
-			// transfer staker to from deferred to current stakers set
-			stakerToReactivate, err := e.State.GetDeferredValidator(constants.PrimaryNetworkID, nodeID)
-			if err != nil {
-				return fmt.Errorf("validator with nodeID %s, does not exist in deferred stakers set: %w", nodeID, errValidatorNotFound)
-			}
-			e.State.DeleteDeferredValidator(stakerToReactivate)
-			e.State.PutCurrentValidator(stakerToReactivate)
-		} else {
-			// transfer staker to from current to deferred stakers set
-			stakerToDefer, err := e.State.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
-			if err != nil {
-				return fmt.Errorf("validator with nodeID %s, does not exist in current stakers set: %w", nodeID, errValidatorNotFound)
-			}
-			e.State.DeleteCurrentValidator(stakerToDefer)
-			e.State.PutDeferredValidator(stakerToDefer)
-		}
+	extendedValidator, err := state.NewCurrentStaker(txID, tx, currentValidator.PotentialReward)
+	if err != nil {
+		return err
 	}
 
-	// Consume the UTXOS
+	e.State.DeleteCurrentValidator(currentValidator)
+	e.State.PutCurrentValidator(extendedValidator)
+
 	avax.Consume(e.State, tx.Ins)
-	// Produce the UTXOS
-	avax.Produce(e.State, txID, tx.Outs)
-	// Set the new states if changed
-	if states != newStates {
-		e.State.SetAddressStates(tx.Address, newStates)
+	if err := utxo.ProduceLocked(e.State, txID, tx.Outs, locked.StateBonded); err != nil {
+		return err
 	}
 
 	return nil
@@ -1809,3 +3322,106 @@ func validatorExists(state state.Chain, subnetID ids.ID, nodeID ids.NodeID) erro
 	}
 	return nil
 }
+
+// Returns errDepositCreatorNotEligible, if [depositCreatorAddress] doesn't satisfy
+// eligibility rules of [offer]: doesn't have required address state or isn't in offer allow-list
+func VerifyDepositCreatorEligibility(chainState state.Chain, offer *deposits.Offer, depositCreatorAddress ids.ShortID) error {
+	if offer.RequiredAddressState != 0 {
+		requiredAddressState := txs.AddressState(offer.RequiredAddressState)
+		depositCreatorAddressState, err := chainState.GetAddressStates(depositCreatorAddress)
+		if err != nil {
+			return err
+		}
+		if depositCreatorAddressState&requiredAddressState != requiredAddressState {
+			return fmt.Errorf("%w: address doesn't have required address state", errDepositCreatorNotEligible)
+		}
+	}
+
+	if offer.AllowListEnabled {
+		allowed, err := chainState.IsDepositOfferAllowedAddress(offer.ID, depositCreatorAddress)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return fmt.Errorf("%w: address isn't in offer allow-list", errDepositCreatorNotEligible)
+		}
+	}
+
+	return nil
+}
+
+// NewDeposit returns deposit, that would be created by deposit tx with given arguments at [chainTime]
+func NewDeposit(
+	depositOfferID ids.ID,
+	amount uint64,
+	duration uint32,
+	flags deposits.Flag,
+	rewardOwner fx.Owner,
+	chainTime time.Time,
+) *deposits.Deposit {
+	deposit := &deposits.Deposit{
+		DepositOfferID: depositOfferID,
+		Duration:       duration,
+		Amount:         amount,
+		Start:          uint64(chainTime.Unix()),
+		RewardOwner:    rewardOwner,
+	}
+	if flags != deposits.FlagNone {
+		deposit.UpgradeVersionID = codec.UpgradeVersion1
+		deposit.Flags = flags
+	}
+	return deposit
+}
+
+// VerifyNewDeposit verifies that [deposit] can be created with [depositOffer] at [chainTime]
+// and returns its potential reward
+func VerifyNewDeposit(
+	cfg *config.Config,
+	chainTime time.Time,
+	depositOffer *deposits.Offer,
+	deposit *deposits.Deposit,
+) (uint64, error) {
+	athensPhase := cfg.IsAthensPhaseActivated(chainTime)
+	berlinPhase := cfg.IsBerlinPhaseActivated(chainTime)
+
+	switch {
+	case !depositOffer.IsActiveAt(uint64(chainTime.Unix())):
+		return 0, errDepositOfferInactive
+	case deposit.Duration < depositOffer.MinDuration:
+		return 0, errDepositDurationTooSmall
+	case deposit.Duration > depositOffer.MaxDuration:
+		return 0, errDepositDurationTooBig
+	case deposit.Amount < depositOffer.MinAmount:
+		return 0, errDepositTooSmall
+	case depositOffer.TotalMaxAmount > 0 && deposit.Amount > depositOffer.RemainingAmount():
+		return 0, errDepositTooBig
+	case !athensPhase && depositOffer.TotalMaxRewardAmount > 0:
+		return 0, errNotAthensPhase
+	case !berlinPhase && deposit.Flags != deposits.FlagNone:
+		return 0, errNotBerlinPhase
+	}
+
+	potentialReward := deposit.TotalReward(depositOffer)
+
+	if depositOffer.TotalMaxRewardAmount > 0 && potentialReward > depositOffer.RemainingReward() {
+		return 0, errDepositTooBig
+	}
+
+	return potentialReward, nil
+}
+
+// VerifyDepositRewardSupply verifies that [potentialReward] can be added to current supply
+// and returns current and new supply
+func VerifyDepositRewardSupply(cfg *config.Config, chainState state.Chain, potentialReward uint64) (uint64, uint64, error) {
+	currentSupply, err := chainState.GetCurrentSupply(constants.PrimaryNetworkID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	newSupply, err := math.Add64(currentSupply, potentialReward)
+	if err != nil || newSupply > cfg.RewardConfig.SupplyCap {
+		return 0, 0, errSupplyOverflow
+	}
+
+	return currentSupply, newSupply, nil
+}
@@ -21,11 +21,13 @@ import (
 	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/utils/nodeid"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/api"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
 	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
@@ -34,6 +36,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/vms/types"
 )
 
 func TestCaminoEnv(t *testing.T) {
@@ -1629,18 +1632,21 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 		Addrs:     []ids.ShortID{preFundedKeys[0].PublicKey().Address()},
 	}
 	sigIndices := []uint32{0}
+	chainTime := uint64(env.state.GetTimestamp().Unix())
 
 	tests := map[string]struct {
-		UpgradeVersion uint16
-		stateAddress   ids.ShortID
-		targetAddress  ids.ShortID
-		txFlag         txs.AddressStateBit
-		existingState  txs.AddressState
-		expectedErrs   []error
-		expectedState  txs.AddressState
-		remove         bool
-		executor       ids.ShortID
-		executorAuth   *secp256k1fx.Input
+		UpgradeVersion        uint16
+		stateAddress          ids.ShortID
+		targetAddress         ids.ShortID
+		txFlag                txs.AddressStateBit
+		existingState         txs.AddressState
+		expectedErrs          []error
+		expectedState         txs.AddressState
+		remove                bool
+		executor              ids.ShortID
+		executorAuth          *secp256k1fx.Input
+		kycExpiration         uint64
+		expectedKYCExpiration uint64
 	}{
 		// Bob has Admin role, and he is trying to give himself Admin role (again)
 		"State: Admin, Flag: Admin role, Add, Same Address": {
@@ -1657,7 +1663,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			targetAddress: bob,
 			txFlag:        txs.AddressStateBitRoleKYC,
 			existingState: txs.AddressStateRoleKYC,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 			remove:        false,
 		},
 		// Bob has KYC role, and he is trying to give himself Admin role
@@ -1666,7 +1672,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			targetAddress: bob,
 			txFlag:        txs.AddressStateBitRoleAdmin,
 			existingState: txs.AddressStateRoleKYC,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 			remove:        false,
 		},
 		// Bob has Admin role, and he is trying to give Alice Admin role
@@ -1712,7 +1718,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			txFlag:        txs.AddressStateBitRoleAdmin,
 			existingState: txs.AddressStateRoleAdmin,
 			expectedState: 0,
-			expectedErrs:  []error{errAdminCannotBeDeleted, errAdminCannotBeDeleted},
+			expectedErrs:  []error{errAdminCannotBeDeleted, errAdminCannotBeDeleted, errAdminCannotBeDeleted},
 			remove:        true,
 		},
 		// Bob has Admin role, and he is trying to give Alice the KYC Verified state
@@ -1766,7 +1772,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			targetAddress: alice,
 			txFlag:        txs.AddressStateBitRoleAdmin,
 			existingState: txs.AddressStateRoleAdmin,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 			remove:        false,
 		},
 		// An Empty Address has Admin role, and he is trying to give Alice Admin role
@@ -1775,7 +1781,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			targetAddress: alice,
 			txFlag:        txs.AddressStateBitRoleAdmin,
 			existingState: txs.AddressStateRoleAdmin,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 			remove:        false,
 		},
 		// Bob has Admin role, and he is trying to give Admin role to an Empty Address
@@ -1784,7 +1790,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			targetAddress: ids.ShortEmpty,
 			txFlag:        txs.AddressStateBitRoleAdmin,
 			existingState: txs.AddressStateRoleAdmin,
-			expectedErrs:  []error{txs.ErrEmptyAddress, txs.ErrEmptyAddress},
+			expectedErrs:  []error{txs.ErrEmptyAddress, txs.ErrEmptyAddress, txs.ErrEmptyAddress},
 			remove:        false,
 		},
 		// Bob has empty addr state, and he is trying to give Alice Admin role
@@ -1794,7 +1800,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			txFlag:        txs.AddressStateBitRoleAdmin,
 			existingState: txs.AddressStateEmpty,
 			remove:        false,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 		},
 		// Bob has empty addr state, and he is trying to remove Admin role from Alice
 		"State: none, Flag: Admin role, Remove, Different Address": {
@@ -1803,7 +1809,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			txFlag:        txs.AddressStateBitRoleAdmin,
 			existingState: txs.AddressStateEmpty,
 			remove:        true,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 		},
 		// Bob has empty addr state, and he is trying to give Alice KYC role
 		"State: none, Flag: KYC role, Add, Different Address": {
@@ -1812,7 +1818,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			txFlag:        txs.AddressStateBitRoleKYC,
 			existingState: txs.AddressStateEmpty,
 			remove:        false,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 		},
 		// Bob has empty addr state, and he is trying to remove KYC role from Alice
 		"State: none, Flag: KYC role, Remove, Different Address": {
@@ -1821,7 +1827,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			txFlag:        txs.AddressStateBitRoleKYC,
 			existingState: txs.AddressStateEmpty,
 			remove:        true,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 		},
 		// Bob has empty addr state, and he is trying to give Alice KYC Verified state
 		"State: none, Flag: KYC Verified, Add, Different Address": {
@@ -1830,7 +1836,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			txFlag:        txs.AddressStateBitKYCVerified,
 			existingState: txs.AddressStateEmpty,
 			remove:        false,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 		},
 		// Bob has empty addr state, and he is trying to remove KYC Verified state from Alice
 		"State: none, Flag: KYC Verified, Remove, Different Address": {
@@ -1839,7 +1845,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			txFlag:        txs.AddressStateBitKYCVerified,
 			existingState: txs.AddressStateEmpty,
 			remove:        true,
-			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted},
+			expectedErrs:  []error{errAddrStateNotPermitted, errAddrStateNotPermitted, errAddrStateNotPermitted},
 		},
 		// Bob has KYC role, and he is trying to give Alice KYC Expired state
 		"Upgrade: 1, State: KYC, Flag: KYC Expired, Add, Different Address": {
@@ -1862,11 +1868,49 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 			txFlag:         txs.AddressStateBitKYCExpired,
 			existingState:  txs.AddressStateRoleKYC,
 			expectedState:  txs.AddressStateKYCExpired,
-			expectedErrs:   []error{errNotAthensPhase, errSignatureMissing},
+			expectedErrs:   []error{errNotAthensPhase, errSignatureMissing, errSignatureMissing},
 			remove:         false,
 			executor:       alice,
 			executorAuth:   &secp256k1fx.Input{SigIndices: []uint32{0}},
 		},
+		// Bob has KYC role, and he is trying to give Alice KYC Expired state with tx, that supports node deferral end
+		"Upgrade: 3, State: KYC, Flag: KYC Expired, Add, Different Address": {
+			UpgradeVersion: 3,
+			stateAddress:   bob,
+			targetAddress:  alice,
+			txFlag:         txs.AddressStateBitKYCExpired,
+			existingState:  txs.AddressStateRoleKYC,
+			expectedState:  txs.AddressStateKYCExpired,
+			expectedErrs:   []error{errNotAthensPhase, errNotBerlinPhase},
+			executor:       bob,
+			executorAuth:   &secp256k1fx.Input{SigIndices: []uint32{0}},
+		},
+		// Bob has KYC role, and he is trying to give Alice KYC Verified state, that will expire
+		"Upgrade: 2, State: KYC, Flag: KYC Verified, Add with expiration": {
+			UpgradeVersion:        2,
+			stateAddress:          bob,
+			targetAddress:         alice,
+			txFlag:                txs.AddressStateBitKYCVerified,
+			existingState:         txs.AddressStateRoleKYC,
+			expectedState:         txs.AddressStateKYCVerified,
+			expectedErrs:          []error{errNotAthensPhase, errNotBerlinPhase},
+			executor:              bob,
+			executorAuth:          &secp256k1fx.Input{SigIndices: []uint32{0}},
+			kycExpiration:         chainTime + 1000,
+			expectedKYCExpiration: chainTime + 1000,
+		},
+		// Bob has KYC role, and he is trying to give Alice KYC Verified state, that is already expired
+		"Upgrade: 2, State: KYC, Flag: KYC Verified, Add with expiration in the past": {
+			UpgradeVersion: 2,
+			stateAddress:   bob,
+			targetAddress:  alice,
+			txFlag:         txs.AddressStateBitKYCVerified,
+			existingState:  txs.AddressStateRoleKYC,
+			expectedErrs:   []error{errNotAthensPhase, errNotBerlinPhase, errKYCExpirationInPast},
+			executor:       bob,
+			executorAuth:   &secp256k1fx.Input{SigIndices: []uint32{0}},
+			kycExpiration:  chainTime,
+		},
 	}
 
 	baseTx := txs.BaseTx{BaseTx: avax.BaseTx{
@@ -1880,13 +1924,24 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 		},
 	}}
 
-	athensPhaseTimes := []time.Time{
-		env.state.GetTimestamp().Add(24 * time.Hour), // AthensPhase not yet active (> chainTime)
-		env.state.GetTimestamp(),                     // AthensPhase active (<= chainTime)
+	phaseTimes := []struct{ athens, berlin time.Time }{
+		{ // AthensPhase not yet active (> chainTime)
+			athens: env.state.GetTimestamp().Add(24 * time.Hour),
+			berlin: env.state.GetTimestamp().Add(24 * time.Hour),
+		},
+		{ // AthensPhase active (<= chainTime), BerlinPhase not yet active (> chainTime)
+			athens: env.state.GetTimestamp(),
+			berlin: env.state.GetTimestamp().Add(24 * time.Hour),
+		},
+		{ // BerlinPhase active (<= chainTime)
+			athens: env.state.GetTimestamp(),
+			berlin: env.state.GetTimestamp(),
+		},
 	}
 
-	for phase := 0; phase < 2; phase++ {
-		env.config.AthensPhaseTime = athensPhaseTimes[phase]
+	for phase := 0; phase < len(phaseTimes); phase++ {
+		env.config.AthensPhaseTime = phaseTimes[phase].athens
+		env.config.BerlinPhaseTime = phaseTimes[phase].berlin
 		for name, tt := range tests {
 			t.Run(fmt.Sprintf("Phase %d; %s", phase, name), func(t *testing.T) {
 				addressStateTx := &txs.AddressStateTx{
@@ -1897,6 +1952,7 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 					Remove:           tt.remove,
 					Executor:         tt.executor,
 					ExecutorAuth:     tt.executorAuth,
+					KYCExpiration:    tt.kycExpiration,
 				}
 
 				tx, err := txs.NewSigned(addressStateTx, txs.Codec, signers)
@@ -1929,6 +1985,9 @@ func TestAddAddressStateTxExecutor(t *testing.T) {
 				if err == nil {
 					targetStates, _ := executor.State.GetAddressStates(tt.targetAddress)
 					require.Equal(t, targetStates, tt.expectedState)
+					kycExpiration, err := executor.State.GetKYCExpiration(tt.targetAddress)
+					require.NoError(t, err)
+					require.Equal(t, tt.expectedKYCExpiration, kycExpiration)
 				}
 			})
 		}
@@ -1982,6 +2041,18 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 		OwnerAddress: offerOwnerAddr,
 	}
 
+	offerWithEligibilityRules := &deposit.Offer{
+		UpgradeVersionID:     codec.UpgradeVersion3,
+		ID:                   ids.ID{0, 0, 5},
+		End:                  100,
+		MinAmount:            2,
+		MinDuration:          10,
+		MaxDuration:          20,
+		OwnerAddress:         offerOwnerAddr,
+		RequiredAddressState: uint64(txs.AddressStateKYCVerified),
+		AllowListEnabled:     true,
+	}
+
 	feeUTXO := generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
 	doubleFeeUTXO := generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, defaultTxFee*2, feeOwner, ids.Empty, ids.Empty)
 	unlockedUTXO1 := generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, offer.MinAmount, utxoOwner, ids.Empty, ids.Empty)
@@ -1997,12 +2068,21 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 			name: "SunrisePhase0",
 			prepare: func(env *caminoEnvironment, chaintime time.Time) {
 				env.config.AthensPhaseTime = chaintime.Add(1 * time.Second)
+				env.config.BerlinPhaseTime = chaintime.Add(1 * time.Second)
 			},
 		},
 		{
 			name: "AthensPhase",
 			prepare: func(env *caminoEnvironment, chaintime time.Time) {
 				env.config.AthensPhaseTime = chaintime
+				env.config.BerlinPhaseTime = chaintime.Add(1 * time.Second)
+			},
+		},
+		{
+			name: "BerlinPhase",
+			prepare: func(env *caminoEnvironment, chaintime time.Time) {
+				env.config.AthensPhaseTime = chaintime
+				env.config.BerlinPhaseTime = chaintime
 			},
 		},
 	}
@@ -2031,7 +2111,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 					RewardsOwner: &secp256k1fx.OutputOwners{},
 				}
 			},
-			expectedErr: []error{errWrongLockMode, errWrongLockMode},
+			expectedErr: []error{errWrongLockMode, errWrongLockMode, errWrongLockMode},
 		},
 		"Stakeable ins": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2051,7 +2131,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 					RewardsOwner: &secp256k1fx.OutputOwners{},
 				}
 			},
-			expectedErr: []error{locked.ErrWrongInType, locked.ErrWrongInType},
+			expectedErr: []error{locked.ErrWrongInType, locked.ErrWrongInType, locked.ErrWrongInType},
 		},
 		"Stakeable outs": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2071,7 +2151,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 					RewardsOwner: &secp256k1fx.OutputOwners{},
 				}
 			},
-			expectedErr: []error{locked.ErrWrongOutType, locked.ErrWrongOutType},
+			expectedErr: []error{locked.ErrWrongOutType, locked.ErrWrongOutType, locked.ErrWrongOutType},
 		},
 		"Not existing deposit offer ID": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2090,7 +2170,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 					RewardsOwner:   &secp256k1fx.OutputOwners{},
 				}
 			},
-			expectedErr: []error{database.ErrNotFound, database.ErrNotFound},
+			expectedErr: []error{database.ErrNotFound, database.ErrNotFound, database.ErrNotFound},
 		},
 		"Deposit offer is inactive by flag": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2111,7 +2191,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offer.StartTime(),
-			expectedErr: []error{errDepositOfferInactive, errDepositOfferInactive},
+			expectedErr: []error{errDepositOfferInactive, errDepositOfferInactive, errDepositOfferInactive},
 		},
 		"Deposit offer is not active yet": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2132,7 +2212,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offer.StartTime().Add(-1),
-			expectedErr: []error{errDepositOfferInactive, errDepositOfferInactive},
+			expectedErr: []error{errDepositOfferInactive, errDepositOfferInactive, errDepositOfferInactive},
 		},
 		"Deposit offer has expired": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2153,7 +2233,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offer.EndTime().Add(time.Second),
-			expectedErr: []error{errDepositOfferInactive, errDepositOfferInactive},
+			expectedErr: []error{errDepositOfferInactive, errDepositOfferInactive, errDepositOfferInactive},
 		},
 		"Deposit duration is too small": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2175,7 +2255,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offer.StartTime(),
-			expectedErr: []error{errDepositDurationTooSmall, errDepositDurationTooSmall},
+			expectedErr: []error{errDepositDurationTooSmall, errDepositDurationTooSmall, errDepositDurationTooSmall},
 		},
 		"Deposit duration is too big": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2197,7 +2277,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offer.StartTime(),
-			expectedErr: []error{errDepositDurationTooBig, errDepositDurationTooBig},
+			expectedErr: []error{errDepositDurationTooBig, errDepositDurationTooBig, errDepositDurationTooBig},
 		},
 		"Deposit amount is too small": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2222,7 +2302,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offer.StartTime(),
-			expectedErr: []error{errDepositTooSmall, errDepositTooSmall},
+			expectedErr: []error{errDepositTooSmall, errDepositTooSmall, errDepositTooSmall},
 		},
 		"Deposit amount is too big (offer.TotalMaxAmount)": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2248,7 +2328,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offerWithMaxAmount.StartTime(),
-			expectedErr: []error{errDepositTooBig, errDepositTooBig},
+			expectedErr: []error{errDepositTooBig, errDepositTooBig, errDepositTooBig},
 		},
 		"Deposit amount is too big (offer.TotalMaxRewardAmount)": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2274,7 +2354,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offerWithMaxRewardAmount.StartTime(),
-			expectedErr: []error{errNotAthensPhase, errDepositTooBig},
+			expectedErr: []error{errNotAthensPhase, errDepositTooBig, errDepositTooBig},
 		},
 		"UTXO not found": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2304,7 +2384,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				}
 			},
 			chaintime:   offer.StartTime(),
-			expectedErr: []error{errFlowCheckFailed, errFlowCheckFailed},
+			expectedErr: []error{errFlowCheckFailed, errFlowCheckFailed, errFlowCheckFailed},
 		},
 		"Inputs and credentials length mismatch": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2334,7 +2414,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 			},
 			chaintime:   offer.StartTime(),
 			signers:     [][]*secp256k1.PrivateKey{{utxoOwnerKey}, {utxoOwnerKey}},
-			expectedErr: []error{errFlowCheckFailed, errFlowCheckFailed},
+			expectedErr: []error{errFlowCheckFailed, errFlowCheckFailed, errFlowCheckFailed},
 		},
 		"Owned offer, bad offer permission credential (wrong deposit creator addr)": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2381,7 +2461,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				copy(cred.Sigs[0][:], sig)
 				return cred
 			},
-			expectedErr: []error{errNotAthensPhase, errOfferPermissionCredentialMismatch},
+			expectedErr: []error{errNotAthensPhase, errOfferPermissionCredentialMismatch, errOfferPermissionCredentialMismatch},
 		},
 		"Owned offer, bad offer permission credential (wrong offer owner key)": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2428,7 +2508,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				copy(cred.Sigs[0][:], sig)
 				return cred
 			},
-			expectedErr: []error{errNotAthensPhase, errOfferPermissionCredentialMismatch},
+			expectedErr: []error{errNotAthensPhase, errOfferPermissionCredentialMismatch, errOfferPermissionCredentialMismatch},
 		},
 		"Owned offer, bad offer permission credential (wrong offer owner auth)": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2475,7 +2555,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				copy(cred.Sigs[0][:], sig)
 				return cred
 			},
-			expectedErr: []error{errNotAthensPhase, errOfferPermissionCredentialMismatch},
+			expectedErr: []error{errNotAthensPhase, errOfferPermissionCredentialMismatch, errOfferPermissionCredentialMismatch},
 		},
 		"Owned offer, bad deposit creator credential (wrong key)": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2522,7 +2602,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				copy(cred.Sigs[0][:], sig)
 				return cred
 			},
-			expectedErr: []error{errNotAthensPhase, errDepositCreatorCredentialMismatch},
+			expectedErr: []error{errNotAthensPhase, errDepositCreatorCredentialMismatch, errDepositCreatorCredentialMismatch},
 		},
 		"Owned offer, bad deposit creator credential (wrong auth)": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2569,7 +2649,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 				copy(cred.Sigs[0][:], sig)
 				return cred
 			},
-			expectedErr: []error{errNotAthensPhase, errDepositCreatorCredentialMismatch},
+			expectedErr: []error{errNotAthensPhase, errDepositCreatorCredentialMismatch, errDepositCreatorCredentialMismatch},
 		},
 		"Supply overflow": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2614,7 +2694,7 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 			},
 			chaintime:   offer.StartTime(),
 			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {utxoOwnerKey}},
-			expectedErr: []error{errSupplyOverflow, errSupplyOverflow},
+			expectedErr: []error{errSupplyOverflow, errSupplyOverflow, errSupplyOverflow},
 		},
 		"OK": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
@@ -2967,6 +3047,63 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {utxoOwnerKey}},
 			expectedErr: []error{errNotAthensPhase},
 		},
+		"OK|Fail: rollover deposit": {
+			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer, nil)
+				s.EXPECT().GetTimestamp().Return(offer.StartTime())
+				if phaseIndex > 1 { // if Berlin
+					expectVerifyLock(s, utx.Ins,
+						[]*avax.UTXO{feeUTXO, unlockedUTXO1},
+						[]ids.ShortID{
+							feeOwnerAddr, utxoOwnerAddr, // consumed
+							utxoOwnerAddr, // produced
+						}, nil)
+
+					deposit1 := &deposit.Deposit{
+						UpgradeVersionID: codec.UpgradeVersion1,
+						DepositOfferID:   utx.DepositOfferID,
+						Duration:         utx.DepositDuration,
+						Amount:           utx.DepositAmount(),
+						Start:            offer.Start, // current chaintime
+						RewardOwner:      utx.RewardsOwner,
+						Flags:            deposit.FlagRollover,
+					}
+					s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).
+						Return(cfg.RewardConfig.SupplyCap-deposit1.TotalReward(offer), nil)
+					s.EXPECT().AddDeposit(txID, deposit1)
+					expectConsumeUTXOs(s, utx.Ins)
+					expectProduceNewlyLockedUTXOs(s, utx.Outs, txID, 0, locked.StateDeposited)
+				}
+				return s
+			},
+			utx: func() *txs.DepositTx {
+				return &txs.DepositTx{
+					UpgradeVersionID: codec.UpgradeVersion2,
+					BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins: []*avax.TransferableInput{
+							generateTestInFromUTXO(feeUTXO, []uint32{0}),
+							generateTestInFromUTXO(unlockedUTXO1, []uint32{0}),
+						},
+						Outs: []*avax.TransferableOutput{
+							generateTestOut(ctx.AVAXAssetID, offer.MinAmount, utxoOwner, locked.ThisTxID, ids.Empty),
+						},
+					}},
+					DepositOfferID:        offer.ID,
+					DepositDuration:       offer.MinDuration,
+					RewardsOwner:          &secp256k1fx.OutputOwners{},
+					DepositCreatorAuth:    &secp256k1fx.Input{},
+					DepositOfferOwnerAuth: &secp256k1fx.Input{},
+					DepositFlags:          deposit.FlagRollover,
+				}
+			},
+			chaintime:   offer.StartTime(),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {utxoOwnerKey}},
+			expectedErr: []error{errNotBerlinPhase, errNotBerlinPhase},
+		},
 		"OK|Fail: deposit offer with owner": {
 			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
 				s := state.NewMockDiff(c)
@@ -3033,6 +3170,256 @@ func TestCaminoStandardTxExecutorDepositTx(t *testing.T) {
 			},
 			expectedErr: []error{errNotAthensPhase},
 		},
+		"Offer with eligibility rules, no offer owner permission": {
+			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offerWithEligibilityRules, nil)
+				s.EXPECT().GetTimestamp().Return(offerWithEligibilityRules.StartTime())
+				if phaseIndex > 1 { // if Berlin
+					expectVerifyMultisigPermission(s, []ids.ShortID{offerWithEligibilityRules.OwnerAddress}, nil)
+				}
+				return s
+			},
+			utx: func() *txs.DepositTx {
+				return &txs.DepositTx{
+					UpgradeVersionID: codec.UpgradeVersion1,
+					BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins: []*avax.TransferableInput{
+							generateTestInFromUTXO(feeUTXO, []uint32{0}),
+							generateTestInFromUTXO(unlockedUTXO1, []uint32{0}),
+						},
+						Outs: []*avax.TransferableOutput{
+							generateTestOut(ctx.AVAXAssetID, offer.MinAmount, utxoOwner, locked.ThisTxID, ids.Empty),
+						},
+					}},
+					DepositOfferID:        offerWithEligibilityRules.ID,
+					DepositDuration:       offerWithEligibilityRules.MaxDuration,
+					RewardsOwner:          &secp256k1fx.OutputOwners{},
+					DepositCreatorAddress: depositCreatorAddr,
+					DepositCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+					DepositOfferOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			chaintime:   offerWithEligibilityRules.StartTime(),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {utxoOwnerKey}, {depositCreatorKey}, {depositCreatorKey}},
+			expectedErr: []error{errNotAthensPhase, errNotBerlinPhase, errOfferPermissionCredentialMismatch},
+		},
+		"Offer with eligibility rules, deposit creator doesn't have required address state": {
+			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offerWithEligibilityRules, nil)
+				s.EXPECT().GetTimestamp().Return(offerWithEligibilityRules.StartTime())
+				if phaseIndex > 1 { // if Berlin
+					expectVerifyMultisigPermission(s, []ids.ShortID{offerWithEligibilityRules.OwnerAddress, utx.DepositCreatorAddress}, nil)
+					s.EXPECT().GetAddressStates(utx.DepositCreatorAddress).Return(txs.AddressStateKYCExpired, nil)
+				}
+				return s
+			},
+			utx: func() *txs.DepositTx {
+				return &txs.DepositTx{
+					UpgradeVersionID: codec.UpgradeVersion1,
+					BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins: []*avax.TransferableInput{
+							generateTestInFromUTXO(feeUTXO, []uint32{0}),
+							generateTestInFromUTXO(unlockedUTXO1, []uint32{0}),
+						},
+						Outs: []*avax.TransferableOutput{
+							generateTestOut(ctx.AVAXAssetID, offer.MinAmount, utxoOwner, locked.ThisTxID, ids.Empty),
+						},
+					}},
+					DepositOfferID:        offerWithEligibilityRules.ID,
+					DepositDuration:       offerWithEligibilityRules.MaxDuration,
+					RewardsOwner:          &secp256k1fx.OutputOwners{},
+					DepositCreatorAddress: depositCreatorAddr,
+					DepositCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+					DepositOfferOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			chaintime: offerWithEligibilityRules.StartTime(),
+			offerPermissionCred: func(t *testing.T) *secp256k1fx.Credential {
+				hash := hashing.ComputeHash256(offerWithEligibilityRules.PermissionMsg(depositCreatorAddr))
+				sig, err := offerOwnerKey.SignHash(hash)
+				require.NoError(t, err)
+				cred := &secp256k1fx.Credential{
+					Sigs: make([][secp256k1.SignatureLen]byte, 1),
+				}
+				copy(cred.Sigs[0][:], sig)
+				return cred
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {utxoOwnerKey}, {depositCreatorKey}},
+			expectedErr: []error{errNotAthensPhase, errNotBerlinPhase, errDepositCreatorNotEligible},
+		},
+		"Offer with eligibility rules, deposit creator isn't in allow-list": {
+			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offerWithEligibilityRules, nil)
+				s.EXPECT().GetTimestamp().Return(offerWithEligibilityRules.StartTime())
+				if phaseIndex > 1 { // if Berlin
+					expectVerifyMultisigPermission(s, []ids.ShortID{offerWithEligibilityRules.OwnerAddress, utx.DepositCreatorAddress}, nil)
+					s.EXPECT().GetAddressStates(utx.DepositCreatorAddress).Return(txs.AddressStateKYCVerified, nil)
+					s.EXPECT().IsDepositOfferAllowedAddress(offerWithEligibilityRules.ID, utx.DepositCreatorAddress).Return(false, nil)
+				}
+				return s
+			},
+			utx: func() *txs.DepositTx {
+				return &txs.DepositTx{
+					UpgradeVersionID: codec.UpgradeVersion1,
+					BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins: []*avax.TransferableInput{
+							generateTestInFromUTXO(feeUTXO, []uint32{0}),
+							generateTestInFromUTXO(unlockedUTXO1, []uint32{0}),
+						},
+						Outs: []*avax.TransferableOutput{
+							generateTestOut(ctx.AVAXAssetID, offer.MinAmount, utxoOwner, locked.ThisTxID, ids.Empty),
+						},
+					}},
+					DepositOfferID:        offerWithEligibilityRules.ID,
+					DepositDuration:       offerWithEligibilityRules.MaxDuration,
+					RewardsOwner:          &secp256k1fx.OutputOwners{},
+					DepositCreatorAddress: depositCreatorAddr,
+					DepositCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+					DepositOfferOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			chaintime: offerWithEligibilityRules.StartTime(),
+			offerPermissionCred: func(t *testing.T) *secp256k1fx.Credential {
+				hash := hashing.ComputeHash256(offerWithEligibilityRules.PermissionMsg(depositCreatorAddr))
+				sig, err := offerOwnerKey.SignHash(hash)
+				require.NoError(t, err)
+				cred := &secp256k1fx.Credential{
+					Sigs: make([][secp256k1.SignatureLen]byte, 1),
+				}
+				copy(cred.Sigs[0][:], sig)
+				return cred
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {utxoOwnerKey}, {depositCreatorKey}},
+			expectedErr: []error{errNotAthensPhase, errNotBerlinPhase, errDepositCreatorNotEligible},
+		},
+		"Offer with eligibility rules, bad deposit creator credential": {
+			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offerWithEligibilityRules, nil)
+				s.EXPECT().GetTimestamp().Return(offerWithEligibilityRules.StartTime())
+				if phaseIndex > 1 { // if Berlin
+					expectVerifyMultisigPermission(s, []ids.ShortID{offerWithEligibilityRules.OwnerAddress, utx.DepositCreatorAddress}, nil)
+				}
+				return s
+			},
+			utx: func() *txs.DepositTx {
+				return &txs.DepositTx{
+					UpgradeVersionID: codec.UpgradeVersion1,
+					BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins: []*avax.TransferableInput{
+							generateTestInFromUTXO(feeUTXO, []uint32{0}),
+							generateTestInFromUTXO(unlockedUTXO1, []uint32{0}),
+						},
+						Outs: []*avax.TransferableOutput{
+							generateTestOut(ctx.AVAXAssetID, offer.MinAmount, utxoOwner, locked.ThisTxID, ids.Empty),
+						},
+					}},
+					DepositOfferID:        offerWithEligibilityRules.ID,
+					DepositDuration:       offerWithEligibilityRules.MaxDuration,
+					RewardsOwner:          &secp256k1fx.OutputOwners{},
+					DepositCreatorAddress: depositCreatorAddr,
+					DepositCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+					DepositOfferOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			chaintime: offerWithEligibilityRules.StartTime(),
+			offerPermissionCred: func(t *testing.T) *secp256k1fx.Credential {
+				hash := hashing.ComputeHash256(offerWithEligibilityRules.PermissionMsg(depositCreatorAddr))
+				sig, err := offerOwnerKey.SignHash(hash)
+				require.NoError(t, err)
+				cred := &secp256k1fx.Credential{
+					Sigs: make([][secp256k1.SignatureLen]byte, 1),
+				}
+				copy(cred.Sigs[0][:], sig)
+				return cred
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {utxoOwnerKey}, {utxoOwnerKey}},
+			expectedErr: []error{errNotAthensPhase, errNotBerlinPhase, errDepositCreatorCredentialMismatch},
+		},
+		"OK|Fail: offer with eligibility rules": {
+			state: func(c *gomock.Controller, utx *txs.DepositTx, txID ids.ID, cfg *config.Config, phaseIndex int) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offerWithEligibilityRules, nil)
+				s.EXPECT().GetTimestamp().Return(offerWithEligibilityRules.StartTime())
+				if phaseIndex > 1 { // if Berlin
+					expectVerifyMultisigPermission(s, []ids.ShortID{offerWithEligibilityRules.OwnerAddress, utx.DepositCreatorAddress}, nil)
+					s.EXPECT().GetAddressStates(utx.DepositCreatorAddress).
+						Return(txs.AddressStateKYCVerified|txs.AddressStateConsortiumMember, nil)
+					s.EXPECT().IsDepositOfferAllowedAddress(offerWithEligibilityRules.ID, utx.DepositCreatorAddress).Return(true, nil)
+					expectVerifyLock(s, utx.Ins,
+						[]*avax.UTXO{feeUTXO, unlockedUTXO1},
+						[]ids.ShortID{
+							feeOwnerAddr, utxoOwnerAddr, // consumed
+							utxoOwnerAddr, // produced
+						}, nil)
+
+					deposit1 := &deposit.Deposit{
+						DepositOfferID: utx.DepositOfferID,
+						Duration:       utx.DepositDuration,
+						Amount:         utx.DepositAmount(),
+						Start:          offerWithEligibilityRules.Start, // current chaintime
+						RewardOwner:    utx.RewardsOwner,
+					}
+					s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).
+						Return(cfg.RewardConfig.SupplyCap-deposit1.TotalReward(offer), nil)
+					s.EXPECT().AddDeposit(txID, deposit1)
+					expectConsumeUTXOs(s, utx.Ins)
+					expectProduceNewlyLockedUTXOs(s, utx.Outs, txID, 0, locked.StateDeposited)
+				}
+				return s
+			},
+			utx: func() *txs.DepositTx {
+				return &txs.DepositTx{
+					UpgradeVersionID: codec.UpgradeVersion1,
+					BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins: []*avax.TransferableInput{
+							generateTestInFromUTXO(feeUTXO, []uint32{0}),
+							generateTestInFromUTXO(unlockedUTXO1, []uint32{0}),
+						},
+						Outs: []*avax.TransferableOutput{
+							generateTestOut(ctx.AVAXAssetID, offer.MinAmount, utxoOwner, locked.ThisTxID, ids.Empty),
+						},
+					}},
+					DepositOfferID:        offerWithEligibilityRules.ID,
+					DepositDuration:       offerWithEligibilityRules.MaxDuration,
+					RewardsOwner:          &secp256k1fx.OutputOwners{},
+					DepositCreatorAddress: depositCreatorAddr,
+					DepositCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+					DepositOfferOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			chaintime: offerWithEligibilityRules.StartTime(),
+			offerPermissionCred: func(t *testing.T) *secp256k1fx.Credential {
+				hash := hashing.ComputeHash256(offerWithEligibilityRules.PermissionMsg(depositCreatorAddr))
+				sig, err := offerOwnerKey.SignHash(hash)
+				require.NoError(t, err)
+				cred := &secp256k1fx.Credential{
+					Sigs: make([][secp256k1.SignatureLen]byte, 1),
+				}
+				copy(cred.Sigs[0][:], sig)
+				return cred
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {utxoOwnerKey}, {depositCreatorKey}},
+			expectedErr: []error{errNotAthensPhase, errNotBerlinPhase},
+		},
 	}
 	for name, tt := range tests {
 		for phaseIndex, phase := range phases {
@@ -3121,7 +3508,49 @@ func TestCaminoStandardTxExecutorUnlockDepositTx(t *testing.T) {
 		Amount:         20000,
 		DepositOfferID: depositOffer.ID,
 	}
-
+	depositOfferWithEarlyUnlock := &deposit.Offer{
+		UpgradeVersionID:                codec.UpgradeVersion2,
+		ID:                              ids.ID{0, 3},
+		MinAmount:                       1,
+		MinDuration:                     60,
+		MaxDuration:                     60,
+		UnlockPeriodDuration:            50,
+		InterestRateNominator:           365 * 24 * 60 * 60 * 1_000_000 / 10, // 10%
+		EarlyUnlockPenaltyRateNominator: 100_000,                             // 10%
+	}
+	depositWithEarlyUnlock := &deposit.Deposit{
+		Duration:            depositOfferWithEarlyUnlock.MinDuration,
+		Amount:              365 * 24 * 60 * 60 * 10,
+		ClaimedRewardAmount: 10,
+		DepositOfferID:      depositOfferWithEarlyUnlock.ID,
+		RewardOwner:         &owner1,
+	}
+	depositWithEarlyUnlockTxID := ids.ID{0, 0, 4}
+	depositOfferWithEarlyUnlockAndRewardLimit := *depositOfferWithEarlyUnlock
+	depositOfferWithEarlyUnlockAndRewardLimit.UpgradeVersionID = codec.UpgradeVersion2
+	depositOfferWithEarlyUnlockAndRewardLimit.TotalMaxRewardAmount = 365 * 24 * 60 * 60 * 100
+	depositWithEarlyUnlockAndRewardLimit := *depositWithEarlyUnlock
+	depositWithEarlyUnlockAndRewardLimit.DepositOfferID = depositOfferWithEarlyUnlockAndRewardLimit.ID
+	rolloverOffer := &deposit.Offer{
+		ID:                    ids.ID{0, 4},
+		End:                   100,
+		MinAmount:             1,
+		MinDuration:           60,
+		MaxDuration:           60,
+		TotalMaxAmount:        365 * 24 * 60 * 60 * 100,
+		InterestRateNominator: 365 * 24 * 60 * 60 * 1_000_000 / 10, // 10%
+	}
+	rolloverDeposit := &deposit.Deposit{
+		UpgradeVersionID:    codec.UpgradeVersion1,
+		Duration:            rolloverOffer.MinDuration,
+		Amount:              365 * 24 * 60 * 60 * 10,
+		ClaimedRewardAmount: 10,
+		DepositOfferID:      rolloverOffer.ID,
+		RewardOwner:         &owner1,
+		Flags:               deposit.FlagRollover | deposit.FlagRolloverRewards,
+	}
+	rolloverDepositTxID := ids.ID{0, 0, 5}
+
 	deposit1StartUnlockTime := deposit1.StartTime().
 		Add(time.Duration(deposit1.Duration) * time.Second).
 		Add(-time.Duration(depositOffer.UnlockPeriodDuration) * time.Second)
@@ -3131,6 +3560,30 @@ func TestCaminoStandardTxExecutorUnlockDepositTx(t *testing.T) {
 	deposit1Expired := deposit1.StartTime().
 		Add(time.Duration(deposit1.Duration) * time.Second)
 
+	beforeUnlockPeriodTime := deposit1StartUnlockTime.Add(-time.Second)
+	earlyUnlockPenalty := depositWithEarlyUnlock.EarlyUnlockPenalty(depositOfferWithEarlyUnlock, uint64(beforeUnlockPeriodTime.Unix()))
+	forfeitedReward := depositWithEarlyUnlock.TotalReward(depositOfferWithEarlyUnlock) - depositWithEarlyUnlock.ClaimedRewardAmount
+	depositOfferWithEarlyUnlockAndRewardLimit.RewardedAmount = forfeitedReward + 10
+	updatedDepositOfferWithEarlyUnlockAndRewardLimit := depositOfferWithEarlyUnlockAndRewardLimit
+	updatedDepositOfferWithEarlyUnlockAndRewardLimit.RewardedAmount = 10
+
+	rolledOverReward := rolloverDeposit.TotalReward(rolloverOffer) - rolloverDeposit.ClaimedRewardAmount
+	rolledOverDeposit := &deposit.Deposit{
+		UpgradeVersionID: codec.UpgradeVersion1,
+		DepositOfferID:   rolloverOffer.ID,
+		Start:            uint64(deposit1Expired.Unix()),
+		Duration:         rolloverDeposit.Duration,
+		Amount:           rolloverDeposit.Amount + rolledOverReward,
+		RewardOwner:      rolloverDeposit.RewardOwner,
+		Flags:            rolloverDeposit.Flags,
+	}
+	updatedRolloverOffer := *rolloverOffer
+	updatedRolloverOffer.DepositedAmount += rolledOverDeposit.Amount
+	rolloverOfferWithAllowList := *rolloverOffer
+	rolloverOfferWithAllowList.UpgradeVersionID = codec.UpgradeVersion3
+	rolloverOfferWithAllowList.AllowListEnabled = true
+	rolloverOfferWithAllowList.OwnerAddress = owner1Addr
+
 	deposit1HalfUnlockableAmount := deposit1.UnlockableAmount(depositOffer, uint64(deposit1HalfUnlockTime.Unix()))
 	deposit2HalfUnlockableAmount := deposit2.UnlockableAmount(depositOffer, uint64(deposit1HalfUnlockTime.Unix()))
 
@@ -3141,12 +3594,16 @@ func TestCaminoStandardTxExecutorUnlockDepositTx(t *testing.T) {
 	deposit1WithRewardUTXO := generateTestUTXO(ids.ID{5}, ctx.AVAXAssetID, deposit1WithReward.Amount, owner1, depositWithRewardTxID1, ids.Empty)
 	deposit1UTXOLargerTxID := generateTestUTXO(ids.ID{6}, ctx.AVAXAssetID, deposit1.Amount, owner1, depositTxID1, ids.Empty)
 	unlockedUTXOWithLargerTxID := generateTestUTXO(ids.ID{7}, ctx.AVAXAssetID, 1, owner1, ids.Empty, ids.Empty)
+	depositWithEarlyUnlockUTXO := generateTestUTXO(ids.ID{8}, ctx.AVAXAssetID, depositWithEarlyUnlock.Amount, owner1, depositWithEarlyUnlockTxID, ids.Empty)
+	rolloverDepositUTXO := generateTestUTXO(ids.ID{9}, ctx.AVAXAssetID, rolloverDeposit.Amount, owner1, rolloverDepositTxID, ids.Empty)
+	rolloverDeposit2UTXO := generateTestUTXO(ids.ID{10}, ctx.AVAXAssetID, rolloverDeposit.Amount, owner1, ids.ID{0, 0, 6}, ids.Empty)
 
 	tests := map[string]struct {
-		state       func(*gomock.Controller, *txs.UnlockDepositTx, ids.ID) *state.MockDiff
-		utx         *txs.UnlockDepositTx
-		signers     [][]*secp256k1.PrivateKey
-		expectedErr error
+		state             func(*gomock.Controller, *txs.UnlockDepositTx, ids.ID) *state.MockDiff
+		utx               *txs.UnlockDepositTx
+		signers           [][]*secp256k1.PrivateKey
+		beforeBerlinPhase bool
+		expectedErr       error
 	}{
 		"Wrong lockModeBondDeposit flag": {
 			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
@@ -3312,1258 +3769,3848 @@ func TestCaminoStandardTxExecutorUnlockDepositTx(t *testing.T) {
 			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {feeOwnerKey}, {owner1Key}},
 			expectedErr: errNoUnlock,
 		},
-		"OK: unlock full amount, expired deposit with unclaimed reward": {
+		"Early unlock, not full amount": {
 			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				// checks
 				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
-				s.EXPECT().GetTimestamp().Return(deposit1Expired)
+				s.EXPECT().GetTimestamp().Return(beforeUnlockPeriodTime)
 				expectVerifyUnlockDeposit(s, utx.Ins,
-					[]*avax.UTXO{deposit1WithRewardUTXO},
+					[]*avax.UTXO{feeUTXO, depositWithEarlyUnlockUTXO},
 					[]ids.ShortID{
+						feeOwnerAddr, owner1Addr, // consumed (not expired deposit)
 						owner1Addr, // produced unlocked
 					}, nil)
-				// state update: deposit1
-				s.EXPECT().GetDeposit(depositWithRewardTxID1).Return(deposit1WithReward, nil).Times(2)
-				s.EXPECT().GetDepositOffer(deposit1WithReward.DepositOfferID).Return(depositOfferWithReward, nil)
-				s.EXPECT().GetClaimable(owner1ID).Return(&state.Claimable{Owner: &owner1}, nil)
-				remainingReward := deposit1WithReward.TotalReward(depositOfferWithReward) - deposit1WithReward.ClaimedRewardAmount
-				s.EXPECT().SetClaimable(owner1ID, &state.Claimable{
-					Owner:                &owner1,
-					ExpiredDepositReward: remainingReward,
-				})
-				s.EXPECT().RemoveDeposit(depositWithRewardTxID1, deposit1WithReward)
-				// state update: ins/outs/utxos
-				expectConsumeUTXOs(s, utx.Ins)
-				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				s.EXPECT().GetDeposit(depositWithEarlyUnlockTxID).Return(depositWithEarlyUnlock, nil).Times(2)
+				s.EXPECT().GetDepositOffer(depositWithEarlyUnlock.DepositOfferID).Return(depositOfferWithEarlyUnlock, nil)
 				return s
 			},
 			utx: &txs.UnlockDepositTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-				Ins: generateInsFromUTXOs([]*avax.UTXO{deposit1WithRewardUTXO}),
+				Ins: generateInsFromUTXOs([]*avax.UTXO{feeUTXO, depositWithEarlyUnlockUTXO}),
 				Outs: []*avax.TransferableOutput{
-					generateTestOut(ctx.AVAXAssetID, deposit1WithReward.Amount, owner1, ids.Empty, ids.Empty),
+					generateTestOut(ctx.AVAXAssetID, depositWithEarlyUnlock.Amount-1, owner1, ids.Empty, ids.Empty),
+					generateTestOut(ctx.AVAXAssetID, 1, owner1, depositWithEarlyUnlockTxID, ids.Empty),
 				},
 			}}},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+			expectedErr: errEarlyUnlockNotFull,
 		},
-		"OK: unlock available amount, deposit is still unlocking": {
+		"Penalty output indices before BerlinPhase": {
 			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				// checks
 				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
-				s.EXPECT().GetTimestamp().Return(deposit1HalfUnlockTime)
+				s.EXPECT().GetTimestamp().Return(beforeUnlockPeriodTime)
+				return s
+			},
+			utx: &txs.UnlockDepositTx{
+				UpgradeVersionID: codec.UpgradeVersion1,
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					Ins: generateInsFromUTXOs([]*avax.UTXO{feeUTXO, depositWithEarlyUnlockUTXO}),
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, earlyUnlockPenalty, *treasury.Owner, ids.Empty, ids.Empty),
+						generateTestOut(ctx.AVAXAssetID, depositWithEarlyUnlock.Amount-earlyUnlockPenalty, owner1, ids.Empty, ids.Empty),
+					},
+				}},
+				PenaltyOutputIndices: []uint32{0},
+			},
+			signers:           [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+			beforeBerlinPhase: true,
+			expectedErr:       errNotBerlinPhase,
+		},
+		"Early unlock before BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(beforeUnlockPeriodTime)
 				expectVerifyUnlockDeposit(s, utx.Ins,
-					[]*avax.UTXO{feeUTXO, deposit1UTXO, deposit2UTXO},
+					[]*avax.UTXO{feeUTXO, depositWithEarlyUnlockUTXO},
 					[]ids.ShortID{
-						feeOwnerAddr, owner1Addr, owner1Addr, // consumed (not expired deposit)
+						feeOwnerAddr, owner1Addr, // consumed (not expired deposit)
 						owner1Addr, // produced unlocked
 					}, nil)
-				// state update: deposit1
-				s.EXPECT().GetDeposit(depositTxID1).Return(deposit1, nil).Times(2)
-				s.EXPECT().GetDepositOffer(deposit1.DepositOfferID).Return(depositOffer, nil)
-				s.EXPECT().ModifyDeposit(depositTxID1, &deposit.Deposit{
-					DepositOfferID:      deposit1.DepositOfferID,
-					UnlockedAmount:      deposit1.UnlockedAmount + deposit1HalfUnlockableAmount,
-					ClaimedRewardAmount: deposit1.ClaimedRewardAmount,
-					Start:               deposit1.Start,
-					Duration:            deposit1.Duration,
-					Amount:              deposit1.Amount,
-					RewardOwner:         deposit2.RewardOwner,
-				})
-				// state update: deposit2
-				s.EXPECT().GetDeposit(depositTxID2).Return(deposit2, nil).Times(2)
-				s.EXPECT().GetDepositOffer(deposit2.DepositOfferID).Return(depositOffer, nil)
-				s.EXPECT().ModifyDeposit(depositTxID2, &deposit.Deposit{
-					DepositOfferID:      deposit2.DepositOfferID,
-					UnlockedAmount:      deposit2.UnlockedAmount + deposit2HalfUnlockableAmount,
-					ClaimedRewardAmount: deposit2.ClaimedRewardAmount,
-					Start:               deposit2.Start,
-					Duration:            deposit2.Duration,
-					Amount:              deposit2.Amount,
-					RewardOwner:         deposit2.RewardOwner,
-				})
-				// state update: ins/outs/utxos
-				expectConsumeUTXOs(s, utx.Ins)
-				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				s.EXPECT().GetDeposit(depositWithEarlyUnlockTxID).Return(depositWithEarlyUnlock, nil).Times(2)
+				s.EXPECT().GetDepositOffer(depositWithEarlyUnlock.DepositOfferID).Return(depositOfferWithEarlyUnlock, nil)
 				return s
 			},
 			utx: &txs.UnlockDepositTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-				Ins: generateInsFromUTXOs([]*avax.UTXO{feeUTXO, deposit1UTXO, deposit2UTXO}),
+				Ins: generateInsFromUTXOs([]*avax.UTXO{feeUTXO, depositWithEarlyUnlockUTXO}),
 				Outs: []*avax.TransferableOutput{
-					generateTestOut(ctx.AVAXAssetID, deposit1HalfUnlockableAmount+deposit2HalfUnlockableAmount, owner1, ids.Empty, ids.Empty),
-					generateTestOut(ctx.AVAXAssetID, deposit1.Amount-deposit1HalfUnlockableAmount, owner1, depositTxID1, ids.Empty),
-					generateTestOut(ctx.AVAXAssetID, deposit2.Amount-deposit2HalfUnlockableAmount, owner1, depositTxID2, ids.Empty),
+					generateTestOut(ctx.AVAXAssetID, depositWithEarlyUnlock.Amount, owner1, ids.Empty, ids.Empty),
 				},
 			}}},
-			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}, {owner1Key}},
+			signers:           [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+			beforeBerlinPhase: true,
+			expectedErr:       errUnlockedMoreThanAvailable,
 		},
-	}
-	for name, tt := range tests {
-		t.Run(name, func(t *testing.T) {
-			require := require.New(t)
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
-			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
-			defer func() { require.NoError(shutdownCaminoEnvironment(env)) }() //nolint:lint
-
-			tt.utx.BlockchainID = env.ctx.ChainID
-			tt.utx.NetworkID = env.ctx.NetworkID
-			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
-			require.NoError(err)
-
-			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
-				StandardTxExecutor{
-					Backend: &env.backend,
-					State:   tt.state(ctrl, tt.utx, tx.ID()),
-					Tx:      tx,
-				},
-			})
-			require.ErrorIs(err, tt.expectedErr)
-		})
-	}
-}
-
-func TestCaminoStandardTxExecutorClaimTx(t *testing.T) {
-	ctx, _ := defaultCtx(nil)
-
-	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
-	depositRewardOwnerKey, _, depositRewardOwner := generateKeyAndOwner(t)
-	claimableOwnerKey1, _, claimableOwner1 := generateKeyAndOwner(t)
-	claimableOwnerKey2, _, claimableOwner2 := generateKeyAndOwner(t)
-	_, claimToOwnerAddr1, claimToOwner1 := generateKeyAndOwner(t)
-	_, claimToOwnerAddr2, claimToOwner2 := generateKeyAndOwner(t)
-	depositRewardMsigKeys, depositRewardMsigAlias, depositRewardMsigAliasOwner, depositRewardMsigOwner := generateMsigAliasAndKeys(t, 1, 2, false)
-	claimableMsigKeys, claimableMsigAlias, claimableMsigAliasOwner, claimableMsigOwner := generateMsigAliasAndKeys(t, 2, 3, false)
-	feeMsigKeys, feeMsigAlias, feeMsigAliasOwner, feeMsigOwner := generateMsigAliasAndKeys(t, 2, 2, false)
-
-	feeUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
-	msigFeeUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, *feeMsigOwner, ids.Empty, ids.Empty)
-
-	depositOfferID := ids.GenerateTestID()
-	depositTxID1 := ids.GenerateTestID()
-	depositTxID2 := ids.GenerateTestID()
-	claimableOwnerID1 := ids.GenerateTestID()
-	claimableOwnerID2 := ids.GenerateTestID()
-	timestamp := time.Now()
-
-	claimableValidatorReward1 := &state.Claimable{
-		Owner:           &claimableOwner1,
-		ValidatorReward: 10,
-	}
-	claimableValidatorReward2 := &state.Claimable{
-		Owner:           &claimableOwner2,
-		ValidatorReward: 11,
-	}
-	claimable1 := &state.Claimable{
-		Owner:                &claimableOwner1,
-		ExpiredDepositReward: 20,
-		ValidatorReward:      10,
-	}
-	claimable2 := &state.Claimable{
-		Owner:                &claimableOwner2,
-		ExpiredDepositReward: 21,
-		ValidatorReward:      11,
-	}
-	claimableMsigOwned := &state.Claimable{
-		Owner:                claimableMsigOwner,
-		ExpiredDepositReward: 22,
-		ValidatorReward:      12,
-	}
-
-	caminoGenesisConf := api.Camino{
-		VerifyNodeSignature: true,
-		LockModeBondDeposit: true,
-	}
-
-	baseTxWithFeeInput := func(outs []*avax.TransferableOutput) *txs.BaseTx {
-		return &txs.BaseTx{BaseTx: avax.BaseTx{
-			NetworkID:    ctx.NetworkID,
-			BlockchainID: ctx.ChainID,
-			Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
-			Outs:         outs,
-		}}
-	}
-
-	tests := map[string]struct {
-		state       func(*gomock.Controller, *txs.ClaimTx, ids.ID) *state.MockDiff
-		utx         *txs.ClaimTx
-		signers     [][]*secp256k1.PrivateKey
-		expectedErr error
-	}{
-		"Deposit not found": {
-			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+		"Early unlock, penalty isn't taken from deposit": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				// common checks and fee
 				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
-				s.EXPECT().GetTimestamp().Return(timestamp)
-				// deposit
-				s.EXPECT().GetDeposit(depositTxID1).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetTimestamp().Return(beforeUnlockPeriodTime)
+				expectVerifyUnlockDeposit(s, utx.Ins,
+					[]*avax.UTXO{feeUTXO, depositWithEarlyUnlockUTXO},
+					[]ids.ShortID{
+						feeOwnerAddr, owner1Addr, // consumed (not expired deposit)
+						owner1Addr, // produced unlocked
+					}, nil)
+				s.EXPECT().GetDeposit(depositWithEarlyUnlockTxID).Return(depositWithEarlyUnlock, nil)
 				return s
 			},
-			utx: &txs.ClaimTx{
-				BaseTx: *baseTxWithFeeInput(nil), // doesn't matter
-				Claimables: []txs.ClaimAmount{{
-					ID:        depositTxID1,
-					Amount:    1,
-					Type:      txs.ClaimTypeActiveDepositReward,
-					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			utx: &txs.UnlockDepositTx{
+				UpgradeVersionID: codec.UpgradeVersion1,
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					Ins: generateInsFromUTXOs([]*avax.UTXO{feeUTXO, depositWithEarlyUnlockUTXO}),
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, earlyUnlockPenalty, *treasury.Owner, ids.Empty, ids.Empty),
+						generateTestOut(ctx.AVAXAssetID, depositWithEarlyUnlock.Amount, owner1, ids.Empty, ids.Empty),
+					},
 				}},
+				PenaltyOutputIndices: []uint32{0},
 			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{depositRewardOwnerKey},
-			},
-			expectedErr: errDepositNotFound,
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+			expectedErr: errFlowCheckFailed,
 		},
-		"Bad deposit credential": {
-			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+		"Early unlock, wrong penalty": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				// common checks and fee
 				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
-				s.EXPECT().GetTimestamp().Return(timestamp)
-				// deposit
-				s.EXPECT().GetDeposit(depositTxID1).
-					Return(&deposit.Deposit{RewardOwner: &depositRewardOwner}, nil)
-				expectVerifyMultisigPermission(s, depositRewardOwner.Addrs, nil)
+				s.EXPECT().GetTimestamp().Return(beforeUnlockPeriodTime)
+				expectVerifyUnlockDeposit(s, utx.Ins,
+					[]*avax.UTXO{feeUTXO, depositWithEarlyUnlockUTXO},
+					[]ids.ShortID{
+						feeOwnerAddr, owner1Addr, // consumed (not expired deposit)
+						owner1Addr, // produced unlocked
+					}, nil)
+				s.EXPECT().GetDeposit(depositWithEarlyUnlockTxID).Return(depositWithEarlyUnlock, nil).Times(2)
+				s.EXPECT().GetDepositOffer(depositWithEarlyUnlock.DepositOfferID).Return(depositOfferWithEarlyUnlock, nil)
+				s.EXPECT().RemoveDeposit(depositWithEarlyUnlockTxID, depositWithEarlyUnlock)
 				return s
 			},
-			utx: &txs.ClaimTx{
-				BaseTx: *baseTxWithFeeInput(nil), // doesn't matter
-				Claimables: []txs.ClaimAmount{{
-					ID:        depositTxID1,
-					Amount:    1,
-					Type:      txs.ClaimTypeActiveDepositReward,
-					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			utx: &txs.UnlockDepositTx{
+				UpgradeVersionID: codec.UpgradeVersion1,
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					Ins: generateInsFromUTXOs([]*avax.UTXO{feeUTXO, depositWithEarlyUnlockUTXO}),
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, earlyUnlockPenalty-1, *treasury.Owner, ids.Empty, ids.Empty),
+						generateTestOut(ctx.AVAXAssetID, depositWithEarlyUnlock.Amount-earlyUnlockPenalty+1, owner1, ids.Empty, ids.Empty),
+					},
 				}},
+				PenaltyOutputIndices: []uint32{0},
 			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{feeOwnerKey},
-			},
-			expectedErr: errClaimableCredentialMismatch,
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+			expectedErr: errWrongEarlyUnlockPenalty,
 		},
-		"Bad claimable credential": {
-			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+		"OK: early unlock with penalty": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				// common checks and fee
+				// checks
 				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
-				s.EXPECT().GetTimestamp().Return(timestamp)
-				// claimable
-				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimable1, nil)
-				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
+				s.EXPECT().GetTimestamp().Return(beforeUnlockPeriodTime)
+				expectVerifyUnlockDeposit(s, utx.Ins,
+					[]*avax.UTXO{feeUTXO, depositWithEarlyUnlockUTXO},
+					[]ids.ShortID{
+						feeOwnerAddr, owner1Addr, // consumed (not expired deposit)
+						owner1Addr, // produced unlocked
+					}, nil)
+				// state update: deposit
+				s.EXPECT().GetDeposit(depositWithEarlyUnlockTxID).Return(depositWithEarlyUnlock, nil).Times(2)
+				s.EXPECT().GetDepositOffer(depositWithEarlyUnlock.DepositOfferID).Return(depositOfferWithEarlyUnlock, nil)
+				s.EXPECT().RemoveDeposit(depositWithEarlyUnlockTxID, depositWithEarlyUnlock)
+				// state update: forfeited reward
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(forfeitedReward+100, nil)
+				s.EXPECT().SetCurrentSupply(constants.PrimaryNetworkID, uint64(100))
+				// state update: ins/outs/utxos
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
 				return s
 			},
-			utx: &txs.ClaimTx{
-				BaseTx: *baseTxWithFeeInput(nil), // doesn't matter
-				Claimables: []txs.ClaimAmount{{
-					ID:        claimableOwnerID1,
-					Amount:    1,
-					Type:      txs.ClaimTypeAllTreasury,
-					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			utx: &txs.UnlockDepositTx{
+				UpgradeVersionID: codec.UpgradeVersion1,
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					Ins: generateInsFromUTXOs([]*avax.UTXO{feeUTXO, depositWithEarlyUnlockUTXO}),
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, earlyUnlockPenalty, *treasury.Owner, ids.Empty, ids.Empty),
+						generateTestOut(ctx.AVAXAssetID, depositWithEarlyUnlock.Amount-earlyUnlockPenalty, owner1, ids.Empty, ids.Empty),
+					},
 				}},
+				PenaltyOutputIndices: []uint32{0},
 			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{feeOwnerKey},
-			},
-			expectedErr: errClaimableCredentialMismatch,
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
 		},
-		// no test case for expired deposits - expected to be alike
-		"Claimed more than available (validator rewards)": {
-			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+		"Early unlock, treasury output isn't marked as penalty": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				// common checks and fee
 				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
-				s.EXPECT().GetTimestamp().Return(timestamp)
-
-				// claimable 1
-				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimableValidatorReward1, nil)
-				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
-				s.EXPECT().SetClaimable(claimableOwnerID1, &state.Claimable{
-					Owner:           claimableValidatorReward1.Owner,
-					ValidatorReward: claimableValidatorReward1.ValidatorReward - utx.Claimables[0].Amount,
-				})
-
-				// claimable 2
-				s.EXPECT().GetClaimable(claimableOwnerID2).Return(claimableValidatorReward2, nil)
-				expectVerifyMultisigPermission(s, claimableOwner2.Addrs, nil)
+				s.EXPECT().GetTimestamp().Return(beforeUnlockPeriodTime)
+				expectVerifyUnlockDeposit(s, utx.Ins,
+					[]*avax.UTXO{feeUTXO, depositWithEarlyUnlockUTXO},
+					[]ids.ShortID{
+						feeOwnerAddr, owner1Addr, // consumed (not expired deposit)
+						treasury.Addr, // produced unlocked
+					}, nil)
+				s.EXPECT().GetDeposit(depositWithEarlyUnlockTxID).Return(depositWithEarlyUnlock, nil)
 				return s
 			},
-			utx: &txs.ClaimTx{
-				BaseTx: *baseTxWithFeeInput(nil), // doesn't matter
-				Claimables: []txs.ClaimAmount{
-					{
-						ID:        claimableOwnerID1,
-						Amount:    claimableValidatorReward1.ValidatorReward - 1,
-						Type:      txs.ClaimTypeValidatorReward,
-						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
-					},
-					{
-						ID:        claimableOwnerID2,
-						Amount:    claimableValidatorReward2.ValidatorReward + 1,
-						Type:      txs.ClaimTypeValidatorReward,
-						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			utx: &txs.UnlockDepositTx{
+				UpgradeVersionID: codec.UpgradeVersion1,
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					Ins: generateInsFromUTXOs([]*avax.UTXO{feeUTXO, depositWithEarlyUnlockUTXO}),
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, earlyUnlockPenalty, *treasury.Owner, ids.Empty, ids.Empty),
+						generateTestOut(ctx.AVAXAssetID, depositWithEarlyUnlock.Amount-earlyUnlockPenalty, owner1, ids.Empty, ids.Empty),
 					},
-				},
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{claimableOwnerKey1},
-				{claimableOwnerKey2},
+				}},
 			},
-			expectedErr: errWrongClaimedAmount,
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+			expectedErr: errFlowCheckFailed,
 		},
-		"Claimed more than available (all treasury)": {
-			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+		"Early unlock, penalty output isn't owned by treasury": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				// common checks and fee
 				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
-				s.EXPECT().GetTimestamp().Return(timestamp)
-
-				// claimable 1
-				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimable1, nil)
-				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
-				s.EXPECT().SetClaimable(claimableOwnerID1, &state.Claimable{
-					Owner:                claimable1.Owner,
-					ExpiredDepositReward: 1,
-				})
-
-				// claimable 2
-				s.EXPECT().GetClaimable(claimableOwnerID2).Return(claimable2, nil)
-				expectVerifyMultisigPermission(s, claimableOwner2.Addrs, nil)
+				s.EXPECT().GetTimestamp().Return(beforeUnlockPeriodTime)
+				s.EXPECT().GetDeposit(depositWithEarlyUnlockTxID).Return(depositWithEarlyUnlock, nil)
 				return s
 			},
-			utx: &txs.ClaimTx{
-				BaseTx: *baseTxWithFeeInput(nil), // doesn't matter
-				Claimables: []txs.ClaimAmount{
-					{
-						ID:        claimableOwnerID1,
-						Amount:    claimable1.ValidatorReward - 1 + claimable1.ExpiredDepositReward,
-						Type:      txs.ClaimTypeAllTreasury,
-						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
-					},
-					{
-						ID:        claimableOwnerID2,
-						Amount:    claimable2.ValidatorReward + 1 + claimable2.ExpiredDepositReward,
-						Type:      txs.ClaimTypeAllTreasury,
-						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			utx: &txs.UnlockDepositTx{
+				UpgradeVersionID: codec.UpgradeVersion1,
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					Ins: generateInsFromUTXOs([]*avax.UTXO{feeUTXO, depositWithEarlyUnlockUTXO}),
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, earlyUnlockPenalty, owner1, ids.Empty, ids.Empty),
+						generateTestOut(ctx.AVAXAssetID, depositWithEarlyUnlock.Amount-earlyUnlockPenalty, owner1, ids.Empty, ids.Empty),
 					},
-				},
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{claimableOwnerKey1},
-				{claimableOwnerKey2},
+				}},
+				PenaltyOutputIndices: []uint32{0},
 			},
-			expectedErr: errWrongClaimedAmount,
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+			expectedErr: errWrongEarlyUnlockPenalty,
 		},
-		"Claimed more than available (active deposit)": {
-			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+		"OK: early unlock with penalty, offer with reward limit": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				// common checks and fee
+				// checks
 				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
-				s.EXPECT().GetTimestamp().Return(timestamp)
-
-				// deposit1
-				deposit1 := &deposit.Deposit{
-					DepositOfferID: depositOfferID,
-					Start:          uint64(timestamp.Unix()) - 365*24*60*60/2, // 0.5 year ago
-					Duration:       365 * 24 * 60 * 60,                        // 1 year
-					Amount:         10,
-					RewardOwner:    &depositRewardOwner,
-				}
-				s.EXPECT().GetDeposit(depositTxID1).Return(deposit1, nil)
-				expectVerifyMultisigPermission(s, depositRewardOwner.Addrs, nil)
-				s.EXPECT().GetDepositOffer(depositOfferID).Return(&deposit.Offer{
-					InterestRateNominator: 1_000_000, // 100%
-				}, nil)
+				s.EXPECT().GetTimestamp().Return(beforeUnlockPeriodTime)
+				expectVerifyUnlockDeposit(s, utx.Ins,
+					[]*avax.UTXO{feeUTXO, depositWithEarlyUnlockUTXO},
+					[]ids.ShortID{
+						feeOwnerAddr, owner1Addr, // consumed (not expired deposit)
+						owner1Addr, // produced unlocked
+					}, nil)
+				// state update: deposit
+				s.EXPECT().GetDeposit(depositWithEarlyUnlockTxID).Return(&depositWithEarlyUnlockAndRewardLimit, nil).Times(2)
+				s.EXPECT().GetDepositOffer(depositWithEarlyUnlockAndRewardLimit.DepositOfferID).
+					Return(&depositOfferWithEarlyUnlockAndRewardLimit, nil)
+				s.EXPECT().RemoveDeposit(depositWithEarlyUnlockTxID, &depositWithEarlyUnlockAndRewardLimit)
+				// state update: forfeited reward
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(forfeitedReward+100, nil)
+				s.EXPECT().SetCurrentSupply(constants.PrimaryNetworkID, uint64(100))
+				s.EXPECT().SetDepositOffer(&updatedDepositOfferWithEarlyUnlockAndRewardLimit)
+				// state update: ins/outs/utxos
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
 				return s
 			},
-			utx: &txs.ClaimTx{
-				BaseTx: *baseTxWithFeeInput(nil), // doesn't matter
-				Claimables: []txs.ClaimAmount{{
-					ID:        depositTxID1,
-					Amount:    6, // 5 is expected claimable reward amount
-					Type:      txs.ClaimTypeActiveDepositReward,
-					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+			utx: &txs.UnlockDepositTx{
+				UpgradeVersionID: codec.UpgradeVersion1,
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					Ins: generateInsFromUTXOs([]*avax.UTXO{feeUTXO, depositWithEarlyUnlockUTXO}),
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, earlyUnlockPenalty, *treasury.Owner, ids.Empty, ids.Empty),
+						generateTestOut(ctx.AVAXAssetID, depositWithEarlyUnlock.Amount-earlyUnlockPenalty, owner1, ids.Empty, ids.Empty),
+					},
 				}},
+				PenaltyOutputIndices: []uint32{0},
 			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{depositRewardOwnerKey},
-			},
-			expectedErr: errWrongClaimedAmount,
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
 		},
-		"OK, 2 deposits and claimable": {
-			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+		"Unlock multiple rollover deposits": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				// common checks and fee
 				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO},
-					[]ids.ShortID{feeOwnerAddr, claimToOwnerAddr1, claimToOwnerAddr1, claimToOwnerAddr1}, nil)
-				s.EXPECT().GetTimestamp().Return(timestamp)
-				expectConsumeUTXOs(s, utx.Ins)
-				expectProduceUTXOs(s, utx.Outs, txID, 0)
-
-				// deposit1
-				expectVerifyMultisigPermission(s, depositRewardOwner.Addrs, nil)
-				deposit1 := &deposit.Deposit{
-					DepositOfferID: depositOfferID,
-					Start:          uint64(timestamp.Unix()) - 365*24*60*60/2, // 0.5 year ago
-					Duration:       365 * 24 * 60 * 60,                        // 1 year
-					Amount:         10,
-					RewardOwner:    &depositRewardOwner,
-				}
-				s.EXPECT().GetDeposit(depositTxID1).Return(deposit1, nil)
-				s.EXPECT().GetDepositOffer(depositOfferID).Return(&deposit.Offer{
-					InterestRateNominator: 1_000_000, // 100%
-				}, nil)
-				s.EXPECT().ModifyDeposit(depositTxID1, &deposit.Deposit{
-					DepositOfferID:      deposit1.DepositOfferID,
-					UnlockedAmount:      deposit1.UnlockedAmount,
-					ClaimedRewardAmount: deposit1.ClaimedRewardAmount + utx.Claimables[0].Amount,
-					Start:               deposit1.Start,
-					Duration:            deposit1.Duration,
-					Amount:              deposit1.Amount,
-					RewardOwner:         deposit1.RewardOwner,
-				})
-
-				// deposit2
-				expectVerifyMultisigPermission(s, depositRewardOwner.Addrs, nil)
-				deposit2 := &deposit.Deposit{
-					DepositOfferID: depositOfferID,
-					Start:          uint64(timestamp.Unix()) - 365*24*60*60/2, // 0.5 year ago
-					Duration:       365 * 24 * 60 * 60,                        // 1 year
-					Amount:         10,
-					RewardOwner:    &depositRewardOwner,
-				}
-				s.EXPECT().GetDeposit(depositTxID2).Return(deposit2, nil)
-				s.EXPECT().GetDepositOffer(depositOfferID).Return(&deposit.Offer{
-					InterestRateNominator: 1_000_000, // 100%
-				}, nil)
-				s.EXPECT().ModifyDeposit(depositTxID2, &deposit.Deposit{
-					DepositOfferID:      deposit2.DepositOfferID,
-					UnlockedAmount:      deposit2.UnlockedAmount,
-					ClaimedRewardAmount: deposit2.ClaimedRewardAmount + utx.Claimables[1].Amount,
-					Start:               deposit2.Start,
-					Duration:            deposit2.Duration,
-					Amount:              deposit2.Amount,
-					RewardOwner:         deposit1.RewardOwner,
-				})
-
-				// claimable
-				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimable1, nil)
-				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
-				s.EXPECT().SetClaimable(claimableOwnerID1, nil)
+				s.EXPECT().GetTimestamp().Return(deposit1Expired)
+				s.EXPECT().GetDeposit(rolloverDepositTxID).Return(rolloverDeposit, nil)
+				s.EXPECT().GetDeposit(ids.ID{0, 0, 6}).Return(rolloverDeposit, nil)
 				return s
 			},
-			utx: &txs.ClaimTx{
-				BaseTx: *baseTxWithFeeInput([]*avax.TransferableOutput{
-					{
-						Asset: avax.Asset{ID: ctx.AVAXAssetID},
-						Out: &secp256k1fx.TransferOutput{
-							Amt:          5, // expected claimable reward amount
-							OutputOwners: claimToOwner1,
-						},
-					},
-					{
-						Asset: avax.Asset{ID: ctx.AVAXAssetID},
-						Out: &secp256k1fx.TransferOutput{
-							Amt:          5, // expected claimable reward amount
-							OutputOwners: claimToOwner1,
-						},
-					},
-					{
-						Asset: avax.Asset{ID: ctx.AVAXAssetID},
-						Out: &secp256k1fx.TransferOutput{
-							Amt:          claimable1.ValidatorReward + claimable1.ExpiredDepositReward,
-							OutputOwners: claimToOwner1,
-						},
-					},
-				}),
-				Claimables: []txs.ClaimAmount{
-					{
-						ID:        depositTxID1,
-						Amount:    5, // expected claimable reward amount
-						Type:      txs.ClaimTypeActiveDepositReward,
-						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
-					},
-					{
-						ID:        depositTxID2,
-						Amount:    5, // expected claimable reward amount
-						Type:      txs.ClaimTypeActiveDepositReward,
-						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
-					},
-					{
-						ID:        claimableOwnerID1,
-						Amount:    claimable1.ValidatorReward + claimable1.ExpiredDepositReward,
-						Type:      txs.ClaimTypeAllTreasury,
-						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
-					},
+			utx: &txs.UnlockDepositTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				Ins: generateInsFromUTXOs([]*avax.UTXO{rolloverDepositUTXO, rolloverDeposit2UTXO}),
+				Outs: []*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, rolloverDeposit.Amount*2, owner1, ids.Empty, ids.Empty),
 				},
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{depositRewardOwnerKey},
-				{depositRewardOwnerKey},
-				{claimableOwnerKey1},
-			},
+			}}},
+			expectedErr: errMultipleRolloverDeposits,
 		},
-		"OK, 2 claimable (splitted outs)": {
-			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+		"Rollover deposit isn't re-deposited": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				// common checks and fee
 				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO},
-					[]ids.ShortID{
-						feeOwnerAddr, claimToOwnerAddr1, claimToOwnerAddr2,
-						claimToOwnerAddr1, claimToOwnerAddr1,
-					}, nil)
-				s.EXPECT().GetTimestamp().Return(timestamp)
-				expectConsumeUTXOs(s, utx.Ins)
-				expectProduceUTXOs(s, utx.Outs, txID, 0)
-
-				// claimable1
-				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimableValidatorReward1, nil)
-				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
-				s.EXPECT().SetClaimable(claimableOwnerID1, nil)
-
-				// claimable2
-				s.EXPECT().GetClaimable(claimableOwnerID2).Return(claimableValidatorReward2, nil)
-				expectVerifyMultisigPermission(s, claimableOwner2.Addrs, nil)
-				s.EXPECT().SetClaimable(claimableOwnerID2, &state.Claimable{
-					Owner:           claimableValidatorReward2.Owner,
-					ValidatorReward: claimableValidatorReward2.ValidatorReward - utx.Claimables[1].Amount,
-				})
+				s.EXPECT().GetTimestamp().Return(deposit1Expired)
+				s.EXPECT().GetDeposit(rolloverDepositTxID).Return(rolloverDeposit, nil)
+				s.EXPECT().GetDepositOffer(rolloverOffer.ID).Return(rolloverOffer, nil)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(uint64(0), nil)
 				return s
 			},
-			utx: &txs.ClaimTx{
-				BaseTx: *baseTxWithFeeInput([]*avax.TransferableOutput{
-					{
-						Asset: avax.Asset{ID: ctx.AVAXAssetID},
-						Out: &secp256k1fx.TransferOutput{
-							Amt:          7, // part of claimableValidatorReward1.ValidatorReward
-							OutputOwners: claimToOwner1,
-						},
-					},
-					{
-						Asset: avax.Asset{ID: ctx.AVAXAssetID},
-						Out: &secp256k1fx.TransferOutput{
-							Amt:          claimableValidatorReward1.ValidatorReward - 7,
-							OutputOwners: claimToOwner2,
-						},
-					},
-					{
-						Asset: avax.Asset{ID: ctx.AVAXAssetID},
-						Out: &secp256k1fx.TransferOutput{
-							Amt:          2, // part of claimableValidatorReward2.ValidatorReward
-							OutputOwners: claimToOwner1,
-						},
-					},
-					{
-						Asset: avax.Asset{ID: ctx.AVAXAssetID},
-						Out: &secp256k1fx.TransferOutput{
-							Amt:          claimableValidatorReward2.ValidatorReward/2 - 2,
-							OutputOwners: claimToOwner1,
-						},
-					},
-				}),
-				Claimables: []txs.ClaimAmount{
-					{
-						ID:        claimableOwnerID1,
-						Amount:    claimableValidatorReward1.ValidatorReward,
-						Type:      txs.ClaimTypeAllTreasury,
-						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
-					},
-					{
-						ID:        claimableOwnerID2,
-						Amount:    claimableValidatorReward2.ValidatorReward / 2,
-						Type:      txs.ClaimTypeAllTreasury,
-						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
-					},
+			utx: &txs.UnlockDepositTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				Ins: generateInsFromUTXOs([]*avax.UTXO{rolloverDepositUTXO}),
+				Outs: []*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, rolloverDeposit.Amount, owner1, ids.Empty, ids.Empty),
 				},
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{claimableOwnerKey1},
-				{claimableOwnerKey2},
-			},
+			}}},
+			expectedErr: errWrongRolloverAmount,
 		},
-		"OK, 2 claimable (compacted out)": {
-			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+		"Rollover deposit is re-deposited into offer with eligibility rules": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				// common checks and fee
 				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO},
-					[]ids.ShortID{feeOwnerAddr, claimToOwnerAddr1}, nil)
-				s.EXPECT().GetTimestamp().Return(timestamp)
-				expectConsumeUTXOs(s, utx.Ins)
-				expectProduceUTXOs(s, utx.Outs, txID, 0)
-
-				// claimable1
-				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimableValidatorReward1, nil)
-				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
-				s.EXPECT().SetClaimable(claimableOwnerID1, nil)
-
-				// claimable2
-				s.EXPECT().GetClaimable(claimableOwnerID2).Return(claimableValidatorReward2, nil)
-				expectVerifyMultisigPermission(s, claimableOwner2.Addrs, nil)
-				s.EXPECT().SetClaimable(claimableOwnerID2, &state.Claimable{
-					Owner:           claimableValidatorReward2.Owner,
-					ValidatorReward: claimableValidatorReward2.ValidatorReward - utx.Claimables[1].Amount,
-				})
+				s.EXPECT().GetTimestamp().Return(deposit1Expired)
+				s.EXPECT().GetDeposit(rolloverDepositTxID).Return(rolloverDeposit, nil)
+				s.EXPECT().GetDepositOffer(rolloverOffer.ID).Return(&rolloverOfferWithAllowList, nil)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(uint64(100), nil)
 				return s
 			},
-			utx: &txs.ClaimTx{
-				BaseTx: *baseTxWithFeeInput([]*avax.TransferableOutput{
-					{
-						Asset: avax.Asset{ID: ctx.AVAXAssetID},
-						Out: &secp256k1fx.TransferOutput{
-							Amt:          claimableValidatorReward1.ValidatorReward + claimableValidatorReward2.ValidatorReward/2,
-							OutputOwners: claimToOwner1,
-						},
-					},
-				}),
-				Claimables: []txs.ClaimAmount{
-					{
-						ID:        claimableOwnerID1,
-						Amount:    claimableValidatorReward1.ValidatorReward,
-						Type:      txs.ClaimTypeAllTreasury,
-						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
-					},
-					{
-						ID:        claimableOwnerID2,
-						Amount:    claimableValidatorReward2.ValidatorReward / 2,
-						Type:      txs.ClaimTypeAllTreasury,
-						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
-					},
+			utx: &txs.UnlockDepositTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				Ins: generateInsFromUTXOs([]*avax.UTXO{rolloverDepositUTXO}),
+				Outs: []*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, rolloverDeposit.Amount, owner1, locked.ThisTxID, ids.Empty),
+					generateTestOut(ctx.AVAXAssetID, rolledOverReward, owner1, locked.ThisTxID, ids.Empty),
 				},
+			}}},
+			expectedErr: errWrongRolloverAmount,
+		},
+		"Rollover deposit reward isn't re-deposited": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(deposit1Expired)
+				s.EXPECT().GetDeposit(rolloverDepositTxID).Return(rolloverDeposit, nil)
+				s.EXPECT().GetDepositOffer(rolloverOffer.ID).Return(rolloverOffer, nil)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(uint64(0), nil)
+				return s
 			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{claimableOwnerKey1},
-				{claimableOwnerKey2},
-			},
+			utx: &txs.UnlockDepositTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				Ins: generateInsFromUTXOs([]*avax.UTXO{rolloverDepositUTXO}),
+				Outs: []*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, rolloverDeposit.Amount, owner1, locked.ThisTxID, ids.Empty),
+				},
+			}}},
+			expectedErr: errWrongRolloverAmount,
 		},
-		"OK, active deposit with non-zero already claimed reward and no rewards period": {
-			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+		"OK: expired rollover deposit can't be rolled over, unlock full amount": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				// common checks and fee
+				// checks
 				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO},
-					[]ids.ShortID{feeOwnerAddr, claimToOwnerAddr1}, nil)
-				s.EXPECT().GetTimestamp().Return(timestamp)
+				s.EXPECT().GetTimestamp().Return(deposit1Expired)
+				s.EXPECT().GetDeposit(rolloverDepositTxID).Return(rolloverDeposit, nil).Times(2)
+				s.EXPECT().GetDepositOffer(rolloverOffer.ID).Return(rolloverOffer, nil).Times(2)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(defaultCaminoConfig(true).RewardConfig.SupplyCap, nil)
+				expectVerifyUnlockDeposit(s, utx.Ins,
+					[]*avax.UTXO{rolloverDepositUTXO},
+					[]ids.ShortID{
+						owner1Addr, // produced unlocked
+					}, nil)
+				// state update: deposit
+				s.EXPECT().GetClaimable(owner1ID).Return(&state.Claimable{Owner: &owner1}, nil)
+				s.EXPECT().SetClaimable(owner1ID, &state.Claimable{
+					Owner:                &owner1,
+					ExpiredDepositReward: rolledOverReward,
+				})
+				s.EXPECT().RemoveDeposit(rolloverDepositTxID, rolloverDeposit)
+				// state update: ins/outs/utxos
 				expectConsumeUTXOs(s, utx.Ins)
 				expectProduceUTXOs(s, utx.Outs, txID, 0)
-
-				// deposit
-				expectVerifyMultisigPermission(s, depositRewardOwner.Addrs, nil)
-				deposit1 := &deposit.Deposit{
-					DepositOfferID:      depositOfferID,
-					Start:               uint64(timestamp.Unix()) - 365*24*60*60/12*6, // 6 month
-					Duration:            365 * 24 * 60 * 60 / 12 * 14,                 // 14 month
-					Amount:              10,
-					ClaimedRewardAmount: 1,
-					RewardOwner:         &depositRewardOwner,
-				}
-				s.EXPECT().GetDeposit(depositTxID1).Return(deposit1, nil)
-				s.EXPECT().GetDepositOffer(depositOfferID).Return(&deposit.Offer{
-					NoRewardsPeriodDuration: 365 * 24 * 60 * 60 / 12 * 2, // 2 month
-					InterestRateNominator:   1_000_000,                   // 100%
-				}, nil)
-				s.EXPECT().ModifyDeposit(depositTxID1, &deposit.Deposit{
-					DepositOfferID:      deposit1.DepositOfferID,
-					UnlockedAmount:      deposit1.UnlockedAmount,
-					ClaimedRewardAmount: deposit1.ClaimedRewardAmount + utx.Claimables[0].Amount,
-					Start:               deposit1.Start,
-					Duration:            deposit1.Duration,
-					Amount:              deposit1.Amount,
-					RewardOwner:         deposit1.RewardOwner,
-				})
 				return s
 			},
-			utx: &txs.ClaimTx{
-				BaseTx: *baseTxWithFeeInput([]*avax.TransferableOutput{{
-					Asset: avax.Asset{ID: ctx.AVAXAssetID},
-					Out: &secp256k1fx.TransferOutput{
-						Amt:          4, // expected claimable reward amount
-						OutputOwners: claimToOwner1,
-					},
-				}}),
-				Claimables: []txs.ClaimAmount{{
-					ID:        depositTxID1,
-					Amount:    4, // expected claimable reward amount: 10 * (6m / (14m - 2m)) - 1 = 10 * 0.5 - 1 = 5 - 1 = 4
-					Type:      txs.ClaimTypeActiveDepositReward,
-					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
-				}},
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{depositRewardOwnerKey},
-			},
+			utx: &txs.UnlockDepositTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				Ins: generateInsFromUTXOs([]*avax.UTXO{rolloverDepositUTXO}),
+				Outs: []*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, rolloverDeposit.Amount, owner1, ids.Empty, ids.Empty),
+				},
+			}}},
 		},
-		"OK, partial claim": {
-			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+		"OK: expired rollover deposit before BerlinPhase, unlock full amount": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				// common checks and fee
+				// checks
 				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO},
-					[]ids.ShortID{feeOwnerAddr, claimToOwnerAddr1, claimToOwnerAddr1}, nil)
-				s.EXPECT().GetTimestamp().Return(timestamp)
+				s.EXPECT().GetTimestamp().Return(deposit1Expired)
+				s.EXPECT().GetDeposit(rolloverDepositTxID).Return(rolloverDeposit, nil).Times(2)
+				expectVerifyUnlockDeposit(s, utx.Ins,
+					[]*avax.UTXO{rolloverDepositUTXO},
+					[]ids.ShortID{
+						owner1Addr, // produced unlocked
+					}, nil)
+				// state update: deposit
+				s.EXPECT().GetDepositOffer(rolloverOffer.ID).Return(rolloverOffer, nil)
+				s.EXPECT().GetClaimable(owner1ID).Return(&state.Claimable{Owner: &owner1}, nil)
+				s.EXPECT().SetClaimable(owner1ID, &state.Claimable{
+					Owner:                &owner1,
+					ExpiredDepositReward: rolledOverReward,
+				})
+				s.EXPECT().RemoveDeposit(rolloverDepositTxID, rolloverDeposit)
+				// state update: ins/outs/utxos
 				expectConsumeUTXOs(s, utx.Ins)
 				expectProduceUTXOs(s, utx.Outs, txID, 0)
-
-				// deposit1
-				expectVerifyMultisigPermission(s, depositRewardOwner.Addrs, nil)
-				deposit1 := &deposit.Deposit{
-					DepositOfferID: depositOfferID,
-					Start:          uint64(timestamp.Unix()) - 365*24*60*60/2, // 0.5 year ago
-					Duration:       365 * 24 * 60 * 60,                        // 1 year
-					Amount:         10,
-					RewardOwner:    &depositRewardOwner,
-				}
-				s.EXPECT().GetDeposit(depositTxID1).Return(deposit1, nil)
-				s.EXPECT().GetDepositOffer(depositOfferID).Return(&deposit.Offer{
-					InterestRateNominator: 1_000_000, // 100%
-				}, nil)
-				s.EXPECT().ModifyDeposit(depositTxID1, &deposit.Deposit{
-					DepositOfferID:      deposit1.DepositOfferID,
-					UnlockedAmount:      deposit1.UnlockedAmount,
-					ClaimedRewardAmount: deposit1.ClaimedRewardAmount + utx.Claimables[1].Amount,
-					Start:               deposit1.Start,
-					Duration:            deposit1.Duration,
-					Amount:              deposit1.Amount,
-					RewardOwner:         deposit1.RewardOwner,
-				})
-
-				// claimable
-				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimable1, nil)
-				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
-				s.EXPECT().SetClaimable(claimableOwnerID1, &state.Claimable{
-					Owner:                claimable1.Owner,
-					ExpiredDepositReward: claimable1.ExpiredDepositReward / 2,
-				})
 				return s
 			},
-			utx: &txs.ClaimTx{
-				BaseTx: *baseTxWithFeeInput([]*avax.TransferableOutput{
+			utx: &txs.UnlockDepositTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				Ins: generateInsFromUTXOs([]*avax.UTXO{rolloverDepositUTXO}),
+				Outs: []*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, rolloverDeposit.Amount, owner1, ids.Empty, ids.Empty),
+				},
+			}}},
+			beforeBerlinPhase: true,
+		},
+		"OK: expired rollover deposit is re-deposited with reward": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// checks
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(deposit1Expired)
+				s.EXPECT().GetDeposit(rolloverDepositTxID).Return(rolloverDeposit, nil).Times(2)
+				s.EXPECT().GetDepositOffer(rolloverOffer.ID).Return(rolloverOffer, nil).Times(2)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(uint64(100), nil).Times(2)
+				expectVerifyUnlockDeposit(s, utx.Ins, []*avax.UTXO{rolloverDepositUTXO}, nil, nil)
+				// state update: deposits
+				s.EXPECT().RemoveDeposit(rolloverDepositTxID, rolloverDeposit)
+				s.EXPECT().SetDepositOffer(&updatedRolloverOffer)
+				s.EXPECT().SetCurrentSupply(constants.PrimaryNetworkID, 100+rolledOverDeposit.TotalReward(rolloverOffer))
+				s.EXPECT().AddDeposit(txID, rolledOverDeposit)
+				// state update: ins/outs/utxos
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceNewlyLockedUTXOs(s, utx.Outs, txID, 0, locked.StateDeposited)
+				return s
+			},
+			utx: &txs.UnlockDepositTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				Ins: generateInsFromUTXOs([]*avax.UTXO{rolloverDepositUTXO}),
+				Outs: []*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, rolloverDeposit.Amount, owner1, locked.ThisTxID, ids.Empty),
+					generateTestOut(ctx.AVAXAssetID, rolledOverReward, owner1, locked.ThisTxID, ids.Empty),
+				},
+			}}},
+		},
+		"OK: unlock full amount, expired deposit with unclaimed reward": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// checks
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(deposit1Expired)
+				expectVerifyUnlockDeposit(s, utx.Ins,
+					[]*avax.UTXO{deposit1WithRewardUTXO},
+					[]ids.ShortID{
+						owner1Addr, // produced unlocked
+					}, nil)
+				// state update: deposit1
+				s.EXPECT().GetDeposit(depositWithRewardTxID1).Return(deposit1WithReward, nil).Times(2)
+				s.EXPECT().GetDepositOffer(deposit1WithReward.DepositOfferID).Return(depositOfferWithReward, nil)
+				s.EXPECT().GetClaimable(owner1ID).Return(&state.Claimable{Owner: &owner1}, nil)
+				remainingReward := deposit1WithReward.TotalReward(depositOfferWithReward) - deposit1WithReward.ClaimedRewardAmount
+				s.EXPECT().SetClaimable(owner1ID, &state.Claimable{
+					Owner:                &owner1,
+					ExpiredDepositReward: remainingReward,
+				})
+				s.EXPECT().RemoveDeposit(depositWithRewardTxID1, deposit1WithReward)
+				// state update: ins/outs/utxos
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			utx: &txs.UnlockDepositTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				Ins: generateInsFromUTXOs([]*avax.UTXO{deposit1WithRewardUTXO}),
+				Outs: []*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, deposit1WithReward.Amount, owner1, ids.Empty, ids.Empty),
+				},
+			}}},
+		},
+		"OK: unlock available amount, deposit is still unlocking": {
+			state: func(c *gomock.Controller, utx *txs.UnlockDepositTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// checks
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(deposit1HalfUnlockTime)
+				expectVerifyUnlockDeposit(s, utx.Ins,
+					[]*avax.UTXO{feeUTXO, deposit1UTXO, deposit2UTXO},
+					[]ids.ShortID{
+						feeOwnerAddr, owner1Addr, owner1Addr, // consumed (not expired deposit)
+						owner1Addr, // produced unlocked
+					}, nil)
+				// state update: deposit1
+				s.EXPECT().GetDeposit(depositTxID1).Return(deposit1, nil).Times(2)
+				s.EXPECT().GetDepositOffer(deposit1.DepositOfferID).Return(depositOffer, nil)
+				s.EXPECT().ModifyDeposit(depositTxID1, &deposit.Deposit{
+					DepositOfferID:      deposit1.DepositOfferID,
+					UnlockedAmount:      deposit1.UnlockedAmount + deposit1HalfUnlockableAmount,
+					ClaimedRewardAmount: deposit1.ClaimedRewardAmount,
+					Start:               deposit1.Start,
+					Duration:            deposit1.Duration,
+					Amount:              deposit1.Amount,
+					RewardOwner:         deposit2.RewardOwner,
+				})
+				// state update: deposit2
+				s.EXPECT().GetDeposit(depositTxID2).Return(deposit2, nil).Times(2)
+				s.EXPECT().GetDepositOffer(deposit2.DepositOfferID).Return(depositOffer, nil)
+				s.EXPECT().ModifyDeposit(depositTxID2, &deposit.Deposit{
+					DepositOfferID:      deposit2.DepositOfferID,
+					UnlockedAmount:      deposit2.UnlockedAmount + deposit2HalfUnlockableAmount,
+					ClaimedRewardAmount: deposit2.ClaimedRewardAmount,
+					Start:               deposit2.Start,
+					Duration:            deposit2.Duration,
+					Amount:              deposit2.Amount,
+					RewardOwner:         deposit2.RewardOwner,
+				})
+				// state update: ins/outs/utxos
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			utx: &txs.UnlockDepositTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				Ins: generateInsFromUTXOs([]*avax.UTXO{feeUTXO, deposit1UTXO, deposit2UTXO}),
+				Outs: []*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, deposit1HalfUnlockableAmount+deposit2HalfUnlockableAmount, owner1, ids.Empty, ids.Empty),
+					generateTestOut(ctx.AVAXAssetID, deposit1.Amount-deposit1HalfUnlockableAmount, owner1, depositTxID1, ids.Empty),
+					generateTestOut(ctx.AVAXAssetID, deposit2.Amount-deposit2HalfUnlockableAmount, owner1, depositTxID2, ids.Empty),
+				},
+			}}},
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}, {owner1Key}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(shutdownCaminoEnvironment(env)) }() //nolint:lint
+			if tt.beforeBerlinPhase {
+				env.config.BerlinPhaseTime = mockable.MaxTime
+			}
+
+			tt.utx.BlockchainID = env.ctx.ChainID
+			tt.utx.NetworkID = env.ctx.NetworkID
+			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
+			require.NoError(err)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, tt.utx, tx.ID()),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorClaimTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	depositRewardOwnerKey, _, depositRewardOwner := generateKeyAndOwner(t)
+	claimableOwnerKey1, _, claimableOwner1 := generateKeyAndOwner(t)
+	claimableOwnerKey2, _, claimableOwner2 := generateKeyAndOwner(t)
+	_, claimToOwnerAddr1, claimToOwner1 := generateKeyAndOwner(t)
+	_, claimToOwnerAddr2, claimToOwner2 := generateKeyAndOwner(t)
+	depositRewardMsigKeys, depositRewardMsigAlias, depositRewardMsigAliasOwner, depositRewardMsigOwner := generateMsigAliasAndKeys(t, 1, 2, false)
+	claimableMsigKeys, claimableMsigAlias, claimableMsigAliasOwner, claimableMsigOwner := generateMsigAliasAndKeys(t, 2, 3, false)
+	feeMsigKeys, feeMsigAlias, feeMsigAliasOwner, feeMsigOwner := generateMsigAliasAndKeys(t, 2, 2, false)
+	treasuryOwnerKey, _, treasuryOwner := generateKeyAndOwner(t)
+
+	feeUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+	msigFeeUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, *feeMsigOwner, ids.Empty, ids.Empty)
+
+	depositOfferID := ids.GenerateTestID()
+	depositTxID1 := ids.GenerateTestID()
+	depositTxID2 := ids.GenerateTestID()
+	claimableOwnerID1 := ids.GenerateTestID()
+	claimableOwnerID2 := ids.GenerateTestID()
+	treasuryClaimableID := ids.GenerateTestID()
+	timestamp := time.Now()
+	treasuryPeriodStart := uint64(timestamp.Unix()) - uint64(timestamp.Unix())%100
+
+	claimableValidatorReward1 := &state.Claimable{
+		Owner:           &claimableOwner1,
+		ValidatorReward: 10,
+	}
+	claimableValidatorReward2 := &state.Claimable{
+		Owner:           &claimableOwner2,
+		ValidatorReward: 11,
+	}
+	claimable1 := &state.Claimable{
+		Owner:                &claimableOwner1,
+		ExpiredDepositReward: 20,
+		ValidatorReward:      10,
+	}
+	claimable2 := &state.Claimable{
+		Owner:                &claimableOwner2,
+		ExpiredDepositReward: 21,
+		ValidatorReward:      11,
+	}
+	claimableMsigOwned := &state.Claimable{
+		Owner:                claimableMsigOwner,
+		ExpiredDepositReward: 22,
+		ValidatorReward:      12,
+	}
+	treasuryClaimable := &state.Claimable{
+		Owner:                treasury.Owner,
+		ExpiredDepositReward: 23,
+		ValidatorReward:      13,
+	}
+	treasuryConfig := &treasury.Config{
+		Owner:          treasuryOwner,
+		SpendingPeriod: 100,
+		SpendingCap:    50,
+	}
+
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	baseTxWithFeeInput := func(outs []*avax.TransferableOutput) *txs.BaseTx {
+		return &txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    ctx.NetworkID,
+			BlockchainID: ctx.ChainID,
+			Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
+			Outs:         outs,
+		}}
+	}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.ClaimTx, ids.ID) *state.MockDiff
+		utx         *txs.ClaimTx
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"Deposit not found": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// common checks and fee
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(timestamp)
+				// deposit
+				s.EXPECT().GetDeposit(depositTxID1).Return(nil, database.ErrNotFound)
+				return s
+			},
+			utx: &txs.ClaimTx{
+				BaseTx: *baseTxWithFeeInput(nil), // doesn't matter
+				Claimables: []txs.ClaimAmount{{
+					ID:        depositTxID1,
+					Amount:    1,
+					Type:      txs.ClaimTypeActiveDepositReward,
+					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{depositRewardOwnerKey},
+			},
+			expectedErr: errDepositNotFound,
+		},
+		"Bad deposit credential": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// common checks and fee
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(timestamp)
+				// deposit
+				s.EXPECT().GetDeposit(depositTxID1).
+					Return(&deposit.Deposit{RewardOwner: &depositRewardOwner}, nil)
+				expectVerifyMultisigPermission(s, depositRewardOwner.Addrs, nil)
+				return s
+			},
+			utx: &txs.ClaimTx{
+				BaseTx: *baseTxWithFeeInput(nil), // doesn't matter
+				Claimables: []txs.ClaimAmount{{
+					ID:        depositTxID1,
+					Amount:    1,
+					Type:      txs.ClaimTypeActiveDepositReward,
+					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{feeOwnerKey},
+			},
+			expectedErr: errClaimableCredentialMismatch,
+		},
+		"Bad claimable credential": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// common checks and fee
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(timestamp)
+				// claimable
+				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimable1, nil)
+				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
+				return s
+			},
+			utx: &txs.ClaimTx{
+				BaseTx: *baseTxWithFeeInput(nil), // doesn't matter
+				Claimables: []txs.ClaimAmount{{
+					ID:        claimableOwnerID1,
+					Amount:    1,
+					Type:      txs.ClaimTypeAllTreasury,
+					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{feeOwnerKey},
+			},
+			expectedErr: errClaimableCredentialMismatch,
+		},
+		// no test case for expired deposits - expected to be alike
+		"Claimed more than available (validator rewards)": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// common checks and fee
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(timestamp)
+
+				// claimable 1
+				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimableValidatorReward1, nil)
+				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
+				s.EXPECT().SetClaimable(claimableOwnerID1, &state.Claimable{
+					Owner:           claimableValidatorReward1.Owner,
+					ValidatorReward: claimableValidatorReward1.ValidatorReward - utx.Claimables[0].Amount,
+				})
+
+				// claimable 2
+				s.EXPECT().GetClaimable(claimableOwnerID2).Return(claimableValidatorReward2, nil)
+				expectVerifyMultisigPermission(s, claimableOwner2.Addrs, nil)
+				return s
+			},
+			utx: &txs.ClaimTx{
+				BaseTx: *baseTxWithFeeInput(nil), // doesn't matter
+				Claimables: []txs.ClaimAmount{
+					{
+						ID:        claimableOwnerID1,
+						Amount:    claimableValidatorReward1.ValidatorReward - 1,
+						Type:      txs.ClaimTypeValidatorReward,
+						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+					},
+					{
+						ID:        claimableOwnerID2,
+						Amount:    claimableValidatorReward2.ValidatorReward + 1,
+						Type:      txs.ClaimTypeValidatorReward,
+						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+					},
+				},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{claimableOwnerKey1},
+				{claimableOwnerKey2},
+			},
+			expectedErr: errWrongClaimedAmount,
+		},
+		"Claimed more than available (all treasury)": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// common checks and fee
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(timestamp)
+
+				// claimable 1
+				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimable1, nil)
+				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
+				s.EXPECT().SetClaimable(claimableOwnerID1, &state.Claimable{
+					Owner:                claimable1.Owner,
+					ExpiredDepositReward: 1,
+				})
+
+				// claimable 2
+				s.EXPECT().GetClaimable(claimableOwnerID2).Return(claimable2, nil)
+				expectVerifyMultisigPermission(s, claimableOwner2.Addrs, nil)
+				return s
+			},
+			utx: &txs.ClaimTx{
+				BaseTx: *baseTxWithFeeInput(nil), // doesn't matter
+				Claimables: []txs.ClaimAmount{
+					{
+						ID:        claimableOwnerID1,
+						Amount:    claimable1.ValidatorReward - 1 + claimable1.ExpiredDepositReward,
+						Type:      txs.ClaimTypeAllTreasury,
+						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+					},
+					{
+						ID:        claimableOwnerID2,
+						Amount:    claimable2.ValidatorReward + 1 + claimable2.ExpiredDepositReward,
+						Type:      txs.ClaimTypeAllTreasury,
+						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+					},
+				},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{claimableOwnerKey1},
+				{claimableOwnerKey2},
+			},
+			expectedErr: errWrongClaimedAmount,
+		},
+		"Claimed more than available (active deposit)": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// common checks and fee
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(timestamp)
+
+				// deposit1
+				deposit1 := &deposit.Deposit{
+					DepositOfferID: depositOfferID,
+					Start:          uint64(timestamp.Unix()) - 365*24*60*60/2, // 0.5 year ago
+					Duration:       365 * 24 * 60 * 60,                        // 1 year
+					Amount:         10,
+					RewardOwner:    &depositRewardOwner,
+				}
+				s.EXPECT().GetDeposit(depositTxID1).Return(deposit1, nil)
+				expectVerifyMultisigPermission(s, depositRewardOwner.Addrs, nil)
+				s.EXPECT().GetDepositOffer(depositOfferID).Return(&deposit.Offer{
+					InterestRateNominator: 1_000_000, // 100%
+				}, nil)
+				return s
+			},
+			utx: &txs.ClaimTx{
+				BaseTx: *baseTxWithFeeInput(nil), // doesn't matter
+				Claimables: []txs.ClaimAmount{{
+					ID:        depositTxID1,
+					Amount:    6, // 5 is expected claimable reward amount
+					Type:      txs.ClaimTypeActiveDepositReward,
+					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{depositRewardOwnerKey},
+			},
+			expectedErr: errWrongClaimedAmount,
+		},
+		"OK, 2 deposits and claimable": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// common checks and fee
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO},
+					[]ids.ShortID{feeOwnerAddr, claimToOwnerAddr1, claimToOwnerAddr1, claimToOwnerAddr1}, nil)
+				s.EXPECT().GetTimestamp().Return(timestamp)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+
+				// deposit1
+				expectVerifyMultisigPermission(s, depositRewardOwner.Addrs, nil)
+				deposit1 := &deposit.Deposit{
+					DepositOfferID: depositOfferID,
+					Start:          uint64(timestamp.Unix()) - 365*24*60*60/2, // 0.5 year ago
+					Duration:       365 * 24 * 60 * 60,                        // 1 year
+					Amount:         10,
+					RewardOwner:    &depositRewardOwner,
+				}
+				s.EXPECT().GetDeposit(depositTxID1).Return(deposit1, nil)
+				s.EXPECT().GetDepositOffer(depositOfferID).Return(&deposit.Offer{
+					InterestRateNominator: 1_000_000, // 100%
+				}, nil)
+				s.EXPECT().ModifyDeposit(depositTxID1, &deposit.Deposit{
+					DepositOfferID:      deposit1.DepositOfferID,
+					UnlockedAmount:      deposit1.UnlockedAmount,
+					ClaimedRewardAmount: deposit1.ClaimedRewardAmount + utx.Claimables[0].Amount,
+					Start:               deposit1.Start,
+					Duration:            deposit1.Duration,
+					Amount:              deposit1.Amount,
+					RewardOwner:         deposit1.RewardOwner,
+				})
+
+				// deposit2
+				expectVerifyMultisigPermission(s, depositRewardOwner.Addrs, nil)
+				deposit2 := &deposit.Deposit{
+					DepositOfferID: depositOfferID,
+					Start:          uint64(timestamp.Unix()) - 365*24*60*60/2, // 0.5 year ago
+					Duration:       365 * 24 * 60 * 60,                        // 1 year
+					Amount:         10,
+					RewardOwner:    &depositRewardOwner,
+				}
+				s.EXPECT().GetDeposit(depositTxID2).Return(deposit2, nil)
+				s.EXPECT().GetDepositOffer(depositOfferID).Return(&deposit.Offer{
+					InterestRateNominator: 1_000_000, // 100%
+				}, nil)
+				s.EXPECT().ModifyDeposit(depositTxID2, &deposit.Deposit{
+					DepositOfferID:      deposit2.DepositOfferID,
+					UnlockedAmount:      deposit2.UnlockedAmount,
+					ClaimedRewardAmount: deposit2.ClaimedRewardAmount + utx.Claimables[1].Amount,
+					Start:               deposit2.Start,
+					Duration:            deposit2.Duration,
+					Amount:              deposit2.Amount,
+					RewardOwner:         deposit1.RewardOwner,
+				})
+
+				// claimable
+				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimable1, nil)
+				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
+				s.EXPECT().SetClaimable(claimableOwnerID1, nil)
+				return s
+			},
+			utx: &txs.ClaimTx{
+				BaseTx: *baseTxWithFeeInput([]*avax.TransferableOutput{
+					{
+						Asset: avax.Asset{ID: ctx.AVAXAssetID},
+						Out: &secp256k1fx.TransferOutput{
+							Amt:          5, // expected claimable reward amount
+							OutputOwners: claimToOwner1,
+						},
+					},
+					{
+						Asset: avax.Asset{ID: ctx.AVAXAssetID},
+						Out: &secp256k1fx.TransferOutput{
+							Amt:          5, // expected claimable reward amount
+							OutputOwners: claimToOwner1,
+						},
+					},
+					{
+						Asset: avax.Asset{ID: ctx.AVAXAssetID},
+						Out: &secp256k1fx.TransferOutput{
+							Amt:          claimable1.ValidatorReward + claimable1.ExpiredDepositReward,
+							OutputOwners: claimToOwner1,
+						},
+					},
+				}),
+				Claimables: []txs.ClaimAmount{
+					{
+						ID:        depositTxID1,
+						Amount:    5, // expected claimable reward amount
+						Type:      txs.ClaimTypeActiveDepositReward,
+						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+					},
+					{
+						ID:        depositTxID2,
+						Amount:    5, // expected claimable reward amount
+						Type:      txs.ClaimTypeActiveDepositReward,
+						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+					},
+					{
+						ID:        claimableOwnerID1,
+						Amount:    claimable1.ValidatorReward + claimable1.ExpiredDepositReward,
+						Type:      txs.ClaimTypeAllTreasury,
+						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+					},
+				},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{depositRewardOwnerKey},
+				{depositRewardOwnerKey},
+				{claimableOwnerKey1},
+			},
+		},
+		"OK, 2 claimable (splitted outs)": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// common checks and fee
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO},
+					[]ids.ShortID{
+						feeOwnerAddr, claimToOwnerAddr1, claimToOwnerAddr2,
+						claimToOwnerAddr1, claimToOwnerAddr1,
+					}, nil)
+				s.EXPECT().GetTimestamp().Return(timestamp)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+
+				// claimable1
+				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimableValidatorReward1, nil)
+				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
+				s.EXPECT().SetClaimable(claimableOwnerID1, nil)
+
+				// claimable2
+				s.EXPECT().GetClaimable(claimableOwnerID2).Return(claimableValidatorReward2, nil)
+				expectVerifyMultisigPermission(s, claimableOwner2.Addrs, nil)
+				s.EXPECT().SetClaimable(claimableOwnerID2, &state.Claimable{
+					Owner:           claimableValidatorReward2.Owner,
+					ValidatorReward: claimableValidatorReward2.ValidatorReward - utx.Claimables[1].Amount,
+				})
+				return s
+			},
+			utx: &txs.ClaimTx{
+				BaseTx: *baseTxWithFeeInput([]*avax.TransferableOutput{
+					{
+						Asset: avax.Asset{ID: ctx.AVAXAssetID},
+						Out: &secp256k1fx.TransferOutput{
+							Amt:          7, // part of claimableValidatorReward1.ValidatorReward
+							OutputOwners: claimToOwner1,
+						},
+					},
+					{
+						Asset: avax.Asset{ID: ctx.AVAXAssetID},
+						Out: &secp256k1fx.TransferOutput{
+							Amt:          claimableValidatorReward1.ValidatorReward - 7,
+							OutputOwners: claimToOwner2,
+						},
+					},
+					{
+						Asset: avax.Asset{ID: ctx.AVAXAssetID},
+						Out: &secp256k1fx.TransferOutput{
+							Amt:          2, // part of claimableValidatorReward2.ValidatorReward
+							OutputOwners: claimToOwner1,
+						},
+					},
+					{
+						Asset: avax.Asset{ID: ctx.AVAXAssetID},
+						Out: &secp256k1fx.TransferOutput{
+							Amt:          claimableValidatorReward2.ValidatorReward/2 - 2,
+							OutputOwners: claimToOwner1,
+						},
+					},
+				}),
+				Claimables: []txs.ClaimAmount{
+					{
+						ID:        claimableOwnerID1,
+						Amount:    claimableValidatorReward1.ValidatorReward,
+						Type:      txs.ClaimTypeAllTreasury,
+						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+					},
+					{
+						ID:        claimableOwnerID2,
+						Amount:    claimableValidatorReward2.ValidatorReward / 2,
+						Type:      txs.ClaimTypeAllTreasury,
+						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+					},
+				},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{claimableOwnerKey1},
+				{claimableOwnerKey2},
+			},
+		},
+		"OK, 2 claimable (compacted out)": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// common checks and fee
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO},
+					[]ids.ShortID{feeOwnerAddr, claimToOwnerAddr1}, nil)
+				s.EXPECT().GetTimestamp().Return(timestamp)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+
+				// claimable1
+				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimableValidatorReward1, nil)
+				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
+				s.EXPECT().SetClaimable(claimableOwnerID1, nil)
+
+				// claimable2
+				s.EXPECT().GetClaimable(claimableOwnerID2).Return(claimableValidatorReward2, nil)
+				expectVerifyMultisigPermission(s, claimableOwner2.Addrs, nil)
+				s.EXPECT().SetClaimable(claimableOwnerID2, &state.Claimable{
+					Owner:           claimableValidatorReward2.Owner,
+					ValidatorReward: claimableValidatorReward2.ValidatorReward - utx.Claimables[1].Amount,
+				})
+				return s
+			},
+			utx: &txs.ClaimTx{
+				BaseTx: *baseTxWithFeeInput([]*avax.TransferableOutput{
+					{
+						Asset: avax.Asset{ID: ctx.AVAXAssetID},
+						Out: &secp256k1fx.TransferOutput{
+							Amt:          claimableValidatorReward1.ValidatorReward + claimableValidatorReward2.ValidatorReward/2,
+							OutputOwners: claimToOwner1,
+						},
+					},
+				}),
+				Claimables: []txs.ClaimAmount{
+					{
+						ID:        claimableOwnerID1,
+						Amount:    claimableValidatorReward1.ValidatorReward,
+						Type:      txs.ClaimTypeAllTreasury,
+						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+					},
+					{
+						ID:        claimableOwnerID2,
+						Amount:    claimableValidatorReward2.ValidatorReward / 2,
+						Type:      txs.ClaimTypeAllTreasury,
+						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+					},
+				},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{claimableOwnerKey1},
+				{claimableOwnerKey2},
+			},
+		},
+		"OK, active deposit with non-zero already claimed reward and no rewards period": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// common checks and fee
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO},
+					[]ids.ShortID{feeOwnerAddr, claimToOwnerAddr1}, nil)
+				s.EXPECT().GetTimestamp().Return(timestamp)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+
+				// deposit
+				expectVerifyMultisigPermission(s, depositRewardOwner.Addrs, nil)
+				deposit1 := &deposit.Deposit{
+					DepositOfferID:      depositOfferID,
+					Start:               uint64(timestamp.Unix()) - 365*24*60*60/12*6, // 6 month
+					Duration:            365 * 24 * 60 * 60 / 12 * 14,                 // 14 month
+					Amount:              10,
+					ClaimedRewardAmount: 1,
+					RewardOwner:         &depositRewardOwner,
+				}
+				s.EXPECT().GetDeposit(depositTxID1).Return(deposit1, nil)
+				s.EXPECT().GetDepositOffer(depositOfferID).Return(&deposit.Offer{
+					NoRewardsPeriodDuration: 365 * 24 * 60 * 60 / 12 * 2, // 2 month
+					InterestRateNominator:   1_000_000,                   // 100%
+				}, nil)
+				s.EXPECT().ModifyDeposit(depositTxID1, &deposit.Deposit{
+					DepositOfferID:      deposit1.DepositOfferID,
+					UnlockedAmount:      deposit1.UnlockedAmount,
+					ClaimedRewardAmount: deposit1.ClaimedRewardAmount + utx.Claimables[0].Amount,
+					Start:               deposit1.Start,
+					Duration:            deposit1.Duration,
+					Amount:              deposit1.Amount,
+					RewardOwner:         deposit1.RewardOwner,
+				})
+				return s
+			},
+			utx: &txs.ClaimTx{
+				BaseTx: *baseTxWithFeeInput([]*avax.TransferableOutput{{
+					Asset: avax.Asset{ID: ctx.AVAXAssetID},
+					Out: &secp256k1fx.TransferOutput{
+						Amt:          4, // expected claimable reward amount
+						OutputOwners: claimToOwner1,
+					},
+				}}),
+				Claimables: []txs.ClaimAmount{{
+					ID:        depositTxID1,
+					Amount:    4, // expected claimable reward amount: 10 * (6m / (14m - 2m)) - 1 = 10 * 0.5 - 1 = 5 - 1 = 4
+					Type:      txs.ClaimTypeActiveDepositReward,
+					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{depositRewardOwnerKey},
+			},
+		},
+		"OK, partial claim": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// common checks and fee
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO},
+					[]ids.ShortID{feeOwnerAddr, claimToOwnerAddr1, claimToOwnerAddr1}, nil)
+				s.EXPECT().GetTimestamp().Return(timestamp)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+
+				// deposit1
+				expectVerifyMultisigPermission(s, depositRewardOwner.Addrs, nil)
+				deposit1 := &deposit.Deposit{
+					DepositOfferID: depositOfferID,
+					Start:          uint64(timestamp.Unix()) - 365*24*60*60/2, // 0.5 year ago
+					Duration:       365 * 24 * 60 * 60,                        // 1 year
+					Amount:         10,
+					RewardOwner:    &depositRewardOwner,
+				}
+				s.EXPECT().GetDeposit(depositTxID1).Return(deposit1, nil)
+				s.EXPECT().GetDepositOffer(depositOfferID).Return(&deposit.Offer{
+					InterestRateNominator: 1_000_000, // 100%
+				}, nil)
+				s.EXPECT().ModifyDeposit(depositTxID1, &deposit.Deposit{
+					DepositOfferID:      deposit1.DepositOfferID,
+					UnlockedAmount:      deposit1.UnlockedAmount,
+					ClaimedRewardAmount: deposit1.ClaimedRewardAmount + utx.Claimables[1].Amount,
+					Start:               deposit1.Start,
+					Duration:            deposit1.Duration,
+					Amount:              deposit1.Amount,
+					RewardOwner:         deposit1.RewardOwner,
+				})
+
+				// claimable
+				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimable1, nil)
+				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
+				s.EXPECT().SetClaimable(claimableOwnerID1, &state.Claimable{
+					Owner:                claimable1.Owner,
+					ExpiredDepositReward: claimable1.ExpiredDepositReward / 2,
+				})
+				return s
+			},
+			utx: &txs.ClaimTx{
+				BaseTx: *baseTxWithFeeInput([]*avax.TransferableOutput{
+					{
+						Asset: avax.Asset{ID: ctx.AVAXAssetID},
+						Out: &secp256k1fx.TransferOutput{
+							Amt:          claimable1.ValidatorReward + claimable1.ExpiredDepositReward/2,
+							OutputOwners: claimToOwner1,
+						},
+					},
+					{
+						Asset: avax.Asset{ID: ctx.AVAXAssetID},
+						Out: &secp256k1fx.TransferOutput{
+							Amt:          2, // 5 is expected available reward amount
+							OutputOwners: claimToOwner1,
+						},
+					},
+				}),
+				Claimables: []txs.ClaimAmount{
+					{
+						ID:        claimableOwnerID1,
+						Amount:    claimable1.ValidatorReward + claimable1.ExpiredDepositReward/2,
+						Type:      txs.ClaimTypeAllTreasury,
+						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+					},
 					{
+						ID:        depositTxID1,
+						Amount:    2, // 5 is expected available reward amount
+						Type:      txs.ClaimTypeActiveDepositReward,
+						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+					},
+				},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{claimableOwnerKey1},
+				{depositRewardOwnerKey},
+			},
+		},
+		"OK, claim (expired deposit rewards)": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// common checks and fee
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO},
+					[]ids.ShortID{feeOwnerAddr, claimToOwnerAddr1}, nil)
+				s.EXPECT().GetTimestamp().Return(timestamp)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+
+				// claimable
+				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimable1, nil)
+				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
+				s.EXPECT().SetClaimable(claimableOwnerID1, &state.Claimable{
+					Owner:           claimable1.Owner,
+					ValidatorReward: claimable1.ValidatorReward,
+				})
+				return s
+			},
+			utx: &txs.ClaimTx{
+				BaseTx: *baseTxWithFeeInput([]*avax.TransferableOutput{{
+					Asset: avax.Asset{ID: ctx.AVAXAssetID},
+					Out: &secp256k1fx.TransferOutput{
+						Amt:          claimable1.ExpiredDepositReward,
+						OutputOwners: claimToOwner1,
+					},
+				}}),
+				Claimables: []txs.ClaimAmount{{
+					ID:        claimableOwnerID1,
+					Amount:    claimable1.ExpiredDepositReward,
+					Type:      txs.ClaimTypeExpiredDepositReward,
+					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{claimableOwnerKey1},
+			},
+		},
+		"OK, claim (validator rewards)": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// common checks and fee
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO},
+					[]ids.ShortID{feeOwnerAddr, claimToOwnerAddr1}, nil)
+				s.EXPECT().GetTimestamp().Return(timestamp)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+
+				// claimable
+				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimable1, nil)
+				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
+				s.EXPECT().SetClaimable(claimableOwnerID1, &state.Claimable{
+					Owner:                claimable1.Owner,
+					ExpiredDepositReward: claimable1.ExpiredDepositReward,
+				})
+				return s
+			},
+			utx: &txs.ClaimTx{
+				BaseTx: *baseTxWithFeeInput([]*avax.TransferableOutput{{
+					Asset: avax.Asset{ID: ctx.AVAXAssetID},
+					Out: &secp256k1fx.TransferOutput{
+						Amt:          claimable1.ValidatorReward,
+						OutputOwners: claimToOwner1,
+					},
+				}}),
+				Claimables: []txs.ClaimAmount{{
+					ID:        claimableOwnerID1,
+					Amount:    claimable1.ValidatorReward,
+					Type:      txs.ClaimTypeValidatorReward,
+					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{claimableOwnerKey1},
+			},
+		},
+		"Treasury claimable: treasury owner credential mismatch": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// common checks and fee
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(timestamp)
+				// claimable
+				s.EXPECT().GetClaimable(treasuryClaimableID).Return(treasuryClaimable, nil)
+				s.EXPECT().GetTreasuryConfig().Return(treasuryConfig, nil)
+				expectVerifyMultisigPermission(s, treasuryOwner.Addrs, nil)
+				return s
+			},
+			utx: &txs.ClaimTx{
+				BaseTx: *baseTxWithFeeInput(nil), // doesn't matter
+				Claimables: []txs.ClaimAmount{{
+					ID:        treasuryClaimableID,
+					Amount:    treasuryClaimable.ValidatorReward,
+					Type:      txs.ClaimTypeAllTreasury,
+					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{feeOwnerKey},
+			},
+			expectedErr: errClaimableCredentialMismatch,
+		},
+		"Treasury claimable: spending cap exceeded": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// common checks and fee
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO},
+					[]ids.ShortID{feeOwnerAddr, claimToOwnerAddr1}, nil)
+				s.EXPECT().GetTimestamp().Return(timestamp)
+				// claimable
+				s.EXPECT().GetClaimable(treasuryClaimableID).Return(treasuryClaimable, nil)
+				s.EXPECT().GetTreasuryConfig().Return(treasuryConfig, nil)
+				expectVerifyMultisigPermission(s, treasuryOwner.Addrs, nil)
+				s.EXPECT().SetClaimable(treasuryClaimableID, &state.Claimable{
+					Owner:                treasuryClaimable.Owner,
+					ExpiredDepositReward: treasuryClaimable.ExpiredDepositReward,
+				})
+				// treasury spending
+				s.EXPECT().GetTreasurySpending().
+					Return(&treasury.Spending{PeriodStart: treasuryPeriodStart, Amount: 38}, nil)
+				return s
+			},
+			utx: &txs.ClaimTx{
+				BaseTx: *baseTxWithFeeInput([]*avax.TransferableOutput{{
+					Asset: avax.Asset{ID: ctx.AVAXAssetID},
+					Out: &secp256k1fx.TransferOutput{
+						Amt:          treasuryClaimable.ValidatorReward,
+						OutputOwners: claimToOwner1,
+					},
+				}}),
+				Claimables: []txs.ClaimAmount{{
+					ID:        treasuryClaimableID,
+					Amount:    treasuryClaimable.ValidatorReward,
+					Type:      txs.ClaimTypeValidatorReward,
+					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{treasuryOwnerKey},
+			},
+			expectedErr: errTreasurySpendingCapExceeded,
+		},
+		"OK, treasury claimable (all treasury)": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// common checks and fee
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO},
+					[]ids.ShortID{feeOwnerAddr, claimToOwnerAddr1}, nil)
+				s.EXPECT().GetTimestamp().Return(timestamp)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				// claimable
+				s.EXPECT().GetClaimable(treasuryClaimableID).Return(treasuryClaimable, nil)
+				s.EXPECT().GetTreasuryConfig().Return(treasuryConfig, nil)
+				expectVerifyMultisigPermission(s, treasuryOwner.Addrs, nil)
+				s.EXPECT().SetClaimable(treasuryClaimableID, nil)
+				// treasury spending
+				s.EXPECT().GetTreasurySpending().
+					Return(&treasury.Spending{PeriodStart: treasuryPeriodStart - 100, Amount: 50}, nil)
+				s.EXPECT().SetTreasurySpending(&treasury.Spending{
+					PeriodStart: treasuryPeriodStart,
+					Amount:      treasuryClaimable.ValidatorReward + treasuryClaimable.ExpiredDepositReward,
+				})
+				return s
+			},
+			utx: &txs.ClaimTx{
+				BaseTx: *baseTxWithFeeInput([]*avax.TransferableOutput{{
+					Asset: avax.Asset{ID: ctx.AVAXAssetID},
+					Out: &secp256k1fx.TransferOutput{
+						Amt:          treasuryClaimable.ValidatorReward + treasuryClaimable.ExpiredDepositReward,
+						OutputOwners: claimToOwner1,
+					},
+				}}),
+				Claimables: []txs.ClaimAmount{{
+					ID:        treasuryClaimableID,
+					Amount:    treasuryClaimable.ValidatorReward + treasuryClaimable.ExpiredDepositReward,
+					Type:      txs.ClaimTypeAllTreasury,
+					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{treasuryOwnerKey},
+			},
+		},
+		"OK, msig fee, claimable and deposit": {
+			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				// common checks and fee+
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{msigFeeUTXO},
+					[]ids.ShortID{
+						feeMsigAlias.ID,
+						feeMsigAliasOwner.Addrs[0],
+						feeMsigAliasOwner.Addrs[1],
+						claimToOwnerAddr1,
+					},
+					[]*multisig.AliasWithNonce{feeMsigAlias})
+				s.EXPECT().GetTimestamp().Return(timestamp)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+
+				// deposit1
+				expectVerifyMultisigPermission(s, []ids.ShortID{
+					depositRewardMsigAlias.ID,
+					depositRewardMsigAliasOwner.Addrs[0],
+					depositRewardMsigAliasOwner.Addrs[1],
+				}, []*multisig.AliasWithNonce{depositRewardMsigAlias})
+				deposit1 := &deposit.Deposit{
+					DepositOfferID: depositOfferID,
+					Start:          uint64(timestamp.Unix()) - 365*24*60*60/2, // 0.5 year ago
+					Duration:       365 * 24 * 60 * 60,                        // 1 year
+					Amount:         10,
+					RewardOwner:    depositRewardMsigOwner,
+				}
+				s.EXPECT().GetDeposit(depositTxID1).Return(deposit1, nil)
+				s.EXPECT().GetDepositOffer(depositOfferID).Return(&deposit.Offer{
+					InterestRateNominator: 1_000_000, // 100%
+				}, nil)
+				s.EXPECT().ModifyDeposit(depositTxID1, &deposit.Deposit{
+					DepositOfferID:      deposit1.DepositOfferID,
+					UnlockedAmount:      deposit1.UnlockedAmount,
+					ClaimedRewardAmount: deposit1.ClaimedRewardAmount + utx.Claimables[0].Amount,
+					Start:               deposit1.Start,
+					Duration:            deposit1.Duration,
+					Amount:              deposit1.Amount,
+					RewardOwner:         deposit1.RewardOwner,
+				})
+
+				// claimable
+				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimableMsigOwned, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{
+					claimableMsigAlias.ID,
+					claimableMsigAliasOwner.Addrs[0],
+					claimableMsigAliasOwner.Addrs[1],
+					claimableMsigAliasOwner.Addrs[2],
+				}, []*multisig.AliasWithNonce{claimableMsigAlias})
+				s.EXPECT().SetClaimable(claimableOwnerID1, nil)
+				return s
+			},
+			utx: &txs.ClaimTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins:          []*avax.TransferableInput{generateTestInFromUTXO(msigFeeUTXO, []uint32{0, 1})},
+					Outs: []*avax.TransferableOutput{{
 						Asset: avax.Asset{ID: ctx.AVAXAssetID},
 						Out: &secp256k1fx.TransferOutput{
-							Amt:          claimable1.ValidatorReward + claimable1.ExpiredDepositReward/2,
+							Amt:          claimableMsigOwned.ExpiredDepositReward,
 							OutputOwners: claimToOwner1,
 						},
+					}},
+				}},
+				Claimables: []txs.ClaimAmount{
+					{
+						ID:        depositTxID1,
+						Amount:    5,
+						Type:      txs.ClaimTypeActiveDepositReward,
+						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+					},
+					{
+						ID:        claimableOwnerID1,
+						Amount:    claimableMsigOwned.ValidatorReward + claimableMsigOwned.ExpiredDepositReward,
+						Type:      txs.ClaimTypeAllTreasury,
+						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0, 2}},
+					},
+				},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeMsigKeys[0], feeMsigKeys[1]},
+				{depositRewardMsigKeys[0]},
+				{claimableMsigKeys[0], claimableMsigKeys[2]},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(shutdownCaminoEnvironment(env)) }() //nolint:lint
+
+			// ensuring that ins and outs from test case are sorted, signing tx
+
+			avax.SortTransferableInputsWithSigners(tt.utx.Ins, tt.signers)
+			avax.SortTransferableOutputs(tt.utx.Outs, txs.Codec)
+			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
+			require.NoError(err)
+
+			// testing
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, tt.utx, tx.ID()),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorRegisterNodeTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	consortiumMemberKey, consortiumMemberAddr, _ := generateKeyAndOwner(t)
+	consortiumMemberMsigKeys, consortiumMemberMsigAlias, consortiumMemberMsigAliasOwner, _ := generateMsigAliasAndKeys(t, 2, 3, false)
+	nodeKey1, nodeAddr1, _ := generateKeyAndOwner(t)
+	nodeKey2, nodeAddr2, _ := generateKeyAndOwner(t)
+	nodeID1 := ids.NodeID(nodeAddr1)
+	nodeID2 := ids.NodeID(nodeAddr2)
+
+	feeUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+
+	baseTx := txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+		Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
+	}}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.RegisterNodeTx, ids.ID) *state.MockDiff
+		utx         func() *txs.RegisterNodeTx
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"Not consortium member": {
+			state: func(c *gomock.Controller, utx *txs.RegisterNodeTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetAddressStates(utx.NodeOwnerAddress).Return(txs.AddressStateEmpty, nil)
+				return s
+			},
+			utx: func() *txs.RegisterNodeTx {
+				return &txs.RegisterNodeTx{
+					BaseTx:           baseTx,
+					OldNodeID:        ids.EmptyNodeID,
+					NewNodeID:        nodeID1,
+					NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+					NodeOwnerAddress: consortiumMemberAddr,
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {nodeKey1}, {consortiumMemberKey},
+			},
+			expectedErr: errNotConsortiumMember,
+		},
+		"Consortium member has already registered node": {
+			state: func(c *gomock.Controller, utx *txs.RegisterNodeTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetAddressStates(utx.NodeOwnerAddress).Return(txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetShortIDLink(utx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode).
+					Return(nodeAddr2, nil)
+				return s
+			},
+			utx: func() *txs.RegisterNodeTx {
+				return &txs.RegisterNodeTx{
+					BaseTx:           baseTx,
+					OldNodeID:        ids.EmptyNodeID,
+					NewNodeID:        nodeID1,
+					NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+					NodeOwnerAddress: consortiumMemberAddr,
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {nodeKey1}, {consortiumMemberKey},
+			},
+			expectedErr: errConsortiumMemberHasNode,
+		},
+		"Old node is in current validator's set": {
+			state: func(c *gomock.Controller, utx *txs.RegisterNodeTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetAddressStates(utx.NodeOwnerAddress).Return(txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetShortIDLink(utx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode).
+					Return(nodeAddr1, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.NodeOwnerAddress}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, utx.OldNodeID).Return(nil, nil) // no error
+				return s
+			},
+			utx: func() *txs.RegisterNodeTx {
+				return &txs.RegisterNodeTx{
+					BaseTx:           baseTx,
+					OldNodeID:        nodeID1,
+					NewNodeID:        nodeID2,
+					NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+					NodeOwnerAddress: consortiumMemberAddr,
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{nodeKey2},
+				{consortiumMemberKey},
+			},
+			expectedErr: errValidatorExists,
+		},
+		"Old node is in pending validator's set": {
+			state: func(c *gomock.Controller, utx *txs.RegisterNodeTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetAddressStates(utx.NodeOwnerAddress).Return(txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetShortIDLink(utx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode).
+					Return(nodeAddr1, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.NodeOwnerAddress}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, utx.OldNodeID).
+					Return(nil, database.ErrNotFound)
+				s.EXPECT().GetPendingValidator(constants.PrimaryNetworkID, utx.OldNodeID).Return(nil, nil) // no error
+				return s
+			},
+			utx: func() *txs.RegisterNodeTx {
+				return &txs.RegisterNodeTx{
+					BaseTx:           baseTx,
+					OldNodeID:        nodeID1,
+					NewNodeID:        nodeID2,
+					NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+					NodeOwnerAddress: consortiumMemberAddr,
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{nodeKey2},
+				{consortiumMemberKey},
+			},
+			expectedErr: errValidatorExists,
+		},
+		"Old node is in deferred validator's set": {
+			state: func(c *gomock.Controller, utx *txs.RegisterNodeTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetAddressStates(utx.NodeOwnerAddress).Return(txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetShortIDLink(utx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode).
+					Return(nodeAddr1, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.NodeOwnerAddress}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, utx.OldNodeID).
+					Return(nil, database.ErrNotFound)
+				s.EXPECT().GetPendingValidator(constants.PrimaryNetworkID, utx.OldNodeID).
+					Return(nil, database.ErrNotFound)
+				s.EXPECT().GetDeferredValidator(constants.PrimaryNetworkID, utx.OldNodeID).Return(nil, nil) // no error
+				return s
+			},
+			utx: func() *txs.RegisterNodeTx {
+				return &txs.RegisterNodeTx{
+					BaseTx:           baseTx,
+					OldNodeID:        nodeID1,
+					NewNodeID:        nodeID2,
+					NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+					NodeOwnerAddress: consortiumMemberAddr,
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{nodeKey2},
+				{consortiumMemberKey},
+			},
+			expectedErr: errValidatorExists,
+		},
+		"OK: change registered node": {
+			state: func(c *gomock.Controller, utx *txs.RegisterNodeTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetAddressStates(utx.NodeOwnerAddress).Return(txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetShortIDLink(utx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode).
+					Return(nodeAddr1, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.NodeOwnerAddress}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, utx.OldNodeID).
+					Return(nil, database.ErrNotFound)
+				s.EXPECT().GetPendingValidator(constants.PrimaryNetworkID, utx.OldNodeID).
+					Return(nil, database.ErrNotFound)
+				s.EXPECT().GetDeferredValidator(constants.PrimaryNetworkID, utx.OldNodeID).
+					Return(nil, database.ErrNotFound)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().SetShortIDLink(ids.ShortID(utx.OldNodeID), state.ShortLinkKeyRegisterNode, nil)
+				s.EXPECT().SetShortIDLink(utx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode, nil)
+				s.EXPECT().SetNodeRegistrationTxID(utx.OldNodeID, nil)
+				s.EXPECT().SetShortIDLink(
+					ids.ShortID(utx.NewNodeID),
+					state.ShortLinkKeyRegisterNode,
+					&utx.NodeOwnerAddress,
+				)
+				link := ids.ShortID(utx.NewNodeID)
+				s.EXPECT().SetShortIDLink(
+					utx.NodeOwnerAddress,
+					state.ShortLinkKeyRegisterNode,
+					&link,
+				)
+				s.EXPECT().SetNodeRegistrationTxID(utx.NewNodeID, &txID)
+				expectConsumeUTXOs(s, utx.Ins)
+				return s
+			},
+			utx: func() *txs.RegisterNodeTx {
+				return &txs.RegisterNodeTx{
+					BaseTx:           baseTx,
+					OldNodeID:        nodeID1,
+					NewNodeID:        nodeID2,
+					NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+					NodeOwnerAddress: consortiumMemberAddr,
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{nodeKey2},
+				{consortiumMemberKey},
+			},
+		},
+		"OK: consortium member is msig alias": {
+			state: func(c *gomock.Controller, utx *txs.RegisterNodeTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetAddressStates(utx.NodeOwnerAddress).Return(txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetShortIDLink(utx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode).
+					Return(ids.ShortEmpty, database.ErrNotFound)
+				s.EXPECT().GetShortIDLink(ids.ShortID(utx.NewNodeID), state.ShortLinkKeyRegisterNode).
+					Return(ids.ShortEmpty, database.ErrNotFound)
+				expectVerifyMultisigPermission(s,
+					[]ids.ShortID{
+						utx.NodeOwnerAddress,
+						consortiumMemberMsigAliasOwner.Addrs[0],
+						consortiumMemberMsigAliasOwner.Addrs[1],
+						consortiumMemberMsigAliasOwner.Addrs[2],
+					},
+					[]*multisig.AliasWithNonce{consortiumMemberMsigAlias})
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().SetShortIDLink(
+					ids.ShortID(utx.NewNodeID),
+					state.ShortLinkKeyRegisterNode,
+					&utx.NodeOwnerAddress,
+				)
+				link := ids.ShortID(utx.NewNodeID)
+				s.EXPECT().SetShortIDLink(
+					utx.NodeOwnerAddress,
+					state.ShortLinkKeyRegisterNode,
+					&link,
+				)
+				s.EXPECT().SetNodeRegistrationTxID(utx.NewNodeID, &txID)
+				expectConsumeUTXOs(s, utx.Ins)
+				return s
+			},
+			utx: func() *txs.RegisterNodeTx {
+				return &txs.RegisterNodeTx{
+					BaseTx:           baseTx,
+					OldNodeID:        ids.EmptyNodeID,
+					NewNodeID:        nodeID1,
+					NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0, 1}},
+					NodeOwnerAddress: consortiumMemberMsigAlias.ID,
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{nodeKey1},
+				{consortiumMemberMsigKeys[0], consortiumMemberMsigKeys[1]},
+			},
+		},
+		"OK": {
+			state: func(c *gomock.Controller, utx *txs.RegisterNodeTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetAddressStates(utx.NodeOwnerAddress).Return(txs.AddressStateConsortiumMember, nil)
+				s.EXPECT().GetShortIDLink(utx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode).
+					Return(ids.ShortEmpty, database.ErrNotFound)
+				s.EXPECT().GetShortIDLink(ids.ShortID(utx.NewNodeID), state.ShortLinkKeyRegisterNode).
+					Return(ids.ShortEmpty, database.ErrNotFound)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.NodeOwnerAddress}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().SetShortIDLink(
+					ids.ShortID(utx.NewNodeID),
+					state.ShortLinkKeyRegisterNode,
+					&utx.NodeOwnerAddress,
+				)
+				link := ids.ShortID(utx.NewNodeID)
+				s.EXPECT().SetShortIDLink(
+					utx.NodeOwnerAddress,
+					state.ShortLinkKeyRegisterNode,
+					&link,
+				)
+				s.EXPECT().SetNodeRegistrationTxID(utx.NewNodeID, &txID)
+				expectConsumeUTXOs(s, utx.Ins)
+				return s
+			},
+			utx: func() *txs.RegisterNodeTx {
+				return &txs.RegisterNodeTx{
+					BaseTx:           baseTx,
+					OldNodeID:        ids.EmptyNodeID,
+					NewNodeID:        nodeID1,
+					NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+					NodeOwnerAddress: consortiumMemberAddr,
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{nodeKey1},
+				{consortiumMemberKey},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }() //nolint:lint
+
+			utx := tt.utx()
+			avax.SortTransferableInputsWithSigners(utx.Ins, tt.signers)
+			avax.SortTransferableOutputs(utx.Outs, txs.Codec)
+			tx, err := txs.NewSigned(utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, utx, tx.ID()),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorRewardsImportTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+	caminoStateConf := &state.CaminoConfig{
+		VerifyNodeSignature: caminoGenesisConf.VerifyNodeSignature,
+		LockModeBondDeposit: caminoGenesisConf.LockModeBondDeposit,
+	}
+
+	blockTime := time.Unix(1000, 0)
+
+	shmWithUTXOs := func(t *testing.T, c *gomock.Controller, utxos []*avax.TimedUTXO) *atomic.MockSharedMemory {
+		shm := atomic.NewMockSharedMemory(c)
+		utxosBytes := make(map[string][]byte, len(utxos))
+		for _, utxo := range utxos {
+			var toMarshal interface{} = utxo
+			if utxo.Timestamp == 0 {
+				toMarshal = utxo.UTXO
+			}
+			utxoBytes, err := txs.Codec.Marshal(txs.Version, toMarshal)
+			require.NoError(t, err)
+			utxoID := utxo.InputID()
+			utxosBytes[string(utxoID[:])] = utxoBytes
+		}
+		shm.EXPECT().Get(ctx.CChainID, gomock.Any()).DoAndReturn(
+			func(_ ids.ID, keys [][]byte) ([][]byte, error) {
+				values := make([][]byte, len(keys))
+				for i, key := range keys {
+					utxoBytes, ok := utxosBytes[string(key)]
+					if !ok {
+						return nil, database.ErrNotFound
+					}
+					values[i] = utxoBytes
+				}
+				return values, nil
+			})
+		return shm
+	}
+
+	tests := map[string]struct {
+		beforeBerlinPhase      bool
+		state                  func(*gomock.Controller, *txs.RewardsImportTx, ids.ID) *state.MockDiff
+		sharedMemory           func(*testing.T, *gomock.Controller, []*avax.TimedUTXO) *atomic.MockSharedMemory
+		utx                    func([]*avax.TimedUTXO) *txs.RewardsImportTx
+		signers                [][]*secp256k1.PrivateKey
+		utxos                  []*avax.TimedUTXO // sorted by txID
+		expectedAtomicInputs   func([]*avax.TimedUTXO) set.Set[ids.ID]
+		expectedAtomicRequests func([]*avax.TimedUTXO) map[ids.ID]*atomic.Requests
+		expectedErr            error
+	}{
+		"Not BerlinPhase: not all importable utxos are imported": {
+			beforeBerlinPhase: true,
+			state: func(c *gomock.Controller, utx *txs.RewardsImportTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(blockTime)
+				return s
+			},
+			sharedMemory: func(t *testing.T, c *gomock.Controller, utxos []*avax.TimedUTXO) *atomic.MockSharedMemory {
+				shm := atomic.NewMockSharedMemory(c)
+				utxosBytes := make([][]byte, len(utxos))
+				for i, utxo := range utxos {
+					utxoBytes, err := txs.Codec.Marshal(txs.Version, utxo)
+					require.NoError(t, err)
+					utxosBytes[i] = utxoBytes
+				}
+				shm.EXPECT().Indexed(ctx.CChainID, treasury.AddrTraitsBytes,
+					ids.ShortEmpty[:], ids.Empty[:], maxPageSize).Return(utxosBytes, nil, nil, nil)
+				return shm
+			},
+			utx: func(utxos []*avax.TimedUTXO) *txs.RewardsImportTx {
+				return &txs.RewardsImportTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestInFromUTXO(&utxos[0].UTXO, []uint32{0}),
+					},
+				}}}
+			},
+			utxos: []*avax.TimedUTXO{
+				{
+					UTXO:      *generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, 1, *treasury.Owner, ids.Empty, ids.Empty),
+					Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound,
+				},
+				{
+					UTXO:      *generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, 1, *treasury.Owner, ids.Empty, ids.Empty),
+					Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound,
+				},
+			},
+			expectedErr: errInputsUTXOSMismatch,
+		},
+		"Imported utxo isn't old enough": {
+			state: func(c *gomock.Controller, utx *txs.RewardsImportTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(blockTime)
+				return s
+			},
+			sharedMemory: shmWithUTXOs,
+			utx: func(utxos []*avax.TimedUTXO) *txs.RewardsImportTx {
+				return &txs.RewardsImportTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestInFromUTXO(&utxos[0].UTXO, []uint32{0}),
+					},
+				}}}
+			},
+			utxos: []*avax.TimedUTXO{{
+				UTXO:      *generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, 1, *treasury.Owner, ids.Empty, ids.Empty),
+				Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound + 1,
+			}},
+			expectedErr: errImportedUTXOTooNew,
+		},
+		"Imported utxo isn't timed": {
+			state: func(c *gomock.Controller, utx *txs.RewardsImportTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(blockTime)
+				return s
+			},
+			sharedMemory: shmWithUTXOs,
+			utx: func(utxos []*avax.TimedUTXO) *txs.RewardsImportTx {
+				return &txs.RewardsImportTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestInFromUTXO(&utxos[0].UTXO, []uint32{0}),
+					},
+				}}}
+			},
+			utxos: []*avax.TimedUTXO{{
+				UTXO: *generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, 1, *treasury.Owner, ids.Empty, ids.Empty),
+			}},
+			expectedErr: errImportedUTXOMismatch,
+		},
+		"Imported utxo isn't treasury utxo": {
+			state: func(c *gomock.Controller, utx *txs.RewardsImportTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(blockTime)
+				return s
+			},
+			sharedMemory: shmWithUTXOs,
+			utx: func(utxos []*avax.TimedUTXO) *txs.RewardsImportTx {
+				return &txs.RewardsImportTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestInFromUTXO(&utxos[0].UTXO, []uint32{0}),
+					},
+				}}}
+			},
+			utxos: []*avax.TimedUTXO{{
+				UTXO: *generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, 1, secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{{1}},
+				}, ids.Empty, ids.Empty),
+				Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound,
+			}},
+			expectedErr: errImportedUTXOMismatch,
+		},
+		"Imported utxo not found": {
+			state: func(c *gomock.Controller, utx *txs.RewardsImportTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(blockTime)
+				return s
+			},
+			sharedMemory: shmWithUTXOs,
+			utx: func(utxos []*avax.TimedUTXO) *txs.RewardsImportTx {
+				return &txs.RewardsImportTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestIn(ctx.AVAXAssetID, 1, ids.Empty, ids.Empty, []uint32{}),
+					},
+				}}}
+			},
+			utxos: []*avax.TimedUTXO{{
+				UTXO:      *generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, 1, *treasury.Owner, ids.Empty, ids.Empty),
+				Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound,
+			}},
+			expectedErr: database.ErrNotFound,
+		},
+		"Imported input doesn't match reward utxo": {
+			state: func(c *gomock.Controller, utx *txs.RewardsImportTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(blockTime)
+				return s
+			},
+			sharedMemory: shmWithUTXOs,
+			utx: func(utxos []*avax.TimedUTXO) *txs.RewardsImportTx {
+				return &txs.RewardsImportTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{{
+						UTXOID: utxos[0].UTXOID,
+						Asset:  avax.Asset{ID: ctx.AVAXAssetID},
+						In: &secp256k1fx.TransferInput{
+							Amt:   1,
+							Input: secp256k1fx.Input{SigIndices: []uint32{0}},
+						},
+					}},
+				}}}
+			},
+			utxos: []*avax.TimedUTXO{{
+				UTXO:      *generateTestUTXO(ids.ID{1}, ids.ID{1, 1}, 1, *treasury.Owner, ids.Empty, ids.Empty),
+				Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound,
+			}},
+			expectedErr: errImportedUTXOMismatch,
+		},
+		"Input & utxo amount mismatch": {
+			state: func(c *gomock.Controller, utx *txs.RewardsImportTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(blockTime)
+				return s
+			},
+			sharedMemory: shmWithUTXOs,
+			utx: func(utxos []*avax.TimedUTXO) *txs.RewardsImportTx {
+				return &txs.RewardsImportTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{{
+						UTXOID: utxos[0].UTXOID,
+						Asset:  avax.Asset{ID: ctx.AVAXAssetID},
+						In: &secp256k1fx.TransferInput{
+							Amt:   2,
+							Input: secp256k1fx.Input{SigIndices: []uint32{0}},
+						},
+					}},
+				}}}
+			},
+			utxos: []*avax.TimedUTXO{{
+				UTXO:      *generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, 1, *treasury.Owner, ids.Empty, ids.Empty),
+				Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound,
+			}},
+			expectedErr: errInputAmountMismatch,
+		},
+		"OK": {
+			state: func(c *gomock.Controller, utx *txs.RewardsImportTx, txID ids.ID) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(blockTime)
+
+				rewardOwner1 := &secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{{1}},
+				}
+				rewardOwner2 := &secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{{2}},
+				}
+				rewardOwner4 := &secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{{4}},
+				}
+
+				staker1 := &state.Staker{TxID: ids.ID{0, 1}, NodeID: ids.NodeID{1}, SubnetID: constants.PrimaryNetworkID}
+				staker2 := &state.Staker{TxID: ids.ID{0, 2}, NodeID: ids.NodeID{2}, SubnetID: constants.PrimaryNetworkID}
+				staker3 := &state.Staker{TxID: ids.ID{0, 3}, NodeID: ids.NodeID{3}, SubnetID: ids.ID{0, 0, 1}}
+				staker4 := &state.Staker{TxID: ids.ID{0, 4}, NodeID: ids.NodeID{4}, SubnetID: constants.PrimaryNetworkID}
+				staker5 := &state.Staker{TxID: ids.ID{0, 5}, NodeID: ids.NodeID{5}, SubnetID: constants.PrimaryNetworkID}
+
+				currentStakerIterator := state.NewMockStakerIterator(c)
+				currentStakerIterator.EXPECT().Next().Return(true).Times(5)
+				currentStakerIterator.EXPECT().Value().Return(staker1)
+				currentStakerIterator.EXPECT().Value().Return(staker2)
+				currentStakerIterator.EXPECT().Value().Return(staker3)
+				currentStakerIterator.EXPECT().Value().Return(staker4)
+				currentStakerIterator.EXPECT().Value().Return(staker5)
+				currentStakerIterator.EXPECT().Next().Return(false)
+				currentStakerIterator.EXPECT().Release()
+
+				s.EXPECT().GetCurrentStakerIterator().Return(currentStakerIterator, nil)
+				s.EXPECT().GetTx(staker1.TxID).Return(&txs.Tx{Unsigned: &txs.CaminoAddValidatorTx{AddValidatorTx: txs.AddValidatorTx{
+					RewardsOwner: rewardOwner1,
+				}}}, status.Committed, nil)
+				s.EXPECT().GetTx(staker2.TxID).Return(&txs.Tx{Unsigned: &txs.CaminoAddValidatorTx{AddValidatorTx: txs.AddValidatorTx{
+					RewardsOwner: rewardOwner2,
+				}}}, status.Committed, nil)
+				s.EXPECT().GetTx(staker4.TxID).Return(&txs.Tx{Unsigned: &txs.CaminoAddValidatorTx{AddValidatorTx: txs.AddValidatorTx{
+					RewardsOwner: rewardOwner4,
+				}}}, status.Committed, nil)
+				s.EXPECT().GetTx(staker5.TxID).Return(&txs.Tx{Unsigned: &txs.CaminoAddValidatorTx{AddValidatorTx: txs.AddValidatorTx{
+					RewardsOwner: rewardOwner1, // same as staker1
+				}}}, status.Committed, nil)
+				s.EXPECT().GetNotDistributedValidatorReward().Return(uint64(1), nil) // old
+				s.EXPECT().GetRewardsImportProgress().Return(&state.RewardsImportProgress{
+					ImportedUTXOs:  3,
+					ImportedAmount: 10,
+					LastImportTxID: ids.ID{1, 1},
+					LastImportTime: 10,
+				}, nil)
+				s.EXPECT().SetRewardsImportProgress(&state.RewardsImportProgress{
+					ImportedUTXOs:  5,
+					ImportedAmount: 15,
+					LastImportTxID: txID,
+					LastImportTime: uint64(blockTime.Unix()),
+				})
+				s.EXPECT().SetNotDistributedValidatorReward(uint64(2)) // new
+				s.EXPECT().AddValidatorRewardsDistribution(&state.ValidatorRewardsDistribution{
+					TxID:                           txID,
+					Timestamp:                      uint64(blockTime.Unix()),
+					ImportedAmount:                 5,
+					PreviouslyNotDistributedAmount: 1,
+					NotDistributedAmount:           2,
+					Rewards: []*state.ValidatorReward{
+						{NodeID: staker1.NodeID, RewardOwner: rewardOwner1, Amount: 1},
+						{NodeID: staker2.NodeID, RewardOwner: rewardOwner2, Amount: 1},
+						{NodeID: staker4.NodeID, RewardOwner: rewardOwner4, Amount: 1},
+						{NodeID: staker5.NodeID, RewardOwner: rewardOwner1, Amount: 1},
+					},
+				})
+				rewardOwnerID1, err := txs.GetOwnerID(rewardOwner1)
+				require.NoError(t, err)
+				rewardOwnerID2, err := txs.GetOwnerID(rewardOwner2)
+				require.NoError(t, err)
+				rewardOwnerID4, err := txs.GetOwnerID(rewardOwner4)
+				require.NoError(t, err)
+
+				s.EXPECT().GetClaimable(rewardOwnerID1).Return(&state.Claimable{
+					Owner:                rewardOwner1,
+					ValidatorReward:      10,
+					ExpiredDepositReward: 100,
+				}, nil)
+				s.EXPECT().GetClaimable(rewardOwnerID2).Return(&state.Claimable{
+					Owner:                rewardOwner2,
+					ValidatorReward:      20,
+					ExpiredDepositReward: 200,
+				}, nil)
+				s.EXPECT().GetClaimable(rewardOwnerID4).Return(nil, database.ErrNotFound)
+
+				s.EXPECT().SetClaimable(rewardOwnerID1, &state.Claimable{
+					Owner:                rewardOwner1,
+					ValidatorReward:      12,
+					ExpiredDepositReward: 100,
+				})
+				s.EXPECT().SetClaimable(rewardOwnerID2, &state.Claimable{
+					Owner:                rewardOwner2,
+					ValidatorReward:      21,
+					ExpiredDepositReward: 200,
+				})
+				s.EXPECT().SetClaimable(rewardOwnerID4, &state.Claimable{
+					Owner:           rewardOwner4,
+					ValidatorReward: 1,
+				})
+
+				return s
+			},
+			sharedMemory: shmWithUTXOs,
+			utx: func(utxos []*avax.TimedUTXO) *txs.RewardsImportTx {
+				return &txs.RewardsImportTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins: []*avax.TransferableInput{
+						generateTestInFromUTXO(&utxos[0].UTXO, []uint32{0}),
+						generateTestInFromUTXO(&utxos[2].UTXO, []uint32{0}),
+					},
+				}}}
+			},
+			utxos: []*avax.TimedUTXO{
+				{ // timed utxo, old enough
+					UTXO:      *generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, 3, *treasury.Owner, ids.Empty, ids.Empty),
+					Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound,
+				},
+				{ // not timed utxo
+					UTXO: *generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, 5, *treasury.Owner, ids.Empty, ids.Empty),
+				},
+				{ // timed utxo, old enough
+					UTXO:      *generateTestUTXO(ids.ID{3}, ctx.AVAXAssetID, 2, *treasury.Owner, ids.Empty, ids.Empty),
+					Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound,
+				},
+				{ // timed utxo, not old enough
+					UTXO:      *generateTestUTXO(ids.ID{4}, ctx.AVAXAssetID, 1, *treasury.Owner, ids.Empty, ids.Empty),
+					Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound + 1,
+				},
+			},
+			expectedAtomicInputs: func(utxos []*avax.TimedUTXO) set.Set[ids.ID] {
+				return set.Set[ids.ID]{
+					utxos[0].InputID(): struct{}{},
+					utxos[2].InputID(): struct{}{},
+				}
+			},
+			expectedAtomicRequests: func(utxos []*avax.TimedUTXO) map[ids.ID]*atomic.Requests {
+				utxoID0 := utxos[0].InputID()
+				utxoID2 := utxos[2].InputID()
+				return map[ids.ID]*atomic.Requests{ctx.CChainID: {
+					RemoveRequests: [][]byte{utxoID0[:], utxoID2[:]},
+				}}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, tt.sharedMemory(t, ctrl, tt.utxos))
+			defer func() { require.NoError(shutdownCaminoEnvironment(env)) }() //nolint:lint
+			if tt.beforeBerlinPhase {
+				env.config.BerlinPhaseTime = mockable.MaxTime
+			}
+
+			utx := tt.utx(tt.utxos)
+			avax.SortTransferableInputsWithSigners(utx.Ins, tt.signers)
+			avax.SortTransferableOutputs(utx.Outs, txs.Codec)
+			// utx.ImportedInputs must be already sorted cause of utxos sort
+			tx, err := txs.NewSigned(utx, txs.Codec, tt.signers)
+			require.NoError(err)
+
+			e := &CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, utx, tx.ID()),
+					Tx:      tx,
+				},
+			}
+
+			require.ErrorIs(tx.Unsigned.Visit(e), tt.expectedErr)
+
+			if tt.expectedAtomicInputs != nil {
+				require.Equal(tt.expectedAtomicInputs(tt.utxos), e.Inputs)
+			} else {
+				require.Nil(e.Inputs)
+			}
+
+			if tt.expectedAtomicRequests != nil {
+				require.Equal(tt.expectedAtomicRequests(tt.utxos), e.AtomicRequests)
+			} else {
+				require.Nil(e.AtomicRequests)
+			}
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorSuspendValidator(t *testing.T) {
+	// finding first staker to remove
+	env := newCaminoEnvironment( /*postBanff*/ true, false, api.Camino{LockModeBondDeposit: true})
+	stakerIterator, err := env.state.GetCurrentStakerIterator()
+	require.NoError(t, err)
+	require.True(t, stakerIterator.Next())
+	stakerToRemove := stakerIterator.Value()
+	stakerIterator.Release()
+	nodeID := stakerToRemove.NodeID
+	consortiumMemberAddress, err := env.state.GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode)
+	require.NoError(t, err)
+	require.NoError(t, shutdownCaminoEnvironment(env))
+
+	initAdmin := caminoPreFundedKeys[0]
+	// other common data
+
+	outputOwners := &secp256k1fx.OutputOwners{
+		Locktime:  0,
+		Threshold: 1,
+		Addrs:     []ids.ShortID{consortiumMemberAddress},
+	}
+
+	type args struct {
+		address    ids.ShortID
+		remove     bool
+		keys       []*secp256k1.PrivateKey
+		changeAddr *secp256k1fx.OutputOwners
+	}
+	tests := map[string]struct {
+		generateArgs func() args
+		preExecute   func(*testing.T, *txs.Tx, state.State)
+		expectedErr  error
+		assert       func(*testing.T)
+	}{
+		"Set state to deferred from address with no roles -> ErrAddrStateNotPermitted": {
+			generateArgs: func() args {
+				return args{
+					address:    consortiumMemberAddress,
+					keys:       []*secp256k1.PrivateKey{caminoPreFundedKeys[1]}, // non-admin address
+					changeAddr: outputOwners,
+				}
+			},
+			preExecute:  func(t *testing.T, tx *txs.Tx, state state.State) {},
+			expectedErr: errAddrStateNotPermitted,
+		},
+		"Set state to deferred from kyc address -> ErrAddrStateNotPermitted": {
+			generateArgs: func() args {
+				return args{
+					address:    consortiumMemberAddress,
+					keys:       []*secp256k1.PrivateKey{caminoPreFundedKeys[1]},
+					changeAddr: outputOwners,
+				}
+			},
+			preExecute: func(t *testing.T, tx *txs.Tx, state state.State) {
+				state.SetAddressStates(caminoPreFundedKeys[1].Address(), txs.AddressStateRoleKYC)
+			},
+			expectedErr: errAddrStateNotPermitted,
+		},
+		"Happy path set state to deferred": {
+			generateArgs: func() args {
+				return args{
+					address:    consortiumMemberAddress,
+					keys:       []*secp256k1.PrivateKey{initAdmin},
+					changeAddr: outputOwners,
+				}
+			},
+			preExecute:  func(t *testing.T, tx *txs.Tx, state state.State) {},
+			expectedErr: nil,
+		},
+		"Remove deferred state from non-admin address -> ErrAddrStateNotPermitted": {
+			generateArgs: func() args {
+				return args{
+					address:    consortiumMemberAddress,
+					remove:     true,
+					keys:       []*secp256k1.PrivateKey{caminoPreFundedKeys[1]}, // non-admin address
+					changeAddr: outputOwners,
+				}
+			},
+			preExecute:  func(t *testing.T, tx *txs.Tx, state state.State) {},
+			expectedErr: errAddrStateNotPermitted,
+		},
+		"Happy path set state to active": {
+			generateArgs: func() args {
+				return args{
+					address:    consortiumMemberAddress,
+					remove:     true,
+					keys:       []*secp256k1.PrivateKey{initAdmin},
+					changeAddr: outputOwners,
+				}
+			},
+			preExecute: func(t *testing.T, tx *txs.Tx, state state.State) {
+				stakerToTransfer, err := state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+				require.NoError(t, err)
+				state.DeleteCurrentValidator(stakerToTransfer)
+				state.PutDeferredValidator(stakerToTransfer)
+			},
+			expectedErr: nil,
+		},
+		"Remove deferred state of an active validator": {
+			generateArgs: func() args {
+				return args{
+					address:    consortiumMemberAddress,
+					remove:     true,
+					keys:       []*secp256k1.PrivateKey{initAdmin},
+					changeAddr: outputOwners,
+				}
+			},
+			preExecute:  func(t *testing.T, tx *txs.Tx, state state.State) {},
+			expectedErr: errValidatorNotFound,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			caminoGenesisConf := api.Camino{
+				VerifyNodeSignature: true,
+				LockModeBondDeposit: true,
+				InitialAdmin:        initAdmin.Address(),
+			}
+			env := newCaminoEnvironment( /*postBanff*/ true, false, caminoGenesisConf)
+			env.ctx.Lock.Lock()
+			defer func() {
+				if err := shutdownCaminoEnvironment(env); err != nil {
+					t.Fatal(err)
+				}
+			}()
+
+			env.config.BanffTime = env.state.GetTimestamp()
+			require.NoError(t, env.state.Commit())
+
+			setAddressStateArgs := tt.generateArgs()
+			tx, err := env.txBuilder.NewAddressStateTx(
+				setAddressStateArgs.address,
+				setAddressStateArgs.remove,
+				txs.AddressStateBitNodeDeferred,
+				setAddressStateArgs.keys,
+				setAddressStateArgs.changeAddr,
+			)
+			require.NoError(t, err)
+
+			tt.preExecute(t, tx, env.state)
+			onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(t, err)
+
+			executor := CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   onAcceptState,
+					Tx:      tx,
+				},
+			}
+
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+			var stakerIterator state.StakerIterator
+			if setAddressStateArgs.remove {
+				stakerIterator, err = onAcceptState.GetCurrentStakerIterator()
+				require.NoError(t, err)
+			} else {
+				stakerIterator, err = onAcceptState.GetDeferredStakerIterator()
+				require.NoError(t, err)
+			}
+			require.True(t, stakerIterator.Next())
+			stakerToRemove := stakerIterator.Value()
+			stakerIterator.Release()
+			require.Equal(t, stakerToRemove.NodeID, nodeID)
+
+			nodeDeferral, err := onAcceptState.GetNodeDeferral(consortiumMemberAddress)
+			if setAddressStateArgs.remove {
+				require.ErrorIs(t, err, database.ErrNotFound)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, &state.NodeDeferral{
+					TxID:  tx.ID(),
+					Start: uint64(onAcceptState.GetTimestamp().Unix()),
+				}, nodeDeferral)
+			}
+
+			onAcceptState.Apply(env.state)
+			require.NoError(t, env.state.Commit())
+			addressStateChanges, err := env.state.GetAddressStateChanges(consortiumMemberAddress, 0, ids.Empty, 10)
+			require.NoError(t, err)
+			require.Equal(t, []*state.AddressStateChange{{
+				Address:   consortiumMemberAddress,
+				TxID:      tx.ID(),
+				Timestamp: uint64(onAcceptState.GetTimestamp().Unix()),
+				Bit:       txs.AddressStateBitNodeDeferred,
+				Remove:    setAddressStateArgs.remove,
+				Executors: []ids.ShortID{initAdmin.Address()},
+			}}, addressStateChanges)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorExportTxMultisig(t *testing.T) {
+	fakeMSigAlias := preFundedKeys[0].Address()
+	sourceKey := preFundedKeys[1]
+	nestedAlias := ids.ShortID{0, 0, 0, 1, 0xa}
+	aliasMemberOwners := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{sourceKey.Address()},
+	}
+	aliasDefinition := &multisig.AliasWithNonce{
+		Alias: multisig.Alias{
+			ID:     fakeMSigAlias,
+			Owners: &aliasMemberOwners,
+		},
+	}
+	nestedAliasDefinition := &multisig.AliasWithNonce{
+		Alias: multisig.Alias{
+			ID: nestedAlias,
+			Owners: &secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{fakeMSigAlias, sourceKey.Address()},
+			},
+		},
+	}
+
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+		MultisigAliases:     []*multisig.Alias{&aliasDefinition.Alias, &nestedAliasDefinition.Alias},
+	}
+
+	env := newCaminoEnvironment( /*postBanff*/ true, false, caminoGenesisConf)
+	env.ctx.Lock.Lock()
+	defer func() {
+		if err := shutdownCaminoEnvironment(env); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	type test struct {
+		destinationChainID ids.ID
+		to                 ids.ShortID
+		expectedErr        error
+		expectedMsigAddrs  []ids.ShortID
+	}
+
+	tests := map[string]test{
+		"P->C export from msig wallet": {
+			destinationChainID: cChainID,
+			to:                 fakeMSigAlias,
+			expectedErr:        nil,
+			expectedMsigAddrs:  []ids.ShortID{fakeMSigAlias},
+		},
+		"P->C export simple account not multisig": {
+			destinationChainID: cChainID,
+			to:                 sourceKey.Address(),
+			expectedErr:        nil,
+			expectedMsigAddrs:  []ids.ShortID{},
+		},
+		// unsupported for now
+		// "P->C export from nested msig wallet": {
+		// 	destinationChainID: cChainID,
+		// 	to:                 nestedAlias,
+		// 	expectedErr:        nil,
+		// 	expectedMsigAddrs:  []ids.ShortID{nestedAlias, fakeMSigAlias},
+		// },
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			tx, err := env.txBuilder.NewExportTx(
+				defaultBalance-defaultTxFee,
+				tt.destinationChainID,
+				tt.to,
+				preFundedKeys,
+				ids.ShortEmpty,
+			)
+			require.NoError(err)
+
+			fakedState, err := state.NewDiff(lastAcceptedID, env)
+			require.NoError(err)
+
+			executor := CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   fakedState,
+					Tx:      tx,
+				},
+			}
+			err = tx.Unsigned.Visit(&executor)
+			require.ErrorIs(err, tt.expectedErr)
+			if err != nil {
+				return
+			}
+
+			// Check atomic elts
+			ar, exists := executor.AtomicRequests[tt.destinationChainID]
+			require.True(exists)
+			require.Len(ar.PutRequests, 1)
+			req := ar.PutRequests[0]
+			require.Equal(req.Traits[0], tt.to.Bytes())
+
+			var (
+				utxo    avax.UTXO
+				aliases []verify.State
+			)
+
+			isMultisig := len(tt.expectedMsigAddrs) > 0
+			if isMultisig {
+				wrap := avax.UTXOWithMSig{}
+				_, err = txs.Codec.Unmarshal(req.Value, &wrap)
+				utxo = wrap.UTXO
+				aliases = wrap.Aliases
+				require.NoError(err)
+				require.NotNil(aliases)
+				require.True(len(aliases) > 0)
+			} else {
+				utxo = avax.UTXO{}
+				_, err = txs.Codec.Unmarshal(req.Value, &utxo)
+				require.NoError(err)
+			}
+
+			require.NotNil(utxo)
+			out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+			require.True(ok)
+			require.Equal(tt.to, out.OutputOwners.Addrs[0])
+
+			// Check aliases contain the Output owner
+			aliasIDs := make([]ids.ShortID, len(aliases))
+			for i, alias := range aliases {
+				aliasIDs[i] = alias.(*multisig.AliasWithNonce).ID
+			}
+			require.Equal(tt.expectedMsigAddrs, aliasIDs)
+		})
+	}
+}
+
+func TestCaminoCrossExport(t *testing.T) {
+	addr0 := caminoPreFundedKeys[0].Address()
+	addr1 := caminoPreFundedKeys[1].Address()
+
+	sigIndices := []uint32{0}
+	signers := [][]*secp256k1.PrivateKey{{caminoPreFundedKeys[0]}}
+
+	outputOwners := secp256k1fx.OutputOwners{
+		Locktime:  0,
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr0},
+	}
+
+	tests := map[string]struct {
+		utxos        []*avax.UTXO
+		ins          []*avax.TransferableInput
+		exportedOuts []*avax.TransferableOutput
+		expectedErr  error
+	}{
+		"CrossTransferOutput OK": {
+			utxos: []*avax.UTXO{
+				generateTestUTXO(ids.ID{0}, avaxAssetID, defaultCaminoValidatorWeight, outputOwners, ids.Empty, ids.Empty),
+			},
+			ins: []*avax.TransferableInput{
+				generateTestIn(avaxAssetID, defaultCaminoValidatorWeight, ids.Empty, ids.Empty, sigIndices),
+			},
+			exportedOuts: []*avax.TransferableOutput{
+				generateCrossOut(avaxAssetID, defaultCaminoValidatorWeight-defaultTxFee, outputOwners, addr1),
+			},
+		},
+		"CrossTransferOutput Invalid Recipient": {
+			utxos: []*avax.UTXO{
+				generateTestUTXO(ids.ID{0}, avaxAssetID, defaultCaminoValidatorWeight, outputOwners, ids.Empty, ids.Empty),
+			},
+			ins: []*avax.TransferableInput{
+				generateTestIn(avaxAssetID, defaultCaminoValidatorWeight, ids.Empty, ids.Empty, sigIndices),
+			},
+			exportedOuts: []*avax.TransferableOutput{
+				generateCrossOut(avaxAssetID, defaultCaminoValidatorWeight-defaultTxFee, outputOwners, ids.ShortEmpty),
+			},
+			expectedErr: secp256k1fx.ErrEmptyRecipient,
+		},
+	}
+
+	generateExecutor := func(unsidngedTx txs.UnsignedTx, env *caminoEnvironment) CaminoStandardTxExecutor {
+		tx, err := txs.NewSigned(unsidngedTx, txs.Codec, signers)
+		require.NoError(t, err)
+
+		onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+		require.NoError(t, err)
+
+		executor := CaminoStandardTxExecutor{
+			StandardTxExecutor{
+				Backend: &env.backend,
+				State:   onAcceptState,
+				Tx:      tx,
+			},
+		}
+
+		return executor
+	}
+
+	for name, tt := range tests {
+		t.Run("ExportTx "+name, func(t *testing.T) {
+			env := newCaminoEnvironment( /*postBanff*/ true, false, api.Camino{LockModeBondDeposit: true, VerifyNodeSignature: true})
+			for _, utxo := range tt.utxos {
+				env.state.AddUTXO(utxo)
+			}
+			env.ctx.Lock.Lock()
+			defer func() {
+				err := shutdownCaminoEnvironment(env)
+				require.NoError(t, err)
+			}()
+			env.config.BanffTime = env.state.GetTimestamp()
+
+			exportTx := &txs.ExportTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    env.ctx.NetworkID,
+					BlockchainID: env.ctx.ChainID,
+					Ins:          tt.ins,
+					Outs:         []*avax.TransferableOutput{},
+				}},
+				DestinationChain: env.ctx.XChainID,
+				ExportedOutputs:  tt.exportedOuts,
+			}
+
+			executor := generateExecutor(exportTx, env)
+
+			err := executor.ExportTx(exportTx)
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorMultisigAliasTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+
+	ownerKey, ownerAddr, owner := generateKeyAndOwner(t)
+	msigKeys, msigAlias, msigAliasOwners, msigOwner := generateMsigAliasAndKeys(t, 2, 2, true)
+	_, newMsigAlias, _, _ := generateMsigAliasAndKeys(t, 2, 2, true)
+
+	ownerUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, owner, ids.Empty, ids.Empty)
+	msigUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, *msigOwner, ids.Empty, ids.Empty)
+
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	tests := map[string]struct {
+		state          func(*gomock.Controller, *txs.MultisigAliasTx, ids.ID, *config.Config) *state.MockDiff
+		utx            *txs.MultisigAliasTx
+		signers        [][]*secp256k1.PrivateKey
+		publishedAlias func(txID ids.ID) *multisig.AliasWithNonce
+		expectedErr    error
+	}{
+		"Updating alias which does not exist": {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetMultisigAlias(msigAlias.ID).Return(nil, database.ErrNotFound)
+				return s
+			},
+			utx: &txs.MultisigAliasTx{
+				BaseTx: txs.BaseTx{
+					BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(ownerUTXO, []uint32{0})},
+					},
+				},
+				MultisigAlias: msigAlias.Alias,
+				Auth:          &secp256k1fx.Input{SigIndices: []uint32{0, 1}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{ownerKey},
+				{msigKeys[0], msigKeys[1]},
+			},
+			expectedErr: errAliasNotFound,
+		},
+		"Weighted alias owners before BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				return s
+			},
+			utx: &txs.MultisigAliasTx{
+				BaseTx: txs.BaseTx{
+					BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(ownerUTXO, []uint32{0})},
+					},
+				},
+				MultisigAlias: multisig.Alias{
+					Owners: &secp256k1fx.WeightedOutputOwners{
+						Threshold: 2,
+						Addrs:     []ids.ShortID{msigAliasOwners.Addrs[0], msigAliasOwners.Addrs[1]},
+						Weights:   []uint32{1, 1},
+					},
+				},
+				Auth: &secp256k1fx.Input{},
+			},
+			signers:     [][]*secp256k1.PrivateKey{{ownerKey}},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Updating existing alias with less signatures than threshold": {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetMultisigAlias(msigAlias.ID).Return(msigAlias, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{
+					msigAliasOwners.Addrs[0],
+					msigAliasOwners.Addrs[1],
+				}, []*multisig.AliasWithNonce{})
+				return s
+			},
+			utx: &txs.MultisigAliasTx{
+				BaseTx: txs.BaseTx{
+					BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(ownerUTXO, []uint32{0})},
+					},
+				},
+				MultisigAlias: msigAlias.Alias,
+				Auth:          &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{ownerKey},
+				{msigKeys[0]},
+			},
+			expectedErr: errAliasCredentialMismatch,
+		},
+		"OK, update existing alias before BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetMultisigAlias(msigAlias.ID).Return(msigAlias, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{
+					msigAliasOwners.Addrs[0],
+					msigAliasOwners.Addrs[1],
+				}, []*multisig.AliasWithNonce{})
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{ownerUTXO}, []ids.ShortID{ownerAddr}, nil)
+				s.EXPECT().SetMultisigAlias(&multisig.AliasWithNonce{
+					Alias: msigAlias.Alias,
+					Nonce: msigAlias.Nonce + 1,
+				})
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			utx: &txs.MultisigAliasTx{
+				BaseTx: txs.BaseTx{
+					BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(ownerUTXO, []uint32{0})},
+					},
+				},
+				MultisigAlias: msigAlias.Alias,
+				Auth:          &secp256k1fx.Input{SigIndices: []uint32{0, 1}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{ownerKey},
+				{msigKeys[0], msigKeys[1]},
+			},
+		},
+		"OK, update existing alias": {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetMultisigAlias(msigAlias.ID).Return(msigAlias, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{
+					msigAliasOwners.Addrs[0],
+					msigAliasOwners.Addrs[1],
+				}, []*multisig.AliasWithNonce{})
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{ownerUTXO}, []ids.ShortID{ownerAddr}, nil)
+				s.EXPECT().SetMultisigAlias(&multisig.AliasWithNonce{
+					Alias: msigAlias.Alias,
+					Nonce: msigAlias.Nonce + 1,
+				})
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			utx: &txs.MultisigAliasTx{
+				BaseTx: txs.BaseTx{
+					BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(ownerUTXO, []uint32{0})},
+					},
+				},
+				MultisigAlias: msigAlias.Alias,
+				Auth:          &secp256k1fx.Input{SigIndices: []uint32{0, 1}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{ownerKey},
+				{msigKeys[0], msigKeys[1]},
+			},
+			publishedAlias: func(ids.ID) *multisig.AliasWithNonce {
+				return &multisig.AliasWithNonce{
+					Alias: msigAlias.Alias,
+					Nonce: msigAlias.Nonce + 1,
+				}
+			},
+		},
+		"OK, add new alias": {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{ownerUTXO}, []ids.ShortID{ownerAddr}, nil)
+				s.EXPECT().SetMultisigAlias(&multisig.AliasWithNonce{
+					Alias: multisig.Alias{
+						ID:     multisig.ComputeAliasID(txID),
+						Memo:   msigAlias.Memo,
+						Owners: msigAlias.Owners,
+					},
+					Nonce: 0,
+				})
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			utx: &txs.MultisigAliasTx{
+				BaseTx: txs.BaseTx{
+					BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(ownerUTXO, []uint32{0})},
+					},
+				},
+				MultisigAlias: multisig.Alias{
+					ID:     ids.ShortID{},
+					Memo:   msigAlias.Memo,
+					Owners: msigAlias.Owners,
+				},
+				Auth: &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{ownerKey},
+			},
+			publishedAlias: func(txID ids.ID) *multisig.AliasWithNonce {
+				return &multisig.AliasWithNonce{Alias: multisig.Alias{
+					ID:     multisig.ComputeAliasID(txID),
+					Memo:   msigAlias.Memo,
+					Owners: msigAlias.Owners,
+				}}
+			},
+		},
+		"OK, add new alias with multisig sender": {
+			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{msigUTXO}, []ids.ShortID{
+					msigAlias.ID,
+					msigAliasOwners.Addrs[0],
+					msigAliasOwners.Addrs[1],
+				}, []*multisig.AliasWithNonce{msigAlias})
+				s.EXPECT().SetMultisigAlias(&multisig.AliasWithNonce{
+					Alias: multisig.Alias{
+						ID:     multisig.ComputeAliasID(txID),
+						Memo:   newMsigAlias.Memo,
+						Owners: newMsigAlias.Owners,
+					},
+					Nonce: 0,
+				})
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			utx: &txs.MultisigAliasTx{
+				BaseTx: txs.BaseTx{
+					BaseTx: avax.BaseTx{
+						NetworkID:    ctx.NetworkID,
+						BlockchainID: ctx.ChainID,
+						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(msigUTXO, []uint32{0, 1})},
+					},
+				},
+				MultisigAlias: multisig.Alias{
+					ID:     ids.ShortID{},
+					Memo:   newMsigAlias.Memo,
+					Owners: newMsigAlias.Owners,
+				},
+				Auth: &secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{msigKeys[0], msigKeys[1]},
+			},
+			publishedAlias: func(txID ids.ID) *multisig.AliasWithNonce {
+				return &multisig.AliasWithNonce{Alias: multisig.Alias{
+					ID:     multisig.ComputeAliasID(txID),
+					Memo:   newMsigAlias.Memo,
+					Owners: newMsigAlias.Owners,
+				}}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+
+			defer func() { require.NoError(shutdownCaminoEnvironment(env)) }() //nolint:lint
+
+			avax.SortTransferableInputsWithSigners(tt.utx.Ins, tt.signers)
+
+			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
+			require.NoError(err)
+
+			executor := &CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, tt.utx, tx.ID(), env.config),
+					Tx:      tx,
+				},
+			}
+			err = tx.Unsigned.Visit(executor)
+			require.ErrorIs(err, tt.expectedErr)
+
+			var expectedAtomicRequests map[ids.ID]*atomic.Requests
+			if tt.publishedAlias != nil {
+				alias := tt.publishedAlias(tx.ID())
+				aliasBytes, err := txs.Codec.Marshal(txs.Version, alias)
+				require.NoError(err)
+				aliasKey := multisig.AliasUpdateKey(alias.ID, alias.Nonce)
+				expectedAtomicRequests = map[ids.ID]*atomic.Requests{
+					env.ctx.XChainID: {PutRequests: []*atomic.Element{{
+						Key:   aliasKey[:],
+						Value: aliasBytes,
+					}}},
+				}
+			}
+			require.Equal(expectedAtomicRequests, executor.AtomicRequests)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorAddDepositOfferTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	offerCreatorKey, offerCreatorAddr, _ := generateKeyAndOwner(t)
+
+	feeUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+
+	offer1 := &deposit.Offer{
+		UpgradeVersionID:      codec.UpgradeVersion1,
+		Start:                 0,
+		End:                   1,
+		MinDuration:           1,
+		MaxDuration:           1,
+		MinAmount:             deposit.OfferMinDepositAmount,
+		InterestRateNominator: 1,
+		TotalMaxRewardAmount:  100,
+	}
+	offerWithEarlyUnlock := *offer1
+	offerWithEarlyUnlock.UpgradeVersionID = codec.UpgradeVersion2
+	offerWithEarlyUnlock.EarlyUnlockPenaltyRateNominator = 1
+	offerWithEligibilityRules := *offer1
+	offerWithEligibilityRules.UpgradeVersionID = codec.UpgradeVersion3
+	offerWithEligibilityRules.RequiredAddressState = uint64(txs.AddressStateKYCVerified)
+	offerWithInterestRateTiers := *offer1
+	offerWithInterestRateTiers.UpgradeVersionID = codec.UpgradeVersion4
+	offerWithInterestRateTiers.InterestRateTiers = []deposit.InterestRateTier{{
+		MinDuration:           offer1.MaxDuration,
+		MinAmount:             offer1.MinAmount,
+		InterestRateNominator: offer1.InterestRateNominator + 1,
+	}}
+
+	baseTx := txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    ctx.NetworkID,
+		BlockchainID: ctx.ChainID,
+		Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
+	}}
+
+	tests := map[string]struct {
+		state             func(*gomock.Controller, *txs.AddDepositOfferTx, ids.ID, *config.Config) *state.MockDiff
+		utx               func() *txs.AddDepositOfferTx
+		signers           [][]*secp256k1.PrivateKey
+		beforeBerlinPhase bool
+		expectedErr       error
+	}{
+		"Not AthensPhase": {
+			state: func(c *gomock.Controller, utx *txs.AddDepositOfferTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(cfg.AthensPhaseTime.Add(-1 * time.Second))
+				return s
+			},
+			utx: func() *txs.AddDepositOfferTx {
+				return &txs.AddDepositOfferTx{
+					BaseTx:                     baseTx,
+					DepositOffer:               offer1,
+					DepositOfferCreatorAddress: offerCreatorAddr,
+					DepositOfferCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {offerCreatorKey},
+			},
+			expectedErr: errNotAthensPhase,
+		},
+		"Offer v2 before BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.AddDepositOfferTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				return s
+			},
+			utx: func() *txs.AddDepositOfferTx {
+				return &txs.AddDepositOfferTx{
+					BaseTx:                     baseTx,
+					DepositOffer:               &offerWithEarlyUnlock,
+					DepositOfferCreatorAddress: offerCreatorAddr,
+					DepositOfferCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {offerCreatorKey},
+			},
+			beforeBerlinPhase: true,
+			expectedErr:       errNotBerlinPhase,
+		},
+		"Offer v3 before BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.AddDepositOfferTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				return s
+			},
+			utx: func() *txs.AddDepositOfferTx {
+				return &txs.AddDepositOfferTx{
+					BaseTx:                     baseTx,
+					DepositOffer:               &offerWithEligibilityRules,
+					DepositOfferCreatorAddress: offerCreatorAddr,
+					DepositOfferCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {offerCreatorKey},
+			},
+			beforeBerlinPhase: true,
+			expectedErr:       errNotBerlinPhase,
+		},
+		"Offer v4 before BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.AddDepositOfferTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				return s
+			},
+			utx: func() *txs.AddDepositOfferTx {
+				return &txs.AddDepositOfferTx{
+					BaseTx:                     baseTx,
+					DepositOffer:               &offerWithInterestRateTiers,
+					DepositOfferCreatorAddress: offerCreatorAddr,
+					DepositOfferCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {offerCreatorKey},
+			},
+			beforeBerlinPhase: true,
+			expectedErr:       errNotBerlinPhase,
+		},
+		"Not offer creator": {
+			state: func(c *gomock.Controller, utx *txs.AddDepositOfferTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().GetAddressStates(utx.DepositOfferCreatorAddress).Return(txs.AddressStateEmpty, nil)
+				return s
+			},
+			utx: func() *txs.AddDepositOfferTx {
+				return &txs.AddDepositOfferTx{
+					BaseTx:                     baseTx,
+					DepositOffer:               offer1,
+					DepositOfferCreatorAddress: offerCreatorAddr,
+					DepositOfferCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {offerCreatorKey},
+			},
+			expectedErr: errNotOfferCreator,
+		},
+		"Bad offer creator signature": {
+			state: func(c *gomock.Controller, utx *txs.AddDepositOfferTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().GetAddressStates(utx.DepositOfferCreatorAddress).Return(txs.AddressStateOffersCreator, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferCreatorAddress}, nil)
+				return s
+			},
+			utx: func() *txs.AddDepositOfferTx {
+				return &txs.AddDepositOfferTx{
+					BaseTx:                     baseTx,
+					DepositOffer:               offer1,
+					DepositOfferCreatorAddress: offerCreatorAddr,
+					DepositOfferCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey}, {feeOwnerKey},
+			},
+			expectedErr: errOfferCreatorCredentialMismatch,
+		},
+		"Supply overflow (v1, no existing offers)": {
+			state: func(c *gomock.Controller, utx *txs.AddDepositOfferTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				chainTime := time.Unix(100, 0)
+
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().GetAddressStates(utx.DepositOfferCreatorAddress).Return(txs.AddressStateOffersCreator, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferCreatorAddress}, nil)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).
+					Return(cfg.RewardConfig.SupplyCap-offer1.TotalMaxRewardAmount+1, nil)
+				s.EXPECT().GetAllDepositOffers().Return(nil, nil)
+				return s
+			},
+			utx: func() *txs.AddDepositOfferTx {
+				return &txs.AddDepositOfferTx{
+					BaseTx:                     baseTx,
+					DepositOffer:               offer1,
+					DepositOfferCreatorAddress: offerCreatorAddr,
+					DepositOfferCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{offerCreatorKey},
+			},
+			expectedErr: errSupplyOverflow,
+		},
+		"Supply overflow (v1, existing offers)": {
+			state: func(c *gomock.Controller, utx *txs.AddDepositOfferTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				chainTime := time.Unix(100, 0)
+				existingOffers := []*deposit.Offer{
+					{ // [0], expired
+						UpgradeVersionID:     1,
+						Start:                uint64(chainTime.Add(-2 * time.Second).Unix()),
+						End:                  uint64(chainTime.Add(-1 * time.Second).Unix()),
+						TotalMaxRewardAmount: 101,
 					},
-					{
-						Asset: avax.Asset{ID: ctx.AVAXAssetID},
-						Out: &secp256k1fx.TransferOutput{
-							Amt:          2, // 5 is expected available reward amount
-							OutputOwners: claimToOwner1,
-						},
+					{ // [1], not started
+						UpgradeVersionID:     1,
+						Start:                uint64(chainTime.Add(1 * time.Second).Unix()),
+						End:                  uint64(chainTime.Add(2 * time.Second).Unix()),
+						TotalMaxRewardAmount: 102,
 					},
-				}),
-				Claimables: []txs.ClaimAmount{
-					{
-						ID:        claimableOwnerID1,
-						Amount:    claimable1.ValidatorReward + claimable1.ExpiredDepositReward/2,
-						Type:      txs.ClaimTypeAllTreasury,
-						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+					{ // [2], disabled
+						UpgradeVersionID:     1,
+						Start:                uint64(chainTime.Unix()),
+						End:                  uint64(chainTime.Add(1 * time.Second).Unix()),
+						Flags:                deposit.OfferFlagLocked,
+						TotalMaxRewardAmount: 103,
 					},
-					{
-						ID:        depositTxID1,
-						Amount:    2, // 5 is expected available reward amount
-						Type:      txs.ClaimTypeActiveDepositReward,
-						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+					{ // [3]
+						Start: uint64(chainTime.Unix()),
+						End:   uint64(chainTime.Add(1 * time.Second).Unix()),
+					},
+					{ // [4] with TotalMaxAmount
+						MaxDuration:           100,
+						InterestRateNominator: 100,
+						Start:                 uint64(chainTime.Unix()),
+						End:                   uint64(chainTime.Add(1 * time.Second).Unix()),
+						TotalMaxAmount:        104,
+						DepositedAmount:       50,
+					},
+					{ // [5] with TotalMaxRewardAmount
+						UpgradeVersionID:     1,
+						Start:                uint64(chainTime.Add(-1 * time.Second).Unix()),
+						End:                  uint64(chainTime.Add(1 * time.Second).Unix()),
+						TotalMaxRewardAmount: 105,
+						RewardedAmount:       50,
 					},
+				}
+				promisedSupply := existingOffers[4].MaxRemainingRewardByTotalMaxAmount() + existingOffers[5].RemainingReward()
+				currentSupply := cfg.RewardConfig.SupplyCap - promisedSupply - offer1.TotalMaxRewardAmount + 1
+
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().GetAddressStates(utx.DepositOfferCreatorAddress).Return(txs.AddressStateOffersCreator, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferCreatorAddress}, nil)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(currentSupply, nil)
+				s.EXPECT().GetAllDepositOffers().Return(existingOffers, nil)
+				return s
+			},
+			utx: func() *txs.AddDepositOfferTx {
+				return &txs.AddDepositOfferTx{
+					BaseTx:                     baseTx,
+					DepositOffer:               offer1,
+					DepositOfferCreatorAddress: offerCreatorAddr,
+					DepositOfferCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{offerCreatorKey},
+			},
+			expectedErr: errSupplyOverflow,
+		},
+		"OK: v1": {
+			state: func(c *gomock.Controller, utx *txs.AddDepositOfferTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(time.Time{})
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().GetAddressStates(utx.DepositOfferCreatorAddress).Return(txs.AddressStateOffersCreator, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferCreatorAddress}, nil)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).
+					Return(cfg.RewardConfig.SupplyCap-offer1.TotalMaxRewardAmount, nil)
+				s.EXPECT().GetAllDepositOffers().Return(nil, nil)
+
+				offer := *utx.DepositOffer
+				offer.ID = txID
+				s.EXPECT().SetDepositOffer(&offer)
+
+				expectConsumeUTXOs(s, utx.Ins)
+				return s
+			},
+			utx: func() *txs.AddDepositOfferTx {
+				return &txs.AddDepositOfferTx{
+					BaseTx:                     baseTx,
+					DepositOffer:               offer1,
+					DepositOfferCreatorAddress: offerCreatorAddr,
+					DepositOfferCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+				}
+			},
+			signers: [][]*secp256k1.PrivateKey{
+				{feeOwnerKey},
+				{offerCreatorKey},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
+			if tt.beforeBerlinPhase {
+				env.config.BerlinPhaseTime = mockable.MaxTime
+			}
+
+			utx := tt.utx()
+			avax.SortTransferableInputsWithSigners(utx.Ins, tt.signers)
+			avax.SortTransferableOutputs(utx.Outs, txs.Codec)
+			tx, err := txs.NewSigned(utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, utx, tx.ID(), env.config),
+					Tx:      tx,
 				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorAddProposalTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+	caminoStateConf := &state.CaminoConfig{LockModeBondDeposit: true}
+	bondAmount := defaultCaminoConfig(true).CaminoConfig.DaoProposalBondAmount
+
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	proposerKey, proposerAddr, _ := generateKeyAndOwner(t)
+
+	feeUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee+bondAmount, feeOwner, ids.Empty, ids.Empty)
+
+	chainTime := time.Unix(100, 0)
+	proposal := &dao.Proposal{
+		Start:   uint64(chainTime.Unix()),
+		End:     uint64(chainTime.Unix()) + dao.MinProposalDuration,
+		Options: []types.JSONByteSlice{{1}, {2}},
+	}
+
+	baseTx := func(bond uint64) txs.BaseTx {
+		return txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    ctx.NetworkID,
+			BlockchainID: ctx.ChainID,
+			Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
+			Outs: []*avax.TransferableOutput{
+				generateTestOut(ctx.AVAXAssetID, bond, feeOwner, ids.Empty, locked.ThisTxID),
+			},
+		}}
+	}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.AddProposalTx, ids.ID, *config.Config) *state.MockDiff
+		utx         *txs.AddProposalTx
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"Wrong lockModeBondDeposit flag": {
+			state: func(c *gomock.Controller, utx *txs.AddProposalTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{}, nil)
+				return s
+			},
+			utx: &txs.AddProposalTx{
+				BaseTx:          baseTx(bondAmount),
+				Proposal:        proposal,
+				ProposerAddress: proposerAddr,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {proposerKey}},
+			expectedErr: errWrongLockMode,
+		},
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.AddProposalTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				return s
+			},
+			utx: &txs.AddProposalTx{
+				BaseTx:          baseTx(bondAmount),
+				Proposal:        proposal,
+				ProposerAddress: proposerAddr,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {proposerKey}},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Proposal starts before chain time": {
+			state: func(c *gomock.Controller, utx *txs.AddProposalTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime.Add(time.Second))
+				return s
+			},
+			utx: &txs.AddProposalTx{
+				BaseTx:          baseTx(bondAmount),
+				Proposal:        proposal,
+				ProposerAddress: proposerAddr,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {proposerKey}},
+			expectedErr: errProposalStartTooEarly,
+		},
+		"Wrong bond amount": {
+			state: func(c *gomock.Controller, utx *txs.AddProposalTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				return s
+			},
+			utx: &txs.AddProposalTx{
+				BaseTx:          baseTx(bondAmount - 1),
+				Proposal:        proposal,
+				ProposerAddress: proposerAddr,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {proposerKey}},
+			expectedErr: errWrongProposalBondAmount,
+		},
+		"Not consortium member": {
+			state: func(c *gomock.Controller, utx *txs.AddProposalTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetAddressStates(utx.ProposerAddress).Return(txs.AddressStateKYCVerified, nil)
+				return s
 			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{claimableOwnerKey1},
-				{depositRewardOwnerKey},
+			utx: &txs.AddProposalTx{
+				BaseTx:          baseTx(bondAmount),
+				Proposal:        proposal,
+				ProposerAddress: proposerAddr,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
 			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {proposerKey}},
+			expectedErr: errNotConsortiumMember,
 		},
-		"OK, claim (expired deposit rewards)": {
-			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+		"Bad proposer signature": {
+			state: func(c *gomock.Controller, utx *txs.AddProposalTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				// common checks and fee
-				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO},
-					[]ids.ShortID{feeOwnerAddr, claimToOwnerAddr1}, nil)
-				s.EXPECT().GetTimestamp().Return(timestamp)
-				expectConsumeUTXOs(s, utx.Ins)
-				expectProduceUTXOs(s, utx.Outs, txID, 0)
-
-				// claimable
-				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimable1, nil)
-				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
-				s.EXPECT().SetClaimable(claimableOwnerID1, &state.Claimable{
-					Owner:           claimable1.Owner,
-					ValidatorReward: claimable1.ValidatorReward,
-				})
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetAddressStates(utx.ProposerAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.ProposerAddress}, nil)
 				return s
 			},
-			utx: &txs.ClaimTx{
-				BaseTx: *baseTxWithFeeInput([]*avax.TransferableOutput{{
-					Asset: avax.Asset{ID: ctx.AVAXAssetID},
-					Out: &secp256k1fx.TransferOutput{
-						Amt:          claimable1.ExpiredDepositReward,
-						OutputOwners: claimToOwner1,
-					},
-				}}),
-				Claimables: []txs.ClaimAmount{{
-					ID:        claimableOwnerID1,
-					Amount:    claimable1.ExpiredDepositReward,
-					Type:      txs.ClaimTypeExpiredDepositReward,
-					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
-				}},
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{claimableOwnerKey1},
+			utx: &txs.AddProposalTx{
+				BaseTx:          baseTx(bondAmount),
+				Proposal:        proposal,
+				ProposerAddress: proposerAddr,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
 			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {feeOwnerKey}},
+			expectedErr: errProposerCredentialMismatch,
 		},
-		"OK, claim (validator rewards)": {
-			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+		"OK": {
+			state: func(c *gomock.Controller, utx *txs.AddProposalTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				// common checks and fee
-				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO},
-					[]ids.ShortID{feeOwnerAddr, claimToOwnerAddr1}, nil)
-				s.EXPECT().GetTimestamp().Return(timestamp)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetAddressStates(utx.ProposerAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.ProposerAddress}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{
+					feeOwnerAddr, // consumed
+					feeOwnerAddr, // produced
+				}, nil)
+				s.EXPECT().AddProposal(txID, dao.NewProposalState(utx.Proposal, utx.ProposerAddress))
 				expectConsumeUTXOs(s, utx.Ins)
-				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				expectProduceNewlyLockedUTXOs(s, utx.Outs, txID, 0, locked.StateBonded)
+				return s
+			},
+			utx: &txs.AddProposalTx{
+				BaseTx:          baseTx(bondAmount),
+				Proposal:        proposal,
+				ProposerAddress: proposerAddr,
+				ProposerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+			},
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {proposerKey}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
 
-				// claimable
-				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimable1, nil)
-				expectVerifyMultisigPermission(s, claimableOwner1.Addrs, nil)
-				s.EXPECT().SetClaimable(claimableOwnerID1, &state.Claimable{
-					Owner:                claimable1.Owner,
-					ExpiredDepositReward: claimable1.ExpiredDepositReward,
-				})
+			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
+
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, tt.utx, tx.ID(), env.config),
+					Tx:      tx,
+				},
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCaminoStandardTxExecutorAddVoteTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
+	caminoStateConf := &state.CaminoConfig{LockModeBondDeposit: true}
+
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	voterKey, voterAddr, _ := generateKeyAndOwner(t)
+
+	feeUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+
+	proposalID := ids.GenerateTestID()
+	proposal := dao.NewProposalState(&dao.Proposal{
+		Start:   100,
+		End:     100 + dao.MinProposalDuration,
+		Options: []types.JSONByteSlice{{1}, {2}},
+	}, ids.ShortID{1})
+	votedProposal, err := proposal.AddVote(voterAddr, 1)
+	require.NoError(t, err)
+
+	utx := func(option uint32) *txs.AddVoteTx {
+		return &txs.AddVoteTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+				Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
+			}},
+			ProposalID:   proposalID,
+			Option:       option,
+			VoterAddress: voterAddr,
+			VoterAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
+		}
+	}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.AddVoteTx, ids.ID, *config.Config) *state.MockDiff
+		utx         *txs.AddVoteTx
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.AddVoteTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
 				return s
 			},
-			utx: &txs.ClaimTx{
-				BaseTx: *baseTxWithFeeInput([]*avax.TransferableOutput{{
-					Asset: avax.Asset{ID: ctx.AVAXAssetID},
-					Out: &secp256k1fx.TransferOutput{
-						Amt:          claimable1.ValidatorReward,
-						OutputOwners: claimToOwner1,
-					},
-				}}),
-				Claimables: []txs.ClaimAmount{{
-					ID:        claimableOwnerID1,
-					Amount:    claimable1.ValidatorReward,
-					Type:      txs.ClaimTypeValidatorReward,
-					OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
-				}},
+			utx:         utx(1),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {voterKey}},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Not consortium member": {
+			state: func(c *gomock.Controller, utx *txs.AddVoteTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				s.EXPECT().GetAddressStates(utx.VoterAddress).Return(txs.AddressStateKYCVerified, nil)
+				return s
 			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{claimableOwnerKey1},
+			utx:         utx(1),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {voterKey}},
+			expectedErr: errNotConsortiumMember,
+		},
+		"Bad voter signature": {
+			state: func(c *gomock.Controller, utx *txs.AddVoteTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				s.EXPECT().GetAddressStates(utx.VoterAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.VoterAddress}, nil)
+				return s
 			},
+			utx:         utx(1),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {feeOwnerKey}},
+			expectedErr: errVoterCredentialMismatch,
 		},
-		"OK, msig fee, claimable and deposit": {
-			state: func(c *gomock.Controller, utx *txs.ClaimTx, txID ids.ID) *state.MockDiff {
+		"Proposal not found": {
+			state: func(c *gomock.Controller, utx *txs.AddVoteTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				// common checks and fee+
-				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{msigFeeUTXO},
-					[]ids.ShortID{
-						feeMsigAlias.ID,
-						feeMsigAliasOwner.Addrs[0],
-						feeMsigAliasOwner.Addrs[1],
-						claimToOwnerAddr1,
-					},
-					[]*multisig.AliasWithNonce{feeMsigAlias})
-				s.EXPECT().GetTimestamp().Return(timestamp)
-				expectConsumeUTXOs(s, utx.Ins)
-				expectProduceUTXOs(s, utx.Outs, txID, 0)
-
-				// deposit1
-				expectVerifyMultisigPermission(s, []ids.ShortID{
-					depositRewardMsigAlias.ID,
-					depositRewardMsigAliasOwner.Addrs[0],
-					depositRewardMsigAliasOwner.Addrs[1],
-				}, []*multisig.AliasWithNonce{depositRewardMsigAlias})
-				deposit1 := &deposit.Deposit{
-					DepositOfferID: depositOfferID,
-					Start:          uint64(timestamp.Unix()) - 365*24*60*60/2, // 0.5 year ago
-					Duration:       365 * 24 * 60 * 60,                        // 1 year
-					Amount:         10,
-					RewardOwner:    depositRewardMsigOwner,
-				}
-				s.EXPECT().GetDeposit(depositTxID1).Return(deposit1, nil)
-				s.EXPECT().GetDepositOffer(depositOfferID).Return(&deposit.Offer{
-					InterestRateNominator: 1_000_000, // 100%
-				}, nil)
-				s.EXPECT().ModifyDeposit(depositTxID1, &deposit.Deposit{
-					DepositOfferID:      deposit1.DepositOfferID,
-					UnlockedAmount:      deposit1.UnlockedAmount,
-					ClaimedRewardAmount: deposit1.ClaimedRewardAmount + utx.Claimables[0].Amount,
-					Start:               deposit1.Start,
-					Duration:            deposit1.Duration,
-					Amount:              deposit1.Amount,
-					RewardOwner:         deposit1.RewardOwner,
-				})
-
-				// claimable
-				s.EXPECT().GetClaimable(claimableOwnerID1).Return(claimableMsigOwned, nil)
-				expectVerifyMultisigPermission(s, []ids.ShortID{
-					claimableMsigAlias.ID,
-					claimableMsigAliasOwner.Addrs[0],
-					claimableMsigAliasOwner.Addrs[1],
-					claimableMsigAliasOwner.Addrs[2],
-				}, []*multisig.AliasWithNonce{claimableMsigAlias})
-				s.EXPECT().SetClaimable(claimableOwnerID1, nil)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				s.EXPECT().GetAddressStates(utx.VoterAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.VoterAddress}, nil)
+				s.EXPECT().GetProposal(utx.ProposalID).Return(nil, database.ErrNotFound)
 				return s
 			},
-			utx: &txs.ClaimTx{
-				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-					NetworkID:    ctx.NetworkID,
-					BlockchainID: ctx.ChainID,
-					Ins:          []*avax.TransferableInput{generateTestInFromUTXO(msigFeeUTXO, []uint32{0, 1})},
-					Outs: []*avax.TransferableOutput{{
-						Asset: avax.Asset{ID: ctx.AVAXAssetID},
-						Out: &secp256k1fx.TransferOutput{
-							Amt:          claimableMsigOwned.ExpiredDepositReward,
-							OutputOwners: claimToOwner1,
-						},
-					}},
-				}},
-				Claimables: []txs.ClaimAmount{
-					{
-						ID:        depositTxID1,
-						Amount:    5,
-						Type:      txs.ClaimTypeActiveDepositReward,
-						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
-					},
-					{
-						ID:        claimableOwnerID1,
-						Amount:    claimableMsigOwned.ValidatorReward + claimableMsigOwned.ExpiredDepositReward,
-						Type:      txs.ClaimTypeAllTreasury,
-						OwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0, 2}},
-					},
-				},
+			utx:         utx(1),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {voterKey}},
+			expectedErr: errProposalNotFound,
+		},
+		"Proposal is inactive": {
+			state: func(c *gomock.Controller, utx *txs.AddVoteTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(proposal.EndTime())
+				s.EXPECT().GetAddressStates(utx.VoterAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.VoterAddress}, nil)
+				s.EXPECT().GetProposal(utx.ProposalID).Return(proposal, nil)
+				return s
 			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeMsigKeys[0], feeMsigKeys[1]},
-				{depositRewardMsigKeys[0]},
-				{claimableMsigKeys[0], claimableMsigKeys[2]},
+			utx:         utx(1),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {voterKey}},
+			expectedErr: dao.ErrProposalInactive,
+		},
+		"Already voted": {
+			state: func(c *gomock.Controller, utx *txs.AddVoteTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				s.EXPECT().GetAddressStates(utx.VoterAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.VoterAddress}, nil)
+				s.EXPECT().GetProposal(utx.ProposalID).Return(votedProposal, nil)
+				return s
+			},
+			utx:         utx(0),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {voterKey}},
+			expectedErr: dao.ErrAlreadyVoted,
+		},
+		"Wrong option": {
+			state: func(c *gomock.Controller, utx *txs.AddVoteTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				s.EXPECT().GetAddressStates(utx.VoterAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.VoterAddress}, nil)
+				s.EXPECT().GetProposal(utx.ProposalID).Return(proposal, nil)
+				return s
+			},
+			utx:         utx(2),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {voterKey}},
+			expectedErr: dao.ErrWrongVoteOption,
+		},
+		"OK": {
+			state: func(c *gomock.Controller, utx *txs.AddVoteTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
+				s.EXPECT().GetAddressStates(utx.VoterAddress).Return(txs.AddressStateConsortiumMember, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.VoterAddress}, nil)
+				s.EXPECT().GetProposal(utx.ProposalID).Return(proposal, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().ModifyProposal(utx.ProposalID, votedProposal)
+				expectConsumeUTXOs(s, utx.Ins)
+				return s
 			},
+			utx:     utx(1),
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {voterKey}},
 		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			require := require.New(t)
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
-			defer func() { require.NoError(shutdownCaminoEnvironment(env)) }() //nolint:lint
-
-			// ensuring that ins and outs from test case are sorted, signing tx
-
-			avax.SortTransferableInputsWithSigners(tt.utx.Ins, tt.signers)
-			avax.SortTransferableOutputs(tt.utx.Outs, txs.Codec)
-			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
-			require.NoError(err)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
 
-			// testing
+			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
 
 			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
 				StandardTxExecutor{
 					Backend: &env.backend,
-					State:   tt.state(ctrl, tt.utx, tx.ID()),
+					State:   tt.state(ctrl, tt.utx, tx.ID(), env.config),
 					Tx:      tx,
 				},
 			})
-			require.ErrorIs(err, tt.expectedErr)
+			require.ErrorIs(t, err, tt.expectedErr)
 		})
 	}
 }
 
-func TestCaminoStandardTxExecutorRegisterNodeTx(t *testing.T) {
+func TestCaminoStandardTxExecutorFinishProposalsTx(t *testing.T) {
 	ctx, _ := defaultCtx(nil)
 	caminoGenesisConf := api.Camino{
 		VerifyNodeSignature: true,
 		LockModeBondDeposit: true,
 	}
+	caminoStateConf := &state.CaminoConfig{LockModeBondDeposit: true}
 
-	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
-	consortiumMemberKey, consortiumMemberAddr, _ := generateKeyAndOwner(t)
-	consortiumMemberMsigKeys, consortiumMemberMsigAlias, consortiumMemberMsigAliasOwner, _ := generateMsigAliasAndKeys(t, 2, 3, false)
-	nodeKey1, nodeAddr1, _ := generateKeyAndOwner(t)
-	nodeKey2, nodeAddr2, _ := generateKeyAndOwner(t)
-	nodeID1 := ids.NodeID(nodeAddr1)
-	nodeID2 := ids.NodeID(nodeAddr2)
+	_, proposerAddr, proposerOwner := generateKeyAndOwner(t)
 
-	feeUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+	proposalID := ids.GenerateTestID()
+	proposal := dao.NewProposalState(&dao.Proposal{
+		Start:   100,
+		End:     100 + dao.MinProposalDuration,
+		Options: []types.JSONByteSlice{{1}, {2}},
+	}, proposerAddr)
+	proposal, err := proposal.AddVote(ids.ShortID{1}, 1)
+	require.NoError(t, err)
+
+	bondUTXO := generateTestUTXO(proposalID, ctx.AVAXAssetID, 10, proposerOwner, ids.Empty, proposalID)
+	proposalTx := &txs.Tx{Unsigned: &txs.AddProposalTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			Outs: []*avax.TransferableOutput{
+				generateTestOut(ctx.AVAXAssetID, 10, proposerOwner, ids.Empty, locked.ThisTxID),
+			},
+		}},
+	}}
 
 	baseTx := txs.BaseTx{BaseTx: avax.BaseTx{
 		NetworkID:    ctx.NetworkID,
 		BlockchainID: ctx.ChainID,
-		Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
+		Ins:          []*avax.TransferableInput{generateTestInFromUTXO(bondUTXO, []uint32{})},
+		Outs: []*avax.TransferableOutput{
+			generateTestOut(ctx.AVAXAssetID, 10, proposerOwner, ids.Empty, ids.Empty),
+		},
 	}}
 
+	expectUnlock := func(s *state.MockDiff) {
+		s.EXPECT().GetTx(proposalID).Return(proposalTx, status.Committed, nil)
+		s.EXPECT().LockedUTXOs(set.Set[ids.ID]{proposalID: struct{}{}}, set.Set[ids.ShortID]{proposerAddr: struct{}{}}, locked.StateBonded).
+			Return([]*avax.UTXO{bondUTXO}, nil)
+	}
+
 	tests := map[string]struct {
-		state       func(*gomock.Controller, *txs.RegisterNodeTx) *state.MockDiff
-		utx         func() *txs.RegisterNodeTx
+		state       func(*gomock.Controller, *txs.FinishProposalsTx, ids.ID, *config.Config) *state.MockDiff
+		utx         *txs.FinishProposalsTx
 		signers     [][]*secp256k1.PrivateKey
 		expectedErr error
 	}{
-		"Not consortium member": {
-			state: func(c *gomock.Controller, utx *txs.RegisterNodeTx) *state.MockDiff {
-				s := state.NewMockDiff(c)
-				s.EXPECT().GetAddressStates(utx.NodeOwnerAddress).Return(txs.AddressStateEmpty, nil)
-				return s
-			},
-			utx: func() *txs.RegisterNodeTx {
-				return &txs.RegisterNodeTx{
-					BaseTx:           baseTx,
-					OldNodeID:        ids.EmptyNodeID,
-					NewNodeID:        nodeID1,
-					NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
-					NodeOwnerAddress: consortiumMemberAddr,
-				}
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey}, {nodeKey1}, {consortiumMemberKey},
-			},
-			expectedErr: errNotConsortiumMember,
-		},
-		"Consortium member has already registered node": {
-			state: func(c *gomock.Controller, utx *txs.RegisterNodeTx) *state.MockDiff {
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.FinishProposalsTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().GetAddressStates(utx.NodeOwnerAddress).Return(txs.AddressStateConsortiumMember, nil)
-				s.EXPECT().GetShortIDLink(utx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode).
-					Return(nodeAddr2, nil)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
 				return s
 			},
-			utx: func() *txs.RegisterNodeTx {
-				return &txs.RegisterNodeTx{
-					BaseTx:           baseTx,
-					OldNodeID:        ids.EmptyNodeID,
-					NewNodeID:        nodeID1,
-					NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
-					NodeOwnerAddress: consortiumMemberAddr,
-				}
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey}, {nodeKey1}, {consortiumMemberKey},
+			utx: &txs.FinishProposalsTx{
+				BaseTx:            baseTx,
+				FinishedProposals: []txs.FinishedProposal{{ProposalID: proposalID, Option: 1, Successful: true}},
 			},
-			expectedErr: errConsortiumMemberHasNode,
+			expectedErr: errNotBerlinPhase,
 		},
-		"Old node is in current validator's set": {
-			state: func(c *gomock.Controller, utx *txs.RegisterNodeTx) *state.MockDiff {
+		"Has credentials": {
+			state: func(c *gomock.Controller, utx *txs.FinishProposalsTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().GetAddressStates(utx.NodeOwnerAddress).Return(txs.AddressStateConsortiumMember, nil)
-				s.EXPECT().GetShortIDLink(utx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode).
-					Return(nodeAddr1, nil)
-				expectVerifyMultisigPermission(s, []ids.ShortID{utx.NodeOwnerAddress}, nil)
-				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, utx.OldNodeID).Return(nil, nil) // no error
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(proposal.EndTime())
 				return s
 			},
-			utx: func() *txs.RegisterNodeTx {
-				return &txs.RegisterNodeTx{
-					BaseTx:           baseTx,
-					OldNodeID:        nodeID1,
-					NewNodeID:        nodeID2,
-					NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
-					NodeOwnerAddress: consortiumMemberAddr,
-				}
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{nodeKey2},
-				{consortiumMemberKey},
+			utx: &txs.FinishProposalsTx{
+				BaseTx:            baseTx,
+				FinishedProposals: []txs.FinishedProposal{{ProposalID: proposalID, Option: 1, Successful: true}},
 			},
-			expectedErr: errValidatorExists,
+			signers:     [][]*secp256k1.PrivateKey{{}},
+			expectedErr: errWrongCredentialsNumber,
 		},
-		"Old node is in pending validator's set": {
-			state: func(c *gomock.Controller, utx *txs.RegisterNodeTx) *state.MockDiff {
+		"Proposal voting isn't finished yet": {
+			state: func(c *gomock.Controller, utx *txs.FinishProposalsTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().GetAddressStates(utx.NodeOwnerAddress).Return(txs.AddressStateConsortiumMember, nil)
-				s.EXPECT().GetShortIDLink(utx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode).
-					Return(nodeAddr1, nil)
-				expectVerifyMultisigPermission(s, []ids.ShortID{utx.NodeOwnerAddress}, nil)
-				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, utx.OldNodeID).
-					Return(nil, database.ErrNotFound)
-				s.EXPECT().GetPendingValidator(constants.PrimaryNetworkID, utx.OldNodeID).Return(nil, nil) // no error
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(proposal.EndTime().Add(-time.Second)).Times(2)
+				s.EXPECT().GetNextToExpireProposalIDsAndTime(nil).Return([]ids.ID{proposalID}, proposal.EndTime(), nil)
 				return s
 			},
-			utx: func() *txs.RegisterNodeTx {
-				return &txs.RegisterNodeTx{
-					BaseTx:           baseTx,
-					OldNodeID:        nodeID1,
-					NewNodeID:        nodeID2,
-					NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
-					NodeOwnerAddress: consortiumMemberAddr,
-				}
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{nodeKey2},
-				{consortiumMemberKey},
+			utx: &txs.FinishProposalsTx{
+				BaseTx:            baseTx,
+				FinishedProposals: []txs.FinishedProposal{{ProposalID: proposalID, Option: 1, Successful: true}},
 			},
-			expectedErr: errValidatorExists,
+			expectedErr: errFinishProposalsTooEarly,
 		},
-		"Old node is in deferred validator's set": {
-			state: func(c *gomock.Controller, utx *txs.RegisterNodeTx) *state.MockDiff {
+		"Wrong proposal outcome": {
+			state: func(c *gomock.Controller, utx *txs.FinishProposalsTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().GetAddressStates(utx.NodeOwnerAddress).Return(txs.AddressStateConsortiumMember, nil)
-				s.EXPECT().GetShortIDLink(utx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode).
-					Return(nodeAddr1, nil)
-				expectVerifyMultisigPermission(s, []ids.ShortID{utx.NodeOwnerAddress}, nil)
-				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, utx.OldNodeID).
-					Return(nil, database.ErrNotFound)
-				s.EXPECT().GetPendingValidator(constants.PrimaryNetworkID, utx.OldNodeID).
-					Return(nil, database.ErrNotFound)
-				s.EXPECT().GetDeferredValidator(constants.PrimaryNetworkID, utx.OldNodeID).Return(nil, nil) // no error
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(proposal.EndTime()).Times(2)
+				s.EXPECT().GetNextToExpireProposalIDsAndTime(nil).Return([]ids.ID{proposalID}, proposal.EndTime(), nil)
+				s.EXPECT().GetProposal(proposalID).Return(proposal, nil)
+				expectUnlock(s)
 				return s
 			},
-			utx: func() *txs.RegisterNodeTx {
-				return &txs.RegisterNodeTx{
-					BaseTx:           baseTx,
-					OldNodeID:        nodeID1,
-					NewNodeID:        nodeID2,
-					NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
-					NodeOwnerAddress: consortiumMemberAddr,
-				}
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{nodeKey2},
-				{consortiumMemberKey},
+			utx: &txs.FinishProposalsTx{
+				BaseTx:            baseTx,
+				FinishedProposals: []txs.FinishedProposal{{ProposalID: proposalID, Option: 0, Successful: true}},
 			},
-			expectedErr: errValidatorExists,
+			expectedErr: errInvalidSystemTxBody,
 		},
-		"OK: change registered node": {
-			state: func(c *gomock.Controller, utx *txs.RegisterNodeTx) *state.MockDiff {
+		"Wrong outputs": {
+			state: func(c *gomock.Controller, utx *txs.FinishProposalsTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().GetAddressStates(utx.NodeOwnerAddress).Return(txs.AddressStateConsortiumMember, nil)
-				s.EXPECT().GetShortIDLink(utx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode).
-					Return(nodeAddr1, nil)
-				expectVerifyMultisigPermission(s, []ids.ShortID{utx.NodeOwnerAddress}, nil)
-				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, utx.OldNodeID).
-					Return(nil, database.ErrNotFound)
-				s.EXPECT().GetPendingValidator(constants.PrimaryNetworkID, utx.OldNodeID).
-					Return(nil, database.ErrNotFound)
-				s.EXPECT().GetDeferredValidator(constants.PrimaryNetworkID, utx.OldNodeID).
-					Return(nil, database.ErrNotFound)
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
-				s.EXPECT().SetShortIDLink(ids.ShortID(utx.OldNodeID), state.ShortLinkKeyRegisterNode, nil)
-				s.EXPECT().SetShortIDLink(utx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode, nil)
-				s.EXPECT().SetShortIDLink(
-					ids.ShortID(utx.NewNodeID),
-					state.ShortLinkKeyRegisterNode,
-					&utx.NodeOwnerAddress,
-				)
-				link := ids.ShortID(utx.NewNodeID)
-				s.EXPECT().SetShortIDLink(
-					utx.NodeOwnerAddress,
-					state.ShortLinkKeyRegisterNode,
-					&link,
-				)
-				expectConsumeUTXOs(s, utx.Ins)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(proposal.EndTime()).Times(2)
+				s.EXPECT().GetNextToExpireProposalIDsAndTime(nil).Return([]ids.ID{proposalID}, proposal.EndTime(), nil)
+				s.EXPECT().GetProposal(proposalID).Return(proposal, nil)
+				expectUnlock(s)
 				return s
 			},
-			utx: func() *txs.RegisterNodeTx {
-				return &txs.RegisterNodeTx{
-					BaseTx:           baseTx,
-					OldNodeID:        nodeID1,
-					NewNodeID:        nodeID2,
-					NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
-					NodeOwnerAddress: consortiumMemberAddr,
-				}
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{nodeKey2},
-				{consortiumMemberKey},
-			},
-		},
-		"OK: consortium member is msig alias": {
-			state: func(c *gomock.Controller, utx *txs.RegisterNodeTx) *state.MockDiff {
-				s := state.NewMockDiff(c)
-				s.EXPECT().GetAddressStates(utx.NodeOwnerAddress).Return(txs.AddressStateConsortiumMember, nil)
-				s.EXPECT().GetShortIDLink(utx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode).
-					Return(ids.ShortEmpty, database.ErrNotFound)
-				s.EXPECT().GetShortIDLink(ids.ShortID(utx.NewNodeID), state.ShortLinkKeyRegisterNode).
-					Return(ids.ShortEmpty, database.ErrNotFound)
-				expectVerifyMultisigPermission(s,
-					[]ids.ShortID{
-						utx.NodeOwnerAddress,
-						consortiumMemberMsigAliasOwner.Addrs[0],
-						consortiumMemberMsigAliasOwner.Addrs[1],
-						consortiumMemberMsigAliasOwner.Addrs[2],
+			utx: &txs.FinishProposalsTx{
+				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+					NetworkID:    ctx.NetworkID,
+					BlockchainID: ctx.ChainID,
+					Ins:          baseTx.Ins,
+					Outs: []*avax.TransferableOutput{
+						generateTestOut(ctx.AVAXAssetID, 10, secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{{1}}}, ids.Empty, ids.Empty),
 					},
-					[]*multisig.AliasWithNonce{consortiumMemberMsigAlias})
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
-				s.EXPECT().SetShortIDLink(
-					ids.ShortID(utx.NewNodeID),
-					state.ShortLinkKeyRegisterNode,
-					&utx.NodeOwnerAddress,
-				)
-				link := ids.ShortID(utx.NewNodeID)
-				s.EXPECT().SetShortIDLink(
-					utx.NodeOwnerAddress,
-					state.ShortLinkKeyRegisterNode,
-					&link,
-				)
-				expectConsumeUTXOs(s, utx.Ins)
-				return s
-			},
-			utx: func() *txs.RegisterNodeTx {
-				return &txs.RegisterNodeTx{
-					BaseTx:           baseTx,
-					OldNodeID:        ids.EmptyNodeID,
-					NewNodeID:        nodeID1,
-					NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0, 1}},
-					NodeOwnerAddress: consortiumMemberMsigAlias.ID,
-				}
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{nodeKey1},
-				{consortiumMemberMsigKeys[0], consortiumMemberMsigKeys[1]},
+				}},
+				FinishedProposals: []txs.FinishedProposal{{ProposalID: proposalID, Option: 1, Successful: true}},
 			},
+			expectedErr: errInvalidSystemTxBody,
 		},
 		"OK": {
-			state: func(c *gomock.Controller, utx *txs.RegisterNodeTx) *state.MockDiff {
+			state: func(c *gomock.Controller, utx *txs.FinishProposalsTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().GetAddressStates(utx.NodeOwnerAddress).Return(txs.AddressStateConsortiumMember, nil)
-				s.EXPECT().GetShortIDLink(utx.NodeOwnerAddress, state.ShortLinkKeyRegisterNode).
-					Return(ids.ShortEmpty, database.ErrNotFound)
-				s.EXPECT().GetShortIDLink(ids.ShortID(utx.NewNodeID), state.ShortLinkKeyRegisterNode).
-					Return(ids.ShortEmpty, database.ErrNotFound)
-				expectVerifyMultisigPermission(s, []ids.ShortID{utx.NodeOwnerAddress}, nil)
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
-				s.EXPECT().SetShortIDLink(
-					ids.ShortID(utx.NewNodeID),
-					state.ShortLinkKeyRegisterNode,
-					&utx.NodeOwnerAddress,
-				)
-				link := ids.ShortID(utx.NewNodeID)
-				s.EXPECT().SetShortIDLink(
-					utx.NodeOwnerAddress,
-					state.ShortLinkKeyRegisterNode,
-					&link,
-				)
+				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
+				s.EXPECT().GetTimestamp().Return(proposal.EndTime()).Times(2)
+				s.EXPECT().GetNextToExpireProposalIDsAndTime(nil).Return([]ids.ID{proposalID}, proposal.EndTime(), nil)
+				s.EXPECT().GetProposal(proposalID).Return(proposal, nil)
+				expectUnlock(s)
+				s.EXPECT().SetProposalOutcome(proposalID, &state.ProposalOutcome{
+					TxID:       txID,
+					Option:     1,
+					Successful: true,
+				})
+				s.EXPECT().RemoveProposal(proposalID, proposal)
 				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
 				return s
 			},
-			utx: func() *txs.RegisterNodeTx {
-				return &txs.RegisterNodeTx{
-					BaseTx:           baseTx,
-					OldNodeID:        ids.EmptyNodeID,
-					NewNodeID:        nodeID1,
-					NodeOwnerAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
-					NodeOwnerAddress: consortiumMemberAddr,
-				}
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{nodeKey1},
-				{consortiumMemberKey},
+			utx: &txs.FinishProposalsTx{
+				BaseTx:            baseTx,
+				FinishedProposals: []txs.FinishedProposal{{ProposalID: proposalID, Option: 1, Successful: true}},
 			},
 		},
 	}
@@ -4572,18 +7619,15 @@ func TestCaminoStandardTxExecutorRegisterNodeTx(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
-			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }() //nolint:lint
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
 
-			utx := tt.utx()
-			avax.SortTransferableInputsWithSigners(utx.Ins, tt.signers)
-			avax.SortTransferableOutputs(utx.Outs, txs.Codec)
-			tx, err := txs.NewSigned(utx, txs.Codec, tt.signers)
+			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
 			require.NoError(t, err)
 
 			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
 				StandardTxExecutor{
 					Backend: &env.backend,
-					State:   tt.state(ctrl, utx),
+					State:   tt.state(ctrl, tt.utx, tx.ID(), env.config),
 					Tx:      tx,
 				},
 			})
@@ -4592,917 +7636,1122 @@ func TestCaminoStandardTxExecutorRegisterNodeTx(t *testing.T) {
 	}
 }
 
-func TestCaminoStandardTxExecutorRewardsImportTx(t *testing.T) {
+func TestCaminoStandardTxExecutorUpdateDepositOfferTx(t *testing.T) {
 	ctx, _ := defaultCtx(nil)
 	caminoGenesisConf := api.Camino{
 		VerifyNodeSignature: true,
 		LockModeBondDeposit: true,
 	}
-	caminoStateConf := &state.CaminoConfig{
-		VerifyNodeSignature: caminoGenesisConf.VerifyNodeSignature,
-		LockModeBondDeposit: caminoGenesisConf.LockModeBondDeposit,
-	}
 
-	blockTime := time.Unix(1000, 0)
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	offerOwnerKey, offerOwnerAddr, _ := generateKeyAndOwner(t)
+	offersAdminKey, offersAdminAddr, _ := generateKeyAndOwner(t)
 
-	shmWithUTXOs := func(t *testing.T, c *gomock.Controller, utxos []*avax.TimedUTXO) *atomic.MockSharedMemory {
-		shm := atomic.NewMockSharedMemory(c)
-		utxoIDs := make([][]byte, len(utxos))
-		var utxosBytes [][]byte
-		if len(utxos) != 0 {
-			utxosBytes = make([][]byte, len(utxos))
-		}
-		for i, utxo := range utxos {
-			var toMarshal interface{} = utxo
-			if utxo.Timestamp == 0 {
-				toMarshal = utxo.UTXO
-			}
-			utxoID := utxo.InputID()
-			utxoIDs[i] = utxoID[:]
-			utxoBytes, err := txs.Codec.Marshal(txs.Version, toMarshal)
-			require.NoError(t, err)
-			utxosBytes[i] = utxoBytes
+	feeUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+
+	chainTime := time.Unix(100, 0)
+	offer := &deposit.Offer{
+		UpgradeVersionID:      codec.UpgradeVersion1,
+		ID:                    ids.GenerateTestID(),
+		Start:                 0,
+		End:                   200,
+		MinDuration:           1,
+		MaxDuration:           1,
+		MinAmount:             deposit.OfferMinDepositAmount,
+		InterestRateNominator: 1,
+		TotalMaxRewardAmount:  100,
+		RewardedAmount:        50,
+		OwnerAddress:          offerOwnerAddr,
+	}
+	lockedOffer := *offer
+	lockedOffer.Flags = deposit.OfferFlagLocked
+	unlimitedOffer := *offer
+	unlimitedOffer.InterestRateNominator = 0
+	unlimitedOffer.TotalMaxRewardAmount = 0
+	unlimitedOffer.RewardedAmount = 0
+
+	utx := func(updaterAddr ids.ShortID, flags deposit.OfferFlag, end, totalMaxRewardAmount uint64) *txs.UpdateDepositOfferTx {
+		return &txs.UpdateDepositOfferTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+				Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
+			}},
+			DepositOfferID:             offer.ID,
+			Flags:                      flags,
+			End:                        end,
+			TotalMaxRewardAmount:       totalMaxRewardAmount,
+			DepositOfferUpdaterAddress: updaterAddr,
+			DepositOfferUpdaterAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
 		}
-		shm.EXPECT().Indexed(ctx.CChainID, treasury.AddrTraitsBytes,
-			ids.ShortEmpty[:], ids.Empty[:], maxPageSize).Return(utxosBytes, nil, nil, nil)
-		return shm
 	}
 
 	tests := map[string]struct {
-		state                  func(*gomock.Controller, *txs.RewardsImportTx, ids.ID) *state.MockDiff
-		sharedMemory           func(*testing.T, *gomock.Controller, []*avax.TimedUTXO) *atomic.MockSharedMemory
-		utx                    func([]*avax.TimedUTXO) *txs.RewardsImportTx
-		signers                [][]*secp256k1.PrivateKey
-		utxos                  []*avax.TimedUTXO // sorted by txID
-		expectedAtomicInputs   func([]*avax.TimedUTXO) set.Set[ids.ID]
-		expectedAtomicRequests func([]*avax.TimedUTXO) map[ids.ID]*atomic.Requests
-		expectedErr            error
+		state       func(*gomock.Controller, *txs.UpdateDepositOfferTx, *config.Config) *state.MockDiff
+		utx         *txs.UpdateDepositOfferTx
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
 	}{
-		"Imported inputs don't contain all reward utxos that are ready to be imported": {
-			state: func(c *gomock.Controller, utx *txs.RewardsImportTx, txID ids.ID) *state.MockDiff {
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
-				s.EXPECT().GetTimestamp().Return(blockTime)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
 				return s
 			},
-			sharedMemory: shmWithUTXOs,
-			utx: func(utxos []*avax.TimedUTXO) *txs.RewardsImportTx {
-				return &txs.RewardsImportTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-					NetworkID:    ctx.NetworkID,
-					BlockchainID: ctx.ChainID,
-					Ins: []*avax.TransferableInput{
-						generateTestInFromUTXO(&utxos[0].UTXO, []uint32{0}),
-						generateTestInFromUTXO(&utxos[1].UTXO, []uint32{0}),
-					},
-				}}}
+			utx:         utx(offerOwnerAddr, deposit.OfferFlagLocked, offer.End, offer.TotalMaxRewardAmount),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {offerOwnerKey}},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Offer not found": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(nil, database.ErrNotFound)
+				return s
 			},
-			utxos: []*avax.TimedUTXO{
-				{
-					UTXO:      *generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, 1, *treasury.Owner, ids.Empty, ids.Empty),
-					Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound,
-				},
-				{
-					UTXO:      *generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, 1, *treasury.Owner, ids.Empty, ids.Empty),
-					Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound,
-				},
-				{
-					UTXO:      *generateTestUTXO(ids.ID{3}, ctx.AVAXAssetID, 1, *treasury.Owner, ids.Empty, ids.Empty),
-					Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound,
-				},
+			utx:         utx(offerOwnerAddr, deposit.OfferFlagLocked, offer.End, offer.TotalMaxRewardAmount),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {offerOwnerKey}},
+			expectedErr: errDepositOfferNotFound,
+		},
+		"Not offer owner or offers admin": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer, nil)
+				s.EXPECT().GetAddressStates(utx.DepositOfferUpdaterAddress).Return(txs.AddressStateOffersCreator, nil)
+				return s
 			},
-			expectedErr: errInputsUTXOSMismatch,
+			utx:         utx(offersAdminAddr, deposit.OfferFlagLocked, offer.End, offer.TotalMaxRewardAmount),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {offersAdminKey}},
+			expectedErr: errNotOfferOwnerOrAdmin,
 		},
-		"Imported input doesn't match reward utxo": {
-			state: func(c *gomock.Controller, utx *txs.RewardsImportTx, txID ids.ID) *state.MockDiff {
+		"Bad updater signature": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
-				s.EXPECT().GetTimestamp().Return(blockTime)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
 				return s
 			},
-			sharedMemory: shmWithUTXOs,
-			utx: func(utxos []*avax.TimedUTXO) *txs.RewardsImportTx {
-				return &txs.RewardsImportTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-					NetworkID:    ctx.NetworkID,
-					BlockchainID: ctx.ChainID,
-					Ins: []*avax.TransferableInput{
-						generateTestIn(ctx.AVAXAssetID, 1, ids.Empty, ids.Empty, []uint32{}),
-					},
-				}}}
+			utx:         utx(offerOwnerAddr, deposit.OfferFlagLocked, offer.End, offer.TotalMaxRewardAmount),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {feeOwnerKey}},
+			expectedErr: errOfferUpdaterCredentialMismatch,
+		},
+		"Extended end": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
+				return s
 			},
-			utxos: []*avax.TimedUTXO{{
-				UTXO:      *generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, 1, *treasury.Owner, ids.Empty, ids.Empty),
-				Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound,
-			}},
-			expectedErr: errImportedUTXOMismatch,
+			utx:         utx(offerOwnerAddr, deposit.OfferFlagNone, offer.End+1, offer.TotalMaxRewardAmount),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {offerOwnerKey}},
+			expectedErr: errOfferEndExtended,
 		},
-		"Input & utxo amount mismatch": {
-			state: func(c *gomock.Controller, utx *txs.RewardsImportTx, txID ids.ID) *state.MockDiff {
+		"End in the past": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
-				s.EXPECT().GetTimestamp().Return(blockTime)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
 				return s
 			},
-			sharedMemory: shmWithUTXOs,
-			utx: func(utxos []*avax.TimedUTXO) *txs.RewardsImportTx {
-				return &txs.RewardsImportTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-					NetworkID:    ctx.NetworkID,
-					BlockchainID: ctx.ChainID,
-					Ins: []*avax.TransferableInput{{
-						UTXOID: utxos[0].UTXOID,
-						Asset:  avax.Asset{ID: ctx.AVAXAssetID},
-						In: &secp256k1fx.TransferInput{
-							Amt:   2,
-							Input: secp256k1fx.Input{SigIndices: []uint32{0}},
-						},
-					}},
-				}}}
+			utx:         utx(offerOwnerAddr, deposit.OfferFlagNone, uint64(chainTime.Unix())-1, offer.TotalMaxRewardAmount),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {offerOwnerKey}},
+			expectedErr: errOfferEndInPast,
+		},
+		"Increased limit": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
+				return s
 			},
-			utxos: []*avax.TimedUTXO{{
-				UTXO:      *generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, 1, *treasury.Owner, ids.Empty, ids.Empty),
-				Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound,
-			}},
-			expectedErr: errInputAmountMismatch,
+			utx:         utx(offerOwnerAddr, deposit.OfferFlagNone, offer.End, offer.TotalMaxRewardAmount+1),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {offerOwnerKey}},
+			expectedErr: errOfferLimitIncreased,
 		},
-		"OK": {
-			state: func(c *gomock.Controller, utx *txs.RewardsImportTx, txID ids.ID) *state.MockDiff {
+		"Removed limit": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().CaminoConfig().Return(caminoStateConf, nil)
-				s.EXPECT().GetTimestamp().Return(blockTime)
-
-				rewardOwner1 := &secp256k1fx.OutputOwners{
-					Threshold: 1,
-					Addrs:     []ids.ShortID{{1}},
-				}
-				rewardOwner2 := &secp256k1fx.OutputOwners{
-					Threshold: 1,
-					Addrs:     []ids.ShortID{{2}},
-				}
-				rewardOwner4 := &secp256k1fx.OutputOwners{
-					Threshold: 1,
-					Addrs:     []ids.ShortID{{4}},
-				}
-
-				staker1 := &state.Staker{TxID: ids.ID{0, 1}, SubnetID: constants.PrimaryNetworkID}
-				staker2 := &state.Staker{TxID: ids.ID{0, 2}, SubnetID: constants.PrimaryNetworkID}
-				staker3 := &state.Staker{TxID: ids.ID{0, 3}, SubnetID: ids.ID{0, 0, 1}}
-				staker4 := &state.Staker{TxID: ids.ID{0, 4}, SubnetID: constants.PrimaryNetworkID}
-				staker5 := &state.Staker{TxID: ids.ID{0, 5}, SubnetID: constants.PrimaryNetworkID}
-
-				currentStakerIterator := state.NewMockStakerIterator(c)
-				currentStakerIterator.EXPECT().Next().Return(true).Times(5)
-				currentStakerIterator.EXPECT().Value().Return(staker1)
-				currentStakerIterator.EXPECT().Value().Return(staker2)
-				currentStakerIterator.EXPECT().Value().Return(staker3)
-				currentStakerIterator.EXPECT().Value().Return(staker4)
-				currentStakerIterator.EXPECT().Value().Return(staker5)
-				currentStakerIterator.EXPECT().Next().Return(false)
-				currentStakerIterator.EXPECT().Release()
-
-				s.EXPECT().GetCurrentStakerIterator().Return(currentStakerIterator, nil)
-				s.EXPECT().GetTx(staker1.TxID).Return(&txs.Tx{Unsigned: &txs.CaminoAddValidatorTx{AddValidatorTx: txs.AddValidatorTx{
-					RewardsOwner: rewardOwner1,
-				}}}, status.Committed, nil)
-				s.EXPECT().GetTx(staker2.TxID).Return(&txs.Tx{Unsigned: &txs.CaminoAddValidatorTx{AddValidatorTx: txs.AddValidatorTx{
-					RewardsOwner: rewardOwner2,
-				}}}, status.Committed, nil)
-				s.EXPECT().GetTx(staker4.TxID).Return(&txs.Tx{Unsigned: &txs.CaminoAddValidatorTx{AddValidatorTx: txs.AddValidatorTx{
-					RewardsOwner: rewardOwner4,
-				}}}, status.Committed, nil)
-				s.EXPECT().GetTx(staker5.TxID).Return(&txs.Tx{Unsigned: &txs.CaminoAddValidatorTx{AddValidatorTx: txs.AddValidatorTx{
-					RewardsOwner: rewardOwner1, // same as staker1
-				}}}, status.Committed, nil)
-				s.EXPECT().GetNotDistributedValidatorReward().Return(uint64(1), nil) // old
-				s.EXPECT().SetNotDistributedValidatorReward(uint64(2))               // new
-				rewardOwnerID1, err := txs.GetOwnerID(rewardOwner1)
-				require.NoError(t, err)
-				rewardOwnerID2, err := txs.GetOwnerID(rewardOwner2)
-				require.NoError(t, err)
-				rewardOwnerID4, err := txs.GetOwnerID(rewardOwner4)
-				require.NoError(t, err)
-
-				s.EXPECT().GetClaimable(rewardOwnerID1).Return(&state.Claimable{
-					Owner:                rewardOwner1,
-					ValidatorReward:      10,
-					ExpiredDepositReward: 100,
-				}, nil)
-				s.EXPECT().GetClaimable(rewardOwnerID2).Return(&state.Claimable{
-					Owner:                rewardOwner2,
-					ValidatorReward:      20,
-					ExpiredDepositReward: 200,
-				}, nil)
-				s.EXPECT().GetClaimable(rewardOwnerID4).Return(nil, database.ErrNotFound)
-
-				s.EXPECT().SetClaimable(rewardOwnerID1, &state.Claimable{
-					Owner:                rewardOwner1,
-					ValidatorReward:      12,
-					ExpiredDepositReward: 100,
-				})
-				s.EXPECT().SetClaimable(rewardOwnerID2, &state.Claimable{
-					Owner:                rewardOwner2,
-					ValidatorReward:      21,
-					ExpiredDepositReward: 200,
-				})
-				s.EXPECT().SetClaimable(rewardOwnerID4, &state.Claimable{
-					Owner:           rewardOwner4,
-					ValidatorReward: 1,
-				})
-
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
 				return s
 			},
-			sharedMemory: shmWithUTXOs,
-			utx: func(utxos []*avax.TimedUTXO) *txs.RewardsImportTx {
-				return &txs.RewardsImportTx{BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-					NetworkID:    ctx.NetworkID,
-					BlockchainID: ctx.ChainID,
-					Ins: []*avax.TransferableInput{
-						generateTestInFromUTXO(&utxos[0].UTXO, []uint32{0}),
-						generateTestInFromUTXO(&utxos[2].UTXO, []uint32{0}),
-					},
-				}}}
+			utx:         utx(offerOwnerAddr, deposit.OfferFlagNone, offer.End, 0),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {offerOwnerKey}},
+			expectedErr: errOfferLimitIncreased,
+		},
+		"Limited unlimited offer": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(&unlimitedOffer, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
+				return s
 			},
-			utxos: []*avax.TimedUTXO{
-				{ // timed utxo, old enough
-					UTXO:      *generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, 3, *treasury.Owner, ids.Empty, ids.Empty),
-					Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound,
-				},
-				{ // not timed utxo
-					UTXO: *generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, 5, *treasury.Owner, ids.Empty, ids.Empty),
-				},
-				{ // timed utxo, old enough
-					UTXO:      *generateTestUTXO(ids.ID{3}, ctx.AVAXAssetID, 2, *treasury.Owner, ids.Empty, ids.Empty),
-					Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound,
-				},
-				{ // timed utxo, not old enough
-					UTXO:      *generateTestUTXO(ids.ID{4}, ctx.AVAXAssetID, 1, *treasury.Owner, ids.Empty, ids.Empty),
-					Timestamp: uint64(blockTime.Unix()) - atomic.SharedMemorySyncBound + 1,
-				},
+			utx: func() *txs.UpdateDepositOfferTx {
+				utx := utx(offerOwnerAddr, deposit.OfferFlagNone, offer.End, 0)
+				utx.TotalMaxAmount = deposit.OfferMinDepositAmount * 10
+				return utx
+			}(),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {offerOwnerKey}},
+			expectedErr: errUnlimitedOfferLimited,
+		},
+		"Limit below already rewarded amount": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
+				return s
+			},
+			utx:         utx(offerOwnerAddr, deposit.OfferFlagNone, offer.End, offer.RewardedAmount-1),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {offerOwnerKey}},
+			expectedErr: errOfferLimitBelowUsed,
+		},
+		"Supply overflow on unlock": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(&lockedOffer, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).
+					Return(cfg.RewardConfig.SupplyCap-lockedOffer.RemainingReward()+1, nil)
+				s.EXPECT().GetAllDepositOffers().Return([]*deposit.Offer{&lockedOffer}, nil)
+				return s
 			},
-			expectedAtomicInputs: func(utxos []*avax.TimedUTXO) set.Set[ids.ID] {
-				return set.Set[ids.ID]{
-					utxos[0].InputID(): struct{}{},
-					utxos[2].InputID(): struct{}{},
-				}
+			utx:         utx(offerOwnerAddr, deposit.OfferFlagNone, offer.End, offer.TotalMaxRewardAmount),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {offerOwnerKey}},
+			expectedErr: errSupplyOverflow,
+		},
+		"OK: owner locks offer and lowers limits": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offer, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				updatedOffer := *offer
+				updatedOffer.Flags = deposit.OfferFlagLocked
+				updatedOffer.End = offer.End - 1
+				updatedOffer.TotalMaxRewardAmount = offer.RewardedAmount
+				s.EXPECT().SetDepositOffer(&updatedOffer)
+				expectConsumeUTXOs(s, utx.Ins)
+				return s
 			},
-			expectedAtomicRequests: func(utxos []*avax.TimedUTXO) map[ids.ID]*atomic.Requests {
-				utxoID0 := utxos[0].InputID()
-				utxoID2 := utxos[2].InputID()
-				return map[ids.ID]*atomic.Requests{ctx.CChainID: {
-					RemoveRequests: [][]byte{utxoID0[:], utxoID2[:]},
-				}}
+			utx:     utx(offerOwnerAddr, deposit.OfferFlagLocked, offer.End-1, offer.RewardedAmount),
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {offerOwnerKey}},
+		},
+		"OK: offers admin unlocks offer": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferTx, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(&lockedOffer, nil)
+				s.EXPECT().GetAddressStates(utx.DepositOfferUpdaterAddress).Return(txs.AddressStateRoleOffersAdmin, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferUpdaterAddress}, nil)
+				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).
+					Return(cfg.RewardConfig.SupplyCap-lockedOffer.RemainingReward(), nil)
+				s.EXPECT().GetAllDepositOffers().Return([]*deposit.Offer{&lockedOffer}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().SetDepositOffer(offer)
+				expectConsumeUTXOs(s, utx.Ins)
+				return s
 			},
+			utx:     utx(offersAdminAddr, deposit.OfferFlagNone, offer.End, offer.TotalMaxRewardAmount),
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {offersAdminKey}},
 		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			require := require.New(t)
-
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
-			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, tt.sharedMemory(t, ctrl, tt.utxos))
-			defer func() { require.NoError(shutdownCaminoEnvironment(env)) }() //nolint:lint
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
 
-			utx := tt.utx(tt.utxos)
-			avax.SortTransferableInputsWithSigners(utx.Ins, tt.signers)
-			avax.SortTransferableOutputs(utx.Outs, txs.Codec)
-			// utx.ImportedInputs must be already sorted cause of utxos sort
-			tx, err := txs.NewSigned(utx, txs.Codec, tt.signers)
-			require.NoError(err)
+			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
 
-			e := &CaminoStandardTxExecutor{
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
 				StandardTxExecutor{
 					Backend: &env.backend,
-					State:   tt.state(ctrl, utx, tx.ID()),
+					State:   tt.state(ctrl, tt.utx, env.config),
 					Tx:      tx,
 				},
-			}
-
-			require.ErrorIs(tx.Unsigned.Visit(e), tt.expectedErr)
-
-			if tt.expectedAtomicInputs != nil {
-				require.Equal(tt.expectedAtomicInputs(tt.utxos), e.Inputs)
-			} else {
-				require.Nil(e.Inputs)
-			}
-
-			if tt.expectedAtomicRequests != nil {
-				require.Equal(tt.expectedAtomicRequests(tt.utxos), e.AtomicRequests)
-			} else {
-				require.Nil(e.AtomicRequests)
-			}
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
 		})
 	}
 }
 
-func TestCaminoStandardTxExecutorSuspendValidator(t *testing.T) {
-	// finding first staker to remove
-	env := newCaminoEnvironment( /*postBanff*/ true, false, api.Camino{LockModeBondDeposit: true})
-	stakerIterator, err := env.state.GetCurrentStakerIterator()
-	require.NoError(t, err)
-	require.True(t, stakerIterator.Next())
-	stakerToRemove := stakerIterator.Value()
-	stakerIterator.Release()
-	nodeID := stakerToRemove.NodeID
-	consortiumMemberAddress, err := env.state.GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode)
-	require.NoError(t, err)
-	require.NoError(t, shutdownCaminoEnvironment(env))
+func TestCaminoStandardTxExecutorTransferDepositTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
 
-	initAdmin := caminoPreFundedKeys[0]
-	// other common data
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	owner1Key, owner1Addr, owner1 := generateKeyAndOwner(t)
+	owner2Key, owner2Addr, owner2 := generateKeyAndOwner(t)
 
-	outputOwners := &secp256k1fx.OutputOwners{
-		Locktime:  0,
-		Threshold: 1,
-		Addrs:     []ids.ShortID{consortiumMemberAddress},
+	chainTime := time.Unix(100, 0)
+	depositTxID := ids.ID{0, 1}
+	deposit1 := &deposit.Deposit{
+		DepositOfferID: ids.ID{0, 2},
+		Start:          0,
+		Duration:       200,
+		Amount:         100,
+		RewardOwner:    &owner1,
 	}
+	depositWithNewRewardOwner := *deposit1
+	depositWithNewRewardOwner.RewardOwner = &owner2
 
-	type args struct {
-		address    ids.ShortID
-		remove     bool
-		keys       []*secp256k1.PrivateKey
-		changeAddr *secp256k1fx.OutputOwners
+	feeUTXO := generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+	depositUTXO := generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, deposit1.Amount, owner1, depositTxID, ids.Empty)
+	depositBondUTXO := generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, deposit1.Amount, owner1, depositTxID, ids.ID{0, 3})
+
+	utx := func(ins []*avax.TransferableInput, outs []*avax.TransferableOutput, newRewardOwner *secp256k1fx.OutputOwners) *txs.TransferDepositTx {
+		return &txs.TransferDepositTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+				Ins:          ins,
+				Outs:         outs,
+			}},
+			DepositTxID:     depositTxID,
+			NewRewardOwner:  newRewardOwner,
+			RewardOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+		}
 	}
+
 	tests := map[string]struct {
-		generateArgs func() args
-		preExecute   func(*testing.T, *txs.Tx, state.State)
-		expectedErr  error
-		assert       func(*testing.T)
+		state       func(*gomock.Controller, *txs.TransferDepositTx, ids.ID, *config.Config) *state.MockDiff
+		utx         *txs.TransferDepositTx
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
 	}{
-		"Set state to deferred from address with no roles -> ErrAddrStateNotPermitted": {
-			generateArgs: func() args {
-				return args{
-					address:    consortiumMemberAddress,
-					keys:       []*secp256k1.PrivateKey{caminoPreFundedKeys[1]}, // non-admin address
-					changeAddr: outputOwners,
-				}
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				return s
 			},
-			preExecute:  func(t *testing.T, tx *txs.Tx, state state.State) {},
-			expectedErr: errAddrStateNotPermitted,
+			utx:         utx(generateInsFromUTXOs([]*avax.UTXO{feeUTXO}), nil, &owner2),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+			expectedErr: errNotBerlinPhase,
 		},
-		"Set state to deferred from kyc address -> ErrAddrStateNotPermitted": {
-			generateArgs: func() args {
-				return args{
-					address:    consortiumMemberAddress,
-					keys:       []*secp256k1.PrivateKey{caminoPreFundedKeys[1]},
-					changeAddr: outputOwners,
-				}
+		"Wrong number of credentials": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				return s
 			},
-			preExecute: func(t *testing.T, tx *txs.Tx, state state.State) {
-				state.SetAddressStates(caminoPreFundedKeys[1].Address(), txs.AddressStateRoleKYC)
+			utx:         utx(generateInsFromUTXOs([]*avax.UTXO{feeUTXO}), nil, &owner2),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}},
+			expectedErr: errWrongCredentialsNumber,
+		},
+		"Deposit not found": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDeposit(depositTxID).Return(nil, database.ErrNotFound)
+				return s
 			},
-			expectedErr: errAddrStateNotPermitted,
+			utx:         utx(generateInsFromUTXOs([]*avax.UTXO{feeUTXO}), nil, &owner2),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+			expectedErr: errDepositNotFound,
 		},
-		"Happy path set state to deferred": {
-			generateArgs: func() args {
-				return args{
-					address:    consortiumMemberAddress,
-					keys:       []*secp256k1.PrivateKey{initAdmin},
-					changeAddr: outputOwners,
-				}
+		"Deposit expired": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(deposit1.EndTime())
+				s.EXPECT().GetDeposit(depositTxID).Return(deposit1, nil)
+				return s
 			},
-			preExecute:  func(t *testing.T, tx *txs.Tx, state state.State) {},
-			expectedErr: nil,
+			utx:         utx(generateInsFromUTXOs([]*avax.UTXO{feeUTXO}), nil, &owner2),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+			expectedErr: errDepositExpired,
 		},
-		"Remove deferred state from non-admin address -> ErrAddrStateNotPermitted": {
-			generateArgs: func() args {
-				return args{
-					address:    consortiumMemberAddress,
-					remove:     true,
-					keys:       []*secp256k1.PrivateKey{caminoPreFundedKeys[1]}, // non-admin address
-					changeAddr: outputOwners,
-				}
+		"Reward owner credential mismatch": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDeposit(depositTxID).Return(deposit1, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{owner1Addr}, nil)
+				return s
 			},
-			preExecute:  func(t *testing.T, tx *txs.Tx, state state.State) {},
-			expectedErr: errAddrStateNotPermitted,
+			utx:         utx(generateInsFromUTXOs([]*avax.UTXO{feeUTXO}), nil, &owner2),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner2Key}},
+			expectedErr: errRewardOwnerCredentialMismatch,
 		},
-		"Happy path set state to active": {
-			generateArgs: func() args {
-				return args{
-					address:    consortiumMemberAddress,
-					remove:     true,
-					keys:       []*secp256k1.PrivateKey{initAdmin},
-					changeAddr: outputOwners,
-				}
+		"Nothing transferred": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDeposit(depositTxID).Return(deposit1, nil)
+				return s
 			},
-			preExecute: func(t *testing.T, tx *txs.Tx, state state.State) {
-				stakerToTransfer, err := state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
-				require.NoError(t, err)
-				state.DeleteCurrentValidator(stakerToTransfer)
-				state.PutDeferredValidator(stakerToTransfer)
+			utx:         utx(generateInsFromUTXOs([]*avax.UTXO{feeUTXO}), nil, &owner1),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+			expectedErr: errNothingTransferred,
+		},
+		"Transferred input doesn't match utxo lock ids": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDeposit(depositTxID).Return(deposit1, nil)
+				expectGetUTXOsFromInputs(s, utx.Ins[1:], []*avax.UTXO{depositBondUTXO})
+				return s
 			},
-			expectedErr: nil,
+			utx: utx(
+				generateInsFromUTXOs([]*avax.UTXO{feeUTXO, depositUTXO}),
+				[]*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, deposit1.Amount, owner2, depositTxID, ids.Empty),
+				},
+				&owner1,
+			),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}, {owner1Key}},
+			expectedErr: errTransferredUTXOMismatch,
 		},
-		"Remove deferred state of an active validator": {
-			generateArgs: func() args {
-				return args{
-					address:    consortiumMemberAddress,
-					remove:     true,
-					keys:       []*secp256k1.PrivateKey{initAdmin},
-					changeAddr: outputOwners,
-				}
+		"Transferred amount isn't fully produced": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDeposit(depositTxID).Return(deposit1, nil)
+				expectVerifyLock(s, utx.Ins[1:], []*avax.UTXO{depositUTXO}, []ids.ShortID{owner1Addr, owner2Addr}, nil)
+				return s
 			},
-			preExecute:  func(t *testing.T, tx *txs.Tx, state state.State) {},
-			expectedErr: errValidatorNotFound,
+			utx: utx(
+				generateInsFromUTXOs([]*avax.UTXO{feeUTXO, depositUTXO}),
+				[]*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, deposit1.Amount-1, owner2, depositTxID, ids.Empty),
+				},
+				&owner1,
+			),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}, {owner1Key}},
+			expectedErr: errWrongTransferredAmount,
 		},
-	}
-	for name, tt := range tests {
-		t.Run(name, func(t *testing.T) {
-			caminoGenesisConf := api.Camino{
-				VerifyNodeSignature: true,
-				LockModeBondDeposit: true,
-				InitialAdmin:        initAdmin.Address(),
-			}
-			env := newCaminoEnvironment( /*postBanff*/ true, false, caminoGenesisConf)
-			env.ctx.Lock.Lock()
-			defer func() {
-				if err := shutdownCaminoEnvironment(env); err != nil {
-					t.Fatal(err)
-				}
-			}()
-
-			env.config.BanffTime = env.state.GetTimestamp()
-			require.NoError(t, env.state.Commit())
-
-			setAddressStateArgs := tt.generateArgs()
-			tx, err := env.txBuilder.NewAddressStateTx(
-				setAddressStateArgs.address,
-				setAddressStateArgs.remove,
-				txs.AddressStateBitNodeDeferred,
-				setAddressStateArgs.keys,
-				setAddressStateArgs.changeAddr,
-			)
-			require.NoError(t, err)
+		"Transferred amount is produced with different lock ids": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDeposit(depositTxID).Return(deposit1, nil)
+				expectVerifyLock(s, utx.Ins[1:], []*avax.UTXO{depositUTXO}, []ids.ShortID{owner1Addr, owner2Addr}, nil)
+				return s
+			},
+			utx: utx(
+				generateInsFromUTXOs([]*avax.UTXO{feeUTXO, depositUTXO}),
+				[]*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, deposit1.Amount, owner2, depositTxID, ids.ID{0, 3}),
+				},
+				&owner1,
+			),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}, {owner1Key}},
+			expectedErr: errWrongTransferredAmount,
+		},
+		"OK: change reward owner": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDeposit(depositTxID).Return(deposit1, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{owner1Addr, owner2Addr}, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().ModifyDeposit(depositTxID, &depositWithNewRewardOwner)
+				expectConsumeUTXOs(s, utx.Ins)
+				return s
+			},
+			utx:     utx(generateInsFromUTXOs([]*avax.UTXO{feeUTXO}), nil, &owner2),
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}},
+		},
+		"OK: transfer deposited utxo": {
+			state: func(c *gomock.Controller, utx *txs.TransferDepositTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDeposit(depositTxID).Return(deposit1, nil)
+				expectVerifyLock(s, utx.Ins[1:], []*avax.UTXO{depositUTXO}, []ids.ShortID{owner1Addr, owner2Addr}, nil)
+				expectVerifyLock(s, utx.Ins[:1], []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
+			},
+			utx: utx(
+				generateInsFromUTXOs([]*avax.UTXO{feeUTXO, depositUTXO}),
+				[]*avax.TransferableOutput{
+					generateTestOut(ctx.AVAXAssetID, deposit1.Amount, owner2, depositTxID, ids.Empty),
+				},
+				&owner1,
+			),
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {owner1Key}, {owner1Key}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
 
-			tt.preExecute(t, tx, env.state)
-			onAcceptState, err := state.NewDiff(lastAcceptedID, env)
+			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
 			require.NoError(t, err)
 
-			executor := CaminoStandardTxExecutor{
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
 				StandardTxExecutor{
 					Backend: &env.backend,
-					State:   onAcceptState,
+					State:   tt.state(ctrl, tt.utx, tx.ID(), env.config),
 					Tx:      tx,
 				},
-			}
-
-			err = tx.Unsigned.Visit(&executor)
+			})
 			require.ErrorIs(t, err, tt.expectedErr)
-			if tt.expectedErr != nil {
-				return
-			}
-			var stakerIterator state.StakerIterator
-			if setAddressStateArgs.remove {
-				stakerIterator, err = onAcceptState.GetCurrentStakerIterator()
-				require.NoError(t, err)
-			} else {
-				stakerIterator, err = onAcceptState.GetDeferredStakerIterator()
-				require.NoError(t, err)
-			}
-			require.True(t, stakerIterator.Next())
-			stakerToRemove := stakerIterator.Value()
-			stakerIterator.Release()
-			require.Equal(t, stakerToRemove.NodeID, nodeID)
 		})
 	}
 }
 
-func TestCaminoStandardTxExecutorExportTxMultisig(t *testing.T) {
-	fakeMSigAlias := preFundedKeys[0].Address()
-	sourceKey := preFundedKeys[1]
-	nestedAlias := ids.ShortID{0, 0, 0, 1, 0xa}
-	aliasMemberOwners := secp256k1fx.OutputOwners{
-		Threshold: 1,
-		Addrs:     []ids.ShortID{sourceKey.Address()},
-	}
-	aliasDefinition := &multisig.AliasWithNonce{
-		Alias: multisig.Alias{
-			ID:     fakeMSigAlias,
-			Owners: &aliasMemberOwners,
-		},
-	}
-	nestedAliasDefinition := &multisig.AliasWithNonce{
-		Alias: multisig.Alias{
-			ID: nestedAlias,
-			Owners: &secp256k1fx.OutputOwners{
-				Threshold: 1,
-				Addrs:     []ids.ShortID{fakeMSigAlias, sourceKey.Address()},
-			},
-		},
-	}
-
+func TestCaminoStandardTxExecutorTreasuryConfigTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
 	caminoGenesisConf := api.Camino{
 		VerifyNodeSignature: true,
 		LockModeBondDeposit: true,
-		MultisigAliases:     []*multisig.Alias{&aliasDefinition.Alias, &nestedAliasDefinition.Alias},
 	}
 
-	env := newCaminoEnvironment( /*postBanff*/ true, false, caminoGenesisConf)
-	env.ctx.Lock.Lock()
-	defer func() {
-		if err := shutdownCaminoEnvironment(env); err != nil {
-			t.Fatal(err)
-		}
-	}()
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	adminKey, adminAddr, _ := generateKeyAndOwner(t)
+	_, _, treasuryOwner := generateKeyAndOwner(t)
 
-	type test struct {
-		destinationChainID ids.ID
-		to                 ids.ShortID
-		expectedErr        error
-		expectedMsigAddrs  []ids.ShortID
+	chainTime := time.Unix(100, 0)
+	feeUTXO := generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+
+	treasuryConfig := treasury.Config{
+		Owner:          treasuryOwner,
+		SpendingPeriod: 100,
+		SpendingCap:    1000,
 	}
 
-	tests := map[string]test{
-		"P->C export from msig wallet": {
-			destinationChainID: cChainID,
-			to:                 fakeMSigAlias,
-			expectedErr:        nil,
-			expectedMsigAddrs:  []ids.ShortID{fakeMSigAlias},
+	utx := &txs.TreasuryConfigTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    ctx.NetworkID,
+			BlockchainID: ctx.ChainID,
+			Ins:          generateInsFromUTXOs([]*avax.UTXO{feeUTXO}),
+		}},
+		TreasuryConfig: treasuryConfig,
+		Executor:       adminAddr,
+		ExecutorAuth:   &secp256k1fx.Input{SigIndices: []uint32{0}},
+	}
+
+	tests := map[string]struct {
+		state       func(*gomock.Controller, *txs.TreasuryConfigTx, ids.ID, *config.Config) *state.MockDiff
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
+	}{
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.TreasuryConfigTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {adminKey}},
+			expectedErr: errNotBerlinPhase,
 		},
-		"P->C export simple account not multisig": {
-			destinationChainID: cChainID,
-			to:                 sourceKey.Address(),
-			expectedErr:        nil,
-			expectedMsigAddrs:  []ids.ShortID{},
+		"Wrong number of credentials": {
+			state: func(c *gomock.Controller, utx *txs.TreasuryConfigTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}},
+			expectedErr: errWrongCredentialsNumber,
+		},
+		"Executor credential mismatch": {
+			state: func(c *gomock.Controller, utx *txs.TreasuryConfigTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{adminAddr}, nil)
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {feeOwnerKey}},
+			expectedErr: errExecutorCredentialMismatch,
+		},
+		"Executor isn't admin": {
+			state: func(c *gomock.Controller, utx *txs.TreasuryConfigTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{adminAddr}, nil)
+				s.EXPECT().GetAddressStates(adminAddr).Return(txs.AddressStateRoleKYC, nil)
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {adminKey}},
+			expectedErr: errAddrStateNotPermitted,
+		},
+		"OK": {
+			state: func(c *gomock.Controller, utx *txs.TreasuryConfigTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				expectVerifyMultisigPermission(s, []ids.ShortID{adminAddr}, nil)
+				s.EXPECT().GetAddressStates(adminAddr).Return(txs.AddressStateRoleAdmin, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
+				s.EXPECT().SetTreasuryConfig(&utx.TreasuryConfig)
+				expectConsumeUTXOs(s, utx.Ins)
+				return s
+			},
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {adminKey}},
 		},
-		// unsupported for now
-		// "P->C export from nested msig wallet": {
-		// 	destinationChainID: cChainID,
-		// 	to:                 nestedAlias,
-		// 	expectedErr:        nil,
-		// 	expectedMsigAddrs:  []ids.ShortID{nestedAlias, fakeMSigAlias},
-		// },
 	}
-
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			require := require.New(t)
-
-			tx, err := env.txBuilder.NewExportTx(
-				defaultBalance-defaultTxFee,
-				tt.destinationChainID,
-				tt.to,
-				preFundedKeys,
-				ids.ShortEmpty,
-			)
-			require.NoError(err)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
 
-			fakedState, err := state.NewDiff(lastAcceptedID, env)
-			require.NoError(err)
+			tx, err := txs.NewSigned(utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
 
-			executor := CaminoStandardTxExecutor{
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
 				StandardTxExecutor{
 					Backend: &env.backend,
-					State:   fakedState,
+					State:   tt.state(ctrl, utx, tx.ID(), env.config),
 					Tx:      tx,
 				},
-			}
-			err = tx.Unsigned.Visit(&executor)
-			require.ErrorIs(err, tt.expectedErr)
-			if err != nil {
-				return
-			}
-
-			// Check atomic elts
-			ar, exists := executor.AtomicRequests[tt.destinationChainID]
-			require.True(exists)
-			require.Len(ar.PutRequests, 1)
-			req := ar.PutRequests[0]
-			require.Equal(req.Traits[0], tt.to.Bytes())
+			})
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
 
-			var (
-				utxo    avax.UTXO
-				aliases []verify.State
-			)
+func TestCaminoStandardTxExecutorTreasurySpendTx(t *testing.T) {
+	ctx, _ := defaultCtx(nil)
+	caminoGenesisConf := api.Camino{
+		VerifyNodeSignature: true,
+		LockModeBondDeposit: true,
+	}
 
-			isMultisig := len(tt.expectedMsigAddrs) > 0
-			if isMultisig {
-				wrap := avax.UTXOWithMSig{}
-				_, err = txs.Codec.Unmarshal(req.Value, &wrap)
-				utxo = wrap.UTXO
-				aliases = wrap.Aliases
-				require.NoError(err)
-				require.NotNil(aliases)
-				require.True(len(aliases) > 0)
-			} else {
-				utxo = avax.UTXO{}
-				_, err = txs.Codec.Unmarshal(req.Value, &utxo)
-				require.NoError(err)
-			}
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	treasuryOwnerKey, treasuryOwnerAddr, treasuryOwner := generateKeyAndOwner(t)
+	_, recipientAddr, recipient := generateKeyAndOwner(t)
 
-			require.NotNil(utxo)
-			out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
-			require.True(ok)
-			require.Equal(tt.to, out.OutputOwners.Addrs[0])
+	chainTime := time.Unix(1050, 0)
+	periodStart := uint64(1000)
 
-			// Check aliases contain the Output owner
-			aliasIDs := make([]ids.ShortID, len(aliases))
-			for i, alias := range aliases {
-				aliasIDs[i] = alias.(*multisig.AliasWithNonce).ID
-			}
-			require.Equal(tt.expectedMsigAddrs, aliasIDs)
-		})
+	treasuryConfig := &treasury.Config{
+		Owner:          treasuryOwner,
+		SpendingPeriod: 100,
+		SpendingCap:    50,
 	}
-}
-
-func TestCaminoCrossExport(t *testing.T) {
-	addr0 := caminoPreFundedKeys[0].Address()
-	addr1 := caminoPreFundedKeys[1].Address()
+	uncappedTreasuryConfig := &treasury.Config{Owner: treasuryConfig.Owner}
 
-	sigIndices := []uint32{0}
-	signers := [][]*secp256k1.PrivateKey{{caminoPreFundedKeys[0]}}
+	feeUTXO := generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+	treasuryUTXO := generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, 100, *treasury.Owner, ids.Empty, ids.Empty)
+	notTreasuryUTXO := generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, 100, feeOwner, ids.Empty, ids.Empty)
+	lockedTreasuryOwner := *treasury.Owner
+	lockedTreasuryOwner.Locktime = uint64(chainTime.Unix()) + 1
+	lockedTreasuryUTXO := generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, 100, lockedTreasuryOwner, ids.Empty, ids.Empty)
+
+	outs := []*avax.TransferableOutput{
+		generateTestOut(ctx.AVAXAssetID, 30, recipient, ids.Empty, ids.Empty),
+		generateTestOut(ctx.AVAXAssetID, 70, *treasury.Owner, ids.Empty, ids.Empty),
+	}
+	avax.SortTransferableOutputs(outs, txs.Codec)
 
-	outputOwners := secp256k1fx.OutputOwners{
-		Locktime:  0,
-		Threshold: 1,
-		Addrs:     []ids.ShortID{addr0},
+	utx := &txs.TreasurySpendTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    ctx.NetworkID,
+			BlockchainID: ctx.ChainID,
+			Ins:          generateInsFromUTXOs([]*avax.UTXO{feeUTXO}),
+			Outs:         outs,
+		}},
+		TreasuryIns:  generateInsFromUTXOs([]*avax.UTXO{treasuryUTXO}),
+		TreasuryAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
 	}
 
 	tests := map[string]struct {
-		utxos        []*avax.UTXO
-		ins          []*avax.TransferableInput
-		exportedOuts []*avax.TransferableOutput
-		expectedErr  error
+		state       func(*gomock.Controller, *txs.TreasurySpendTx, ids.ID, *config.Config) *state.MockDiff
+		signers     [][]*secp256k1.PrivateKey
+		expectedErr error
 	}{
-		"CrossTransferOutput OK": {
-			utxos: []*avax.UTXO{
-				generateTestUTXO(ids.ID{0}, avaxAssetID, defaultCaminoValidatorWeight, outputOwners, ids.Empty, ids.Empty),
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
+				return s
 			},
-			ins: []*avax.TransferableInput{
-				generateTestIn(avaxAssetID, defaultCaminoValidatorWeight, ids.Empty, ids.Empty, sigIndices),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {treasuryOwnerKey}},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Wrong number of credentials": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}},
+			expectedErr: errWrongCredentialsNumber,
+		},
+		"Treasury isn't configured": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetTreasuryConfig().Return(nil, database.ErrNotFound)
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {treasuryOwnerKey}},
+			expectedErr: errTreasuryNotConfigured,
+		},
+		"Treasury owner credential mismatch": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetTreasuryConfig().Return(treasuryConfig, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{treasuryOwnerAddr}, nil)
+				return s
+			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {feeOwnerKey}},
+			expectedErr: errTreasuryCredentialMismatch,
+		},
+		"Treasury input doesn't spend treasury utxo": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetTreasuryConfig().Return(treasuryConfig, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{treasuryOwnerAddr}, nil)
+				expectGetUTXOsFromInputs(s, utx.TreasuryIns, []*avax.UTXO{notTreasuryUTXO})
+				return s
 			},
-			exportedOuts: []*avax.TransferableOutput{
-				generateCrossOut(avaxAssetID, defaultCaminoValidatorWeight-defaultTxFee, outputOwners, addr1),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {treasuryOwnerKey}},
+			expectedErr: errNotTreasuryUTXO,
+		},
+		"Treasury input spends time-locked treasury utxo": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetTreasuryConfig().Return(treasuryConfig, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{treasuryOwnerAddr}, nil)
+				expectGetUTXOsFromInputs(s, utx.TreasuryIns, []*avax.UTXO{lockedTreasuryUTXO})
+				return s
 			},
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {treasuryOwnerKey}},
+			expectedErr: errNotTreasuryUTXO,
 		},
-		"CrossTransferOutput Invalid Recipient": {
-			utxos: []*avax.UTXO{
-				generateTestUTXO(ids.ID{0}, avaxAssetID, defaultCaminoValidatorWeight, outputOwners, ids.Empty, ids.Empty),
+		"Spending cap exceeded": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetTreasuryConfig().Return(treasuryConfig, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{treasuryOwnerAddr}, nil)
+				expectGetUTXOsFromInputs(s, utx.TreasuryIns, []*avax.UTXO{treasuryUTXO})
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr, recipientAddr, treasury.Addr}, nil)
+				s.EXPECT().GetTreasurySpending().Return(&treasury.Spending{PeriodStart: periodStart, Amount: 21}, nil)
+				return s
 			},
-			ins: []*avax.TransferableInput{
-				generateTestIn(avaxAssetID, defaultCaminoValidatorWeight, ids.Empty, ids.Empty, sigIndices),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {treasuryOwnerKey}},
+			expectedErr: errTreasurySpendingCapExceeded,
+		},
+		"OK: spending in current period": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetTreasuryConfig().Return(treasuryConfig, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{treasuryOwnerAddr}, nil)
+				expectGetUTXOsFromInputs(s, utx.TreasuryIns, []*avax.UTXO{treasuryUTXO})
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr, recipientAddr, treasury.Addr}, nil)
+				s.EXPECT().GetTreasurySpending().Return(&treasury.Spending{PeriodStart: periodStart, Amount: 20}, nil)
+				s.EXPECT().SetTreasurySpending(&treasury.Spending{PeriodStart: periodStart, Amount: 50})
+				expectConsumeUTXOs(s, utx.Ins)
+				expectConsumeUTXOs(s, utx.TreasuryIns)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
 			},
-			exportedOuts: []*avax.TransferableOutput{
-				generateCrossOut(avaxAssetID, defaultCaminoValidatorWeight-defaultTxFee, outputOwners, ids.ShortEmpty),
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {treasuryOwnerKey}},
+		},
+		"OK: spending in new period": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetTreasuryConfig().Return(treasuryConfig, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{treasuryOwnerAddr}, nil)
+				expectGetUTXOsFromInputs(s, utx.TreasuryIns, []*avax.UTXO{treasuryUTXO})
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr, recipientAddr, treasury.Addr}, nil)
+				s.EXPECT().GetTreasurySpending().Return(&treasury.Spending{PeriodStart: periodStart - 100, Amount: 50}, nil)
+				s.EXPECT().SetTreasurySpending(&treasury.Spending{PeriodStart: periodStart, Amount: 30})
+				expectConsumeUTXOs(s, utx.Ins)
+				expectConsumeUTXOs(s, utx.TreasuryIns)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
 			},
-			expectedErr: secp256k1fx.ErrEmptyRecipient,
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {treasuryOwnerKey}},
 		},
-	}
-
-	generateExecutor := func(unsidngedTx txs.UnsignedTx, env *caminoEnvironment) CaminoStandardTxExecutor {
-		tx, err := txs.NewSigned(unsidngedTx, txs.Codec, signers)
-		require.NoError(t, err)
-
-		onAcceptState, err := state.NewDiff(lastAcceptedID, env)
-		require.NoError(t, err)
-
-		executor := CaminoStandardTxExecutor{
-			StandardTxExecutor{
-				Backend: &env.backend,
-				State:   onAcceptState,
-				Tx:      tx,
+		"OK: uncapped": {
+			state: func(c *gomock.Controller, utx *txs.TreasurySpendTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetTreasuryConfig().Return(uncappedTreasuryConfig, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{treasuryOwnerAddr}, nil)
+				expectGetUTXOsFromInputs(s, utx.TreasuryIns, []*avax.UTXO{treasuryUTXO})
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr, recipientAddr, treasury.Addr}, nil)
+				expectConsumeUTXOs(s, utx.Ins)
+				expectConsumeUTXOs(s, utx.TreasuryIns)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				return s
 			},
-		}
-
-		return executor
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {treasuryOwnerKey}},
+		},
 	}
-
 	for name, tt := range tests {
-		t.Run("ExportTx "+name, func(t *testing.T) {
-			env := newCaminoEnvironment( /*postBanff*/ true, false, api.Camino{LockModeBondDeposit: true, VerifyNodeSignature: true})
-			for _, utxo := range tt.utxos {
-				env.state.AddUTXO(utxo)
-			}
-			env.ctx.Lock.Lock()
-			defer func() {
-				err := shutdownCaminoEnvironment(env)
-				require.NoError(t, err)
-			}()
-			env.config.BanffTime = env.state.GetTimestamp()
-
-			exportTx := &txs.ExportTx{
-				BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
-					NetworkID:    env.ctx.NetworkID,
-					BlockchainID: env.ctx.ChainID,
-					Ins:          tt.ins,
-					Outs:         []*avax.TransferableOutput{},
-				}},
-				DestinationChain: env.ctx.XChainID,
-				ExportedOutputs:  tt.exportedOuts,
-			}
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
 
-			executor := generateExecutor(exportTx, env)
+			tx, err := txs.NewSigned(utx, txs.Codec, tt.signers)
+			require.NoError(t, err)
 
-			err := executor.ExportTx(exportTx)
+			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
+				StandardTxExecutor{
+					Backend: &env.backend,
+					State:   tt.state(ctrl, utx, tx.ID(), env.config),
+					Tx:      tx,
+				},
+			})
 			require.ErrorIs(t, err, tt.expectedErr)
 		})
 	}
 }
 
-func TestCaminoStandardTxExecutorMultisigAliasTx(t *testing.T) {
+func TestCaminoStandardTxExecutorExtendValidatorTx(t *testing.T) {
 	ctx, _ := defaultCtx(nil)
-
-	ownerKey, ownerAddr, owner := generateKeyAndOwner(t)
-	msigKeys, msigAlias, msigAliasOwners, msigOwner := generateMsigAliasAndKeys(t, 2, 2, true)
-	_, newMsigAlias, _, _ := generateMsigAliasAndKeys(t, 2, 2, true)
-
-	ownerUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, owner, ids.Empty, ids.Empty)
-	msigUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, *msigOwner, ids.Empty, ids.Empty)
-
 	caminoGenesisConf := api.Camino{
 		VerifyNodeSignature: true,
 		LockModeBondDeposit: true,
 	}
 
+	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
+	nodeOwnerKey, nodeOwnerAddr, _ := generateKeyAndOwner(t)
+	_, _, rewardsOwner := generateKeyAndOwner(t)
+	_, _, otherRewardsOwner := generateKeyAndOwner(t)
+	nodeID := ids.NodeID{1}
+
+	chainTime := time.Unix(1050, 0)
+	currentWeight := defaultCaminoValidatorWeight / 2
+	bondAmount := defaultCaminoValidatorWeight / 4
+
+	currentValidator := &state.Staker{
+		TxID:      ids.ID{1},
+		NodeID:    nodeID,
+		SubnetID:  constants.PrimaryNetworkID,
+		Weight:    currentWeight,
+		StartTime: time.Unix(1000, 0),
+		EndTime:   time.Unix(2000, 0),
+		NextTime:  time.Unix(2000, 0),
+		Priority:  txs.PrimaryNetworkValidatorCurrentPriority,
+	}
+	currentValidatorTx := &txs.Tx{Unsigned: &txs.CaminoAddValidatorTx{
+		AddValidatorTx: txs.AddValidatorTx{RewardsOwner: &rewardsOwner},
+	}}
+
+	feeUTXO := generateTestUTXO(ids.ID{2}, ctx.AVAXAssetID, defaultTxFee+bondAmount, feeOwner, ids.Empty, ids.Empty)
+
+	extendValidatorTx := func(start, end, weight uint64, bondAmount uint64, rewardsOwner secp256k1fx.OutputOwners) *txs.ExtendValidatorTx {
+		var outs []*avax.TransferableOutput
+		if bondAmount > 0 {
+			outs = []*avax.TransferableOutput{
+				generateTestOut(ctx.AVAXAssetID, bondAmount, feeOwner, ids.Empty, locked.ThisTxID),
+			}
+		}
+		return &txs.ExtendValidatorTx{
+			BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+				Ins:          generateInsFromUTXOs([]*avax.UTXO{feeUTXO}),
+				Outs:         outs,
+			}},
+			ValidatorTxID: currentValidator.TxID,
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				Start:  start,
+				End:    end,
+				Wght:   weight,
+			},
+			RewardsOwner:  &rewardsOwner,
+			NodeOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+		}
+	}
+	currentStart := uint64(currentValidator.StartTime.Unix())
+	currentEnd := uint64(currentValidator.EndTime.Unix())
+	okUTX := extendValidatorTx(currentStart, currentEnd+100, currentWeight+bondAmount, bondAmount, rewardsOwner)
+
 	tests := map[string]struct {
-		state       func(*gomock.Controller, *txs.MultisigAliasTx, ids.ID) *state.MockDiff
-		utx         *txs.MultisigAliasTx
+		state       func(*gomock.Controller, *txs.ExtendValidatorTx, ids.ID, *config.Config) *state.MockDiff
+		utx         *txs.ExtendValidatorTx
 		signers     [][]*secp256k1.PrivateKey
 		expectedErr error
 	}{
-		"Updating alias which does not exist": {
-			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID) *state.MockDiff {
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.ExtendValidatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().GetMultisigAlias(msigAlias.ID).Return(nil, database.ErrNotFound)
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
 				return s
 			},
-			utx: &txs.MultisigAliasTx{
-				BaseTx: txs.BaseTx{
-					BaseTx: avax.BaseTx{
-						NetworkID:    ctx.NetworkID,
-						BlockchainID: ctx.ChainID,
-						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(ownerUTXO, []uint32{0})},
-					},
-				},
-				MultisigAlias: msigAlias.Alias,
-				Auth:          &secp256k1fx.Input{SigIndices: []uint32{0, 1}},
+			utx:         okUTX,
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {nodeOwnerKey}},
+			expectedErr: errNotBerlinPhase,
+		},
+		"Wrong lock mode": {
+			state: func(c *gomock.Controller, utx *txs.ExtendValidatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{}, nil)
+				return s
 			},
-			signers: [][]*secp256k1.PrivateKey{
-				{ownerKey},
-				{msigKeys[0], msigKeys[1]},
+			utx:         okUTX,
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {nodeOwnerKey}},
+			expectedErr: errWrongLockMode,
+		},
+		"Wrong number of credentials": {
+			state: func(c *gomock.Controller, utx *txs.ExtendValidatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				return s
 			},
-			expectedErr: errAliasNotFound,
+			utx:         okUTX,
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}},
+			expectedErr: errWrongCredentialsNumber,
 		},
-		"Updating existing alias with less signatures than threshold": {
-			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID) *state.MockDiff {
+		"Node isn't registered": {
+			state: func(c *gomock.Controller, utx *txs.ExtendValidatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().GetMultisigAlias(msigAlias.ID).Return(msigAlias, nil)
-				expectVerifyMultisigPermission(s, []ids.ShortID{
-					msigAliasOwners.Addrs[0],
-					msigAliasOwners.Addrs[1],
-				}, []*multisig.AliasWithNonce{})
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).
+					Return(ids.ShortEmpty, database.ErrNotFound)
 				return s
 			},
-			utx: &txs.MultisigAliasTx{
-				BaseTx: txs.BaseTx{
-					BaseTx: avax.BaseTx{
-						NetworkID:    ctx.NetworkID,
-						BlockchainID: ctx.ChainID,
-						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(ownerUTXO, []uint32{0})},
-					},
-				},
-				MultisigAlias: msigAlias.Alias,
-				Auth:          &secp256k1fx.Input{SigIndices: []uint32{0}},
+			utx:         okUTX,
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {nodeOwnerKey}},
+			expectedErr: errNodeNotRegistered,
+		},
+		"Not signed by node owner": {
+			state: func(c *gomock.Controller, utx *txs.ExtendValidatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).Return(nodeOwnerAddr, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				return s
 			},
-			signers: [][]*secp256k1.PrivateKey{
-				{ownerKey},
-				{msigKeys[0]},
+			utx:         okUTX,
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {feeOwnerKey}},
+			expectedErr: errSignatureMissing,
+		},
+		"Validator not found": {
+			state: func(c *gomock.Controller, utx *txs.ExtendValidatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).Return(nodeOwnerAddr, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(nil, database.ErrNotFound)
+				return s
 			},
-			expectedErr: errAliasCredentialMismatch,
+			utx:         okUTX,
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {nodeOwnerKey}},
+			expectedErr: errValidatorNotFound,
 		},
-		"OK, update existing alias": {
-			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID) *state.MockDiff {
+		"Wrong validator tx id": {
+			state: func(c *gomock.Controller, utx *txs.ExtendValidatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().GetMultisigAlias(msigAlias.ID).Return(msigAlias, nil)
-				expectVerifyMultisigPermission(s, []ids.ShortID{
-					msigAliasOwners.Addrs[0],
-					msigAliasOwners.Addrs[1],
-				}, []*multisig.AliasWithNonce{})
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{ownerUTXO}, []ids.ShortID{ownerAddr}, nil)
-				s.EXPECT().SetMultisigAlias(&multisig.AliasWithNonce{
-					Alias: msigAlias.Alias,
-					Nonce: msigAlias.Nonce + 1,
-				})
-				expectConsumeUTXOs(s, utx.Ins)
-				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).Return(nodeOwnerAddr, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				extendedValidator := *currentValidator
+				extendedValidator.TxID = ids.ID{3}
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(&extendedValidator, nil)
 				return s
 			},
-			utx: &txs.MultisigAliasTx{
-				BaseTx: txs.BaseTx{
-					BaseTx: avax.BaseTx{
-						NetworkID:    ctx.NetworkID,
-						BlockchainID: ctx.ChainID,
-						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(ownerUTXO, []uint32{0})},
-					},
-				},
-				MultisigAlias: msigAlias.Alias,
-				Auth:          &secp256k1fx.Input{SigIndices: []uint32{0, 1}},
+			utx:         okUTX,
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {nodeOwnerKey}},
+			expectedErr: errWrongValidatorTxID,
+		},
+		"Start time changed": {
+			state: func(c *gomock.Controller, utx *txs.ExtendValidatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).Return(nodeOwnerAddr, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(currentValidator, nil)
+				return s
 			},
-			signers: [][]*secp256k1.PrivateKey{
-				{ownerKey},
-				{msigKeys[0], msigKeys[1]},
+			utx:         extendValidatorTx(currentStart+1, currentEnd+100, currentWeight, 0, rewardsOwner),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {nodeOwnerKey}},
+			expectedErr: errValidatorStartTimeChanged,
+		},
+		"End time decreased": {
+			state: func(c *gomock.Controller, utx *txs.ExtendValidatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).Return(nodeOwnerAddr, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(currentValidator, nil)
+				return s
 			},
+			utx:         extendValidatorTx(currentStart, currentEnd-1, currentWeight+bondAmount, bondAmount, rewardsOwner),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {nodeOwnerKey}},
+			expectedErr: errValidatorShortened,
 		},
-		"OK, add new alias": {
-			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID) *state.MockDiff {
+		"Weight decreased": {
+			state: func(c *gomock.Controller, utx *txs.ExtendValidatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{ownerUTXO}, []ids.ShortID{ownerAddr}, nil)
-				s.EXPECT().SetMultisigAlias(&multisig.AliasWithNonce{
-					Alias: multisig.Alias{
-						ID:     multisig.ComputeAliasID(txID),
-						Memo:   msigAlias.Memo,
-						Owners: msigAlias.Owners,
-					},
-					Nonce: 0,
-				})
-				expectConsumeUTXOs(s, utx.Ins)
-				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).Return(nodeOwnerAddr, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(currentValidator, nil)
 				return s
 			},
-			utx: &txs.MultisigAliasTx{
-				BaseTx: txs.BaseTx{
-					BaseTx: avax.BaseTx{
-						NetworkID:    ctx.NetworkID,
-						BlockchainID: ctx.ChainID,
-						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(ownerUTXO, []uint32{0})},
-					},
-				},
-				MultisigAlias: multisig.Alias{
-					ID:     ids.ShortID{},
-					Memo:   msigAlias.Memo,
-					Owners: msigAlias.Owners,
-				},
-				Auth: &secp256k1fx.Input{SigIndices: []uint32{}},
+			utx:         extendValidatorTx(currentStart, currentEnd+100, currentWeight-1, 0, rewardsOwner),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {nodeOwnerKey}},
+			expectedErr: errValidatorWeightDecreased,
+		},
+		"Validator isn't extended": {
+			state: func(c *gomock.Controller, utx *txs.ExtendValidatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).Return(nodeOwnerAddr, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(currentValidator, nil)
+				return s
+			},
+			utx:         extendValidatorTx(currentStart, currentEnd, currentWeight, 0, rewardsOwner),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {nodeOwnerKey}},
+			expectedErr: errValidatorNotExtended,
+		},
+		"Weight too large": {
+			state: func(c *gomock.Controller, utx *txs.ExtendValidatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).Return(nodeOwnerAddr, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(currentValidator, nil)
+				return s
+			},
+			utx:         extendValidatorTx(currentStart, currentEnd, defaultCaminoValidatorWeight+1, 0, rewardsOwner),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {nodeOwnerKey}},
+			expectedErr: errWeightTooLarge,
+		},
+		"Stake too long": {
+			state: func(c *gomock.Controller, utx *txs.ExtendValidatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).Return(nodeOwnerAddr, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(currentValidator, nil)
+				return s
 			},
-			signers: [][]*secp256k1.PrivateKey{
-				{ownerKey},
+			utx:         extendValidatorTx(currentStart, currentStart+uint64(defaultMaxStakingDuration.Seconds())+1, currentWeight, 0, rewardsOwner),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {nodeOwnerKey}},
+			expectedErr: errStakeTooLong,
+		},
+		"Wrong bonded amount": {
+			state: func(c *gomock.Controller, utx *txs.ExtendValidatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).Return(nodeOwnerAddr, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(currentValidator, nil)
+				return s
 			},
+			utx:         extendValidatorTx(currentStart, currentEnd, currentWeight+bondAmount+1, bondAmount, rewardsOwner),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {nodeOwnerKey}},
+			expectedErr: errWrongBondedAmount,
 		},
-		"OK, add new alias with multisig sender": {
-			state: func(c *gomock.Controller, utx *txs.MultisigAliasTx, txID ids.ID) *state.MockDiff {
+		"Rewards owner changed": {
+			state: func(c *gomock.Controller, utx *txs.ExtendValidatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{msigUTXO}, []ids.ShortID{
-					msigAlias.ID,
-					msigAliasOwners.Addrs[0],
-					msigAliasOwners.Addrs[1],
-				}, []*multisig.AliasWithNonce{msigAlias})
-				s.EXPECT().SetMultisigAlias(&multisig.AliasWithNonce{
-					Alias: multisig.Alias{
-						ID:     multisig.ComputeAliasID(txID),
-						Memo:   newMsigAlias.Memo,
-						Owners: newMsigAlias.Owners,
-					},
-					Nonce: 0,
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).Return(nodeOwnerAddr, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(currentValidator, nil)
+				s.EXPECT().GetTx(currentValidator.TxID).Return(currentValidatorTx, status.Committed, nil)
+				return s
+			},
+			utx:         extendValidatorTx(currentStart, currentEnd+100, currentWeight, 0, otherRewardsOwner),
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {nodeOwnerKey}},
+			expectedErr: errRewardsOwnerChanged,
+		},
+		"OK": {
+			state: func(c *gomock.Controller, utx *txs.ExtendValidatorTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+				s := state.NewMockDiff(c)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().CaminoConfig().Return(&state.CaminoConfig{LockModeBondDeposit: true}, nil)
+				s.EXPECT().GetShortIDLink(ids.ShortID(nodeID), state.ShortLinkKeyRegisterNode).Return(nodeOwnerAddr, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{nodeOwnerAddr}, nil)
+				s.EXPECT().GetCurrentValidator(constants.PrimaryNetworkID, nodeID).Return(currentValidator, nil)
+				s.EXPECT().GetTx(currentValidator.TxID).Return(currentValidatorTx, status.Committed, nil)
+				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr, feeOwnerAddr}, nil)
+				s.EXPECT().DeleteCurrentValidator(currentValidator)
+				s.EXPECT().PutCurrentValidator(&state.Staker{
+					TxID:      txID,
+					NodeID:    nodeID,
+					SubnetID:  constants.PrimaryNetworkID,
+					Weight:    currentWeight + bondAmount,
+					StartTime: currentValidator.StartTime,
+					EndTime:   currentValidator.EndTime.Add(100 * time.Second),
+					NextTime:  currentValidator.EndTime.Add(100 * time.Second),
+					Priority:  txs.PrimaryNetworkValidatorCurrentPriority,
 				})
 				expectConsumeUTXOs(s, utx.Ins)
-				expectProduceUTXOs(s, utx.Outs, txID, 0)
+				expectProduceNewlyLockedUTXOs(s, utx.Outs, txID, 0, locked.StateBonded)
 				return s
 			},
-			utx: &txs.MultisigAliasTx{
-				BaseTx: txs.BaseTx{
-					BaseTx: avax.BaseTx{
-						NetworkID:    ctx.NetworkID,
-						BlockchainID: ctx.ChainID,
-						Ins:          []*avax.TransferableInput{generateTestInFromUTXO(msigUTXO, []uint32{0, 1})},
-					},
-				},
-				MultisigAlias: multisig.Alias{
-					ID:     ids.ShortID{},
-					Memo:   newMsigAlias.Memo,
-					Owners: newMsigAlias.Owners,
-				},
-				Auth: &secp256k1fx.Input{SigIndices: []uint32{}},
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{msigKeys[0], msigKeys[1]},
-			},
+			utx:     okUTX,
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {nodeOwnerKey}},
 		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			require := require.New(t)
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
-
-			defer func() { require.NoError(shutdownCaminoEnvironment(env)) }() //nolint:lint
-
-			avax.SortTransferableInputsWithSigners(tt.utx.Ins, tt.signers)
+			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
 
 			tx, err := txs.NewSigned(tt.utx, txs.Codec, tt.signers)
-			require.NoError(err)
+			require.NoError(t, err)
 
 			err = tx.Unsigned.Visit(&CaminoStandardTxExecutor{
 				StandardTxExecutor{
 					Backend: &env.backend,
-					State:   tt.state(ctrl, tt.utx, tx.ID()),
+					State:   tt.state(ctrl, tt.utx, tx.ID(), env.config),
 					Tx:      tx,
 				},
 			})
-			require.ErrorIs(err, tt.expectedErr)
+			require.ErrorIs(t, err, tt.expectedErr)
 		})
 	}
 }
 
-func TestCaminoStandardTxExecutorAddDepositOfferTx(t *testing.T) {
+func TestCaminoStandardTxExecutorUpdateDepositOfferAllowListTx(t *testing.T) {
 	ctx, _ := defaultCtx(nil)
 	caminoGenesisConf := api.Camino{
 		VerifyNodeSignature: true,
@@ -5510,222 +8759,107 @@ func TestCaminoStandardTxExecutorAddDepositOfferTx(t *testing.T) {
 	}
 
 	feeOwnerKey, feeOwnerAddr, feeOwner := generateKeyAndOwner(t)
-	offerCreatorKey, offerCreatorAddr, _ := generateKeyAndOwner(t)
+	offerOwnerKey, offerOwnerAddr, _ := generateKeyAndOwner(t)
+	addr1 := ids.ShortID{1}
+	addr2 := ids.ShortID{2}
+	addr3 := ids.ShortID{3}
 
-	feeUTXO := generateTestUTXO(ids.GenerateTestID(), ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
+	feeUTXO := generateTestUTXO(ids.ID{1}, ctx.AVAXAssetID, defaultTxFee, feeOwner, ids.Empty, ids.Empty)
 
-	offer1 := &deposit.Offer{
-		UpgradeVersionID:      codec.UpgradeVersion1,
-		Start:                 0,
-		End:                   1,
-		MinDuration:           1,
-		MaxDuration:           1,
-		MinAmount:             deposit.OfferMinDepositAmount,
-		InterestRateNominator: 1,
-		TotalMaxRewardAmount:  100,
+	chainTime := time.Unix(1050, 0)
+	offerWithoutAllowList := &deposit.Offer{
+		UpgradeVersionID: codec.UpgradeVersion3,
+		ID:               ids.ID{2},
+		End:              2000,
+		MinDuration:      1,
+		MaxDuration:      1,
+		MinAmount:        deposit.OfferMinDepositAmount,
+		OwnerAddress:     offerOwnerAddr,
 	}
+	offer := *offerWithoutAllowList
+	offer.AllowListEnabled = true
 
-	baseTx := txs.BaseTx{BaseTx: avax.BaseTx{
-		NetworkID:    ctx.NetworkID,
-		BlockchainID: ctx.ChainID,
-		Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
-	}}
+	utx := &txs.UpdateDepositOfferAllowListTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    ctx.NetworkID,
+			BlockchainID: ctx.ChainID,
+			Ins:          []*avax.TransferableInput{generateTestInFromUTXO(feeUTXO, []uint32{0})},
+		}},
+		DepositOfferID:        offer.ID,
+		AddedAddresses:        []ids.ShortID{addr1, addr2},
+		RemovedAddresses:      []ids.ShortID{addr3},
+		DepositOfferOwnerAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+	}
 
 	tests := map[string]struct {
-		state       func(*gomock.Controller, *txs.AddDepositOfferTx, ids.ID, *config.Config) *state.MockDiff
-		utx         func() *txs.AddDepositOfferTx
+		state       func(*gomock.Controller, *txs.UpdateDepositOfferAllowListTx, ids.ID, *config.Config) *state.MockDiff
 		signers     [][]*secp256k1.PrivateKey
 		expectedErr error
 	}{
-		"Not AthensPhase": {
-			state: func(c *gomock.Controller, utx *txs.AddDepositOfferTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+		"Not BerlinPhase": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferAllowListTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().GetTimestamp().Return(cfg.AthensPhaseTime.Add(-1 * time.Second))
+				s.EXPECT().GetTimestamp().Return(cfg.BerlinPhaseTime.Add(-1 * time.Second))
 				return s
 			},
-			utx: func() *txs.AddDepositOfferTx {
-				return &txs.AddDepositOfferTx{
-					BaseTx:                     baseTx,
-					DepositOffer:               offer1,
-					DepositOfferCreatorAddress: offerCreatorAddr,
-					DepositOfferCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
-				}
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey}, {offerCreatorKey},
-			},
-			expectedErr: errNotAthensPhase,
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {offerOwnerKey}},
+			expectedErr: errNotBerlinPhase,
 		},
-		"Not offer creator": {
-			state: func(c *gomock.Controller, utx *txs.AddDepositOfferTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+		"Wrong number of credentials": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferAllowListTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
-				s.EXPECT().GetAddressStates(utx.DepositOfferCreatorAddress).Return(txs.AddressStateEmpty, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
 				return s
 			},
-			utx: func() *txs.AddDepositOfferTx {
-				return &txs.AddDepositOfferTx{
-					BaseTx:                     baseTx,
-					DepositOffer:               offer1,
-					DepositOfferCreatorAddress: offerCreatorAddr,
-					DepositOfferCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
-				}
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey}, {offerCreatorKey},
-			},
-			expectedErr: errNotOfferCreator,
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}},
+			expectedErr: errWrongCredentialsNumber,
 		},
-		"Bad offer creator signature": {
-			state: func(c *gomock.Controller, utx *txs.AddDepositOfferTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+		"Offer not found": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferAllowListTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().GetTimestamp().Return(time.Unix(100, 0))
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
-				s.EXPECT().GetAddressStates(utx.DepositOfferCreatorAddress).Return(txs.AddressStateOffersCreator, nil)
-				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferCreatorAddress}, nil)
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(nil, database.ErrNotFound)
 				return s
 			},
-			utx: func() *txs.AddDepositOfferTx {
-				return &txs.AddDepositOfferTx{
-					BaseTx:                     baseTx,
-					DepositOffer:               offer1,
-					DepositOfferCreatorAddress: offerCreatorAddr,
-					DepositOfferCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
-				}
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey}, {feeOwnerKey},
-			},
-			expectedErr: errOfferCreatorCredentialMismatch,
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {offerOwnerKey}},
+			expectedErr: errDepositOfferNotFound,
 		},
-		"Supply overflow (v1, no existing offers)": {
-			state: func(c *gomock.Controller, utx *txs.AddDepositOfferTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
-				chainTime := time.Unix(100, 0)
-
+		"Offer without allow-list": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferAllowListTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
 				s.EXPECT().GetTimestamp().Return(chainTime)
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
-				s.EXPECT().GetAddressStates(utx.DepositOfferCreatorAddress).Return(txs.AddressStateOffersCreator, nil)
-				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferCreatorAddress}, nil)
-				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).
-					Return(cfg.RewardConfig.SupplyCap-offer1.TotalMaxRewardAmount+1, nil)
-				s.EXPECT().GetAllDepositOffers().Return(nil, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(offerWithoutAllowList, nil)
 				return s
 			},
-			utx: func() *txs.AddDepositOfferTx {
-				return &txs.AddDepositOfferTx{
-					BaseTx:                     baseTx,
-					DepositOffer:               offer1,
-					DepositOfferCreatorAddress: offerCreatorAddr,
-					DepositOfferCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
-				}
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{offerCreatorKey},
-			},
-			expectedErr: errSupplyOverflow,
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {offerOwnerKey}},
+			expectedErr: errOfferWithoutAllowList,
 		},
-		"Supply overflow (v1, existing offers)": {
-			state: func(c *gomock.Controller, utx *txs.AddDepositOfferTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
-				chainTime := time.Unix(100, 0)
-				existingOffers := []*deposit.Offer{
-					{ // [0], expired
-						UpgradeVersionID:     1,
-						Start:                uint64(chainTime.Add(-2 * time.Second).Unix()),
-						End:                  uint64(chainTime.Add(-1 * time.Second).Unix()),
-						TotalMaxRewardAmount: 101,
-					},
-					{ // [1], not started
-						UpgradeVersionID:     1,
-						Start:                uint64(chainTime.Add(1 * time.Second).Unix()),
-						End:                  uint64(chainTime.Add(2 * time.Second).Unix()),
-						TotalMaxRewardAmount: 102,
-					},
-					{ // [2], disabled
-						UpgradeVersionID:     1,
-						Start:                uint64(chainTime.Unix()),
-						End:                  uint64(chainTime.Add(1 * time.Second).Unix()),
-						Flags:                deposit.OfferFlagLocked,
-						TotalMaxRewardAmount: 103,
-					},
-					{ // [3]
-						Start: uint64(chainTime.Unix()),
-						End:   uint64(chainTime.Add(1 * time.Second).Unix()),
-					},
-					{ // [4] with TotalMaxAmount
-						MaxDuration:           100,
-						InterestRateNominator: 100,
-						Start:                 uint64(chainTime.Unix()),
-						End:                   uint64(chainTime.Add(1 * time.Second).Unix()),
-						TotalMaxAmount:        104,
-						DepositedAmount:       50,
-					},
-					{ // [5] with TotalMaxRewardAmount
-						UpgradeVersionID:     1,
-						Start:                uint64(chainTime.Add(-1 * time.Second).Unix()),
-						End:                  uint64(chainTime.Add(1 * time.Second).Unix()),
-						TotalMaxRewardAmount: 105,
-						RewardedAmount:       50,
-					},
-				}
-				promisedSupply := existingOffers[4].MaxRemainingRewardByTotalMaxAmount() + existingOffers[5].RemainingReward()
-				currentSupply := cfg.RewardConfig.SupplyCap - promisedSupply - offer1.TotalMaxRewardAmount + 1
-
+		"Not signed by offer owner": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferAllowListTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
 				s.EXPECT().GetTimestamp().Return(chainTime)
-				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
-				s.EXPECT().GetAddressStates(utx.DepositOfferCreatorAddress).Return(txs.AddressStateOffersCreator, nil)
-				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferCreatorAddress}, nil)
-				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(currentSupply, nil)
-				s.EXPECT().GetAllDepositOffers().Return(existingOffers, nil)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(&offer, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{offerOwnerAddr}, nil)
 				return s
 			},
-			utx: func() *txs.AddDepositOfferTx {
-				return &txs.AddDepositOfferTx{
-					BaseTx:                     baseTx,
-					DepositOffer:               offer1,
-					DepositOfferCreatorAddress: offerCreatorAddr,
-					DepositOfferCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
-				}
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{offerCreatorKey},
-			},
-			expectedErr: errSupplyOverflow,
+			signers:     [][]*secp256k1.PrivateKey{{feeOwnerKey}, {feeOwnerKey}},
+			expectedErr: errOfferOwnerCredentialMismatch,
 		},
-		"OK: v1": {
-			state: func(c *gomock.Controller, utx *txs.AddDepositOfferTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
+		"OK": {
+			state: func(c *gomock.Controller, utx *txs.UpdateDepositOfferAllowListTx, txID ids.ID, cfg *config.Config) *state.MockDiff {
 				s := state.NewMockDiff(c)
-				s.EXPECT().GetTimestamp().Return(time.Time{})
+				s.EXPECT().GetTimestamp().Return(chainTime)
+				s.EXPECT().GetDepositOffer(utx.DepositOfferID).Return(&offer, nil)
+				expectVerifyMultisigPermission(s, []ids.ShortID{offerOwnerAddr}, nil)
 				expectVerifyLock(s, utx.Ins, []*avax.UTXO{feeUTXO}, []ids.ShortID{feeOwnerAddr}, nil)
-				s.EXPECT().GetAddressStates(utx.DepositOfferCreatorAddress).Return(txs.AddressStateOffersCreator, nil)
-				expectVerifyMultisigPermission(s, []ids.ShortID{utx.DepositOfferCreatorAddress}, nil)
-				s.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).
-					Return(cfg.RewardConfig.SupplyCap-offer1.TotalMaxRewardAmount, nil)
-				s.EXPECT().GetAllDepositOffers().Return(nil, nil)
-
-				offer := *utx.DepositOffer
-				offer.ID = txID
-				s.EXPECT().SetDepositOffer(&offer)
-
+				s.EXPECT().SetDepositOfferAllowedAddress(offer.ID, addr1, true)
+				s.EXPECT().SetDepositOfferAllowedAddress(offer.ID, addr2, true)
+				s.EXPECT().SetDepositOfferAllowedAddress(offer.ID, addr3, false)
 				expectConsumeUTXOs(s, utx.Ins)
+				expectProduceUTXOs(s, utx.Outs, txID, 0)
 				return s
 			},
-			utx: func() *txs.AddDepositOfferTx {
-				return &txs.AddDepositOfferTx{
-					BaseTx:                     baseTx,
-					DepositOffer:               offer1,
-					DepositOfferCreatorAddress: offerCreatorAddr,
-					DepositOfferCreatorAuth:    &secp256k1fx.Input{SigIndices: []uint32{0}},
-				}
-			},
-			signers: [][]*secp256k1.PrivateKey{
-				{feeOwnerKey},
-				{offerCreatorKey},
-			},
+			signers: [][]*secp256k1.PrivateKey{{feeOwnerKey}, {offerOwnerKey}},
 		},
 	}
 	for name, tt := range tests {
@@ -5735,9 +8869,6 @@ func TestCaminoStandardTxExecutorAddDepositOfferTx(t *testing.T) {
 			env := newCaminoEnvironmentWithMocks(caminoGenesisConf, nil)
 			defer func() { require.NoError(t, shutdownCaminoEnvironment(env)) }()
 
-			utx := tt.utx()
-			avax.SortTransferableInputsWithSigners(utx.Ins, tt.signers)
-			avax.SortTransferableOutputs(utx.Outs, txs.Codec)
 			tx, err := txs.NewSigned(utx, txs.Codec, tt.signers)
 			require.NoError(t, err)
 
@@ -3,7 +3,10 @@
 
 package executor
 
-import "github.com/ava-labs/avalanchego/vms/platformvm/txs"
+import (
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
 
 // Camino Visitor implementations
 
@@ -45,6 +48,42 @@ func (*StandardTxExecutor) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
 	return errWrongTxType
 }
 
+func (*StandardTxExecutor) AddProposalTx(*txs.AddProposalTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) AddVoteTx(*txs.AddVoteTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) UpdateDepositOfferTx(*txs.UpdateDepositOfferTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) TransferDepositTx(*txs.TransferDepositTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) TreasuryConfigTx(*txs.TreasuryConfigTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) TreasurySpendTx(*txs.TreasurySpendTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) ExtendValidatorTx(*txs.ExtendValidatorTx) error {
+	return errWrongTxType
+}
+
+func (*StandardTxExecutor) UpdateDepositOfferAllowListTx(*txs.UpdateDepositOfferAllowListTx) error {
+	return errWrongTxType
+}
+
 // Proposal
 
 func (*ProposalTxExecutor) AddressStateTx(*txs.AddressStateTx) error {
@@ -83,6 +122,42 @@ func (*ProposalTxExecutor) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
 	return errWrongTxType
 }
 
+func (*ProposalTxExecutor) AddProposalTx(*txs.AddProposalTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) AddVoteTx(*txs.AddVoteTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) UpdateDepositOfferTx(*txs.UpdateDepositOfferTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) TransferDepositTx(*txs.TransferDepositTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) TreasuryConfigTx(*txs.TreasuryConfigTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) TreasurySpendTx(*txs.TreasurySpendTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) ExtendValidatorTx(*txs.ExtendValidatorTx) error {
+	return errWrongTxType
+}
+
+func (*ProposalTxExecutor) UpdateDepositOfferAllowListTx(*txs.UpdateDepositOfferAllowListTx) error {
+	return errWrongTxType
+}
+
 // Atomic
 
 func (*AtomicTxExecutor) AddressStateTx(*txs.AddressStateTx) error {
@@ -121,6 +196,42 @@ func (*AtomicTxExecutor) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
 	return errWrongTxType
 }
 
+func (*AtomicTxExecutor) AddProposalTx(*txs.AddProposalTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) AddVoteTx(*txs.AddVoteTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) UpdateDepositOfferTx(*txs.UpdateDepositOfferTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) TransferDepositTx(*txs.TransferDepositTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) TreasuryConfigTx(*txs.TreasuryConfigTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) TreasurySpendTx(*txs.TreasurySpendTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) ExtendValidatorTx(*txs.ExtendValidatorTx) error {
+	return errWrongTxType
+}
+
+func (*AtomicTxExecutor) UpdateDepositOfferAllowListTx(*txs.UpdateDepositOfferAllowListTx) error {
+	return errWrongTxType
+}
+
 // MemPool
 
 func (v *MempoolTxVerifier) AddressStateTx(tx *txs.AddressStateTx) error {
@@ -158,3 +269,60 @@ func (v *MempoolTxVerifier) MultisigAliasTx(tx *txs.MultisigAliasTx) error {
 func (v *MempoolTxVerifier) AddDepositOfferTx(tx *txs.AddDepositOfferTx) error {
 	return v.standardTx(tx)
 }
+
+func (v *MempoolTxVerifier) AddProposalTx(tx *txs.AddProposalTx) error {
+	return v.berlinStandardTx(tx)
+}
+
+func (v *MempoolTxVerifier) AddVoteTx(tx *txs.AddVoteTx) error {
+	return v.berlinStandardTx(tx)
+}
+
+func (*MempoolTxVerifier) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errWrongTxType
+}
+
+func (v *MempoolTxVerifier) UpdateDepositOfferTx(tx *txs.UpdateDepositOfferTx) error {
+	return v.berlinStandardTx(tx)
+}
+
+func (v *MempoolTxVerifier) TransferDepositTx(tx *txs.TransferDepositTx) error {
+	return v.berlinStandardTx(tx)
+}
+
+func (v *MempoolTxVerifier) TreasuryConfigTx(tx *txs.TreasuryConfigTx) error {
+	return v.berlinStandardTx(tx)
+}
+
+func (v *MempoolTxVerifier) TreasurySpendTx(tx *txs.TreasurySpendTx) error {
+	return v.berlinStandardTx(tx)
+}
+
+func (v *MempoolTxVerifier) ExtendValidatorTx(tx *txs.ExtendValidatorTx) error {
+	return v.berlinStandardTx(tx)
+}
+
+func (v *MempoolTxVerifier) UpdateDepositOfferAllowListTx(tx *txs.UpdateDepositOfferAllowListTx) error {
+	return v.berlinStandardTx(tx)
+}
+
+// berlinStandardTx verifies standard tx, that isn't allowed before BerlinPhase.
+// Tx is rejected before mempool state is advanced to the next block time,
+// if the next block can't be BerlinPhase block.
+func (v *MempoolTxVerifier) berlinStandardTx(tx txs.UnsignedTx) error {
+	parentState, err := state.NewDiff(v.ParentID, v.StateVersions)
+	if err != nil {
+		return err
+	}
+
+	nextBlkTime, err := v.nextBlockTime(parentState)
+	if err != nil {
+		return err
+	}
+
+	if !v.Config.IsBerlinPhaseActivated(nextBlkTime) {
+		return errNotBerlinPhase
+	}
+
+	return v.standardTx(tx)
+}
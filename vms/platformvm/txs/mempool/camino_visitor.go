@@ -4,9 +4,13 @@
 package mempool
 
 import (
+	"errors"
+
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
 
+var errCantIssueFinishProposalsTx = errors.New("can not issue a finish proposals tx")
+
 // Issuer
 
 func (i *issuer) AddressStateTx(*txs.AddressStateTx) error {
@@ -54,6 +58,50 @@ func (i *issuer) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
 	return nil
 }
 
+func (i *issuer) AddProposalTx(*txs.AddProposalTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) AddVoteTx(*txs.AddVoteTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (*issuer) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errCantIssueFinishProposalsTx
+}
+
+func (i *issuer) UpdateDepositOfferTx(*txs.UpdateDepositOfferTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) TransferDepositTx(*txs.TransferDepositTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) TreasuryConfigTx(*txs.TreasuryConfigTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) TreasurySpendTx(*txs.TreasurySpendTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) ExtendValidatorTx(*txs.ExtendValidatorTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
+func (i *issuer) UpdateDepositOfferAllowListTx(*txs.UpdateDepositOfferAllowListTx) error {
+	i.m.addDecisionTx(i.tx)
+	return nil
+}
+
 // Remover
 
 func (r *remover) AddressStateTx(*txs.AddressStateTx) error {
@@ -100,3 +148,48 @@ func (r *remover) AddDepositOfferTx(*txs.AddDepositOfferTx) error {
 	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
 	return nil
 }
+
+func (r *remover) AddProposalTx(*txs.AddProposalTx) error {
+	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
+	return nil
+}
+
+func (r *remover) AddVoteTx(*txs.AddVoteTx) error {
+	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
+	return nil
+}
+
+func (*remover) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	// this tx is never in mempool
+	return nil
+}
+
+func (r *remover) UpdateDepositOfferTx(*txs.UpdateDepositOfferTx) error {
+	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
+	return nil
+}
+
+func (r *remover) TransferDepositTx(*txs.TransferDepositTx) error {
+	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
+	return nil
+}
+
+func (r *remover) TreasuryConfigTx(*txs.TreasuryConfigTx) error {
+	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
+	return nil
+}
+
+func (r *remover) TreasurySpendTx(*txs.TreasurySpendTx) error {
+	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
+	return nil
+}
+
+func (r *remover) ExtendValidatorTx(*txs.ExtendValidatorTx) error {
+	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
+	return nil
+}
+
+func (r *remover) UpdateDepositOfferAllowListTx(*txs.UpdateDepositOfferAllowListTx) error {
+	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
+	return nil
+}
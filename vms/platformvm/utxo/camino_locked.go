@@ -235,7 +235,7 @@ func (h *handler) Lock(
 		return nil, nil, nil, nil, fmt.Errorf("couldn't get UTXOs: %w", err)
 	}
 
-	sortUTXOs(utxos, h.ctx.AVAXAssetID, appliedLockState)
+	SortUTXOs(utxos, h.ctx.AVAXAssetID, appliedLockState)
 
 	kc := secp256k1fx.NewKeychain(signer...) // Keychain consumes UTXOs and creates new ones
 
@@ -1352,7 +1352,9 @@ func (sort *innerSortUTXOs) Swap(i, j int) {
 	u[j], u[i] = u[i], u[j]
 }
 
-func sortUTXOs(utxos []*avax.UTXO, allowedAssetID ids.ID, lockState locked.State) {
+// SortUTXOs sorts utxos in order in which they should be consumed to lock tokens with [lockState]:
+// utxos of [allowedAssetID] first, then utxos that aren't locked with [lockState] yet, then by amount.
+func SortUTXOs(utxos []*avax.UTXO, allowedAssetID ids.ID, lockState locked.State) {
 	sort.Sort(&innerSortUTXOs{utxos: utxos, allowedAssetID: allowedAssetID, lockState: lockState})
 }
 
@@ -75,6 +75,99 @@ func (fx *CaminoFx) VerifyTransfer(txIntf, inIntf, credIntf, utxoIntf interface{
 	return fx.Fx.VerifyTransfer(txIntf, inIntf, credIntf, utxoIntf)
 }
 
+// VerifyTransferWithAliases verifies that the specified transaction can spend the
+// provided utxo like VerifyTransfer does, but also supports utxos owned by multisig
+// aliases, which are resolved with [msigIntf], and multisig credentials.
+// Other utxos are verified exactly as VerifyTransfer verifies them.
+func (fx *CaminoFx) VerifyTransferWithAliases(txIntf, inIntf, credIntf, utxoIntf, msigIntf interface{}) error {
+	out, ok := utxoIntf.(*TransferOutput)
+	if !ok {
+		return ErrWrongUTXOType
+	}
+
+	useMultisig, err := requiresMultisig(credIntf, &out.OutputOwners, msigIntf)
+	if err != nil {
+		return err
+	} else if !useMultisig {
+		return fx.Fx.VerifyTransfer(txIntf, inIntf, credIntf, utxoIntf)
+	}
+
+	if out.Locktime > fx.VM.Clock().Unix() {
+		return ErrTimelocked
+	}
+	return fx.VerifyMultisigTransfer(txIntf, inIntf, credIntf, utxoIntf, msigIntf)
+}
+
+// VerifyOperationWithAliases verifies that the specified transaction can perform
+// the provided operation like VerifyOperation does, but also supports mint outputs
+// owned by multisig aliases, which are resolved with [msigIntf], and multisig credentials.
+// Other operations are verified exactly as VerifyOperation verifies them.
+func (fx *CaminoFx) VerifyOperationWithAliases(txIntf, opIntf, credIntf interface{}, utxosIntf []interface{}, msigIntf interface{}) error {
+	tx, ok := txIntf.(UnsignedTx)
+	if !ok {
+		return ErrWrongTxType
+	}
+	op, ok := opIntf.(*MintOperation)
+	if !ok {
+		return ErrWrongOpType
+	}
+	cred, ok := credIntf.(CredentialIntf)
+	if !ok {
+		return ErrWrongCredentialType
+	}
+	if len(utxosIntf) != 1 {
+		return ErrWrongNumberOfUTXOs
+	}
+	out, ok := utxosIntf[0].(*MintOutput)
+	if !ok {
+		return ErrWrongUTXOType
+	}
+
+	useMultisig, err := requiresMultisig(credIntf, &out.OutputOwners, msigIntf)
+	if err != nil {
+		return err
+	} else if !useMultisig {
+		return fx.Fx.VerifyOperation(txIntf, opIntf, credIntf, utxosIntf)
+	}
+
+	if err := verify.All(op, cred, out); err != nil {
+		return err
+	}
+	if !out.Equals(&op.MintOutput.OutputOwners) {
+		return ErrWrongMintCreated
+	}
+	if out.Locktime > fx.VM.Clock().Unix() {
+		return ErrTimelocked
+	}
+	msig, ok := msigIntf.(AliasGetter)
+	if !ok {
+		return ErrNotAliasGetter
+	}
+	return fx.verifyMultisigCredentials(tx.Bytes(), &op.MintInput, cred, &out.OutputOwners, msig)
+}
+
+// requiresMultisig returns true, if [credIntf] is multisig credential
+// or if any of [owners] addresses is multisig alias.
+func requiresMultisig(credIntf interface{}, owners *OutputOwners, msigIntf interface{}) (bool, error) {
+	if _, ok := credIntf.(*MultisigCredential); ok {
+		return true, nil
+	}
+	msig, ok := msigIntf.(AliasGetter)
+	if !ok {
+		return false, ErrNotAliasGetter
+	}
+	for _, addr := range owners.Addrs {
+		switch _, err := msig.GetMultisigAlias(addr); err {
+		case nil:
+			return true, nil
+		case database.ErrNotFound:
+		default:
+			return false, err
+		}
+	}
+	return false, nil
+}
+
 func (fx *Fx) RecoverAddresses(msg []byte, verifies []verify.Verifiable) (RecoverMap, error) {
 	ret := make(RecoverMap, len(verifies))
 	visited := make(map[[secp256k1.SignatureLen]byte]bool)
@@ -115,21 +208,22 @@ func (*Fx) VerifyMultisigOwner(outIntf, msigIntf interface{}) error {
 	}
 
 	// We don't support msig combinations / nesting for now
-	if len(owners.Addrs) == 1 {
-		alias, err := msig.GetMultisigAlias(owners.Addrs[0])
+	addrs := owners.Addrs
+	if len(addrs) == 1 {
+		alias, err := msig.GetMultisigAlias(addrs[0])
 		if err == database.ErrNotFound {
 			return nil
 		} else if err != nil && err != database.ErrNotFound {
 			return err
 		}
-		aliasOwners, ok := alias.Owners.(*OutputOwners)
-		if !ok {
-			return ErrWrongOwnerType
+		aliasOwners, err := toTraversableOwners(alias.Owners)
+		if err != nil {
+			return err
 		}
-		owners = aliasOwners
+		addrs = aliasOwners.addresses()
 	}
 
-	for _, addr := range owners.Addrs {
+	for _, addr := range addrs {
 		if _, err := msig.GetMultisigAlias(addr); err != nil && err != database.ErrNotFound {
 			return err
 		} else if err == nil {
@@ -188,8 +282,8 @@ func (fx *Fx) VerifyMultisigPermission(txIntf, inIntf, credIntf, ownerIntf, msig
 	if !ok {
 		return ErrWrongCredentialType
 	}
-	owners, ok := ownerIntf.(*OutputOwners)
-	if !ok {
+	owners, err := toTraversableOwners(ownerIntf)
+	if err != nil {
 		return ErrWrongUTXOType
 	}
 
@@ -227,8 +321,8 @@ func (fx *Fx) VerifyMultisigMessage(msg []byte, inIntf, credIntf, ownerIntf, msi
 	if !ok {
 		return ErrWrongCredentialType
 	}
-	owners, ok := ownerIntf.(*OutputOwners)
-	if !ok {
+	owners, err := toTraversableOwners(ownerIntf)
+	if err != nil {
 		return ErrWrongUTXOType
 	}
 
@@ -244,7 +338,7 @@ func (fx *Fx) VerifyMultisigMessage(msg []byte, inIntf, credIntf, ownerIntf, msi
 	return fx.verifyMultisigCredentials(msg, in, cred, owners, msig)
 }
 
-func (fx *Fx) verifyMultisigCredentials(msg []byte, in *Input, cred CredentialIntf, owners *OutputOwners, msig AliasGetter) error {
+func (fx *Fx) verifyMultisigCredentials(msg []byte, in *Input, cred CredentialIntf, owners traversableOwners, msig AliasGetter) error {
 	sigIdxs := cred.SignatureIndices()
 	if sigIdxs == nil {
 		sigIdxs = in.SigIndices
@@ -277,7 +371,7 @@ func (fx *Fx) verifyMultisigCredentials(msg []byte, in *Input, cred CredentialIn
 		return false, nil
 	}
 
-	sigsVerified, err := TraverseOwners(owners, msig, tf)
+	sigsVerified, err := traverseOwners(owners, msig, tf)
 	if err != nil {
 		return err
 	}
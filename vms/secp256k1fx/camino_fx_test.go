@@ -4,6 +4,7 @@
 package secp256k1fx
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -38,7 +39,7 @@ func TestVerifyMultisigCredentials(t *testing.T) {
 	tests := map[string]struct {
 		in            *Input
 		signers       []*secp256k1.PrivateKey
-		owners        *OutputOwners
+		owners        traversableOwners
 		msig          func(c *gomock.Controller) AliasGetter
 		expectedError error
 	}{
@@ -206,6 +207,87 @@ func TestVerifyMultisigCredentials(t *testing.T) {
 			},
 			expectedError: errCantSpend,
 		},
+		"OK weighted: addr1 (weight 2), addr2 (weight 1), addr3 (weight 1), thresh: 2, heavy signer": {
+			in:      &Input{SigIndices: []uint32{0}},
+			signers: []*secp256k1.PrivateKey{key1},
+			owners: &WeightedOutputOwners{
+				Threshold: 2,
+				Addrs:     []ids.ShortID{addr1, addr2, addr3},
+				Weights:   []uint32{2, 1, 1},
+			},
+			msig: noAliasesMsigGetter,
+		},
+		"OK weighted: addr1 (weight 2), addr2 (weight 1), addr3 (weight 1), thresh: 2, light signers": {
+			in:      &Input{SigIndices: []uint32{1, 2}},
+			signers: []*secp256k1.PrivateKey{key2, key3},
+			owners: &WeightedOutputOwners{
+				Threshold: 2,
+				Addrs:     []ids.ShortID{addr1, addr2, addr3},
+				Weights:   []uint32{2, 1, 1},
+			},
+			msig: noAliasesMsigGetter,
+		},
+		"Fail weighted: addr1 (weight 2), addr2 (weight 1), addr3 (weight 1), thresh: 2, not enough weight": {
+			in:      &Input{SigIndices: []uint32{1}},
+			signers: []*secp256k1.PrivateKey{key2},
+			owners: &WeightedOutputOwners{
+				Threshold: 2,
+				Addrs:     []ids.ShortID{addr1, addr2, addr3},
+				Weights:   []uint32{2, 1, 1},
+			},
+			msig:          noAliasesMsigGetter,
+			expectedError: errCantSpend,
+		},
+		"OK weighted msig: addr4, alias1{ addr1 (weight 1), alias2{addr2, addr3, thresh: 1} (weight 2), thresh: 2 }": {
+			in:      &Input{SigIndices: []uint32{1, 3}},
+			signers: []*secp256k1.PrivateKey{key2, key4},
+			owners: &OutputOwners{
+				Threshold: 2,
+				Addrs:     []ids.ShortID{aliasAddr1, addr4},
+			},
+			msig: func(c *gomock.Controller) AliasGetter {
+				msig := NewMockAliasGetter(c)
+				expectGetMultisigAliases(msig, []*multisig.AliasWithNonce{
+					{Alias: multisig.Alias{
+						ID: aliasAddr1,
+						Owners: &WeightedOutputOwners{
+							Threshold: 2,
+							Addrs:     []ids.ShortID{addr1, aliasAddr2},
+							Weights:   []uint32{1, 2},
+						},
+					}},
+					{Alias: multisig.Alias{
+						ID: aliasAddr2,
+						Owners: &OutputOwners{
+							Threshold: 1,
+							Addrs:     []ids.ShortID{addr2, addr3},
+						},
+					}},
+				})
+				return msig
+			},
+		},
+		"Fail weighted msig: addr4, alias1{ addr1 (weight 1), addr2 (weight 1), addr3 (weight 2), thresh: 3 }": {
+			in:      &Input{SigIndices: []uint32{0, 1, 3}},
+			signers: []*secp256k1.PrivateKey{key1, key2, key4},
+			owners: &OutputOwners{
+				Threshold: 2,
+				Addrs:     []ids.ShortID{aliasAddr1, addr4},
+			},
+			msig: func(c *gomock.Controller) AliasGetter {
+				msig := NewMockAliasGetter(c)
+				expectGetMultisigAliases(msig, []*multisig.AliasWithNonce{{Alias: multisig.Alias{
+					ID: aliasAddr1,
+					Owners: &WeightedOutputOwners{
+						Threshold: 3,
+						Addrs:     []ids.ShortID{addr1, addr2, addr3},
+						Weights:   []uint32{1, 1, 2},
+					},
+				}}})
+				return msig
+			},
+			expectedError: errCantSpend,
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -314,6 +396,141 @@ func TestCollectMultisigAliases(t *testing.T) {
 	}
 }
 
+func TestVerifyTransferWithAliases(t *testing.T) {
+	key1, addr1 := generateKey(t)
+	key2, addr2 := generateKey(t)
+	_, aliasAddr := generateKey(t)
+	tx := &TestTx{UnsignedBytes: []byte{1, 2, 3}}
+	txHash := hashing.ComputeHash256(tx.Bytes())
+
+	alias := &multisig.AliasWithNonce{Alias: multisig.Alias{
+		ID: aliasAddr,
+		Owners: &OutputOwners{
+			Threshold: 2,
+			Addrs:     []ids.ShortID{addr1, addr2},
+		},
+	}}
+
+	msigGetter := func(c *gomock.Controller) AliasGetter {
+		msig := NewMockAliasGetter(c)
+		msig.EXPECT().GetMultisigAlias(aliasAddr).Return(alias, nil).AnyTimes()
+		msig.EXPECT().GetMultisigAlias(gomock.Not(aliasAddr)).Return(nil, database.ErrNotFound).AnyTimes()
+		return msig
+	}
+
+	sign := func(keys ...*secp256k1.PrivateKey) Credential {
+		cred := Credential{Sigs: make([][secp256k1.SignatureLen]byte, len(keys))}
+		for i, key := range keys {
+			sig, err := key.SignHash(txHash)
+			require.NoError(t, err)
+			copy(cred.Sigs[i][:], sig)
+		}
+		return cred
+	}
+
+	tests := map[string]struct {
+		in            *TransferInput
+		cred          CredentialIntf
+		out           *TransferOutput
+		expectedError error
+	}{
+		"OK: not alias owner": {
+			in:   &TransferInput{Amt: 1, Input: Input{SigIndices: []uint32{0}}},
+			cred: &Credential{Sigs: sign(key1).Sigs},
+			out: &TransferOutput{Amt: 1, OutputOwners: OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{addr1},
+			}},
+		},
+		"OK: alias owner": {
+			in:   &TransferInput{Amt: 1, Input: Input{SigIndices: []uint32{0, 1}}},
+			cred: &Credential{Sigs: sign(key1, key2).Sigs},
+			out: &TransferOutput{Amt: 1, OutputOwners: OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{aliasAddr},
+			}},
+		},
+		"OK: alias owner, multisig credential": {
+			in: &TransferInput{Amt: 1, Input: Input{SigIndices: []uint32{0}}},
+			cred: &MultisigCredential{
+				Credential: sign(key1, key2),
+				SigIdxs:    []uint32{0, 1},
+			},
+			out: &TransferOutput{Amt: 1, OutputOwners: OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{aliasAddr},
+			}},
+		},
+		"Fail: alias owner, not enough signatures": {
+			in:   &TransferInput{Amt: 1, Input: Input{SigIndices: []uint32{0}}},
+			cred: &Credential{Sigs: sign(key1).Sigs},
+			out: &TransferOutput{Amt: 1, OutputOwners: OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{aliasAddr},
+			}},
+			expectedError: errCantSpend,
+		},
+		"Fail: alias owner, timelocked": {
+			in:   &TransferInput{Amt: 1, Input: Input{SigIndices: []uint32{0, 1}}},
+			cred: &Credential{Sigs: sign(key1, key2).Sigs},
+			out: &TransferOutput{Amt: 1, OutputOwners: OutputOwners{
+				Locktime:  math.MaxUint64,
+				Threshold: 1,
+				Addrs:     []ids.ShortID{aliasAddr},
+			}},
+			expectedError: ErrTimelocked,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			fx := &CaminoFx{Fx: *defaultFx(t)}
+
+			err := fx.VerifyTransferWithAliases(tx, tt.in, tt.cred, tt.out, msigGetter(ctrl))
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestVerifyOperationWithAliases(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	key1, addr1 := generateKey(t)
+	_, aliasAddr := generateKey(t)
+	tx := &TestTx{UnsignedBytes: []byte{1, 2, 3}}
+	sig, err := key1.SignHash(hashing.ComputeHash256(tx.Bytes()))
+	require.NoError(err)
+	cred := &Credential{Sigs: make([][secp256k1.SignatureLen]byte, 1)}
+	copy(cred.Sigs[0][:], sig)
+
+	msig := NewMockAliasGetter(ctrl)
+	msig.EXPECT().GetMultisigAlias(aliasAddr).Return(&multisig.AliasWithNonce{Alias: multisig.Alias{
+		ID: aliasAddr,
+		Owners: &OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{addr1},
+		},
+	}}, nil).AnyTimes()
+	msig.EXPECT().GetMultisigAlias(gomock.Not(aliasAddr)).Return(nil, database.ErrNotFound).AnyTimes()
+
+	mintOwners := OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{aliasAddr},
+	}
+	op := &MintOperation{
+		MintInput:      Input{SigIndices: []uint32{0}},
+		MintOutput:     MintOutput{OutputOwners: mintOwners},
+		TransferOutput: TransferOutput{Amt: 1, OutputOwners: mintOwners},
+	}
+	utxos := []interface{}{&MintOutput{OutputOwners: mintOwners}}
+
+	fx := &CaminoFx{Fx: *defaultFx(t)}
+	require.NoError(fx.VerifyOperationWithAliases(tx, op, cred, utxos, msig))
+}
+
 func defaultFx(t *testing.T) *Fx {
 	require := require.New(t)
 	vm := TestVM{
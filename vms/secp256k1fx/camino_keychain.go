@@ -102,17 +102,46 @@ type TraverseAliasFunc func(
 	alias *multisig.AliasWithNonce,
 )
 
+// traversableOwners are owners, that could be traversed through resolving nested multisig aliases
+type traversableOwners interface {
+	verify.Verifiable
+	addresses() []ids.ShortID
+	// Returns weight of address with given index
+	weight(index int) uint32
+	threshold() uint32
+}
+
+// Returns traversable owners, if owners are of one of supported owners types
+func toTraversableOwners(owners interface{}) (traversableOwners, error) {
+	switch owners := owners.(type) {
+	case *OutputOwners:
+		return owners, nil
+	case *WeightedOutputOwners:
+		return owners, nil
+	}
+	return nil, ErrWrongOwnerType
+}
+
 // TraverseOwners traverses through owners, visits every address and callbacks in case a
 // non-multisig address is visited. Nested multisig alias are excluded from sigIndex concept.
 func TraverseOwners(out *OutputOwners, msig AliasGetter, callback TraverseOwnerFunc) (uint32, error) {
+	return traverseOwners(out, msig, callback)
+}
+
+// traverseOwners works like TraverseOwners, but also accepts weighted owners.
+// Verified addresses and nested aliases add their weight to owners verified weight,
+// owners are verified, when their verified weight reaches threshold.
+func traverseOwners(out traversableOwners, msig AliasGetter, callback TraverseOwnerFunc) (uint32, error) {
 	var addrVisited, addrVerified uint32
 
 	type stackItem struct {
 		index,
 		verified,
 		addrVerifiedTotal uint32
+		// weight of this owners in parent owners
+		weight         uint32
 		parentVerified bool
-		owners         *OutputOwners
+		owners         traversableOwners
 	}
 
 	cycleCheck := set.Set[ids.ShortID]{}
@@ -121,9 +150,10 @@ func TraverseOwners(out *OutputOwners, msig AliasGetter, callback TraverseOwnerF
 	Stack:
 		// get head
 		currentStack := stack[len(stack)-1]
-		for int(currentStack.index) < len(currentStack.owners.Addrs) {
+		for int(currentStack.index) < len(currentStack.owners.addresses()) {
 			// get the next address to check
-			addr := currentStack.owners.Addrs[currentStack.index]
+			addrIndex := int(currentStack.index)
+			addr := currentStack.owners.addresses()[addrIndex]
 			currentStack.index++
 			// Is it a multi-sig address ?
 			alias, err := msig.GetMultisigAlias(addr)
@@ -136,18 +166,19 @@ func TraverseOwners(out *OutputOwners, msig AliasGetter, callback TraverseOwnerF
 					return 0, errCyclicAliases
 				}
 				cycleCheck.Add(addr)
-				owners, ok := alias.Owners.(*OutputOwners)
-				if !ok {
-					return 0, ErrWrongOwnerType
+				owners, err := toTraversableOwners(alias.Owners)
+				if err != nil {
+					return 0, err
 				}
 				stack = append(stack, &stackItem{
 					owners:            owners,
 					addrVerifiedTotal: addrVerified,
-					parentVerified:    currentStack.parentVerified || currentStack.verified >= currentStack.owners.Threshold,
+					weight:            currentStack.owners.weight(addrIndex),
+					parentVerified:    currentStack.parentVerified || currentStack.verified >= currentStack.owners.threshold(),
 				})
 				goto Stack
 			case database.ErrNotFound: // non-multi-sig
-				if !currentStack.parentVerified && currentStack.verified < currentStack.owners.Threshold {
+				if !currentStack.parentVerified && currentStack.verified < currentStack.owners.threshold() {
 					success, err := callback(
 						addr,
 						addrVisited,
@@ -157,7 +188,7 @@ func TraverseOwners(out *OutputOwners, msig AliasGetter, callback TraverseOwnerF
 						return 0, err
 					}
 					if success {
-						currentStack.verified++
+						currentStack.verified += currentStack.owners.weight(addrIndex)
 						addrVerified++
 
 						if addrVerified > MaxSignatures {
@@ -174,17 +205,17 @@ func TraverseOwners(out *OutputOwners, msig AliasGetter, callback TraverseOwnerF
 		// remove head
 		stack = stack[:len(stack)-1]
 		// verify current level
-		if currentStack.verified < currentStack.owners.Threshold {
+		if currentStack.verified < currentStack.owners.threshold() {
 			if len(stack) == 0 {
 				return 0, errCantSpend
 			}
 			// We recover to previous state
 			addrVerified = currentStack.addrVerifiedTotal
 		} else if len(stack) > 0 {
-			currentStack = stack[len(stack)-1]
-			if currentStack.verified < currentStack.owners.Threshold {
+			parentStack := stack[len(stack)-1]
+			if parentStack.verified < parentStack.owners.threshold() {
 				// apply child verification
-				currentStack.verified++
+				parentStack.verified += currentStack.weight
 			}
 		}
 	}
@@ -192,9 +223,13 @@ func TraverseOwners(out *OutputOwners, msig AliasGetter, callback TraverseOwnerF
 }
 
 func TraverseAliases(out *OutputOwners, msig AliasGetter, callback TraverseAliasFunc) error {
+	return traverseAliases(out, msig, callback)
+}
+
+func traverseAliases(out traversableOwners, msig AliasGetter, callback TraverseAliasFunc) error {
 	type stackItem struct {
 		index  int
-		owners *OutputOwners
+		owners traversableOwners
 	}
 
 	cycleCheck := set.Set[ids.ShortID]{}
@@ -203,9 +238,9 @@ func TraverseAliases(out *OutputOwners, msig AliasGetter, callback TraverseAlias
 	Stack:
 		// get head
 		currentStack := stack[len(stack)-1]
-		for currentStack.index < len(currentStack.owners.Addrs) {
+		for currentStack.index < len(currentStack.owners.addresses()) {
 			// get the next address to check
-			addr := currentStack.owners.Addrs[currentStack.index]
+			addr := currentStack.owners.addresses()[currentStack.index]
 			currentStack.index++
 			// Is it a multi-sig address ?
 			alias, err := msig.GetMultisigAlias(addr)
@@ -219,9 +254,9 @@ func TraverseAliases(out *OutputOwners, msig AliasGetter, callback TraverseAlias
 				}
 				cycleCheck.Add(addr)
 
-				owners, ok := alias.Owners.(*OutputOwners)
-				if !ok {
-					return ErrWrongOwnerType
+				owners, err := toTraversableOwners(alias.Owners)
+				if err != nil {
+					return err
 				}
 				stack = append(stack, &stackItem{
 					owners: owners,
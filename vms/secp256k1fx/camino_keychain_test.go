@@ -11,6 +11,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/formatting"
 	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -103,6 +104,52 @@ func TestSpendMultiSigMSig(t *testing.T) {
 	require.Equal(len(sigs), 3)
 }
 
+func TestSpendMultiSigWeightedMSig(t *testing.T) {
+	require := require.New(t)
+	kc := NewKeychain()
+
+	addresses := make([]ids.ShortID, 0, len(keys))
+
+	for _, keyStr := range keys {
+		skBytes, err := formatting.Decode(formatting.HexNC, keyStr)
+		require.NoError(err)
+		sk, err := kc.factory.ToPrivateKey(skBytes)
+		require.NoError(err)
+		addresses = append(addresses, sk.PublicKey().Address())
+		// only second and third keys are known
+		if len(addresses) > 1 {
+			kc.Add(sk)
+		}
+	}
+
+	transfer := TransferOutput{
+		Amt: 12345,
+		OutputOwners: OutputOwners{
+			Locktime:  54321,
+			Threshold: 1,
+			Addrs:     []ids.ShortID{msigAddress},
+		},
+	}
+	require.NoError(transfer.Verify())
+
+	msig := NewMockAliasGetter(gomock.NewController(t))
+	msig.EXPECT().GetMultisigAlias(msigAddress).Return(&multisig.AliasWithNonce{Alias: multisig.Alias{
+		ID: msigAddress,
+		Owners: &WeightedOutputOwners{
+			Threshold: 3,
+			Addrs:     []ids.ShortID{addresses[0], addresses[1], addresses[2]},
+			Weights:   []uint32{3, 1, 2},
+		},
+	}}, nil)
+	msig.EXPECT().GetMultisigAlias(gomock.Any()).Return(nil, database.ErrNotFound).AnyTimes()
+
+	in, sigs, err := kc.SpendMultiSig(&transfer, 54321, msig)
+	require.NoError(err)
+
+	require.Len(sigs, 2)
+	require.Equal([]uint32{1, 2}, in.(*TransferInput).SigIndices)
+}
+
 func TestSpendMultiSigFakeKeys(t *testing.T) {
 	require := require.New(t)
 	kc := NewKeychain()
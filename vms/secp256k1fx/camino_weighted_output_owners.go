@@ -0,0 +1,136 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package secp256k1fx
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+)
+
+var (
+	_ verify.State      = (*WeightedOutputOwners)(nil)
+	_ traversableOwners = (*WeightedOutputOwners)(nil)
+	_ traversableOwners = (*OutputOwners)(nil)
+
+	errWeightsLengthMismatch = errors.New("addresses and weights length mismatch")
+	errZeroWeight            = errors.New("address weight is zero")
+	errWeightsOverflow       = errors.New("sum of weights overflows")
+)
+
+// WeightedOutputOwners are multisig alias owners, where every address has its own weight.
+// Owners are verified, if sum of weights of verified addresses reaches threshold.
+type WeightedOutputOwners struct {
+	Locktime  uint64        `serialize:"true" json:"locktime"`
+	Threshold uint32        `serialize:"true" json:"threshold"`
+	Addrs     []ids.ShortID `serialize:"true" json:"addresses"`
+	// Weights[i] is a weight of Addrs[i]
+	Weights []uint32 `serialize:"true" json:"weights"`
+
+	// ctx is used in MarshalJSON to convert Addrs into human readable
+	// format with ChainID and NetworkID.
+	ctx *snow.Context
+}
+
+// InitCtx assigns the WeightedOutputOwners.ctx object to given [ctx] object
+// Must be called at least once for MarshalJSON to work successfully
+func (out *WeightedOutputOwners) InitCtx(ctx *snow.Context) {
+	out.ctx = ctx
+}
+
+// MarshalJSON marshals WeightedOutputOwners as JSON with human readable addresses.
+// WeightedOutputOwners.InitCtx must be called before marshalling this or one of
+// the parent objects to json.
+func (out *WeightedOutputOwners) MarshalJSON() ([]byte, error) {
+	addrsLen := len(out.Addrs)
+	if addrsLen > 0 && out.ctx == nil {
+		return nil, errMarshal
+	}
+
+	addresses := make([]string, addrsLen)
+	for i, addr := range out.Addrs {
+		fAddr, err := formatAddress(out.ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+		addresses[i] = fAddr
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"locktime":  out.Locktime,
+		"threshold": out.Threshold,
+		"addresses": addresses,
+		"weights":   out.Weights,
+	})
+}
+
+// AddressesSet returns addresses as a set
+func (out *WeightedOutputOwners) AddressesSet() set.Set[ids.ShortID] {
+	set := set.NewSet[ids.ShortID](len(out.Addrs))
+	set.Add(out.Addrs...)
+	return set
+}
+
+func (out *WeightedOutputOwners) Verify() error {
+	switch {
+	case out == nil:
+		return errNilOutput
+	case len(out.Addrs) != len(out.Weights):
+		return errWeightsLengthMismatch
+	case out.Threshold == 0 && len(out.Addrs) > 0:
+		return errOutputUnoptimized
+	case !utils.IsSortedAndUniqueSortable(out.Addrs):
+		return errAddrsNotSortedUnique
+	}
+
+	totalWeight := uint64(0)
+	for _, weight := range out.Weights {
+		if weight == 0 {
+			return errZeroWeight
+		}
+		totalWeight += uint64(weight)
+	}
+
+	switch {
+	case totalWeight > math.MaxUint32:
+		return errWeightsOverflow
+	case uint64(out.Threshold) > totalWeight:
+		return errOutputUnspendable
+	}
+	return nil
+}
+
+func (out *WeightedOutputOwners) VerifyState() error {
+	return out.Verify()
+}
+
+func (out *WeightedOutputOwners) addresses() []ids.ShortID {
+	return out.Addrs
+}
+
+func (out *WeightedOutputOwners) weight(index int) uint32 {
+	return out.Weights[index]
+}
+
+func (out *WeightedOutputOwners) threshold() uint32 {
+	return out.Threshold
+}
+
+func (out *OutputOwners) addresses() []ids.ShortID {
+	return out.Addrs
+}
+
+func (*OutputOwners) weight(int) uint32 {
+	return 1
+}
+
+func (out *OutputOwners) threshold() uint32 {
+	return out.Threshold
+}
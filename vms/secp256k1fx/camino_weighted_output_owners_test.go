@@ -0,0 +1,85 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package secp256k1fx
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeightedOutputOwnersVerify(t *testing.T) {
+	addr1 := ids.ShortID{1}
+	addr2 := ids.ShortID{2}
+
+	tests := map[string]struct {
+		owners      *WeightedOutputOwners
+		expectedErr error
+	}{
+		"Nil owners": {
+			expectedErr: errNilOutput,
+		},
+		"Weights length mismatch": {
+			owners: &WeightedOutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{addr1, addr2},
+				Weights:   []uint32{1},
+			},
+			expectedErr: errWeightsLengthMismatch,
+		},
+		"Zero threshold": {
+			owners: &WeightedOutputOwners{
+				Addrs:   []ids.ShortID{addr1},
+				Weights: []uint32{1},
+			},
+			expectedErr: errOutputUnoptimized,
+		},
+		"Addresses not sorted": {
+			owners: &WeightedOutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{addr2, addr1},
+				Weights:   []uint32{1, 1},
+			},
+			expectedErr: errAddrsNotSortedUnique,
+		},
+		"Zero weight": {
+			owners: &WeightedOutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{addr1, addr2},
+				Weights:   []uint32{1, 0},
+			},
+			expectedErr: errZeroWeight,
+		},
+		"Weights overflow": {
+			owners: &WeightedOutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{addr1, addr2},
+				Weights:   []uint32{math.MaxUint32, 1},
+			},
+			expectedErr: errWeightsOverflow,
+		},
+		"Threshold is bigger than sum of weights": {
+			owners: &WeightedOutputOwners{
+				Threshold: 4,
+				Addrs:     []ids.ShortID{addr1, addr2},
+				Weights:   []uint32{2, 1},
+			},
+			expectedErr: errOutputUnspendable,
+		},
+		"OK": {
+			owners: &WeightedOutputOwners{
+				Threshold: 3,
+				Addrs:     []ids.ShortID{addr1, addr2},
+				Weights:   []uint32{2, 1},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tt.owners.Verify(), tt.expectedErr)
+		})
+	}
+}
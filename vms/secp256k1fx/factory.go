@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -18,6 +28,9 @@ var (
 
 type Factory struct{}
 
+// New returns CaminoFx, which verifies regular outputs and credentials exactly
+// like Fx does. Multisig aliases and credentials are only supported by VMs,
+// that explicitly use them once the BerlinPhase upgrade is activated.
 func (*Factory) New(logging.Logger) (interface{}, error) {
-	return &Fx{}, nil
+	return &CaminoFx{}, nil
 }
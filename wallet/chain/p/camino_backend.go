@@ -0,0 +1,126 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"sync"
+
+	stdcontext "context"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	_ MultisigAliasGetter     = (*clientMultisigAliasGetter)(nil)
+	_ secp256k1fx.AliasGetter = (*aliasGetter)(nil)
+)
+
+// MultisigAliasGetter provides multisig alias definitions, required to spend utxos
+// and to authorize owners, that are controlled by multisig aliases.
+type MultisigAliasGetter interface {
+	// GetMultisigAlias returns alias definition or database.ErrNotFound,
+	// if [aliasID] isn't multisig alias.
+	GetMultisigAlias(ctx stdcontext.Context, aliasID ids.ShortID) (*multisig.AliasWithNonce, error)
+}
+
+type clientMultisigAliasGetter struct {
+	client platformvm.CaminoClient
+	hrp    string
+
+	aliasesLock sync.RWMutex
+	// aliasID -> alias or nil, if address isn't alias
+	aliases map[ids.ShortID]*multisig.AliasWithNonce
+}
+
+// NewMultisigAliasGetterFromClient returns alias getter, that fetches multisig aliases
+// over the API with [client] and caches them. [hrp] is used to format alias addresses.
+func NewMultisigAliasGetterFromClient(client platformvm.CaminoClient, hrp string) MultisigAliasGetter {
+	return &clientMultisigAliasGetter{
+		client:  client,
+		hrp:     hrp,
+		aliases: make(map[ids.ShortID]*multisig.AliasWithNonce),
+	}
+}
+
+func (g *clientMultisigAliasGetter) GetMultisigAlias(ctx stdcontext.Context, aliasID ids.ShortID) (*multisig.AliasWithNonce, error) {
+	g.aliasesLock.RLock()
+	alias, cached := g.aliases[aliasID]
+	g.aliasesLock.RUnlock()
+	if cached {
+		if alias == nil {
+			return nil, database.ErrNotFound
+		}
+		return alias, nil
+	}
+
+	alias, err := g.fetchMultisigAlias(ctx, aliasID)
+	if err != nil && err != database.ErrNotFound {
+		return nil, err
+	}
+
+	g.aliasesLock.Lock()
+	g.aliases[aliasID] = alias
+	g.aliasesLock.Unlock()
+
+	return alias, err
+}
+
+func (g *clientMultisigAliasGetter) fetchMultisigAlias(ctx stdcontext.Context, aliasID ids.ShortID) (*multisig.AliasWithNonce, error) {
+	aliasAddr, err := address.Format("P", g.hrp, aliasID.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := g.client.GetMultisigAlias(ctx, aliasAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs, err := address.ParseToIDs(reply.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	alias := &multisig.AliasWithNonce{
+		Alias: multisig.Alias{
+			ID:   aliasID,
+			Memo: reply.Memo,
+		},
+		Nonce: uint64(reply.Nonce),
+	}
+	if len(reply.Weights) > 0 {
+		weights := make([]uint32, len(reply.Weights))
+		for i, weight := range reply.Weights {
+			weights[i] = uint32(weight)
+		}
+		alias.Owners = &secp256k1fx.WeightedOutputOwners{
+			Locktime:  uint64(reply.Locktime),
+			Threshold: uint32(reply.Threshold),
+			Addrs:     addrs,
+			Weights:   weights,
+		}
+	} else {
+		alias.Owners = &secp256k1fx.OutputOwners{
+			Locktime:  uint64(reply.Locktime),
+			Threshold: uint32(reply.Threshold),
+			Addrs:     addrs,
+		}
+	}
+	return alias, nil
+}
+
+// aliasGetter binds [MultisigAliasGetter] to context, so it could be used as secp256k1fx.AliasGetter
+type aliasGetter struct {
+	ctx     stdcontext.Context
+	aliases MultisigAliasGetter
+}
+
+func (g *aliasGetter) GetMultisigAlias(aliasID ids.ShortID) (*multisig.AliasWithNonce, error) {
+	return g.aliases.GetMultisigAlias(g.ctx, aliasID)
+}
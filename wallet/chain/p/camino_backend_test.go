@@ -0,0 +1,109 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"testing"
+
+	stdcontext "context"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/api"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+type testCaminoClient struct {
+	platformvm.CaminoClient
+	// alias address -> reply
+	aliases map[string]*platformvm.GetMultisigAliasReply
+	calls   int
+}
+
+func (c *testCaminoClient) GetMultisigAlias(_ stdcontext.Context, multisigAddress string, _ ...rpc.Option) (*platformvm.GetMultisigAliasReply, error) {
+	c.calls++
+	reply, ok := c.aliases[multisigAddress]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return reply, nil
+}
+
+func TestClientMultisigAliasGetter(t *testing.T) {
+	require := require.New(t)
+
+	hrp := constants.GetHRP(constants.UnitTestID)
+	formatAddr := func(addr ids.ShortID) string {
+		addrStr, err := address.Format("P", hrp, addr.Bytes())
+		require.NoError(err)
+		return addrStr
+	}
+
+	_, ownerAddr1 := generateTestKey(t)
+	_, ownerAddr2 := generateTestKey(t)
+	ownerAddrs := sortedAddrs(ownerAddr1, ownerAddr2)
+	aliasID := ids.ShortID{1}
+	weightedAliasID := ids.ShortID{2}
+
+	client := &testCaminoClient{aliases: map[string]*platformvm.GetMultisigAliasReply{
+		formatAddr(aliasID): {
+			Memo: []byte{1},
+			Owner: api.Owner{
+				Threshold: 1,
+				Addresses: []string{formatAddr(ownerAddrs[0]), formatAddr(ownerAddrs[1])},
+			},
+			Nonce: 3,
+		},
+		formatAddr(weightedAliasID): {
+			Owner: api.Owner{
+				Threshold: 2,
+				Addresses: []string{formatAddr(ownerAddrs[0]), formatAddr(ownerAddrs[1])},
+			},
+			Weights: []json.Uint32{1, 1},
+			Nonce:   1,
+		},
+	}}
+	g := NewMultisigAliasGetterFromClient(client, hrp)
+	ctx := stdcontext.Background()
+
+	alias, err := g.GetMultisigAlias(ctx, aliasID)
+	require.NoError(err)
+	require.Equal(&multisig.AliasWithNonce{
+		Alias: multisig.Alias{
+			ID:     aliasID,
+			Memo:   []byte{1},
+			Owners: &secp256k1fx.OutputOwners{Threshold: 1, Addrs: ownerAddrs},
+		},
+		Nonce: 3,
+	}, alias)
+
+	alias, err = g.GetMultisigAlias(ctx, weightedAliasID)
+	require.NoError(err)
+	require.Equal(&multisig.AliasWithNonce{
+		Alias: multisig.Alias{
+			ID:     weightedAliasID,
+			Owners: &secp256k1fx.WeightedOutputOwners{Threshold: 2, Addrs: ownerAddrs, Weights: []uint32{1, 1}},
+		},
+		Nonce: 1,
+	}, alias)
+
+	_, err = g.GetMultisigAlias(ctx, ownerAddr1)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	// aliases and not found addresses are cached
+	calls := client.calls
+	_, err = g.GetMultisigAlias(ctx, aliasID)
+	require.NoError(err)
+	_, err = g.GetMultisigAlias(ctx, ownerAddr1)
+	require.ErrorIs(err, database.ErrNotFound)
+	require.Equal(calls, client.calls)
+}
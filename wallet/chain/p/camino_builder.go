@@ -0,0 +1,1395 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/utxo"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+var (
+	_ CaminoBuilder = (*caminoBuilder)(nil)
+
+	errInvalidTargetLockState = errors.New("invalid target lock state")
+	errNotValidatorTx         = errors.New("tx isn't validator tx")
+	errNotTreasuryUTXO        = errors.New("utxo isn't unlocked avax utxo owned by treasury")
+	errNotAVAXOutput          = errors.New("treasury funds could only be spent to avax outputs")
+	errNoExecutor             = errors.New("kyc expiration and node deferral end require executor")
+	errNothingToTransfer      = errors.New("neither deposit nor its reward owner is transferred")
+)
+
+// Claimable describes rewards, that will be claimed with claim tx.
+type Claimable struct {
+	// Deposit tx ID for active deposit rewards, otherwise claimable owner ID
+	ID ids.ID
+	// Type of claimed rewards
+	Type txs.ClaimType
+	// Amount that will be claimed
+	Amount uint64
+	// Owner, that must authorize claiming: deposit rewards owner or claimable owner
+	Owner *secp256k1fx.OutputOwners
+}
+
+// CaminoBuilder provides a convenient interface for building unsigned Camino
+// P-chain transactions. Utxos are consumed with the same lock rules as the
+// node applies, and owners are matched through multisig aliases.
+type CaminoBuilder interface {
+	Builder
+
+	// NewCaminoAddValidatorTx creates a new validator of the primary network,
+	// bonding its stake.
+	//
+	// - [vdr] specifies all the details of the validation period such as the
+	//   startTime, endTime, stake weight, and nodeID.
+	// - [nodeOwnerAddress] is the consortium member, that registered validator node.
+	// - [rewardsOwner] specifies the owner of all the rewards this validator
+	//   may accrue during its validation period.
+	// - [shares] specifies the fraction (out of 1,000,000) that this validator
+	//   will take from delegation rewards.
+	NewCaminoAddValidatorTx(
+		vdr *txs.Validator,
+		nodeOwnerAddress ids.ShortID,
+		rewardsOwner *secp256k1fx.OutputOwners,
+		shares uint32,
+		options ...common.Option,
+	) (*txs.CaminoAddValidatorTx, error)
+
+	// NewExtendValidatorTx extends the validation period of the current
+	// validator and bonds additional stake for it.
+	//
+	// - [validatorTxID] is the tx, that created current validator: add validator
+	//   tx or previous extend validator tx. It must be known to the backend.
+	// - [endTime] is the new end time of the validation period.
+	// - [bondAmount] is the amount, that will be added to validator weight.
+	// - [nodeOwnerAddress] is the consortium member, that registered validator node.
+	NewExtendValidatorTx(
+		validatorTxID ids.ID,
+		endTime uint64,
+		bondAmount uint64,
+		nodeOwnerAddress ids.ShortID,
+		options ...common.Option,
+	) (*txs.ExtendValidatorTx, error)
+
+	// NewAddressStateTx sets or removes address state bit.
+	//
+	// - [address] is the address, which state will be changed.
+	// - [remove] specifies, if state bit will be removed or set.
+	// - [state] is the changed state bit.
+	// - [executor] is the address with role, that allows to change [state].
+	//   If empty, tx will be authorized with base tx credentials.
+	// - [kycExpiration] is the time, when added kyc verified state will expire.
+	//   Zero means no expiration. Requires [executor].
+	// - [nodeDeferralEnd] is the time, when added node deferred state will be
+	//   removed. Zero means that it won't be removed automatically. Requires [executor].
+	NewAddressStateTx(
+		address ids.ShortID,
+		remove bool,
+		state txs.AddressStateBit,
+		executor ids.ShortID,
+		kycExpiration uint64,
+		nodeDeferralEnd uint64,
+		options ...common.Option,
+	) (*txs.AddressStateTx, error)
+
+	// NewDepositTx deposits tokens with deposit offer.
+	//
+	// - [depositOfferID] is the offer, that will be used for deposit.
+	// - [duration] is the deposit duration in seconds.
+	// - [amount] is the deposited amount.
+	// - [rewardsOwner] specifies the owner of deposit rewards.
+	// - [depositCreatorAddress] is the address, that creates deposit. Must be set,
+	//   if offer has eligibility rules or owner, otherwise should be empty.
+	// - [depositOfferOwnerAddress] is the offer owner. Must be set, if offer has owner,
+	//   even if offer also has eligibility rules.
+	NewDepositTx(
+		depositOfferID ids.ID,
+		duration uint32,
+		amount uint64,
+		rewardsOwner *secp256k1fx.OutputOwners,
+		depositCreatorAddress ids.ShortID,
+		depositOfferOwnerAddress ids.ShortID,
+		options ...common.Option,
+	) (*txs.DepositTx, error)
+
+	// NewUnlockDepositTx unlocks deposited tokens.
+	//
+	// - [unlockableAmounts] is the amount, that could be unlocked for each
+	//   deposit tx ID, as returned by platform.getDeposits.
+	NewUnlockDepositTx(
+		unlockableAmounts map[ids.ID]uint64,
+		options ...common.Option,
+	) (*txs.UnlockDepositTx, error)
+
+	// NewClaimTx claims deposit and validator rewards.
+	//
+	// - [claimables] describes claimed rewards and their owners.
+	// - [claimTo] specifies the owner of claimed tokens.
+	NewClaimTx(
+		claimables []*Claimable,
+		claimTo *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.ClaimTx, error)
+
+	// NewRegisterNodeTx registers node for consortium member.
+	//
+	// - [oldNodeID] is the node, that will be unregistered. Could be empty.
+	// - [newNodeID] is the node, that will be registered. Could be empty.
+	// - [nodeOwnerAddress] is the consortium member address.
+	NewRegisterNodeTx(
+		oldNodeID ids.NodeID,
+		newNodeID ids.NodeID,
+		nodeOwnerAddress ids.ShortID,
+		options ...common.Option,
+	) (*txs.RegisterNodeTx, error)
+
+	// NewMultisigAliasTx creates new or updates existing multisig alias.
+	//
+	// - [alias] is the new alias definition. Its ID must be empty for new alias,
+	//   otherwise tx will be authorized by existing alias owners.
+	NewMultisigAliasTx(
+		alias *multisig.Alias,
+		options ...common.Option,
+	) (*txs.MultisigAliasTx, error)
+
+	// NewAddDepositOfferTx adds new deposit offer.
+	//
+	// - [offer] is the added deposit offer.
+	// - [depositOfferCreatorAddress] is the address with offers creator role.
+	NewAddDepositOfferTx(
+		offer *deposit.Offer,
+		depositOfferCreatorAddress ids.ShortID,
+		options ...common.Option,
+	) (*txs.AddDepositOfferTx, error)
+
+	// NewUpdateDepositOfferTx updates existing deposit offer.
+	//
+	// - [depositOfferID] is the offer, that will be updated.
+	// - [flags] are the new offer flags, only locked flag could be changed.
+	// - [end] is the new offer end time, can't be after current one.
+	// - [totalMaxAmount] is the new offer total max amount.
+	// - [totalMaxRewardAmount] is the new offer total max reward amount.
+	// - [depositOfferUpdaterAddress] is the offer owner or address with offers admin role.
+	NewUpdateDepositOfferTx(
+		depositOfferID ids.ID,
+		flags deposit.OfferFlag,
+		end uint64,
+		totalMaxAmount uint64,
+		totalMaxRewardAmount uint64,
+		depositOfferUpdaterAddress ids.ShortID,
+		options ...common.Option,
+	) (*txs.UpdateDepositOfferTx, error)
+
+	// NewTransferDepositTx transfers deposited tokens and deposit rewards
+	// to new owners.
+	//
+	// - [depositTxID] is the deposit, that will be transferred.
+	// - [rewardOwner] is the current deposit rewards owner.
+	// - [newRewardOwner] is the new deposit rewards owner. Could be the same
+	//   as [rewardOwner], then current owner doesn't need to authorize tx.
+	// - [newOwner] will own all deposited utxos of [depositTxID], that could be
+	//   spent by the builder. If nil, deposited utxos aren't transferred.
+	NewTransferDepositTx(
+		depositTxID ids.ID,
+		rewardOwner *secp256k1fx.OutputOwners,
+		newRewardOwner *secp256k1fx.OutputOwners,
+		newOwner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.TransferDepositTx, error)
+
+	// NewAddProposalTx adds new dao proposal, bonding proposal bond.
+	//
+	// - [proposal] is the added proposal.
+	// - [bondAmount] is the proposal bond, it must be equal to the bond
+	//   amount from current dao config.
+	// - [proposerAddress] is the address, that creates proposal.
+	NewAddProposalTx(
+		proposal *dao.Proposal,
+		bondAmount uint64,
+		proposerAddress ids.ShortID,
+		options ...common.Option,
+	) (*txs.AddProposalTx, error)
+
+	// NewAddVoteTx votes for dao proposal option.
+	//
+	// - [proposalID] is the proposal, that is voted for.
+	// - [option] is the index of voted proposal option.
+	// - [voterAddress] is the consortium member address, that votes.
+	NewAddVoteTx(
+		proposalID ids.ID,
+		option uint32,
+		voterAddress ids.ShortID,
+		options ...common.Option,
+	) (*txs.AddVoteTx, error)
+
+	// NewUpdateDepositOfferAllowListTx updates deposit offer allow-list.
+	//
+	// - [depositOfferID] is the offer, which allow-list will be updated.
+	// - [depositOfferOwnerAddress] is the offer owner.
+	// - [addedAddresses] will be added to allow-list.
+	// - [removedAddresses] will be removed from allow-list.
+	NewUpdateDepositOfferAllowListTx(
+		depositOfferID ids.ID,
+		depositOfferOwnerAddress ids.ShortID,
+		addedAddresses []ids.ShortID,
+		removedAddresses []ids.ShortID,
+		options ...common.Option,
+	) (*txs.UpdateDepositOfferAllowListTx, error)
+
+	// NewTreasuryConfigTx sets treasury owner and treasury spending cap.
+	//
+	// - [config] is the new treasury config.
+	// - [executor] is the address with admin role.
+	NewTreasuryConfigTx(
+		config *treasury.Config,
+		executor ids.ShortID,
+		options ...common.Option,
+	) (*txs.TreasuryConfigTx, error)
+
+	// NewTreasurySpendTx spends treasury funds. Treasury change is returned
+	// to treasury.
+	//
+	// - [treasuryUTXOs] are the spent treasury utxos. They aren't tracked by
+	//   the backend, so they must be fetched for treasury address.
+	// - [outputs] are the avax outputs, that receive spent treasury funds.
+	// - [treasuryOwner] is the owner from current treasury config.
+	NewTreasurySpendTx(
+		treasuryUTXOs []*avax.UTXO,
+		outputs []*avax.TransferableOutput,
+		treasuryOwner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (*txs.TreasurySpendTx, error)
+}
+
+type caminoBuilder struct {
+	builder
+	aliases MultisigAliasGetter
+}
+
+// NewCaminoBuilder returns a new Camino transaction builder.
+//
+//   - [addrs] is the set of addresses that the builder assumes can be used when
+//     signing the transactions in the future.
+//   - [backend] provides the required access to the chain's context and state
+//     to build out the transactions.
+//   - [aliases] provides multisig aliases, that could own utxos or be authorized.
+func NewCaminoBuilder(
+	addrs set.Set[ids.ShortID],
+	backend BuilderBackend,
+	aliases MultisigAliasGetter,
+) CaminoBuilder {
+	return &caminoBuilder{
+		builder: builder{
+			addrs:   addrs,
+			backend: backend,
+		},
+		aliases: aliases,
+	}
+}
+
+func (b *caminoBuilder) NewCaminoAddValidatorTx(
+	vdr *txs.Validator,
+	nodeOwnerAddress ids.ShortID,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	shares uint32,
+	options ...common.Option,
+) (*txs.CaminoAddValidatorTx, error) {
+	ops := common.NewOptions(options)
+	ins, outs, err := b.lock(vdr.Wght, b.backend.AddPrimaryNetworkValidatorFee(), locked.StateBonded, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeOwnerAuth, err := b.authorizeAddress(nodeOwnerAddress, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	utils.Sort(rewardsOwner.Addrs)
+	return &txs.CaminoAddValidatorTx{
+		AddValidatorTx: txs.AddValidatorTx{
+			BaseTx:           b.baseTx(ins, outs, ops),
+			Validator:        *vdr,
+			RewardsOwner:     rewardsOwner,
+			DelegationShares: shares,
+		},
+		NodeOwnerAuth: nodeOwnerAuth,
+	}, nil
+}
+
+func (b *caminoBuilder) NewExtendValidatorTx(
+	validatorTxID ids.ID,
+	endTime uint64,
+	bondAmount uint64,
+	nodeOwnerAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.ExtendValidatorTx, error) {
+	ops := common.NewOptions(options)
+	validatorTx, err := b.backend.GetTx(ops.Context(), validatorTxID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to fetch validator tx %q: %w",
+			validatorTxID,
+			err,
+		)
+	}
+	validator, ok := validatorTx.Unsigned.(txs.ValidatorTx)
+	if !ok {
+		return nil, errNotValidatorTx
+	}
+
+	weight, err := math.Add64(validator.Weight(), bondAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	ins, outs, err := b.lock(bondAmount, b.backend.BaseTxFee(), locked.StateBonded, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeOwnerAuth, err := b.authorizeAddress(nodeOwnerAddress, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.ExtendValidatorTx{
+		BaseTx:        b.baseTx(ins, outs, ops),
+		ValidatorTxID: validatorTxID,
+		Validator: txs.Validator{
+			NodeID: validator.NodeID(),
+			Start:  uint64(validator.StartTime().Unix()),
+			End:    endTime,
+			Wght:   weight,
+		},
+		RewardsOwner:  validator.ValidationRewardsOwner(),
+		NodeOwnerAuth: nodeOwnerAuth,
+	}, nil
+}
+
+func (b *caminoBuilder) NewAddressStateTx(
+	address ids.ShortID,
+	remove bool,
+	state txs.AddressStateBit,
+	executor ids.ShortID,
+	kycExpiration uint64,
+	nodeDeferralEnd uint64,
+	options ...common.Option,
+) (*txs.AddressStateTx, error) {
+	if executor == ids.ShortEmpty && (kycExpiration != 0 || nodeDeferralEnd != 0) {
+		return nil, errNoExecutor
+	}
+
+	ops := common.NewOptions(options)
+	ins, outs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	utx := &txs.AddressStateTx{
+		BaseTx:  b.baseTx(ins, outs, ops),
+		Address: address,
+		Remove:  remove,
+		State:   state,
+	}
+
+	if executor != ids.ShortEmpty {
+		executorAuth, err := b.authorizeAddress(executor, ops)
+		if err != nil {
+			return nil, err
+		}
+		utx.UpgradeVersionID = codec.UpgradeVersion1
+		utx.Executor = executor
+		utx.ExecutorAuth = executorAuth
+
+		switch {
+		case nodeDeferralEnd != 0:
+			utx.UpgradeVersionID = codec.UpgradeVersion3
+			utx.NodeDeferralEnd = nodeDeferralEnd
+		case state == txs.AddressStateBitKYCVerified && !remove:
+			// kyc expiration is always set, so previous expiration isn't kept
+			utx.UpgradeVersionID = codec.UpgradeVersion2
+			utx.KYCExpiration = kycExpiration
+		}
+	}
+	return utx, nil
+}
+
+func (b *caminoBuilder) NewDepositTx(
+	depositOfferID ids.ID,
+	duration uint32,
+	amount uint64,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	depositCreatorAddress ids.ShortID,
+	depositOfferOwnerAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.DepositTx, error) {
+	ops := common.NewOptions(options)
+	ins, outs, err := b.lock(amount, b.backend.BaseTxFee(), locked.StateDeposited, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	utils.Sort(rewardsOwner.Addrs)
+	utx := &txs.DepositTx{
+		BaseTx:          b.baseTx(ins, outs, ops),
+		DepositOfferID:  depositOfferID,
+		DepositDuration: duration,
+		RewardsOwner:    rewardsOwner,
+	}
+
+	if depositCreatorAddress != ids.ShortEmpty {
+		depositCreatorAuth, err := b.authorizeAddress(depositCreatorAddress, ops)
+		if err != nil {
+			return nil, err
+		}
+
+		// offer owner signs permission message with separate credential,
+		// so its auth is only matched with offer owner address here
+		depositOfferOwnerAuth := &secp256k1fx.Input{}
+		if depositOfferOwnerAddress != ids.ShortEmpty {
+			depositOfferOwnerAuth, err = b.authorizeAddress(depositOfferOwnerAddress, ops)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		utx.UpgradeVersionID = codec.BuildUpgradeVersionID(1)
+		utx.DepositCreatorAddress = depositCreatorAddress
+		utx.DepositCreatorAuth = depositCreatorAuth
+		utx.DepositOfferOwnerAuth = depositOfferOwnerAuth
+	}
+	return utx, nil
+}
+
+func (b *caminoBuilder) NewUnlockDepositTx(
+	unlockableAmounts map[ids.ID]uint64,
+	options ...common.Option,
+) (*txs.UnlockDepositTx, error) {
+	ops := common.NewOptions(options)
+	ins, outs, err := b.unlockDeposit(unlockableAmounts, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	feeIns, feeOuts, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	ins = append(ins, feeIns...)
+	outs = append(outs, feeOuts...)
+	utils.Sort(ins)                               // sort inputs
+	avax.SortTransferableOutputs(outs, txs.Codec) // sort outputs
+
+	return &txs.UnlockDepositTx{
+		BaseTx: b.baseTx(ins, outs, ops),
+	}, nil
+}
+
+func (b *caminoBuilder) NewClaimTx(
+	claimables []*Claimable,
+	claimTo *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.ClaimTx, error) {
+	ops := common.NewOptions(options)
+	ins, outs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	utils.Sort(claimTo.Addrs)
+	claimAmounts := make([]txs.ClaimAmount, len(claimables))
+	for i, claimable := range claimables {
+		ownerAuth, err := b.authorizeOwner(claimable.Owner, ops)
+		if err != nil {
+			return nil, err
+		}
+		claimAmounts[i] = txs.ClaimAmount{
+			ID:        claimable.ID,
+			Type:      claimable.Type,
+			Amount:    claimable.Amount,
+			OwnerAuth: ownerAuth,
+		}
+		outs = append(outs, &avax.TransferableOutput{
+			Asset: avax.Asset{ID: b.backend.AVAXAssetID()},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          claimable.Amount,
+				OutputOwners: *claimTo,
+			},
+		})
+	}
+	avax.SortTransferableOutputs(outs, txs.Codec) // sort outputs
+
+	return &txs.ClaimTx{
+		BaseTx:     b.baseTx(ins, outs, ops),
+		Claimables: claimAmounts,
+	}, nil
+}
+
+func (b *caminoBuilder) NewRegisterNodeTx(
+	oldNodeID ids.NodeID,
+	newNodeID ids.NodeID,
+	nodeOwnerAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.RegisterNodeTx, error) {
+	ops := common.NewOptions(options)
+	ins, outs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeOwnerAuth, err := b.authorizeAddress(nodeOwnerAddress, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.RegisterNodeTx{
+		BaseTx:           b.baseTx(ins, outs, ops),
+		OldNodeID:        oldNodeID,
+		NewNodeID:        newNodeID,
+		NodeOwnerAuth:    nodeOwnerAuth,
+		NodeOwnerAddress: nodeOwnerAddress,
+	}, nil
+}
+
+func (b *caminoBuilder) NewMultisigAliasTx(
+	alias *multisig.Alias,
+	options ...common.Option,
+) (*txs.MultisigAliasTx, error) {
+	ops := common.NewOptions(options)
+	ins, outs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	aliasAuth := &secp256k1fx.Input{}
+	if alias.ID != ids.ShortEmpty {
+		if _, err := b.aliases.GetMultisigAlias(ops.Context(), alias.ID); err != nil {
+			return nil, fmt.Errorf(
+				"failed to fetch multisig alias %q: %w",
+				alias.ID,
+				err,
+			)
+		}
+		// Alias is matched as the only owner address, so its owners are
+		// resolved by alias getter, whether they are weighted or not.
+		// Sig indices are the same as for alias owners.
+		aliasAuth, err = b.authorizeAddress(alias.ID, ops)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &txs.MultisigAliasTx{
+		BaseTx:        b.baseTx(ins, outs, ops),
+		MultisigAlias: *alias,
+		Auth:          aliasAuth,
+	}, nil
+}
+
+func (b *caminoBuilder) NewAddDepositOfferTx(
+	offer *deposit.Offer,
+	depositOfferCreatorAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.AddDepositOfferTx, error) {
+	ops := common.NewOptions(options)
+	ins, outs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	depositOfferCreatorAuth, err := b.authorizeAddress(depositOfferCreatorAddress, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.AddDepositOfferTx{
+		BaseTx:                     b.baseTx(ins, outs, ops),
+		DepositOffer:               offer,
+		DepositOfferCreatorAddress: depositOfferCreatorAddress,
+		DepositOfferCreatorAuth:    depositOfferCreatorAuth,
+	}, nil
+}
+
+func (b *caminoBuilder) NewUpdateDepositOfferTx(
+	depositOfferID ids.ID,
+	flags deposit.OfferFlag,
+	end uint64,
+	totalMaxAmount uint64,
+	totalMaxRewardAmount uint64,
+	depositOfferUpdaterAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.UpdateDepositOfferTx, error) {
+	ops := common.NewOptions(options)
+	ins, outs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	depositOfferUpdaterAuth, err := b.authorizeAddress(depositOfferUpdaterAddress, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.UpdateDepositOfferTx{
+		BaseTx:                     b.baseTx(ins, outs, ops),
+		DepositOfferID:             depositOfferID,
+		Flags:                      flags,
+		End:                        end,
+		TotalMaxAmount:             totalMaxAmount,
+		TotalMaxRewardAmount:       totalMaxRewardAmount,
+		DepositOfferUpdaterAddress: depositOfferUpdaterAddress,
+		DepositOfferUpdaterAuth:    depositOfferUpdaterAuth,
+	}, nil
+}
+
+func (b *caminoBuilder) NewTransferDepositTx(
+	depositTxID ids.ID,
+	rewardOwner *secp256k1fx.OutputOwners,
+	newRewardOwner *secp256k1fx.OutputOwners,
+	newOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.TransferDepositTx, error) {
+	ops := common.NewOptions(options)
+
+	utils.Sort(rewardOwner.Addrs)
+	utils.Sort(newRewardOwner.Addrs)
+	rewardOwnerID, err := txs.GetOwnerID(rewardOwner)
+	if err != nil {
+		return nil, err
+	}
+	newRewardOwnerID, err := txs.GetOwnerID(newRewardOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	// reward owner credential is verified only if reward owner is changed
+	rewardOwnerAuth := &secp256k1fx.Input{}
+	rewardOwnerChanged := rewardOwnerID != newRewardOwnerID
+	if rewardOwnerChanged {
+		rewardOwnerAuth, err = b.authorizeOwner(rewardOwner, ops)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var transferIns []*avax.TransferableInput
+	var transferOuts []*avax.TransferableOutput
+	if newOwner != nil {
+		utils.Sort(newOwner.Addrs)
+		transferIns, transferOuts, err = b.transferDeposit(depositTxID, newOwner, ops)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !rewardOwnerChanged && len(transferIns) == 0 {
+		return nil, errNothingToTransfer
+	}
+
+	ins, outs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	ins = append(ins, transferIns...)
+	outs = append(outs, transferOuts...)
+	utils.Sort(ins)                               // sort inputs
+	avax.SortTransferableOutputs(outs, txs.Codec) // sort outputs
+
+	return &txs.TransferDepositTx{
+		BaseTx:          b.baseTx(ins, outs, ops),
+		DepositTxID:     depositTxID,
+		NewRewardOwner:  newRewardOwner,
+		RewardOwnerAuth: rewardOwnerAuth,
+	}, nil
+}
+
+func (b *caminoBuilder) NewAddProposalTx(
+	proposal *dao.Proposal,
+	bondAmount uint64,
+	proposerAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.AddProposalTx, error) {
+	ops := common.NewOptions(options)
+	ins, outs, err := b.lock(bondAmount, b.backend.BaseTxFee(), locked.StateBonded, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	proposerAuth, err := b.authorizeAddress(proposerAddress, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.AddProposalTx{
+		BaseTx:          b.baseTx(ins, outs, ops),
+		Proposal:        proposal,
+		ProposerAddress: proposerAddress,
+		ProposerAuth:    proposerAuth,
+	}, nil
+}
+
+func (b *caminoBuilder) NewAddVoteTx(
+	proposalID ids.ID,
+	option uint32,
+	voterAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.AddVoteTx, error) {
+	ops := common.NewOptions(options)
+	ins, outs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	voterAuth, err := b.authorizeAddress(voterAddress, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txs.AddVoteTx{
+		BaseTx:       b.baseTx(ins, outs, ops),
+		ProposalID:   proposalID,
+		Option:       option,
+		VoterAddress: voterAddress,
+		VoterAuth:    voterAuth,
+	}, nil
+}
+
+func (b *caminoBuilder) NewUpdateDepositOfferAllowListTx(
+	depositOfferID ids.ID,
+	depositOfferOwnerAddress ids.ShortID,
+	addedAddresses []ids.ShortID,
+	removedAddresses []ids.ShortID,
+	options ...common.Option,
+) (*txs.UpdateDepositOfferAllowListTx, error) {
+	ops := common.NewOptions(options)
+	ins, outs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	depositOfferOwnerAuth, err := b.authorizeAddress(depositOfferOwnerAddress, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	utils.Sort(addedAddresses)
+	utils.Sort(removedAddresses)
+	return &txs.UpdateDepositOfferAllowListTx{
+		BaseTx:                b.baseTx(ins, outs, ops),
+		DepositOfferID:        depositOfferID,
+		AddedAddresses:        addedAddresses,
+		RemovedAddresses:      removedAddresses,
+		DepositOfferOwnerAuth: depositOfferOwnerAuth,
+	}, nil
+}
+
+func (b *caminoBuilder) NewTreasuryConfigTx(
+	config *treasury.Config,
+	executor ids.ShortID,
+	options ...common.Option,
+) (*txs.TreasuryConfigTx, error) {
+	ops := common.NewOptions(options)
+	ins, outs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	executorAuth, err := b.authorizeAddress(executor, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	utils.Sort(config.Owner.Addrs)
+	return &txs.TreasuryConfigTx{
+		BaseTx:         b.baseTx(ins, outs, ops),
+		TreasuryConfig: *config,
+		Executor:       executor,
+		ExecutorAuth:   executorAuth,
+	}, nil
+}
+
+func (b *caminoBuilder) NewTreasurySpendTx(
+	treasuryUTXOs []*avax.UTXO,
+	outputs []*avax.TransferableOutput,
+	treasuryOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.TreasurySpendTx, error) {
+	ops := common.NewOptions(options)
+	avaxAssetID := b.backend.AVAXAssetID()
+	minIssuanceTime := ops.MinIssuanceTime()
+
+	treasuryIns := make([]*avax.TransferableInput, len(treasuryUTXOs))
+	treasuryAmount := uint64(0)
+	for i, utxo := range treasuryUTXOs {
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok || utxo.AssetID() != avaxAssetID ||
+			!treasury.IsTreasuryOwner(&out.OutputOwners) || out.Locktime > minIssuanceTime {
+			return nil, fmt.Errorf("%w: %s", errNotTreasuryUTXO, utxo.InputID())
+		}
+
+		var err error
+		treasuryAmount, err = math.Add64(treasuryAmount, out.Amt)
+		if err != nil {
+			return nil, err
+		}
+
+		treasuryIns[i] = &avax.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In: &secp256k1fx.TransferInput{
+				Amt:   out.Amt,
+				Input: secp256k1fx.Input{SigIndices: []uint32{}},
+			},
+		}
+	}
+
+	spentAmount := uint64(0)
+	for _, out := range outputs {
+		if out.AssetID() != avaxAssetID {
+			return nil, errNotAVAXOutput
+		}
+		var err error
+		spentAmount, err = math.Add64(spentAmount, out.Out.Amount())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if spentAmount > treasuryAmount {
+		return nil, fmt.Errorf(
+			"%w: provided treasury utxos have %d, but %d is spent",
+			errInsufficientFunds,
+			treasuryAmount,
+			spentAmount,
+		)
+	}
+
+	ins, outs, err := b.lock(0, b.backend.BaseTxFee(), locked.StateUnlocked, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	treasuryAuth, err := b.authorizeOwner(treasuryOwner, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	outs = append(outs, outputs...)
+	if treasuryChange := treasuryAmount - spentAmount; treasuryChange > 0 {
+		outs = append(outs, &avax.TransferableOutput{
+			Asset: avax.Asset{ID: avaxAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          treasuryChange,
+				OutputOwners: *treasury.Owner,
+			},
+		})
+	}
+	utils.Sort(treasuryIns)                       // sort treasury inputs
+	avax.SortTransferableOutputs(outs, txs.Codec) // sort outputs
+
+	return &txs.TreasurySpendTx{
+		BaseTx:       b.baseTx(ins, outs, ops),
+		TreasuryIns:  treasuryIns,
+		TreasuryAuth: treasuryAuth,
+	}, nil
+}
+
+func (b *caminoBuilder) baseTx(
+	ins []*avax.TransferableInput,
+	outs []*avax.TransferableOutput,
+	options *common.Options,
+) txs.BaseTx {
+	return txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    b.backend.NetworkID(),
+		BlockchainID: constants.PlatformChainID,
+		Ins:          ins,
+		Outs:         outs,
+		Memo:         options.Memo(),
+	}}
+}
+
+// lock consumes utxos to lock [amountToLock] with [appliedLockState] and to burn
+// [amountToBurn]. It follows the same rules as the node-side spend handler:
+// already locked utxos could be locked with another lock state, unlocked change
+// is sent to change owner unless consumed utxo is owned by multisig alias.
+func (b *caminoBuilder) lock(
+	amountToLock uint64,
+	amountToBurn uint64,
+	appliedLockState locked.State,
+	options *common.Options,
+) (
+	[]*avax.TransferableInput,
+	[]*avax.TransferableOutput,
+	error,
+) {
+	switch appliedLockState {
+	case locked.StateBonded,
+		locked.StateDeposited,
+		locked.StateUnlocked:
+	default:
+		return nil, nil, errInvalidTargetLockState
+	}
+
+	utxos, err := b.backend.UTXOs(options.Context(), constants.PlatformChainID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avaxAssetID := b.backend.AVAXAssetID()
+	utxo.SortUTXOs(utxos, avaxAssetID, appliedLockState)
+
+	addrs := options.Addresses(b.addrs)
+	minIssuanceTime := options.MinIssuanceTime()
+	msig := &aliasGetter{ctx: options.Context(), aliases: b.aliases}
+
+	addr, ok := addrs.Peek()
+	if !ok {
+		return nil, nil, errNoChangeAddress
+	}
+	changeOwner := options.ChangeOwner(&secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr},
+	})
+	changeOwnerID, err := txs.GetOwnerID(changeOwner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type lockedAndRemainedAmounts struct {
+		locked   uint64
+		remained uint64
+	}
+	type ownerAmounts struct {
+		owners *secp256k1fx.OutputOwners
+		// otherLockTxID -> amounts
+		// if appliedLockState == bond, then otherLockTxID is depositTxID and vice versa
+		amounts map[ids.ID]lockedAndRemainedAmounts
+	}
+	// ownerID -> owner amounts
+	insAmounts := make(map[ids.ID]*ownerAmounts)
+	getOwnerAmounts := func(ownerID ids.ID, owners *secp256k1fx.OutputOwners) *ownerAmounts {
+		amounts, ok := insAmounts[ownerID]
+		if !ok {
+			amounts = &ownerAmounts{
+				owners:  owners,
+				amounts: make(map[ids.ID]lockedAndRemainedAmounts),
+			}
+			insAmounts[ownerID] = amounts
+		}
+		return amounts
+	}
+
+	ins := []*avax.TransferableInput{}
+	outs := []*avax.TransferableOutput{}
+	amountLocked := uint64(0)
+	amountBurned := uint64(0)
+
+	for _, utxo := range utxos {
+		// If we have locked and burned enough, then we have no need to
+		// consume more utxos
+		if amountBurned >= amountToBurn && amountLocked >= amountToLock {
+			break
+		}
+
+		// utxos are sorted, so there is no more utxos of the asset we need
+		if utxo.AssetID() != avaxAssetID {
+			break
+		}
+
+		outIntf := utxo.Out
+		lockIDs := locked.IDsEmpty
+		if lockedOut, ok := outIntf.(*locked.Out); ok {
+			// Resolves to true for StateUnlocked
+			if lockedOut.IsLockedWith(appliedLockState) {
+				// utxos are sorted, so other utxos can't be locked with
+				// applied lock state too
+				break
+			}
+			outIntf = lockedOut.TransferableOut
+			lockIDs = lockedOut.IDs
+		}
+
+		out, ok := outIntf.(*secp256k1fx.TransferOutput)
+		if !ok {
+			// We only know how to clone secp256k1 outputs for now
+			continue
+		}
+
+		inputSigIndices, ok := common.MatchMultisigOwners(&out.OutputOwners, addrs, minIssuanceTime, msig)
+		if !ok {
+			// We couldn't spend this UTXO, so we skip to the next one
+			continue
+		}
+
+		outOwnerID, err := txs.GetOwnerID(&out.OutputOwners)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		remainingValue := out.Amt
+		lockedOwnerID, lockedOwner := outOwnerID, &out.OutputOwners
+		remainingOwnerID, remainingOwner := outOwnerID, &out.OutputOwners
+
+		if !lockIDs.IsLocked() {
+			// Burn any value that should be burned
+			amountToBurnFromUTXO := math.Min(
+				amountToBurn-amountBurned, // Amount we still need to burn
+				remainingValue,            // Amount available to burn
+			)
+			amountBurned += amountToBurnFromUTXO
+			remainingValue -= amountToBurnFromUTXO
+
+			isMultisigOwned, err := b.isMultisigOwned(&out.OutputOwners, msig)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !isMultisigOwned {
+				remainingOwnerID, remainingOwner = changeOwnerID, changeOwner
+			}
+		}
+
+		// Lock any value that should be locked
+		amountToLockFromUTXO := math.Min(
+			amountToLock-amountLocked, // Amount we still need to lock
+			remainingValue,            // Amount available to lock
+		)
+		amountLocked += amountToLockFromUTXO
+		remainingValue -= amountToLockFromUTXO
+
+		if amountToLockFromUTXO == 0 && amountToBurn == 0 {
+			continue
+		}
+
+		var in avax.TransferableIn = &secp256k1fx.TransferInput{
+			Amt: out.Amt,
+			Input: secp256k1fx.Input{
+				SigIndices: inputSigIndices,
+			},
+		}
+		if lockIDs.IsLocked() {
+			in = &locked.In{
+				IDs:            lockIDs,
+				TransferableIn: in,
+			}
+		}
+		ins = append(ins, &avax.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In:     in,
+		})
+
+		otherLockTxID := lockIDs.DepositTxID
+		if appliedLockState == locked.StateDeposited {
+			otherLockTxID = lockIDs.BondTxID
+		}
+
+		lockedOwnerAmounts := getOwnerAmounts(lockedOwnerID, lockedOwner)
+		amounts := lockedOwnerAmounts.amounts[otherLockTxID]
+		if amounts.locked, err = math.Add64(amounts.locked, amountToLockFromUTXO); err != nil {
+			return nil, nil, err
+		}
+		lockedOwnerAmounts.amounts[otherLockTxID] = amounts
+
+		remainingOwnerAmounts := getOwnerAmounts(remainingOwnerID, remainingOwner)
+		amounts = remainingOwnerAmounts.amounts[otherLockTxID]
+		if amounts.remained, err = math.Add64(amounts.remained, remainingValue); err != nil {
+			return nil, nil, err
+		}
+		remainingOwnerAmounts.amounts[otherLockTxID] = amounts
+	}
+
+	if amountBurned < amountToBurn || amountLocked < amountToLock {
+		return nil, nil, fmt.Errorf(
+			"%w: provided UTXOs need %d more units of asset %q to lock and %d more to burn",
+			errInsufficientFunds,
+			amountToLock-amountLocked,
+			avaxAssetID,
+			amountToBurn-amountBurned,
+		)
+	}
+
+	for _, ownerAmounts := range insAmounts {
+		addOut := func(amount uint64, lockIDs locked.IDs) {
+			if amount == 0 {
+				return
+			}
+			var out avax.TransferableOut = &secp256k1fx.TransferOutput{
+				Amt:          amount,
+				OutputOwners: *ownerAmounts.owners,
+			}
+			if lockIDs.IsLocked() {
+				out = &locked.Out{
+					IDs:             lockIDs,
+					TransferableOut: out,
+				}
+			}
+			outs = append(outs, &avax.TransferableOutput{
+				Asset: avax.Asset{ID: avaxAssetID},
+				Out:   out,
+			})
+		}
+
+		for otherLockTxID, amounts := range ownerAmounts.amounts {
+			lockIDs := locked.IDs{}
+			switch appliedLockState {
+			case locked.StateBonded:
+				lockIDs.DepositTxID = otherLockTxID
+			case locked.StateDeposited:
+				lockIDs.BondTxID = otherLockTxID
+			}
+
+			newLockIDs := lockIDs.Lock(appliedLockState)
+			if !newLockIDs.IsLocked() {
+				// Unlocked amounts are compacted into one output
+				remained, err := math.Add64(amounts.locked, amounts.remained)
+				if err != nil {
+					return nil, nil, err
+				}
+				addOut(remained, lockIDs)
+				continue
+			}
+			addOut(amounts.locked, newLockIDs)
+			addOut(amounts.remained, lockIDs)
+		}
+	}
+
+	utils.Sort(ins)                               // sort inputs
+	avax.SortTransferableOutputs(outs, txs.Codec) // sort outputs
+	return ins, outs, nil
+}
+
+// unlockDeposit consumes deposited utxos to unlock [unlockableAmounts] of their deposits.
+// Returned inputs and outputs aren't sorted.
+func (b *caminoBuilder) unlockDeposit(
+	unlockableAmounts map[ids.ID]uint64,
+	options *common.Options,
+) (
+	[]*avax.TransferableInput,
+	[]*avax.TransferableOutput,
+	error,
+) {
+	utxos, err := b.backend.UTXOs(options.Context(), constants.PlatformChainID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	depositTxIDs := set.NewSet[ids.ID](len(unlockableAmounts))
+	remainingAmounts := make(map[ids.ID]uint64, len(unlockableAmounts))
+	for depositTxID, amount := range unlockableAmounts {
+		depositTxIDs.Add(depositTxID)
+		remainingAmounts[depositTxID] = amount
+	}
+
+	avaxAssetID := b.backend.AVAXAssetID()
+	addrs := options.Addresses(b.addrs)
+	minIssuanceTime := options.MinIssuanceTime()
+	msig := &aliasGetter{ctx: options.Context(), aliases: b.aliases}
+
+	ins := []*avax.TransferableInput{}
+	outs := []*avax.TransferableOutput{}
+	for _, utxo := range utxos {
+		lockedOut, ok := utxo.Out.(*locked.Out)
+		if !ok || utxo.AssetID() != avaxAssetID {
+			// This output isn't locked
+			continue
+		} else if !lockedOut.IDs.Match(locked.StateDeposited, depositTxIDs) {
+			// This output isn't deposited by one of given deposit txs
+			continue
+		}
+
+		unlockableAmount := remainingAmounts[lockedOut.DepositTxID]
+		if unlockableAmount == 0 {
+			// This deposit doesn't have tokens available for unlock
+			continue
+		}
+
+		out, ok := lockedOut.TransferableOut.(*secp256k1fx.TransferOutput)
+		if !ok {
+			// We only know how to clone secp256k1 outputs for now
+			continue
+		}
+
+		inputSigIndices, ok := common.MatchMultisigOwners(&out.OutputOwners, addrs, minIssuanceTime, msig)
+		if !ok {
+			// We couldn't spend this UTXO, so we skip to the next one
+			continue
+		}
+
+		ins = append(ins, &avax.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In: &locked.In{
+				IDs: lockedOut.IDs,
+				TransferableIn: &secp256k1fx.TransferInput{
+					Amt: out.Amt,
+					Input: secp256k1fx.Input{
+						SigIndices: inputSigIndices,
+					},
+				},
+			},
+		})
+
+		amountToUnlock := math.Min(unlockableAmount, out.Amt)
+		remainingAmounts[lockedOut.DepositTxID] -= amountToUnlock
+
+		var unlockedOut avax.TransferableOut = &secp256k1fx.TransferOutput{
+			Amt:          amountToUnlock,
+			OutputOwners: out.OutputOwners,
+		}
+		if newLockIDs := lockedOut.Unlock(locked.StateDeposited); newLockIDs.IsLocked() {
+			unlockedOut = &locked.Out{
+				IDs:             newLockIDs,
+				TransferableOut: unlockedOut,
+			}
+		}
+		outs = append(outs, &avax.TransferableOutput{
+			Asset: utxo.Asset,
+			Out:   unlockedOut,
+		})
+
+		// This input had extra value, so some of it must stay deposited
+		if remainingValue := out.Amt - amountToUnlock; remainingValue > 0 {
+			outs = append(outs, &avax.TransferableOutput{
+				Asset: utxo.Asset,
+				Out: &locked.Out{
+					IDs: lockedOut.IDs,
+					TransferableOut: &secp256k1fx.TransferOutput{
+						Amt:          remainingValue,
+						OutputOwners: out.OutputOwners,
+					},
+				},
+			})
+		}
+	}
+	return ins, outs, nil
+}
+
+// transferDeposit consumes all deposited utxos of [depositTxID], that could be
+// spent, and sends them to [newOwner]. Lock IDs of utxos stay the same.
+// Returned inputs and outputs aren't sorted.
+func (b *caminoBuilder) transferDeposit(
+	depositTxID ids.ID,
+	newOwner *secp256k1fx.OutputOwners,
+	options *common.Options,
+) (
+	[]*avax.TransferableInput,
+	[]*avax.TransferableOutput,
+	error,
+) {
+	utxos, err := b.backend.UTXOs(options.Context(), constants.PlatformChainID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avaxAssetID := b.backend.AVAXAssetID()
+	addrs := options.Addresses(b.addrs)
+	minIssuanceTime := options.MinIssuanceTime()
+	msig := &aliasGetter{ctx: options.Context(), aliases: b.aliases}
+
+	ins := []*avax.TransferableInput{}
+	outs := []*avax.TransferableOutput{}
+	for _, utxo := range utxos {
+		lockedOut, ok := utxo.Out.(*locked.Out)
+		if !ok || utxo.AssetID() != avaxAssetID || lockedOut.DepositTxID != depositTxID {
+			// This output isn't deposited by transferred deposit
+			continue
+		}
+
+		out, ok := lockedOut.TransferableOut.(*secp256k1fx.TransferOutput)
+		if !ok {
+			// We only know how to clone secp256k1 outputs for now
+			continue
+		}
+
+		inputSigIndices, ok := common.MatchMultisigOwners(&out.OutputOwners, addrs, minIssuanceTime, msig)
+		if !ok {
+			// We couldn't spend this UTXO, so we skip to the next one
+			continue
+		}
+
+		ins = append(ins, &avax.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In: &locked.In{
+				IDs: lockedOut.IDs,
+				TransferableIn: &secp256k1fx.TransferInput{
+					Amt: out.Amt,
+					Input: secp256k1fx.Input{
+						SigIndices: inputSigIndices,
+					},
+				},
+			},
+		})
+		outs = append(outs, &avax.TransferableOutput{
+			Asset: utxo.Asset,
+			Out: &locked.Out{
+				IDs: lockedOut.IDs,
+				TransferableOut: &secp256k1fx.TransferOutput{
+					Amt:          out.Amt,
+					OutputOwners: *newOwner,
+				},
+			},
+		})
+	}
+	return ins, outs, nil
+}
+
+// authorizeAddress returns auth, that could be used to verify
+// credential for [addr] directly or through multisig alias.
+func (b *caminoBuilder) authorizeAddress(addr ids.ShortID, options *common.Options) (*secp256k1fx.Input, error) {
+	return b.authorizeOwner(&secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr},
+	}, options)
+}
+
+// authorizeOwner returns auth, that could be used to verify credential for [owner].
+func (b *caminoBuilder) authorizeOwner(owner *secp256k1fx.OutputOwners, options *common.Options) (*secp256k1fx.Input, error) {
+	addrs := options.Addresses(b.addrs)
+	minIssuanceTime := options.MinIssuanceTime()
+	msig := &aliasGetter{ctx: options.Context(), aliases: b.aliases}
+	inputSigIndices, ok := common.MatchMultisigOwners(owner, addrs, minIssuanceTime, msig)
+	if !ok {
+		// We can't authorize the owner
+		return nil, errInsufficientAuthorization
+	}
+	return &secp256k1fx.Input{
+		SigIndices: inputSigIndices,
+	}, nil
+}
+
+// isMultisigOwned returns true, if any of [owners] addresses is multisig alias
+func (*caminoBuilder) isMultisigOwned(owners *secp256k1fx.OutputOwners, msig secp256k1fx.AliasGetter) (bool, error) {
+	for _, addr := range owners.Addrs {
+		_, err := msig.GetMultisigAlias(addr)
+		switch err {
+		case nil:
+			return true, nil
+		case database.ErrNotFound:
+		default:
+			return false, err
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,615 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"testing"
+
+	stdcontext "context"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/vms/types"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+const testTxFee = 10
+
+var testAVAXAssetID = ids.ID{'a', 'v', 'a', 'x'}
+
+type testBuilderBackend struct {
+	Context
+	utxos []*avax.UTXO
+	txs   map[ids.ID]*txs.Tx
+}
+
+func newTestBuilderBackend(utxos ...*avax.UTXO) *testBuilderBackend {
+	return &testBuilderBackend{
+		Context: NewContext(constants.UnitTestID, testAVAXAssetID, testTxFee, 0, 0, 0, 0, 0, 0, 0),
+		utxos:   utxos,
+		txs:     map[ids.ID]*txs.Tx{},
+	}
+}
+
+func (b *testBuilderBackend) UTXOs(_ stdcontext.Context, chainID ids.ID) ([]*avax.UTXO, error) {
+	if chainID != constants.PlatformChainID {
+		return nil, nil
+	}
+	return b.utxos, nil
+}
+
+func (b *testBuilderBackend) GetTx(_ stdcontext.Context, txID ids.ID) (*txs.Tx, error) {
+	tx, ok := b.txs[txID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return tx, nil
+}
+
+func (b *testBuilderBackend) GetUTXO(_ stdcontext.Context, chainID, utxoID ids.ID) (*avax.UTXO, error) {
+	if chainID == constants.PlatformChainID {
+		for _, utxo := range b.utxos {
+			if utxo.InputID() == utxoID {
+				return utxo, nil
+			}
+		}
+	}
+	return nil, database.ErrNotFound
+}
+
+func (b *testBuilderBackend) AddUTXO(_ stdcontext.Context, chainID ids.ID, utxo *avax.UTXO) error {
+	if chainID == constants.PlatformChainID {
+		b.utxos = append(b.utxos, utxo)
+	}
+	return nil
+}
+
+func (b *testBuilderBackend) RemoveUTXO(_ stdcontext.Context, chainID, utxoID ids.ID) error {
+	if chainID != constants.PlatformChainID {
+		return nil
+	}
+	for i, utxo := range b.utxos {
+		if utxo.InputID() == utxoID {
+			b.utxos = append(b.utxos[:i], b.utxos[i+1:]...)
+			return nil
+		}
+	}
+	return database.ErrNotFound
+}
+
+type testMultisigAliasGetter map[ids.ShortID]*multisig.AliasWithNonce
+
+func (g testMultisigAliasGetter) GetMultisigAlias(_ stdcontext.Context, aliasID ids.ShortID) (*multisig.AliasWithNonce, error) {
+	if alias, ok := g[aliasID]; ok {
+		return alias, nil
+	}
+	return nil, database.ErrNotFound
+}
+
+func testSnowContext() *snow.Context {
+	ctx := snow.DefaultContextTest()
+	ctx.NetworkID = constants.UnitTestID
+	ctx.ChainID = constants.PlatformChainID
+	ctx.AVAXAssetID = testAVAXAssetID
+	return ctx
+}
+
+func testUTXO(txID ids.ID, amount uint64, owner secp256k1fx.OutputOwners) *avax.UTXO {
+	return &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: txID},
+		Asset:  avax.Asset{ID: testAVAXAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          amount,
+			OutputOwners: owner,
+		},
+	}
+}
+
+func testOut(amount uint64, owner secp256k1fx.OutputOwners) *avax.TransferableOutput {
+	return &avax.TransferableOutput{
+		Asset: avax.Asset{ID: testAVAXAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          amount,
+			OutputOwners: owner,
+		},
+	}
+}
+
+func TestCaminoBuilderTreasuryConfigTx(t *testing.T) {
+	require := require.New(t)
+
+	_, feeAddr := generateTestKey(t)
+	_, adminAddr := generateTestKey(t)
+	_, ownerAddr1 := generateTestKey(t)
+	_, ownerAddr2 := generateTestKey(t)
+	feeOwner := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{feeAddr}}
+	feeUTXO := testUTXO(ids.ID{1}, testTxFee, feeOwner)
+
+	b := NewCaminoBuilder(
+		set.Set[ids.ShortID]{feeAddr: struct{}{}, adminAddr: struct{}{}},
+		newTestBuilderBackend(feeUTXO),
+		testMultisigAliasGetter{},
+	)
+
+	config := &treasury.Config{
+		Owner: secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ownerAddr1, ownerAddr2},
+		},
+		SpendingPeriod: 100,
+		SpendingCap:    1000,
+	}
+	utx, err := b.NewTreasuryConfigTx(config, adminAddr)
+	require.NoError(err)
+	require.NoError(utx.SyntacticVerify(testSnowContext()))
+	require.Equal(*config, utx.TreasuryConfig)
+	require.Equal(adminAddr, utx.Executor)
+	require.Equal(&secp256k1fx.Input{SigIndices: []uint32{0}}, utx.ExecutorAuth)
+	require.Len(utx.Ins, 1)
+	require.Empty(utx.Outs)
+
+	_, err = b.NewTreasuryConfigTx(config, ids.ShortID{1})
+	require.ErrorIs(err, errInsufficientAuthorization)
+}
+
+func TestCaminoBuilderTreasurySpendTx(t *testing.T) {
+	_, feeAddr := generateTestKey(t)
+	_, treasuryOwnerAddr := generateTestKey(t)
+	_, recipientAddr := generateTestKey(t)
+	feeOwner := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{feeAddr}}
+	treasuryOwner := &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{treasuryOwnerAddr}}
+	recipient := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{recipientAddr}}
+	lockedTreasuryOwner := *treasury.Owner
+	lockedTreasuryOwner.Locktime = 100
+
+	feeUTXO := testUTXO(ids.ID{1}, testTxFee, feeOwner)
+	treasuryUTXO1 := testUTXO(ids.ID{2}, 30, *treasury.Owner)
+	treasuryUTXO2 := testUTXO(ids.ID{3}, 70, *treasury.Owner)
+	lockedTreasuryUTXO := testUTXO(ids.ID{4}, 70, lockedTreasuryOwner)
+	notTreasuryUTXO := testUTXO(ids.ID{5}, 70, feeOwner)
+
+	tests := map[string]struct {
+		treasuryUTXOs []*avax.UTXO
+		outputs       []*avax.TransferableOutput
+		options       []common.Option
+		expectedOuts  []*avax.TransferableOutput
+		expectedErr   error
+	}{
+		"Not treasury utxo": {
+			treasuryUTXOs: []*avax.UTXO{treasuryUTXO1, notTreasuryUTXO},
+			outputs:       []*avax.TransferableOutput{testOut(60, recipient)},
+			expectedErr:   errNotTreasuryUTXO,
+		},
+		"Time-locked treasury utxo": {
+			treasuryUTXOs: []*avax.UTXO{treasuryUTXO1, lockedTreasuryUTXO},
+			outputs:       []*avax.TransferableOutput{testOut(60, recipient)},
+			options:       []common.Option{common.WithMinIssuanceTime(99)},
+			expectedErr:   errNotTreasuryUTXO,
+		},
+		"Not avax output": {
+			treasuryUTXOs: []*avax.UTXO{treasuryUTXO1, treasuryUTXO2},
+			outputs: []*avax.TransferableOutput{{
+				Asset: avax.Asset{ID: ids.ID{1}},
+				Out:   &secp256k1fx.TransferOutput{Amt: 60, OutputOwners: recipient},
+			}},
+			expectedErr: errNotAVAXOutput,
+		},
+		"Spent more than treasury utxos have": {
+			treasuryUTXOs: []*avax.UTXO{treasuryUTXO1},
+			outputs:       []*avax.TransferableOutput{testOut(60, recipient)},
+			expectedErr:   errInsufficientFunds,
+		},
+		"OK: with treasury change": {
+			treasuryUTXOs: []*avax.UTXO{treasuryUTXO2, treasuryUTXO1},
+			outputs:       []*avax.TransferableOutput{testOut(60, recipient)},
+			expectedOuts: []*avax.TransferableOutput{
+				testOut(60, recipient),
+				testOut(40, *treasury.Owner),
+			},
+		},
+		"OK: unlocked treasury utxo": {
+			treasuryUTXOs: []*avax.UTXO{lockedTreasuryUTXO},
+			outputs:       []*avax.TransferableOutput{testOut(70, recipient)},
+			options:       []common.Option{common.WithMinIssuanceTime(100)},
+			expectedOuts:  []*avax.TransferableOutput{testOut(70, recipient)},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			b := NewCaminoBuilder(
+				set.Set[ids.ShortID]{feeAddr: struct{}{}, treasuryOwnerAddr: struct{}{}},
+				newTestBuilderBackend(feeUTXO),
+				testMultisigAliasGetter{},
+			)
+
+			utx, err := b.NewTreasurySpendTx(tt.treasuryUTXOs, tt.outputs, treasuryOwner, tt.options...)
+			require.ErrorIs(err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+
+			require.NoError(utx.SyntacticVerify(testSnowContext()))
+			require.Equal(&secp256k1fx.Input{SigIndices: []uint32{0}}, utx.TreasuryAuth)
+			require.Len(utx.Ins, 1)
+			require.Equal(feeUTXO.InputID(), utx.Ins[0].InputID())
+			require.Len(utx.TreasuryIns, len(tt.treasuryUTXOs))
+			avax.SortTransferableOutputs(tt.expectedOuts, txs.Codec)
+			require.Equal(tt.expectedOuts, utx.Outs)
+		})
+	}
+}
+
+func TestCaminoBuilderAddressStateTx(t *testing.T) {
+	_, feeAddr := generateTestKey(t)
+	_, executorAddr := generateTestKey(t)
+	_, addr := generateTestKey(t)
+	feeUTXO := testUTXO(ids.ID{1}, testTxFee, secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{feeAddr}})
+
+	tests := map[string]struct {
+		remove                  bool
+		state                   txs.AddressStateBit
+		executor                ids.ShortID
+		kycExpiration           uint64
+		nodeDeferralEnd         uint64
+		expectedUpgradeVersion  codec.UpgradeVersionID
+		expectedKYCExpiration   uint64
+		expectedNodeDeferralEnd uint64
+		expectedErr             error
+	}{
+		"Without executor": {
+			state: txs.AddressStateBitConsortium,
+		},
+		"KYC expiration without executor": {
+			state:         txs.AddressStateBitKYCVerified,
+			kycExpiration: 100,
+			expectedErr:   errNoExecutor,
+		},
+		"Node deferral end without executor": {
+			state:           txs.AddressStateBitNodeDeferred,
+			nodeDeferralEnd: 100,
+			expectedErr:     errNoExecutor,
+		},
+		"Executor isn't controlled by builder": {
+			state:       txs.AddressStateBitConsortium,
+			executor:    ids.ShortID{1},
+			expectedErr: errInsufficientAuthorization,
+		},
+		"OK: with executor": {
+			state:                  txs.AddressStateBitConsortium,
+			executor:               executorAddr,
+			expectedUpgradeVersion: codec.UpgradeVersion1,
+		},
+		"OK: remove kyc verified": {
+			remove:                 true,
+			state:                  txs.AddressStateBitKYCVerified,
+			executor:               executorAddr,
+			expectedUpgradeVersion: codec.UpgradeVersion1,
+		},
+		"OK: add kyc verified with expiration": {
+			state:                  txs.AddressStateBitKYCVerified,
+			executor:               executorAddr,
+			kycExpiration:          100,
+			expectedUpgradeVersion: codec.UpgradeVersion2,
+			expectedKYCExpiration:  100,
+		},
+		"OK: add kyc verified without expiration": {
+			state:                  txs.AddressStateBitKYCVerified,
+			executor:               executorAddr,
+			expectedUpgradeVersion: codec.UpgradeVersion2,
+		},
+		"OK: add node deferred with deferral end": {
+			state:                   txs.AddressStateBitNodeDeferred,
+			executor:                executorAddr,
+			nodeDeferralEnd:         100,
+			expectedUpgradeVersion:  codec.UpgradeVersion3,
+			expectedNodeDeferralEnd: 100,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			b := NewCaminoBuilder(
+				set.Set[ids.ShortID]{feeAddr: struct{}{}, executorAddr: struct{}{}},
+				newTestBuilderBackend(feeUTXO),
+				testMultisigAliasGetter{},
+			)
+
+			utx, err := b.NewAddressStateTx(addr, tt.remove, tt.state, tt.executor, tt.kycExpiration, tt.nodeDeferralEnd)
+			require.ErrorIs(err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+
+			require.NoError(utx.SyntacticVerify(testSnowContext()))
+			require.Equal(tt.expectedUpgradeVersion, utx.UpgradeVersionID)
+			require.Equal(tt.executor, utx.Executor)
+			require.Equal(tt.expectedKYCExpiration, utx.KYCExpiration)
+			require.Equal(tt.expectedNodeDeferralEnd, utx.NodeDeferralEnd)
+			if tt.executor != ids.ShortEmpty {
+				require.Equal(&secp256k1fx.Input{SigIndices: []uint32{0}}, utx.ExecutorAuth)
+			}
+		})
+	}
+}
+
+func TestCaminoBuilderMultisigAliasTx(t *testing.T) {
+	_, feeAddr := generateTestKey(t)
+	_, ownerAddr1 := generateTestKey(t)
+	_, ownerAddr2 := generateTestKey(t)
+	_, ownerAddr3 := generateTestKey(t)
+	feeUTXO := testUTXO(ids.ID{1}, testTxFee, secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{feeAddr}})
+	ownerAddrs := sortedAddrs(ownerAddr1, ownerAddr2, ownerAddr3)
+
+	weightedAlias := &multisig.AliasWithNonce{Alias: multisig.Alias{
+		ID: ids.ShortID{1},
+		Owners: &secp256k1fx.WeightedOutputOwners{
+			Threshold: 3,
+			Addrs:     ownerAddrs,
+			Weights:   []uint32{2, 1, 1},
+		},
+	}}
+	alias := &multisig.AliasWithNonce{Alias: multisig.Alias{
+		ID: ids.ShortID{2},
+		Owners: &secp256k1fx.OutputOwners{
+			Threshold: 2,
+			Addrs:     ownerAddrs,
+		},
+	}}
+	newOwners := &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{ownerAddr1}}
+
+	tests := map[string]struct {
+		alias              *multisig.Alias
+		addrs              set.Set[ids.ShortID]
+		expectedSigIndices []uint32
+		expectedErr        error
+	}{
+		"New alias": {
+			alias:              &multisig.Alias{Owners: newOwners},
+			addrs:              set.Set[ids.ShortID]{feeAddr: struct{}{}},
+			expectedSigIndices: nil,
+		},
+		"Unknown alias": {
+			alias:       &multisig.Alias{ID: ids.ShortID{3}, Owners: newOwners},
+			addrs:       set.Set[ids.ShortID]{feeAddr: struct{}{}},
+			expectedErr: database.ErrNotFound,
+		},
+		"Alias threshold isn't met": {
+			alias:       &multisig.Alias{ID: alias.ID, Owners: newOwners},
+			addrs:       set.Set[ids.ShortID]{feeAddr: struct{}{}, ownerAddrs[0]: struct{}{}},
+			expectedErr: errInsufficientAuthorization,
+		},
+		"OK: alias": {
+			alias:              &multisig.Alias{ID: alias.ID, Owners: newOwners},
+			addrs:              set.Set[ids.ShortID]{feeAddr: struct{}{}, ownerAddrs[0]: struct{}{}, ownerAddrs[2]: struct{}{}},
+			expectedSigIndices: []uint32{0, 2},
+		},
+		"Weighted alias threshold isn't met": {
+			alias:       &multisig.Alias{ID: weightedAlias.ID, Owners: newOwners},
+			addrs:       set.Set[ids.ShortID]{feeAddr: struct{}{}, ownerAddrs[1]: struct{}{}, ownerAddrs[2]: struct{}{}},
+			expectedErr: errInsufficientAuthorization,
+		},
+		"OK: weighted alias": {
+			alias:              &multisig.Alias{ID: weightedAlias.ID, Owners: newOwners},
+			addrs:              set.Set[ids.ShortID]{feeAddr: struct{}{}, ownerAddrs[0]: struct{}{}, ownerAddrs[2]: struct{}{}},
+			expectedSigIndices: []uint32{0, 2},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			b := NewCaminoBuilder(
+				tt.addrs,
+				newTestBuilderBackend(feeUTXO),
+				testMultisigAliasGetter{weightedAlias.ID: weightedAlias, alias.ID: alias},
+			)
+
+			utx, err := b.NewMultisigAliasTx(tt.alias)
+			require.ErrorIs(err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+
+			require.NoError(utx.SyntacticVerify(testSnowContext()))
+			require.Equal(*tt.alias, utx.MultisigAlias)
+			require.Equal(tt.expectedSigIndices, utx.Auth.(*secp256k1fx.Input).SigIndices)
+		})
+	}
+}
+
+func TestCaminoBuilderTransferDepositTx(t *testing.T) {
+	_, feeAddr := generateTestKey(t)
+	_, ownerAddr := generateTestKey(t)
+	_, rewardOwnerAddr := generateTestKey(t)
+	_, newOwnerAddr := generateTestKey(t)
+	feeUTXO := testUTXO(ids.ID{1}, testTxFee, secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{feeAddr}})
+	owner := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{ownerAddr}}
+	rewardOwner := &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{rewardOwnerAddr}}
+	newOwner := &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{newOwnerAddr}}
+
+	depositTxID := ids.ID{10}
+	depositedUTXO := func(txID ids.ID, amount uint64, lockIDs locked.IDs, owner secp256k1fx.OutputOwners) *avax.UTXO {
+		utxo := testUTXO(txID, amount, owner)
+		utxo.Out = &locked.Out{IDs: lockIDs, TransferableOut: utxo.Out.(avax.TransferableOut)}
+		return utxo
+	}
+	depositedOut := func(amount uint64, lockIDs locked.IDs, owner secp256k1fx.OutputOwners) *avax.TransferableOutput {
+		out := testOut(amount, owner)
+		out.Out = &locked.Out{IDs: lockIDs, TransferableOut: out.Out}
+		return out
+	}
+	depositedUTXO1 := depositedUTXO(ids.ID{2}, 100, locked.IDs{DepositTxID: depositTxID}, owner)
+	depositedBondedUTXO := depositedUTXO(ids.ID{3}, 50, locked.IDs{DepositTxID: depositTxID, BondTxID: ids.ID{11}}, owner)
+	otherDepositUTXO := depositedUTXO(ids.ID{4}, 70, locked.IDs{DepositTxID: ids.ID{12}}, owner)
+
+	tests := map[string]struct {
+		addrs          set.Set[ids.ShortID]
+		newRewardOwner *secp256k1fx.OutputOwners
+		newOwner       *secp256k1fx.OutputOwners
+		expectedAuth   *secp256k1fx.Input
+		expectedIns    int
+		expectedOuts   []*avax.TransferableOutput
+		expectedErr    error
+	}{
+		"Nothing transferred": {
+			addrs:          set.Set[ids.ShortID]{feeAddr: struct{}{}, ownerAddr: struct{}{}},
+			newRewardOwner: rewardOwner,
+			expectedErr:    errNothingToTransfer,
+		},
+		"Deposited utxos can't be spent": {
+			addrs:          set.Set[ids.ShortID]{feeAddr: struct{}{}},
+			newRewardOwner: rewardOwner,
+			newOwner:       newOwner,
+			expectedErr:    errNothingToTransfer,
+		},
+		"Reward owner isn't controlled by builder": {
+			addrs:          set.Set[ids.ShortID]{feeAddr: struct{}{}},
+			newRewardOwner: newOwner,
+			expectedErr:    errInsufficientAuthorization,
+		},
+		"OK: transfer reward owner": {
+			addrs:          set.Set[ids.ShortID]{feeAddr: struct{}{}, rewardOwnerAddr: struct{}{}},
+			newRewardOwner: newOwner,
+			expectedAuth:   &secp256k1fx.Input{SigIndices: []uint32{0}},
+			expectedIns:    1,
+			expectedOuts:   []*avax.TransferableOutput{},
+		},
+		"OK: transfer deposited utxos": {
+			addrs:          set.Set[ids.ShortID]{feeAddr: struct{}{}, ownerAddr: struct{}{}},
+			newRewardOwner: rewardOwner,
+			newOwner:       newOwner,
+			expectedAuth:   &secp256k1fx.Input{},
+			expectedIns:    3,
+			expectedOuts: []*avax.TransferableOutput{
+				depositedOut(100, depositedUTXO1.Out.(*locked.Out).IDs, *newOwner),
+				depositedOut(50, depositedBondedUTXO.Out.(*locked.Out).IDs, *newOwner),
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			b := NewCaminoBuilder(
+				tt.addrs,
+				newTestBuilderBackend(feeUTXO, depositedUTXO1, depositedBondedUTXO, otherDepositUTXO),
+				testMultisigAliasGetter{},
+			)
+
+			utx, err := b.NewTransferDepositTx(depositTxID, rewardOwner, tt.newRewardOwner, tt.newOwner)
+			require.ErrorIs(err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+
+			require.NoError(utx.SyntacticVerify(testSnowContext()))
+			require.Equal(depositTxID, utx.DepositTxID)
+			require.Equal(tt.newRewardOwner, utx.NewRewardOwner)
+			require.Equal(tt.expectedAuth, utx.RewardOwnerAuth)
+			require.Len(utx.Ins, tt.expectedIns)
+			avax.SortTransferableOutputs(tt.expectedOuts, txs.Codec)
+			require.Equal(tt.expectedOuts, utx.Outs)
+		})
+	}
+}
+
+func TestCaminoBuilderAddProposalTx(t *testing.T) {
+	require := require.New(t)
+
+	_, addr := generateTestKey(t)
+	owner := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}}
+	utxo := testUTXO(ids.ID{1}, 100+testTxFee, owner)
+
+	b := NewCaminoBuilder(
+		set.Set[ids.ShortID]{addr: struct{}{}},
+		newTestBuilderBackend(utxo),
+		testMultisigAliasGetter{},
+	)
+
+	proposal := &dao.Proposal{
+		Start:   100,
+		End:     100 + dao.MinProposalDuration,
+		Options: []types.JSONByteSlice{{1}, {2}},
+	}
+	utx, err := b.NewAddProposalTx(proposal, 100, addr)
+	require.NoError(err)
+	require.NoError(utx.SyntacticVerify(testSnowContext()))
+	require.Equal(proposal, utx.Proposal)
+	require.Equal(addr, utx.ProposerAddress)
+	require.Equal(&secp256k1fx.Input{SigIndices: []uint32{0}}, utx.ProposerAuth)
+	require.Equal(uint64(100), utx.BondAmount())
+
+	_, err = b.NewAddProposalTx(proposal, 101, addr)
+	require.ErrorIs(err, errInsufficientFunds)
+}
+
+func TestCaminoBuilderAddVoteTx(t *testing.T) {
+	require := require.New(t)
+
+	_, feeAddr := generateTestKey(t)
+	_, voterAddr := generateTestKey(t)
+	feeUTXO := testUTXO(ids.ID{1}, testTxFee, secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{feeAddr}})
+
+	b := NewCaminoBuilder(
+		set.Set[ids.ShortID]{feeAddr: struct{}{}, voterAddr: struct{}{}},
+		newTestBuilderBackend(feeUTXO),
+		testMultisigAliasGetter{},
+	)
+
+	utx, err := b.NewAddVoteTx(ids.ID{1}, 1, voterAddr)
+	require.NoError(err)
+	require.NoError(utx.SyntacticVerify(testSnowContext()))
+	require.Equal(ids.ID{1}, utx.ProposalID)
+	require.Equal(uint32(1), utx.Option)
+	require.Equal(voterAddr, utx.VoterAddress)
+	require.Equal(&secp256k1fx.Input{SigIndices: []uint32{0}}, utx.VoterAuth)
+
+	_, err = b.NewAddVoteTx(ids.ID{1}, 1, ids.ShortID{1})
+	require.ErrorIs(err, errInsufficientAuthorization)
+}
+
+func TestCaminoBuilderUpdateDepositOfferTx(t *testing.T) {
+	require := require.New(t)
+
+	_, feeAddr := generateTestKey(t)
+	_, updaterAddr := generateTestKey(t)
+	feeUTXO := testUTXO(ids.ID{1}, testTxFee, secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{feeAddr}})
+
+	b := NewCaminoBuilder(
+		set.Set[ids.ShortID]{feeAddr: struct{}{}, updaterAddr: struct{}{}},
+		newTestBuilderBackend(feeUTXO),
+		testMultisigAliasGetter{},
+	)
+
+	utx, err := b.NewUpdateDepositOfferTx(ids.ID{1}, deposit.OfferFlagLocked, 100, 0, 1000, updaterAddr)
+	require.NoError(err)
+	require.NoError(utx.SyntacticVerify(testSnowContext()))
+	require.Equal(ids.ID{1}, utx.DepositOfferID)
+	require.Equal(deposit.OfferFlagLocked, utx.Flags)
+	require.Equal(uint64(100), utx.End)
+	require.Zero(utx.TotalMaxAmount)
+	require.Equal(uint64(1000), utx.TotalMaxRewardAmount)
+	require.Equal(updaterAddr, utx.DepositOfferUpdaterAddress)
+	require.Equal(&secp256k1fx.Input{SigIndices: []uint32{0}}, utx.DepositOfferUpdaterAuth)
+
+	_, err = b.NewUpdateDepositOfferTx(ids.ID{1}, 0, 100, 0, 0, ids.ShortID{1})
+	require.ErrorIs(err, errInsufficientAuthorization)
+}
@@ -0,0 +1,275 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+var _ CaminoBuilder = (*caminoBuilderWithOptions)(nil)
+
+type caminoBuilderWithOptions struct {
+	Builder
+	caminoBuilder CaminoBuilder
+	options       []common.Option
+}
+
+// NewCaminoBuilderWithOptions returns a new Camino transaction builder that will
+// use the given options by default.
+//
+//   - [builder] is the builder that will be called to perform the underlying
+//     operations.
+//   - [options] will be provided to the builder in addition to the options
+//     provided in the method calls.
+func NewCaminoBuilderWithOptions(builder CaminoBuilder, options ...common.Option) CaminoBuilder {
+	return &caminoBuilderWithOptions{
+		Builder:       NewBuilderWithOptions(builder, options...),
+		caminoBuilder: builder,
+		options:       options,
+	}
+}
+
+func (b *caminoBuilderWithOptions) NewCaminoAddValidatorTx(
+	vdr *txs.Validator,
+	nodeOwnerAddress ids.ShortID,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	shares uint32,
+	options ...common.Option,
+) (*txs.CaminoAddValidatorTx, error) {
+	return b.caminoBuilder.NewCaminoAddValidatorTx(
+		vdr,
+		nodeOwnerAddress,
+		rewardsOwner,
+		shares,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *caminoBuilderWithOptions) NewExtendValidatorTx(
+	validatorTxID ids.ID,
+	endTime uint64,
+	bondAmount uint64,
+	nodeOwnerAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.ExtendValidatorTx, error) {
+	return b.caminoBuilder.NewExtendValidatorTx(
+		validatorTxID,
+		endTime,
+		bondAmount,
+		nodeOwnerAddress,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *caminoBuilderWithOptions) NewAddressStateTx(
+	address ids.ShortID,
+	remove bool,
+	state txs.AddressStateBit,
+	executor ids.ShortID,
+	kycExpiration uint64,
+	nodeDeferralEnd uint64,
+	options ...common.Option,
+) (*txs.AddressStateTx, error) {
+	return b.caminoBuilder.NewAddressStateTx(
+		address,
+		remove,
+		state,
+		executor,
+		kycExpiration,
+		nodeDeferralEnd,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *caminoBuilderWithOptions) NewDepositTx(
+	depositOfferID ids.ID,
+	duration uint32,
+	amount uint64,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	depositCreatorAddress ids.ShortID,
+	depositOfferOwnerAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.DepositTx, error) {
+	return b.caminoBuilder.NewDepositTx(
+		depositOfferID,
+		duration,
+		amount,
+		rewardsOwner,
+		depositCreatorAddress,
+		depositOfferOwnerAddress,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *caminoBuilderWithOptions) NewUnlockDepositTx(
+	unlockableAmounts map[ids.ID]uint64,
+	options ...common.Option,
+) (*txs.UnlockDepositTx, error) {
+	return b.caminoBuilder.NewUnlockDepositTx(
+		unlockableAmounts,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *caminoBuilderWithOptions) NewClaimTx(
+	claimables []*Claimable,
+	claimTo *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.ClaimTx, error) {
+	return b.caminoBuilder.NewClaimTx(
+		claimables,
+		claimTo,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *caminoBuilderWithOptions) NewRegisterNodeTx(
+	oldNodeID ids.NodeID,
+	newNodeID ids.NodeID,
+	nodeOwnerAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.RegisterNodeTx, error) {
+	return b.caminoBuilder.NewRegisterNodeTx(
+		oldNodeID,
+		newNodeID,
+		nodeOwnerAddress,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *caminoBuilderWithOptions) NewMultisigAliasTx(
+	alias *multisig.Alias,
+	options ...common.Option,
+) (*txs.MultisigAliasTx, error) {
+	return b.caminoBuilder.NewMultisigAliasTx(
+		alias,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *caminoBuilderWithOptions) NewAddDepositOfferTx(
+	offer *deposit.Offer,
+	depositOfferCreatorAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.AddDepositOfferTx, error) {
+	return b.caminoBuilder.NewAddDepositOfferTx(
+		offer,
+		depositOfferCreatorAddress,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *caminoBuilderWithOptions) NewUpdateDepositOfferTx(
+	depositOfferID ids.ID,
+	flags deposit.OfferFlag,
+	end uint64,
+	totalMaxAmount uint64,
+	totalMaxRewardAmount uint64,
+	depositOfferUpdaterAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.UpdateDepositOfferTx, error) {
+	return b.caminoBuilder.NewUpdateDepositOfferTx(
+		depositOfferID,
+		flags,
+		end,
+		totalMaxAmount,
+		totalMaxRewardAmount,
+		depositOfferUpdaterAddress,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *caminoBuilderWithOptions) NewTransferDepositTx(
+	depositTxID ids.ID,
+	rewardOwner *secp256k1fx.OutputOwners,
+	newRewardOwner *secp256k1fx.OutputOwners,
+	newOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.TransferDepositTx, error) {
+	return b.caminoBuilder.NewTransferDepositTx(
+		depositTxID,
+		rewardOwner,
+		newRewardOwner,
+		newOwner,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *caminoBuilderWithOptions) NewAddProposalTx(
+	proposal *dao.Proposal,
+	bondAmount uint64,
+	proposerAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.AddProposalTx, error) {
+	return b.caminoBuilder.NewAddProposalTx(
+		proposal,
+		bondAmount,
+		proposerAddress,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *caminoBuilderWithOptions) NewAddVoteTx(
+	proposalID ids.ID,
+	option uint32,
+	voterAddress ids.ShortID,
+	options ...common.Option,
+) (*txs.AddVoteTx, error) {
+	return b.caminoBuilder.NewAddVoteTx(
+		proposalID,
+		option,
+		voterAddress,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *caminoBuilderWithOptions) NewUpdateDepositOfferAllowListTx(
+	depositOfferID ids.ID,
+	depositOfferOwnerAddress ids.ShortID,
+	addedAddresses []ids.ShortID,
+	removedAddresses []ids.ShortID,
+	options ...common.Option,
+) (*txs.UpdateDepositOfferAllowListTx, error) {
+	return b.caminoBuilder.NewUpdateDepositOfferAllowListTx(
+		depositOfferID,
+		depositOfferOwnerAddress,
+		addedAddresses,
+		removedAddresses,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *caminoBuilderWithOptions) NewTreasuryConfigTx(
+	config *treasury.Config,
+	executor ids.ShortID,
+	options ...common.Option,
+) (*txs.TreasuryConfigTx, error) {
+	return b.caminoBuilder.NewTreasuryConfigTx(
+		config,
+		executor,
+		common.UnionOptions(b.options, options)...,
+	)
+}
+
+func (b *caminoBuilderWithOptions) NewTreasurySpendTx(
+	treasuryUTXOs []*avax.UTXO,
+	outputs []*avax.TransferableOutput,
+	treasuryOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (*txs.TreasurySpendTx, error) {
+	return b.caminoBuilder.NewTreasurySpendTx(
+		treasuryUTXOs,
+		outputs,
+		treasuryOwner,
+		common.UnionOptions(b.options, options)...,
+	)
+}
@@ -0,0 +1,325 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	stdcontext "context"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	_ secp256k1fx.AliasGetter = (*PartiallySignedTx)(nil)
+
+	errNoOwners            = errors.New("partially signed tx has no owners")
+	errWrongSignature      = errors.New("signature doesn't match address")
+	errUnsignedTxMismatch  = errors.New("partially signed txs have different unsigned txs")
+	errNotEnoughSignatures = errors.New("not enough signatures to authorize credential")
+
+	secpFactory secp256k1.Factory
+)
+
+// AddressSignature is a signature of unsigned tx bytes made by address
+type AddressSignature struct {
+	Address   ids.ShortID                  `serialize:"true" json:"address"`
+	Signature [secp256k1.SignatureLen]byte `serialize:"true" json:"signature"`
+}
+
+// PartiallySignedTx is a portable container, that allows co-signers of multisig aliases
+// to sign tx independently on different machines and then combine their signatures.
+//
+// Signing session:
+//  1. Session creator builds unsigned tx and creates PartiallySignedTx with NewPartiallySignedTx.
+//  2. Every co-signer parses container with ParsePartiallySignedTx, signs it with Sign
+//     and sends container Bytes back.
+//  3. Session creator combines received containers with AddSignatures and calls Finalize,
+//     when enough signatures are collected.
+type PartiallySignedTx struct {
+	// Unsigned tx, that is being signed
+	Unsigned txs.UnsignedTx `serialize:"true" json:"unsignedTx"`
+	// Owners, that must authorize tx credentials, in credentials order
+	Owners []*secp256k1fx.OutputOwners `serialize:"true" json:"owners"`
+	// Multisig aliases, that are used by owners directly or through nested aliases
+	Aliases []*multisig.AliasWithNonce `serialize:"true" json:"aliases"`
+	// Signatures of unsigned tx bytes, sorted by address
+	Signatures []AddressSignature `serialize:"true" json:"signatures"`
+
+	unsignedBytes []byte
+}
+
+// NewPartiallySignedTx creates new partially signed tx without signatures.
+// [owners] are owners of tx credentials in credentials order. Multisig aliases used by owners
+// are collected with [msig], so co-signers don't need access to the chain state.
+func NewPartiallySignedTx(
+	utx txs.UnsignedTx,
+	owners []*secp256k1fx.OutputOwners,
+	msig secp256k1fx.AliasGetter,
+) (*PartiallySignedTx, error) {
+	if len(owners) == 0 {
+		return nil, errNoOwners
+	}
+
+	aliases := []*multisig.AliasWithNonce{}
+	collectedAliases := set.Set[ids.ShortID]{}
+	for _, owner := range owners {
+		if err := secp256k1fx.TraverseAliases(owner, msig, func(alias *multisig.AliasWithNonce) {
+			if !collectedAliases.Contains(alias.ID) {
+				collectedAliases.Add(alias.ID)
+				aliases = append(aliases, alias)
+			}
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	psTx := &PartiallySignedTx{
+		Unsigned:   utx,
+		Owners:     owners,
+		Aliases:    aliases,
+		Signatures: []AddressSignature{},
+	}
+	if err := psTx.initialize(); err != nil {
+		return nil, err
+	}
+	return psTx, nil
+}
+
+// ParsePartiallySignedTx parses partially signed tx from bytes created by Bytes.
+// Included signatures are verified against unsigned tx.
+func ParsePartiallySignedTx(psTxBytes []byte) (*PartiallySignedTx, error) {
+	psTx := &PartiallySignedTx{}
+	if _, err := txs.Codec.Unmarshal(psTxBytes, psTx); err != nil {
+		return nil, fmt.Errorf("couldn't parse partially signed tx: %w", err)
+	}
+	if len(psTx.Owners) == 0 {
+		return nil, errNoOwners
+	}
+	if err := psTx.initialize(); err != nil {
+		return nil, err
+	}
+	signatures := psTx.Signatures
+	psTx.Signatures = make([]AddressSignature, 0, len(signatures))
+	for _, signature := range signatures {
+		if _, ok := psTx.signature(signature.Address); ok {
+			continue
+		}
+		if err := psTx.addSignature(signature); err != nil {
+			return nil, err
+		}
+	}
+	return psTx, nil
+}
+
+// Bytes returns serialized partially signed tx, that could be sent to other co-signers
+func (psTx *PartiallySignedTx) Bytes() ([]byte, error) {
+	return txs.Codec.Marshal(txs.Version, psTx)
+}
+
+// GetMultisigAlias returns multisig alias included into partially signed tx
+func (psTx *PartiallySignedTx) GetMultisigAlias(aliasID ids.ShortID) (*multisig.AliasWithNonce, error) {
+	for _, alias := range psTx.Aliases {
+		if alias.ID == aliasID {
+			return alias, nil
+		}
+	}
+	return nil, database.ErrNotFound
+}
+
+// Sign adds signatures of all keys from [kc], that are owners of tx credentials
+// directly or through multisig aliases. Already present signatures are kept.
+// Keychains, that are able to sign with several addresses at once, are asked for all
+// signatures with a single request.
+func (psTx *PartiallySignedTx) Sign(kc keychain.Keychain) error {
+	addrs := []ids.ShortID{}
+	for _, addr := range psTx.signerAddresses().List() {
+		if _, ok := psTx.signature(addr); ok {
+			continue
+		}
+		if _, ok := kc.Get(addr); ok {
+			addrs = append(addrs, addr)
+		}
+	}
+	if len(addrs) == 0 {
+		return nil
+	}
+
+	sigs, err := signTx(kc, psTx.unsignedBytes, addrs)
+	if err != nil {
+		return fmt.Errorf("problem signing tx: %w", err)
+	}
+	for i, addr := range addrs {
+		signature := AddressSignature{Address: addr}
+		copy(signature.Signature[:], sigs[i])
+		if err := psTx.addSignature(signature); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddSignatures adds signatures collected by other co-signers in [other] partially signed tx.
+// Both partially signed txs must have the same unsigned tx.
+func (psTx *PartiallySignedTx) AddSignatures(other *PartiallySignedTx) error {
+	if !bytes.Equal(psTx.unsignedBytes, other.unsignedBytes) {
+		return errUnsignedTxMismatch
+	}
+	for _, signature := range other.Signatures {
+		if _, ok := psTx.signature(signature.Address); ok {
+			continue
+		}
+		if err := psTx.addSignature(signature); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Finalize returns signed tx with multisig credentials created from collected signatures.
+// Returns error, if collected signatures aren't enough to authorize any of tx credentials.
+func (psTx *PartiallySignedTx) Finalize() (*txs.Tx, error) {
+	creds := make([]verify.Verifiable, len(psTx.Owners))
+	for credIndex, owner := range psTx.Owners {
+		sigs := [][secp256k1.SignatureLen]byte{}
+		sigIdxs := []uint32{}
+
+		tf := func(addr ids.ShortID, totalVisited, totalVerified uint32) (bool, error) {
+			signature, ok := psTx.signature(addr)
+			if !ok {
+				return false, nil
+			}
+			// In case a nested alias doesnt meet threshold
+			if totalVerified < uint32(len(sigs)) {
+				sigs = sigs[:totalVerified]
+				sigIdxs = sigIdxs[:totalVerified]
+			}
+			sigs = append(sigs, signature.Signature)
+			sigIdxs = append(sigIdxs, totalVisited)
+			return true, nil
+		}
+
+		totalVerified, err := secp256k1fx.TraverseOwners(owner, psTx, tf)
+		if err != nil {
+			return nil, fmt.Errorf("%w (credential %d): %s", errNotEnoughSignatures, credIndex, err)
+		}
+
+		creds[credIndex] = &secp256k1fx.MultisigCredential{
+			Credential: secp256k1fx.Credential{Sigs: sigs[:totalVerified]},
+			SigIdxs:    sigIdxs[:totalVerified],
+		}
+	}
+
+	tx := &txs.Tx{Unsigned: psTx.Unsigned, Creds: creds}
+	if err := tx.Initialize(txs.Codec); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// InputsOwners returns owners of utxos consumed by [ins], that could be used
+// as credentials owners for NewPartiallySignedTx
+func InputsOwners(
+	ctx stdcontext.Context,
+	backend SignerBackend,
+	sourceChainID ids.ID,
+	ins []*avax.TransferableInput,
+) ([]*secp256k1fx.OutputOwners, error) {
+	owners := make([]*secp256k1fx.OutputOwners, len(ins))
+	for i, in := range ins {
+		utxo, err := backend.GetUTXO(ctx, sourceChainID, in.InputID())
+		if err != nil {
+			return nil, err
+		}
+
+		outIntf := utxo.Out
+		switch out := outIntf.(type) {
+		case *stakeable.LockOut:
+			outIntf = out.TransferableOut
+		case *locked.Out:
+			outIntf = out.TransferableOut
+		}
+
+		out, ok := outIntf.(*secp256k1fx.TransferOutput)
+		if !ok {
+			return nil, errUnknownOutputType
+		}
+		owners[i] = &out.OutputOwners
+	}
+	return owners, nil
+}
+
+func (psTx *PartiallySignedTx) initialize() error {
+	unsignedBytes, err := txs.Codec.Marshal(txs.Version, &psTx.Unsigned)
+	if err != nil {
+		return fmt.Errorf("couldn't marshal unsigned tx: %w", err)
+	}
+	psTx.unsignedBytes = unsignedBytes
+	return nil
+}
+
+// Returns non-alias addresses, that are owners of tx credentials directly or through multisig aliases
+func (psTx *PartiallySignedTx) signerAddresses() set.Set[ids.ShortID] {
+	aliasIDs := set.NewSet[ids.ShortID](len(psTx.Aliases))
+	addrs := set.Set[ids.ShortID]{}
+	for _, alias := range psTx.Aliases {
+		aliasIDs.Add(alias.ID)
+		if owners, ok := alias.Owners.(interface {
+			AddressesSet() set.Set[ids.ShortID]
+		}); ok {
+			addrs.Union(owners.AddressesSet())
+		}
+	}
+	for _, owner := range psTx.Owners {
+		addrs.Add(owner.Addrs...)
+	}
+	addrs.Difference(aliasIDs)
+	return addrs
+}
+
+func (psTx *PartiallySignedTx) signature(addr ids.ShortID) (AddressSignature, bool) {
+	for _, signature := range psTx.Signatures {
+		if signature.Address == addr {
+			return signature, true
+		}
+	}
+	return AddressSignature{}, false
+}
+
+// Verifies signature against unsigned tx bytes and inserts it keeping signatures sorted by address
+func (psTx *PartiallySignedTx) addSignature(signature AddressSignature) error {
+	publicKey, err := secpFactory.RecoverHashPublicKey(
+		hashing.ComputeHash256(psTx.unsignedBytes),
+		signature.Signature[:],
+	)
+	if err != nil {
+		return err
+	}
+	if publicKey.Address() != signature.Address {
+		return fmt.Errorf("%w: %s", errWrongSignature, signature.Address)
+	}
+
+	psTx.Signatures = append(psTx.Signatures, signature)
+	utils.Sort(psTx.Signatures)
+	return nil
+}
+
+func (s AddressSignature) Less(other AddressSignature) bool {
+	return s.Address.Less(other.Address)
+}
@@ -0,0 +1,142 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+type testAliasGetter map[ids.ShortID]*multisig.AliasWithNonce
+
+func (g testAliasGetter) GetMultisigAlias(aliasID ids.ShortID) (*multisig.AliasWithNonce, error) {
+	if alias, ok := g[aliasID]; ok {
+		return alias, nil
+	}
+	return nil, database.ErrNotFound
+}
+
+func TestPartiallySignedTx(t *testing.T) {
+	key1, addr1 := generateTestKey(t)
+	key2, addr2 := generateTestKey(t)
+	key3, addr3 := generateTestKey(t)
+	wrongKey, _ := generateTestKey(t)
+
+	innerAlias := &multisig.AliasWithNonce{Alias: multisig.Alias{
+		ID:     ids.ShortID{1},
+		Owners: &secp256k1fx.OutputOwners{Threshold: 2, Addrs: sortedAddrs(addr1, addr2)},
+	}}
+	outerAlias := &multisig.AliasWithNonce{Alias: multisig.Alias{
+		ID:     ids.ShortID{2},
+		Owners: &secp256k1fx.OutputOwners{Threshold: 2, Addrs: sortedAddrs(innerAlias.ID, addr3)},
+	}}
+	msig := testAliasGetter{innerAlias.ID: innerAlias, outerAlias.ID: outerAlias}
+	owner := &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{outerAlias.ID}}
+
+	utx := &txs.BaseTx{BaseTx: avax.BaseTx{
+		NetworkID:    1,
+		BlockchainID: ids.ID{1},
+		Ins:          []*avax.TransferableInput{},
+		Outs:         []*avax.TransferableOutput{},
+	}}
+
+	// co-signer parses container created by session creator and signs it with its keys
+	cosign := func(t *testing.T, psTxBytes []byte, keys ...*secp256k1.PrivateKey) *PartiallySignedTx {
+		psTx, err := ParsePartiallySignedTx(psTxBytes)
+		require.NoError(t, err)
+		require.NoError(t, psTx.Sign(secp256k1fx.NewKeychain(keys...)))
+		signedBytes, err := psTx.Bytes()
+		require.NoError(t, err)
+		psTx, err = ParsePartiallySignedTx(signedBytes)
+		require.NoError(t, err)
+		return psTx
+	}
+
+	t.Run("Round trip with nested alias owner", func(t *testing.T) {
+		require := require.New(t)
+
+		psTx, err := NewPartiallySignedTx(utx, []*secp256k1fx.OutputOwners{owner}, msig)
+		require.NoError(err)
+		require.ElementsMatch([]*multisig.AliasWithNonce{innerAlias, outerAlias}, psTx.Aliases)
+		psTxBytes, err := psTx.Bytes()
+		require.NoError(err)
+
+		require.NoError(psTx.AddSignatures(cosign(t, psTxBytes, key1)))
+		require.NoError(psTx.AddSignatures(cosign(t, psTxBytes, key2, key3)))
+		require.Len(psTx.Signatures, 3)
+
+		tx, err := psTx.Finalize()
+		require.NoError(err)
+		require.Len(tx.Creds, 1)
+
+		fx := &secp256k1fx.Fx{}
+		vm := &secp256k1fx.TestVM{Codec: linearcodec.NewDefault(), Log: logging.NoLog{}}
+		vm.Clk.Set(time.Unix(0, 0))
+		require.NoError(fx.Initialize(vm))
+		require.NoError(fx.Bootstrapped())
+		require.NoError(fx.VerifyMultisigMessage(tx.Unsigned.Bytes(), &secp256k1fx.Input{}, tx.Creds[0], owner, msig))
+	})
+
+	t.Run("Signature from wrong key", func(t *testing.T) {
+		require := require.New(t)
+
+		psTx, err := NewPartiallySignedTx(utx, []*secp256k1fx.OutputOwners{owner}, msig)
+		require.NoError(err)
+		other, err := NewPartiallySignedTx(utx, []*secp256k1fx.OutputOwners{owner}, msig)
+		require.NoError(err)
+
+		signature := AddressSignature{Address: addr1}
+		sig, err := wrongKey.SignHash(hashing.ComputeHash256(psTx.unsignedBytes))
+		require.NoError(err)
+		copy(signature.Signature[:], sig)
+		other.Signatures = append(other.Signatures, signature)
+
+		require.ErrorIs(psTx.AddSignatures(other), errWrongSignature)
+		require.Empty(psTx.Signatures)
+
+		otherBytes, err := other.Bytes()
+		require.NoError(err)
+		_, err = ParsePartiallySignedTx(otherBytes)
+		require.ErrorIs(err, errWrongSignature)
+	})
+
+	t.Run("Threshold isn't met", func(t *testing.T) {
+		require := require.New(t)
+
+		psTx, err := NewPartiallySignedTx(utx, []*secp256k1fx.OutputOwners{owner}, msig)
+		require.NoError(err)
+		psTxBytes, err := psTx.Bytes()
+		require.NoError(err)
+
+		// inner alias threshold isn't met, so outer alias has only one signer
+		require.NoError(psTx.AddSignatures(cosign(t, psTxBytes, key1, key3)))
+		_, err = psTx.Finalize()
+		require.ErrorIs(err, errNotEnoughSignatures)
+	})
+}
+
+func generateTestKey(t *testing.T) (*secp256k1.PrivateKey, ids.ShortID) {
+	key, err := secpFactory.NewPrivateKey()
+	require.NoError(t, err)
+	return key, key.Address()
+}
+
+func sortedAddrs(addrs ...ids.ShortID) []ids.ShortID {
+	utils.Sort(addrs)
+	return addrs
+}
@@ -0,0 +1,201 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"errors"
+	"fmt"
+
+	stdcontext "context"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	_ CaminoSigner = (*txSigner)(nil)
+
+	errUnknownAuthType = errors.New("unknown auth type")
+)
+
+// CaminoSigner extends Signer with signing of Camino txs auths, which owners
+// can't be derived from the tx and the signer backend.
+type CaminoSigner interface {
+	Signer
+
+	// SignWithAuthOwners signs [tx] like Sign, but also signs credentials of
+	// tx auths, which owners are [authOwners] in auths order:
+	// deposit offer owner of deposit tx and update deposit offer allow-list tx,
+	// claimables owners of claim tx, treasury owner of treasury spend tx,
+	// node owner of camino add validator tx and extend validator tx or
+	// current reward owner of transfer deposit tx.
+	// Auths without known owners are left unsigned.
+	SignWithAuthOwners(ctx stdcontext.Context, tx *txs.Tx, authOwners []*secp256k1fx.OutputOwners) error
+}
+
+// NewCaminoSigner returns signer, that also signs inputs and auths of owners,
+// which are controlled by multisig aliases. Aliases are resolved with [aliases].
+func NewCaminoSigner(kc keychain.Keychain, backend SignerBackend, aliases MultisigAliasGetter) CaminoSigner {
+	return &txSigner{
+		kc:      kc,
+		backend: backend,
+		aliases: aliases,
+	}
+}
+
+func (s *txSigner) SignWithAuthOwners(ctx stdcontext.Context, tx *txs.Tx, authOwners []*secp256k1fx.OutputOwners) error {
+	return tx.Unsigned.Visit(&signerVisitor{
+		kc:         s.kc,
+		backend:    s.backend,
+		aliases:    s.aliases,
+		ctx:        ctx,
+		tx:         tx,
+		authOwners: authOwners,
+	})
+}
+
+// getOwnerSigners returns signers for [sigIndices] of [owner]. If signer has
+// multisig aliases getter, then sig indices are counted across addresses of
+// nested aliases, as expected by multisig credentials verification.
+func (s *signerVisitor) getOwnerSigners(owner *secp256k1fx.OutputOwners, sigIndices []uint32) ([]keychain.Signer, error) {
+	addrs, err := s.resolveSigIndices(owner, sigIndices)
+	if err != nil {
+		return nil, err
+	}
+
+	signers := make([]keychain.Signer, len(sigIndices))
+	for sigIndex, addr := range addrs {
+		key, ok := s.kc.Get(addr)
+		if !ok {
+			// If we don't have access to the key, then we can't sign this
+			// transaction. However, we can attempt to partially sign it.
+			continue
+		}
+		signers[sigIndex] = key
+	}
+	return signers, nil
+}
+
+// getAuthSigners returns signers for [auth] of [owner]. If owner is unknown,
+// returned signers are empty, so auth credential could be partially signed.
+func (s *signerVisitor) getAuthSigners(owner *secp256k1fx.OutputOwners, auth verify.Verifiable) ([]keychain.Signer, error) {
+	input, ok := auth.(*secp256k1fx.Input)
+	if !ok {
+		return nil, errUnknownAuthType
+	}
+	if owner == nil {
+		return make([]keychain.Signer, len(input.SigIndices)), nil
+	}
+	return s.getOwnerSigners(owner, input.SigIndices)
+}
+
+// getAddressAuthSigners returns signers for [auth] of single [addr].
+func (s *signerVisitor) getAddressAuthSigners(addr ids.ShortID, auth verify.Verifiable) ([]keychain.Signer, error) {
+	return s.getAuthSigners(&secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr},
+	}, auth)
+}
+
+// authOwner returns owner of tx auth with [authIndex] or nil, if it's unknown.
+func (s *signerVisitor) authOwner(authIndex int) *secp256k1fx.OutputOwners {
+	if authIndex >= len(s.authOwners) {
+		return nil
+	}
+	return s.authOwners[authIndex]
+}
+
+// resolveSigIndices returns addresses, that are expected to sign for [sigIndices] of [owner].
+func (s *signerVisitor) resolveSigIndices(owner *secp256k1fx.OutputOwners, sigIndices []uint32) ([]ids.ShortID, error) {
+	addrs := make([]ids.ShortID, len(sigIndices))
+	if s.aliases == nil {
+		for sigIndex, addrIndex := range sigIndices {
+			if addrIndex >= uint32(len(owner.Addrs)) {
+				return nil, errInvalidUTXOSigIndex
+			}
+			addrs[sigIndex] = owner.Addrs[addrIndex]
+		}
+		return addrs, nil
+	}
+
+	tf := func(addr ids.ShortID, totalVisited, totalVerified uint32) (bool, error) {
+		if totalVerified >= uint32(len(sigIndices)) || sigIndices[totalVerified] != totalVisited {
+			return false, nil
+		}
+		addrs[totalVerified] = addr
+		return true, nil
+	}
+
+	totalVerified, err := secp256k1fx.TraverseOwners(owner, &aliasGetter{ctx: s.ctx, aliases: s.aliases}, tf)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidUTXOSigIndex, err)
+	}
+	if totalVerified != uint32(len(sigIndices)) {
+		return nil, errInvalidUTXOSigIndex
+	}
+	return addrs, nil
+}
+
+// signMessage signs hash of [msg] with [signers] into credential [credIndex] of [tx].
+// Already present signatures are kept. Must be called before sign, which
+// sets tx bytes, and with credentials expected by sign.
+func signMessage(tx *txs.Tx, credIndex, credsLen int, msg []byte, signers []keychain.Signer) error {
+	if len(tx.Creds) != credsLen {
+		tx.Creds = make([]verify.Verifiable, credsLen)
+	}
+
+	credIntf := tx.Creds[credIndex]
+	if credIntf == nil {
+		credIntf = &secp256k1fx.Credential{}
+		tx.Creds[credIndex] = credIntf
+	}
+
+	cred, ok := credIntf.(*secp256k1fx.Credential)
+	if !ok {
+		return errUnknownCredentialType
+	}
+	if expectedLen := len(signers); expectedLen != len(cred.Sigs) {
+		cred.Sigs = make([][secp256k1.SignatureLen]byte, expectedLen)
+	}
+
+	msgHash := hashing.ComputeHash256(msg)
+	for sigIndex, signer := range signers {
+		if signer == nil || cred.Sigs[sigIndex] != emptySig {
+			continue
+		}
+		sig, err := signer.SignHash(msgHash)
+		if err != nil {
+			return fmt.Errorf("problem signing message: %w", err)
+		}
+		copy(cred.Sigs[sigIndex][:], sig)
+	}
+	return nil
+}
+
+// signTx signs [unsignedTxBytes] with keys of [addrs] from [kc]. Keychains, that are able
+// to sign with several addresses at once, are asked for all signatures with a single request.
+func signTx(kc keychain.Keychain, unsignedTxBytes []byte, addrs []ids.ShortID) ([][]byte, error) {
+	if multiSigner, ok := kc.(keychain.MultiSigner); ok {
+		return multiSigner.MultiSign(unsignedTxBytes, addrs)
+	}
+
+	sigs := make([][]byte, len(addrs))
+	for i, addr := range addrs {
+		signer, ok := kc.Get(addr)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", keychain.ErrUnknownAddress, addr)
+		}
+		sig, err := signer.Sign(unsignedTxBytes)
+		if err != nil {
+			return nil, err
+		}
+		sigs[i] = sig
+	}
+	return sigs, nil
+}
@@ -4,8 +4,12 @@
 package p
 
 import (
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
 // backend
@@ -46,6 +50,50 @@ func (b *backendVisitor) AddDepositOfferTx(tx *txs.AddDepositOfferTx) error {
 	return b.baseTx(&tx.BaseTx)
 }
 
+func (b *backendVisitor) AddProposalTx(tx *txs.AddProposalTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) AddVoteTx(tx *txs.AddVoteTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (*backendVisitor) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errUnsupportedTxType
+}
+
+func (b *backendVisitor) UpdateDepositOfferTx(tx *txs.UpdateDepositOfferTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) TransferDepositTx(tx *txs.TransferDepositTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) TreasuryConfigTx(tx *txs.TreasuryConfigTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) TreasurySpendTx(tx *txs.TreasurySpendTx) error {
+	err := b.b.removeUTXOs(
+		b.ctx,
+		constants.PlatformChainID,
+		tx.TreasuryInputIDs(),
+	)
+	if err != nil {
+		return err
+	}
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) ExtendValidatorTx(tx *txs.ExtendValidatorTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
+func (b *backendVisitor) UpdateDepositOfferAllowListTx(tx *txs.UpdateDepositOfferAllowListTx) error {
+	return b.baseTx(&tx.BaseTx)
+}
+
 // signer
 
 func (s *signerVisitor) AddressStateTx(tx *txs.AddressStateTx) error {
@@ -53,6 +101,13 @@ func (s *signerVisitor) AddressStateTx(tx *txs.AddressStateTx) error {
 	if err != nil {
 		return err
 	}
+	if tx.UpgradeVersionID.Version() > 0 {
+		executorSigners, err := s.getAddressAuthSigners(tx.Executor, tx.ExecutorAuth)
+		if err != nil {
+			return err
+		}
+		txSigners = append(txSigners, executorSigners)
+	}
 	return sign(s.tx, false, txSigners)
 }
 
@@ -61,6 +116,41 @@ func (s *signerVisitor) DepositTx(tx *txs.DepositTx) error {
 	if err != nil {
 		return err
 	}
+	if tx.DepositCreatorAddress == ids.ShortEmpty {
+		return sign(s.tx, false, txSigners)
+	}
+
+	depositCreatorSigners, err := s.getAddressAuthSigners(tx.DepositCreatorAddress, tx.DepositCreatorAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, depositCreatorSigners)
+
+	offerOwnerAuth, ok := tx.DepositOfferOwnerAuth.(*secp256k1fx.Input)
+	if !ok {
+		return errUnknownAuthType
+	}
+	if len(offerOwnerAuth.SigIndices) == 0 {
+		return sign(s.tx, false, txSigners)
+	}
+
+	// Offer owner doesn't sign tx, but permission for deposit creator to use offer
+	offerOwnerSigners, err := s.getAuthSigners(s.authOwner(0), offerOwnerAuth)
+	if err != nil {
+		return err
+	}
+	offer := &deposit.Offer{ID: tx.DepositOfferID}
+	err = signMessage(
+		s.tx,
+		len(txSigners),
+		len(txSigners)+1,
+		offer.PermissionMsg(tx.DepositCreatorAddress),
+		offerOwnerSigners,
+	)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, make([]keychain.Signer, len(offerOwnerSigners)))
 	return sign(s.tx, false, txSigners)
 }
 
@@ -77,6 +167,13 @@ func (s *signerVisitor) ClaimTx(tx *txs.ClaimTx) error {
 	if err != nil {
 		return err
 	}
+	for i, claimable := range tx.Claimables {
+		claimableSigners, err := s.getAuthSigners(s.authOwner(i), claimable.OwnerAuth)
+		if err != nil {
+			return err
+		}
+		txSigners = append(txSigners, claimableSigners)
+	}
 	return sign(s.tx, false, txSigners)
 }
 
@@ -85,6 +182,20 @@ func (s *signerVisitor) RegisterNodeTx(tx *txs.RegisterNodeTx) error {
 	if err != nil {
 		return err
 	}
+	// new node credential is expected even if new node is empty
+	nodeSigners := []keychain.Signer{}
+	if tx.NewNodeID != ids.EmptyNodeID {
+		nodeSigners = make([]keychain.Signer, 1)
+		if key, ok := s.kc.Get(ids.ShortID(tx.NewNodeID)); ok {
+			nodeSigners[0] = key
+		}
+	}
+
+	nodeOwnerSigners, err := s.getAddressAuthSigners(tx.NodeOwnerAddress, tx.NodeOwnerAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, nodeSigners, nodeOwnerSigners)
 	return sign(s.tx, false, txSigners)
 }
 
@@ -105,6 +216,16 @@ func (s *signerVisitor) MultisigAliasTx(tx *txs.MultisigAliasTx) error {
 	if err != nil {
 		return err
 	}
+	if tx.MultisigAlias.ID == ids.ShortEmpty {
+		return sign(s.tx, false, txSigners)
+	}
+
+	// alias auth is matched with alias as the only owner address
+	aliasSigners, err := s.getAddressAuthSigners(tx.MultisigAlias.ID, tx.Auth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, aliasSigners)
 	return sign(s.tx, false, txSigners)
 }
 
@@ -113,5 +234,133 @@ func (s *signerVisitor) AddDepositOfferTx(tx *txs.AddDepositOfferTx) error {
 	if err != nil {
 		return err
 	}
+	authSigners, err := s.getAddressAuthSigners(tx.DepositOfferCreatorAddress, tx.DepositOfferCreatorAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, authSigners)
+	return sign(s.tx, false, txSigners)
+}
+
+func (s *signerVisitor) AddProposalTx(tx *txs.AddProposalTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	authSigners, err := s.getAddressAuthSigners(tx.ProposerAddress, tx.ProposerAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, authSigners)
+	return sign(s.tx, false, txSigners)
+}
+
+func (s *signerVisitor) AddVoteTx(tx *txs.AddVoteTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	authSigners, err := s.getAddressAuthSigners(tx.VoterAddress, tx.VoterAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, authSigners)
+	return sign(s.tx, false, txSigners)
+}
+
+func (*signerVisitor) FinishProposalsTx(*txs.FinishProposalsTx) error {
+	return errUnsupportedTxType
+}
+
+func (s *signerVisitor) UpdateDepositOfferTx(tx *txs.UpdateDepositOfferTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	authSigners, err := s.getAddressAuthSigners(tx.DepositOfferUpdaterAddress, tx.DepositOfferUpdaterAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, authSigners)
+	return sign(s.tx, false, txSigners)
+}
+
+func (s *signerVisitor) TransferDepositTx(tx *txs.TransferDepositTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	// reward owner credential is always expected, but it is empty if owner isn't changed
+	authSigners, err := s.getAuthSigners(s.authOwner(0), tx.RewardOwnerAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, authSigners)
+	return sign(s.tx, false, txSigners)
+}
+
+func (s *signerVisitor) TreasuryConfigTx(tx *txs.TreasuryConfigTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	executorSigners, err := s.getAddressAuthSigners(tx.Executor, tx.ExecutorAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, executorSigners)
+	return sign(s.tx, false, txSigners)
+}
+
+func (s *signerVisitor) TreasurySpendTx(tx *txs.TreasurySpendTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	// treasury inputs are authorized by treasury owner credential only
+	treasurySigners, err := s.getAuthSigners(s.authOwner(0), tx.TreasuryAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, treasurySigners)
+	return sign(s.tx, false, txSigners)
+}
+
+func (s *signerVisitor) ExtendValidatorTx(tx *txs.ExtendValidatorTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	authSigners, err := s.getAuthSigners(s.authOwner(0), tx.NodeOwnerAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, authSigners)
+	return sign(s.tx, false, txSigners)
+}
+
+func (s *signerVisitor) UpdateDepositOfferAllowListTx(tx *txs.UpdateDepositOfferAllowListTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	authSigners, err := s.getAuthSigners(s.authOwner(0), tx.DepositOfferOwnerAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, authSigners)
+	return sign(s.tx, false, txSigners)
+}
+
+func (s *signerVisitor) caminoAddValidatorTx(tx *txs.CaminoAddValidatorTx) error {
+	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
+	if err != nil {
+		return err
+	}
+	nodeOwnerSigners, err := s.getAuthSigners(s.authOwner(0), tx.NodeOwnerAuth)
+	if err != nil {
+		return err
+	}
+	txSigners = append(txSigners, nodeOwnerSigners)
 	return sign(s.tx, false, txSigners)
 }
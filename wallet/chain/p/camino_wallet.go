@@ -0,0 +1,522 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+var _ CaminoWallet = (*caminoWallet)(nil)
+
+// CaminoWallet extends P-chain wallet with Camino transactions.
+type CaminoWallet interface {
+	Wallet
+
+	// CaminoBuilder returns the builder that will be used to create the Camino
+	// transactions.
+	CaminoBuilder() CaminoBuilder
+
+	// IssueCaminoAddValidatorTx creates, signs, and issues a new validator of the
+	// primary network, bonding its stake.
+	//
+	// - [vdr] specifies all the details of the validation period such as the
+	//   startTime, endTime, stake weight, and nodeID.
+	// - [nodeOwnerAddress] is the consortium member, that registered validator node.
+	// - [rewardsOwner] specifies the owner of all the rewards this validator
+	//   may accrue during its validation period.
+	// - [shares] specifies the fraction (out of 1,000,000) that this validator
+	//   will take from delegation rewards.
+	IssueCaminoAddValidatorTx(
+		vdr *txs.Validator,
+		nodeOwnerAddress ids.ShortID,
+		rewardsOwner *secp256k1fx.OutputOwners,
+		shares uint32,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueExtendValidatorTx creates, signs, and issues a tx, that extends the
+	// validation period of the current validator and bonds additional stake for it.
+	//
+	// - [validatorTxID] is the tx, that created current validator.
+	// - [endTime] is the new end time of the validation period.
+	// - [bondAmount] is the amount, that will be added to validator weight.
+	// - [nodeOwnerAddress] is the consortium member, that registered validator node.
+	IssueExtendValidatorTx(
+		validatorTxID ids.ID,
+		endTime uint64,
+		bondAmount uint64,
+		nodeOwnerAddress ids.ShortID,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueAddressStateTx creates, signs, and issues a tx, that sets or
+	// removes address state bit.
+	//
+	// - [address] is the address, which state will be changed.
+	// - [remove] specifies, if state bit will be removed or set.
+	// - [state] is the changed state bit.
+	// - [executor] is the address with role, that allows to change [state].
+	// - [kycExpiration] is the time, when added kyc verified state will expire.
+	// - [nodeDeferralEnd] is the time, when added node deferred state will be removed.
+	IssueAddressStateTx(
+		address ids.ShortID,
+		remove bool,
+		state txs.AddressStateBit,
+		executor ids.ShortID,
+		kycExpiration uint64,
+		nodeDeferralEnd uint64,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueDepositTx creates, signs, and issues a new deposit.
+	//
+	// - [depositOfferID] is the offer, that will be used for deposit.
+	// - [duration] is the deposit duration in seconds.
+	// - [amount] is the deposited amount.
+	// - [rewardsOwner] specifies the owner of deposit rewards.
+	// - [depositCreatorAddress] is the address, that creates deposit.
+	// - [depositOfferOwnerAddress] is the offer owner.
+	IssueDepositTx(
+		depositOfferID ids.ID,
+		duration uint32,
+		amount uint64,
+		rewardsOwner *secp256k1fx.OutputOwners,
+		depositCreatorAddress ids.ShortID,
+		depositOfferOwnerAddress ids.ShortID,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueUnlockDepositTx creates, signs, and issues a tx, that unlocks
+	// deposited tokens.
+	//
+	// - [unlockableAmounts] is the amount, that could be unlocked for each
+	//   deposit tx ID.
+	IssueUnlockDepositTx(
+		unlockableAmounts map[ids.ID]uint64,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueClaimTx creates, signs, and issues a tx, that claims deposit
+	// and validator rewards.
+	//
+	// - [claimables] describes claimed rewards and their owners.
+	// - [claimTo] specifies the owner of claimed tokens.
+	IssueClaimTx(
+		claimables []*Claimable,
+		claimTo *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueRegisterNodeTx creates, signs, and issues a tx, that registers
+	// node for consortium member.
+	//
+	// - [oldNodeID] is the node, that will be unregistered.
+	// - [newNodeID] is the node, that will be registered.
+	// - [nodeOwnerAddress] is the consortium member address.
+	IssueRegisterNodeTx(
+		oldNodeID ids.NodeID,
+		newNodeID ids.NodeID,
+		nodeOwnerAddress ids.ShortID,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueMultisigAliasTx creates, signs, and issues a tx, that creates new
+	// or updates existing multisig alias.
+	//
+	// - [alias] is the new alias definition.
+	IssueMultisigAliasTx(
+		alias *multisig.Alias,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueAddDepositOfferTx creates, signs, and issues a new deposit offer.
+	//
+	// - [offer] is the added deposit offer.
+	// - [depositOfferCreatorAddress] is the address with offers creator role.
+	IssueAddDepositOfferTx(
+		offer *deposit.Offer,
+		depositOfferCreatorAddress ids.ShortID,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueUpdateDepositOfferTx creates, signs, and issues a tx, that updates
+	// existing deposit offer.
+	//
+	// - [depositOfferID] is the offer, that will be updated.
+	// - [flags] are the new offer flags.
+	// - [end] is the new offer end time.
+	// - [totalMaxAmount] is the new offer total max amount.
+	// - [totalMaxRewardAmount] is the new offer total max reward amount.
+	// - [depositOfferUpdaterAddress] is the offer owner or address with offers admin role.
+	IssueUpdateDepositOfferTx(
+		depositOfferID ids.ID,
+		flags deposit.OfferFlag,
+		end uint64,
+		totalMaxAmount uint64,
+		totalMaxRewardAmount uint64,
+		depositOfferUpdaterAddress ids.ShortID,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueTransferDepositTx creates, signs, and issues a tx, that transfers
+	// deposited tokens and deposit rewards to new owners.
+	//
+	// - [depositTxID] is the deposit, that will be transferred.
+	// - [rewardOwner] is the current deposit rewards owner.
+	// - [newRewardOwner] is the new deposit rewards owner.
+	// - [newOwner] will own transferred deposited utxos.
+	IssueTransferDepositTx(
+		depositTxID ids.ID,
+		rewardOwner *secp256k1fx.OutputOwners,
+		newRewardOwner *secp256k1fx.OutputOwners,
+		newOwner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueAddProposalTx creates, signs, and issues a new dao proposal.
+	//
+	// - [proposal] is the added proposal.
+	// - [bondAmount] is the proposal bond from current dao config.
+	// - [proposerAddress] is the address, that creates proposal.
+	IssueAddProposalTx(
+		proposal *dao.Proposal,
+		bondAmount uint64,
+		proposerAddress ids.ShortID,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueAddVoteTx creates, signs, and issues a vote for dao proposal option.
+	//
+	// - [proposalID] is the proposal, that is voted for.
+	// - [option] is the index of voted proposal option.
+	// - [voterAddress] is the consortium member address, that votes.
+	IssueAddVoteTx(
+		proposalID ids.ID,
+		option uint32,
+		voterAddress ids.ShortID,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueUpdateDepositOfferAllowListTx creates, signs, and issues a tx, that
+	// updates deposit offer allow-list.
+	//
+	// - [depositOfferID] is the offer, which allow-list will be updated.
+	// - [depositOfferOwnerAddress] is the offer owner.
+	// - [addedAddresses] will be added to allow-list.
+	// - [removedAddresses] will be removed from allow-list.
+	IssueUpdateDepositOfferAllowListTx(
+		depositOfferID ids.ID,
+		depositOfferOwnerAddress ids.ShortID,
+		addedAddresses []ids.ShortID,
+		removedAddresses []ids.ShortID,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueTreasuryConfigTx creates, signs, and issues a tx, that sets
+	// treasury owner and treasury spending cap.
+	//
+	// - [config] is the new treasury config.
+	// - [executor] is the address with admin role.
+	IssueTreasuryConfigTx(
+		config *treasury.Config,
+		executor ids.ShortID,
+		options ...common.Option,
+	) (ids.ID, error)
+
+	// IssueTreasurySpendTx creates, signs, and issues a tx, that spends
+	// treasury funds.
+	//
+	// - [treasuryUTXOs] are the spent treasury utxos.
+	// - [outputs] are the avax outputs, that receive spent treasury funds.
+	// - [treasuryOwner] is the owner from current treasury config.
+	IssueTreasurySpendTx(
+		treasuryUTXOs []*avax.UTXO,
+		outputs []*avax.TransferableOutput,
+		treasuryOwner *secp256k1fx.OutputOwners,
+		options ...common.Option,
+	) (ids.ID, error)
+}
+
+func NewCaminoWallet(
+	builder CaminoBuilder,
+	signer Signer,
+	client platformvm.Client,
+	backend Backend,
+) CaminoWallet {
+	return &caminoWallet{
+		Wallet:  NewWallet(builder, signer, client, backend),
+		builder: builder,
+		signer:  signer,
+	}
+}
+
+type caminoWallet struct {
+	Wallet
+	builder CaminoBuilder
+	signer  Signer
+}
+
+func (w *caminoWallet) CaminoBuilder() CaminoBuilder {
+	return w.builder
+}
+
+func (w *caminoWallet) IssueCaminoAddValidatorTx(
+	vdr *txs.Validator,
+	nodeOwnerAddress ids.ShortID,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	shares uint32,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewCaminoAddValidatorTx(vdr, nodeOwnerAddress, rewardsOwner, shares, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.issueUnsignedTxWithAuthOwners(utx, addressOwners(nodeOwnerAddress), options...)
+}
+
+func (w *caminoWallet) IssueExtendValidatorTx(
+	validatorTxID ids.ID,
+	endTime uint64,
+	bondAmount uint64,
+	nodeOwnerAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewExtendValidatorTx(validatorTxID, endTime, bondAmount, nodeOwnerAddress, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.issueUnsignedTxWithAuthOwners(utx, addressOwners(nodeOwnerAddress), options...)
+}
+
+func (w *caminoWallet) IssueAddressStateTx(
+	address ids.ShortID,
+	remove bool,
+	state txs.AddressStateBit,
+	executor ids.ShortID,
+	kycExpiration uint64,
+	nodeDeferralEnd uint64,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewAddressStateTx(address, remove, state, executor, kycExpiration, nodeDeferralEnd, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *caminoWallet) IssueDepositTx(
+	depositOfferID ids.ID,
+	duration uint32,
+	amount uint64,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	depositCreatorAddress ids.ShortID,
+	depositOfferOwnerAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewDepositTx(depositOfferID, duration, amount, rewardsOwner, depositCreatorAddress, depositOfferOwnerAddress, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	var authOwners []*secp256k1fx.OutputOwners
+	if depositOfferOwnerAddress != ids.ShortEmpty {
+		authOwners = addressOwners(depositOfferOwnerAddress)
+	}
+	return w.issueUnsignedTxWithAuthOwners(utx, authOwners, options...)
+}
+
+func (w *caminoWallet) IssueUnlockDepositTx(
+	unlockableAmounts map[ids.ID]uint64,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewUnlockDepositTx(unlockableAmounts, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *caminoWallet) IssueClaimTx(
+	claimables []*Claimable,
+	claimTo *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewClaimTx(claimables, claimTo, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	authOwners := make([]*secp256k1fx.OutputOwners, len(claimables))
+	for i, claimable := range claimables {
+		authOwners[i] = claimable.Owner
+	}
+	return w.issueUnsignedTxWithAuthOwners(utx, authOwners, options...)
+}
+
+func (w *caminoWallet) IssueRegisterNodeTx(
+	oldNodeID ids.NodeID,
+	newNodeID ids.NodeID,
+	nodeOwnerAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewRegisterNodeTx(oldNodeID, newNodeID, nodeOwnerAddress, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *caminoWallet) IssueMultisigAliasTx(
+	alias *multisig.Alias,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewMultisigAliasTx(alias, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *caminoWallet) IssueAddDepositOfferTx(
+	offer *deposit.Offer,
+	depositOfferCreatorAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewAddDepositOfferTx(offer, depositOfferCreatorAddress, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *caminoWallet) IssueUpdateDepositOfferTx(
+	depositOfferID ids.ID,
+	flags deposit.OfferFlag,
+	end uint64,
+	totalMaxAmount uint64,
+	totalMaxRewardAmount uint64,
+	depositOfferUpdaterAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewUpdateDepositOfferTx(depositOfferID, flags, end, totalMaxAmount, totalMaxRewardAmount, depositOfferUpdaterAddress, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *caminoWallet) IssueTransferDepositTx(
+	depositTxID ids.ID,
+	rewardOwner *secp256k1fx.OutputOwners,
+	newRewardOwner *secp256k1fx.OutputOwners,
+	newOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewTransferDepositTx(depositTxID, rewardOwner, newRewardOwner, newOwner, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.issueUnsignedTxWithAuthOwners(utx, []*secp256k1fx.OutputOwners{rewardOwner}, options...)
+}
+
+func (w *caminoWallet) IssueAddProposalTx(
+	proposal *dao.Proposal,
+	bondAmount uint64,
+	proposerAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewAddProposalTx(proposal, bondAmount, proposerAddress, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *caminoWallet) IssueAddVoteTx(
+	proposalID ids.ID,
+	option uint32,
+	voterAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewAddVoteTx(proposalID, option, voterAddress, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *caminoWallet) IssueUpdateDepositOfferAllowListTx(
+	depositOfferID ids.ID,
+	depositOfferOwnerAddress ids.ShortID,
+	addedAddresses []ids.ShortID,
+	removedAddresses []ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewUpdateDepositOfferAllowListTx(depositOfferID, depositOfferOwnerAddress, addedAddresses, removedAddresses, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.issueUnsignedTxWithAuthOwners(utx, addressOwners(depositOfferOwnerAddress), options...)
+}
+
+func (w *caminoWallet) IssueTreasuryConfigTx(
+	config *treasury.Config,
+	executor ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewTreasuryConfigTx(config, executor, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
+func (w *caminoWallet) IssueTreasurySpendTx(
+	treasuryUTXOs []*avax.UTXO,
+	outputs []*avax.TransferableOutput,
+	treasuryOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (ids.ID, error) {
+	utx, err := w.builder.NewTreasurySpendTx(treasuryUTXOs, outputs, treasuryOwner, options...)
+	if err != nil {
+		return ids.Empty, err
+	}
+	return w.issueUnsignedTxWithAuthOwners(utx, []*secp256k1fx.OutputOwners{treasuryOwner}, options...)
+}
+
+// issueUnsignedTxWithAuthOwners signs [utx] together with its auths, which
+// owners are [authOwners], and issues it. Auths are left unsigned, if signer
+// isn't able to sign them.
+func (w *caminoWallet) issueUnsignedTxWithAuthOwners(
+	utx txs.UnsignedTx,
+	authOwners []*secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (ids.ID, error) {
+	signer, ok := w.signer.(CaminoSigner)
+	if !ok {
+		return w.IssueUnsignedTx(utx, options...)
+	}
+
+	ops := common.NewOptions(options)
+	tx := &txs.Tx{Unsigned: utx}
+	if err := signer.SignWithAuthOwners(ops.Context(), tx, authOwners); err != nil {
+		return ids.Empty, err
+	}
+	return w.IssueTx(tx, options...)
+}
+
+// addressOwners returns auth owners for auth of single [addr]
+func addressOwners(addr ids.ShortID) []*secp256k1fx.OutputOwners {
+	return []*secp256k1fx.OutputOwners{{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr},
+	}}
+}
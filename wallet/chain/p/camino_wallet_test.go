@@ -0,0 +1,227 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"testing"
+	"time"
+
+	stdcontext "context"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+type testPlatformClient struct {
+	platformvm.Client
+	issuedTxs []*txs.Tx
+}
+
+func (c *testPlatformClient) IssueTx(_ stdcontext.Context, txBytes []byte, _ ...rpc.Option) (ids.ID, error) {
+	tx, err := txs.Parse(txs.Codec, txBytes)
+	if err != nil {
+		return ids.Empty, err
+	}
+	c.issuedTxs = append(c.issuedTxs, tx)
+	return tx.ID(), nil
+}
+
+func newTestCaminoWallet(
+	kc *secp256k1fx.Keychain,
+	aliases testMultisigAliasGetter,
+	utxos ...*avax.UTXO,
+) (CaminoWallet, *testPlatformClient) {
+	chainUTXOs := newTestBuilderBackend(utxos...)
+	backend := NewBackend(chainUTXOs.Context, chainUTXOs, chainUTXOs.txs)
+	client := &testPlatformClient{}
+	return NewCaminoWallet(
+		NewCaminoBuilder(kc.Addrs, backend, aliases),
+		NewCaminoSigner(kc, backend, aliases),
+		client,
+		backend,
+	), client
+}
+
+func newTestFx(t *testing.T) *secp256k1fx.Fx {
+	fx := &secp256k1fx.Fx{}
+	vm := &secp256k1fx.TestVM{Codec: linearcodec.NewDefault(), Log: logging.NoLog{}}
+	vm.Clk.Set(time.Unix(0, 0))
+	require.NoError(t, fx.Initialize(vm))
+	require.NoError(t, fx.Bootstrapped())
+	return fx
+}
+
+func TestCaminoWalletIssueAddressStateTx(t *testing.T) {
+	require := require.New(t)
+
+	feeKey, feeAddr := generateTestKey(t)
+	executorKey, executorAddr := generateTestKey(t)
+	feeUTXO := testUTXO(ids.ID{1}, testTxFee, secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{feeAddr}})
+
+	w, client := newTestCaminoWallet(secp256k1fx.NewKeychain(feeKey, executorKey), testMultisigAliasGetter{}, feeUTXO)
+	_, err := w.IssueAddressStateTx(ids.ShortID{1}, false, txs.AddressStateBitKYCVerified, executorAddr, 100, 0, common.WithAssumeDecided())
+	require.NoError(err)
+
+	require.Len(client.issuedTxs, 1)
+	tx := client.issuedTxs[0]
+	utx, ok := tx.Unsigned.(*txs.AddressStateTx)
+	require.True(ok)
+	require.Equal(uint64(100), utx.KYCExpiration)
+	require.Len(tx.Creds, len(utx.Ins)+1)
+	require.NoError(newTestFx(t).VerifyMultisigPermission(
+		utx,
+		utx.ExecutorAuth,
+		tx.Creds[len(tx.Creds)-1],
+		&secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{executorAddr}},
+		testAliasGetter{},
+	))
+}
+
+func TestCaminoWalletIssueRegisterNodeTx(t *testing.T) {
+	require := require.New(t)
+
+	feeKey, feeAddr := generateTestKey(t)
+	nodeOwnerKey, nodeOwnerAddr := generateTestKey(t)
+	nodeKey, nodeAddr := generateTestKey(t)
+	feeUTXO := testUTXO(ids.ID{1}, testTxFee, secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{feeAddr}})
+
+	w, client := newTestCaminoWallet(secp256k1fx.NewKeychain(feeKey, nodeOwnerKey, nodeKey), testMultisigAliasGetter{}, feeUTXO)
+	_, err := w.IssueRegisterNodeTx(ids.EmptyNodeID, ids.NodeID(nodeAddr), nodeOwnerAddr, common.WithAssumeDecided())
+	require.NoError(err)
+
+	require.Len(client.issuedTxs, 1)
+	tx := client.issuedTxs[0]
+	utx, ok := tx.Unsigned.(*txs.RegisterNodeTx)
+	require.True(ok)
+	require.Len(tx.Creds, len(utx.Ins)+2)
+	fx := newTestFx(t)
+	require.NoError(fx.VerifyMultisigPermission(
+		utx,
+		&secp256k1fx.Input{SigIndices: []uint32{0}},
+		tx.Creds[len(tx.Creds)-2],
+		&secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{nodeAddr}},
+		testAliasGetter{},
+	))
+	require.NoError(fx.VerifyMultisigPermission(
+		utx,
+		utx.NodeOwnerAuth,
+		tx.Creds[len(tx.Creds)-1],
+		&secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{nodeOwnerAddr}},
+		testAliasGetter{},
+	))
+}
+
+func TestCaminoWalletIssueMultisigAliasTx(t *testing.T) {
+	require := require.New(t)
+
+	feeKey, feeAddr := generateTestKey(t)
+	ownerKey1, ownerAddr1 := generateTestKey(t)
+	ownerKey2, ownerAddr2 := generateTestKey(t)
+	feeUTXO := testUTXO(ids.ID{1}, testTxFee, secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{feeAddr}})
+
+	alias := &multisig.AliasWithNonce{Alias: multisig.Alias{
+		ID: ids.ShortID{1},
+		Owners: &secp256k1fx.WeightedOutputOwners{
+			Threshold: 2,
+			Addrs:     sortedAddrs(ownerAddr1, ownerAddr2),
+			Weights:   []uint32{1, 1},
+		},
+	}}
+	aliases := testMultisigAliasGetter{alias.ID: alias}
+
+	w, client := newTestCaminoWallet(secp256k1fx.NewKeychain(feeKey, ownerKey1, ownerKey2), aliases, feeUTXO)
+	_, err := w.IssueMultisigAliasTx(&multisig.Alias{
+		ID:     alias.ID,
+		Owners: &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{ownerAddr1}},
+	}, common.WithAssumeDecided())
+	require.NoError(err)
+
+	require.Len(client.issuedTxs, 1)
+	tx := client.issuedTxs[0]
+	utx, ok := tx.Unsigned.(*txs.MultisigAliasTx)
+	require.True(ok)
+	require.Len(tx.Creds, len(utx.Ins)+1)
+	require.NoError(newTestFx(t).VerifyMultisigPermission(
+		utx,
+		utx.Auth,
+		tx.Creds[len(tx.Creds)-1],
+		alias.Owners,
+		testAliasGetter(aliases),
+	))
+}
+
+func TestCaminoWalletIssueTransferDepositTx(t *testing.T) {
+	require := require.New(t)
+
+	feeKey, feeAddr := generateTestKey(t)
+	rewardOwnerKey, rewardOwnerAddr := generateTestKey(t)
+	_, newRewardOwnerAddr := generateTestKey(t)
+	feeUTXO := testUTXO(ids.ID{1}, testTxFee, secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{feeAddr}})
+	rewardOwner := &secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{rewardOwnerAddr}}
+
+	w, client := newTestCaminoWallet(secp256k1fx.NewKeychain(feeKey, rewardOwnerKey), testMultisigAliasGetter{}, feeUTXO)
+	_, err := w.IssueTransferDepositTx(
+		ids.ID{10},
+		rewardOwner,
+		&secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{newRewardOwnerAddr}},
+		nil,
+		common.WithAssumeDecided(),
+	)
+	require.NoError(err)
+
+	require.Len(client.issuedTxs, 1)
+	tx := client.issuedTxs[0]
+	utx, ok := tx.Unsigned.(*txs.TransferDepositTx)
+	require.True(ok)
+	require.Len(tx.Creds, len(utx.Ins)+1)
+	require.NoError(newTestFx(t).VerifyMultisigPermission(
+		utx,
+		utx.RewardOwnerAuth,
+		tx.Creds[len(tx.Creds)-1],
+		rewardOwner,
+		testAliasGetter{},
+	))
+}
+
+func TestCaminoWalletIssueCaminoAddValidatorTx(t *testing.T) {
+	require := require.New(t)
+
+	key, addr := generateTestKey(t)
+	nodeOwnerKey, nodeOwnerAddr := generateTestKey(t)
+	owner := secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{addr}}
+	utxo := testUTXO(ids.ID{1}, 100+testTxFee, owner)
+
+	w, client := newTestCaminoWallet(secp256k1fx.NewKeychain(key, nodeOwnerKey), testMultisigAliasGetter{}, utxo)
+	_, err := w.IssueCaminoAddValidatorTx(
+		&txs.Validator{NodeID: ids.NodeID{1}, Start: 1, End: 2, Wght: 100},
+		nodeOwnerAddr,
+		&owner,
+		0,
+		common.WithAssumeDecided(),
+	)
+	require.NoError(err)
+
+	require.Len(client.issuedTxs, 1)
+	tx := client.issuedTxs[0]
+	utx, ok := tx.Unsigned.(*txs.CaminoAddValidatorTx)
+	require.True(ok)
+	require.Len(tx.Creds, len(utx.Ins)+1)
+	require.NoError(newTestFx(t).VerifyMultisigPermission(
+		utx,
+		utx.NodeOwnerAuth,
+		tx.Creds[len(tx.Creds)-1],
+		&secp256k1fx.OutputOwners{Threshold: 1, Addrs: []ids.ShortID{nodeOwnerAddr}},
+		testAliasGetter{},
+	))
+}
@@ -0,0 +1,278 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/platformvm/dao"
+	"github.com/ava-labs/avalanchego/vms/platformvm/deposit"
+	"github.com/ava-labs/avalanchego/vms/platformvm/treasury"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+var _ CaminoWallet = (*caminoWalletWithOptions)(nil)
+
+func NewCaminoWalletWithOptions(
+	wallet CaminoWallet,
+	options ...common.Option,
+) CaminoWallet {
+	return &caminoWalletWithOptions{
+		Wallet:       NewWalletWithOptions(wallet, options...),
+		caminoWallet: wallet,
+		options:      options,
+	}
+}
+
+type caminoWalletWithOptions struct {
+	Wallet
+	caminoWallet CaminoWallet
+	options      []common.Option
+}
+
+func (w *caminoWalletWithOptions) CaminoBuilder() CaminoBuilder {
+	return NewCaminoBuilderWithOptions(
+		w.caminoWallet.CaminoBuilder(),
+		w.options...,
+	)
+}
+
+func (w *caminoWalletWithOptions) IssueCaminoAddValidatorTx(
+	vdr *txs.Validator,
+	nodeOwnerAddress ids.ShortID,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	shares uint32,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.caminoWallet.IssueCaminoAddValidatorTx(
+		vdr,
+		nodeOwnerAddress,
+		rewardsOwner,
+		shares,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *caminoWalletWithOptions) IssueExtendValidatorTx(
+	validatorTxID ids.ID,
+	endTime uint64,
+	bondAmount uint64,
+	nodeOwnerAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.caminoWallet.IssueExtendValidatorTx(
+		validatorTxID,
+		endTime,
+		bondAmount,
+		nodeOwnerAddress,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *caminoWalletWithOptions) IssueAddressStateTx(
+	address ids.ShortID,
+	remove bool,
+	state txs.AddressStateBit,
+	executor ids.ShortID,
+	kycExpiration uint64,
+	nodeDeferralEnd uint64,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.caminoWallet.IssueAddressStateTx(
+		address,
+		remove,
+		state,
+		executor,
+		kycExpiration,
+		nodeDeferralEnd,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *caminoWalletWithOptions) IssueDepositTx(
+	depositOfferID ids.ID,
+	duration uint32,
+	amount uint64,
+	rewardsOwner *secp256k1fx.OutputOwners,
+	depositCreatorAddress ids.ShortID,
+	depositOfferOwnerAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.caminoWallet.IssueDepositTx(
+		depositOfferID,
+		duration,
+		amount,
+		rewardsOwner,
+		depositCreatorAddress,
+		depositOfferOwnerAddress,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *caminoWalletWithOptions) IssueUnlockDepositTx(
+	unlockableAmounts map[ids.ID]uint64,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.caminoWallet.IssueUnlockDepositTx(
+		unlockableAmounts,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *caminoWalletWithOptions) IssueClaimTx(
+	claimables []*Claimable,
+	claimTo *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.caminoWallet.IssueClaimTx(
+		claimables,
+		claimTo,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *caminoWalletWithOptions) IssueRegisterNodeTx(
+	oldNodeID ids.NodeID,
+	newNodeID ids.NodeID,
+	nodeOwnerAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.caminoWallet.IssueRegisterNodeTx(
+		oldNodeID,
+		newNodeID,
+		nodeOwnerAddress,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *caminoWalletWithOptions) IssueMultisigAliasTx(
+	alias *multisig.Alias,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.caminoWallet.IssueMultisigAliasTx(
+		alias,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *caminoWalletWithOptions) IssueAddDepositOfferTx(
+	offer *deposit.Offer,
+	depositOfferCreatorAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.caminoWallet.IssueAddDepositOfferTx(
+		offer,
+		depositOfferCreatorAddress,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *caminoWalletWithOptions) IssueUpdateDepositOfferTx(
+	depositOfferID ids.ID,
+	flags deposit.OfferFlag,
+	end uint64,
+	totalMaxAmount uint64,
+	totalMaxRewardAmount uint64,
+	depositOfferUpdaterAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.caminoWallet.IssueUpdateDepositOfferTx(
+		depositOfferID,
+		flags,
+		end,
+		totalMaxAmount,
+		totalMaxRewardAmount,
+		depositOfferUpdaterAddress,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *caminoWalletWithOptions) IssueTransferDepositTx(
+	depositTxID ids.ID,
+	rewardOwner *secp256k1fx.OutputOwners,
+	newRewardOwner *secp256k1fx.OutputOwners,
+	newOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.caminoWallet.IssueTransferDepositTx(
+		depositTxID,
+		rewardOwner,
+		newRewardOwner,
+		newOwner,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *caminoWalletWithOptions) IssueAddProposalTx(
+	proposal *dao.Proposal,
+	bondAmount uint64,
+	proposerAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.caminoWallet.IssueAddProposalTx(
+		proposal,
+		bondAmount,
+		proposerAddress,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *caminoWalletWithOptions) IssueAddVoteTx(
+	proposalID ids.ID,
+	option uint32,
+	voterAddress ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.caminoWallet.IssueAddVoteTx(
+		proposalID,
+		option,
+		voterAddress,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *caminoWalletWithOptions) IssueUpdateDepositOfferAllowListTx(
+	depositOfferID ids.ID,
+	depositOfferOwnerAddress ids.ShortID,
+	addedAddresses []ids.ShortID,
+	removedAddresses []ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.caminoWallet.IssueUpdateDepositOfferAllowListTx(
+		depositOfferID,
+		depositOfferOwnerAddress,
+		addedAddresses,
+		removedAddresses,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *caminoWalletWithOptions) IssueTreasuryConfigTx(
+	config *treasury.Config,
+	executor ids.ShortID,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.caminoWallet.IssueTreasuryConfigTx(
+		config,
+		executor,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
+func (w *caminoWalletWithOptions) IssueTreasurySpendTx(
+	treasuryUTXOs []*avax.UTXO,
+	outputs []*avax.TransferableOutput,
+	treasuryOwner *secp256k1fx.OutputOwners,
+	options ...common.Option,
+) (ids.ID, error) {
+	return w.caminoWallet.IssueTreasurySpendTx(
+		treasuryUTXOs,
+		outputs,
+		treasuryOwner,
+		common.UnionOptions(w.options, options)...,
+	)
+}
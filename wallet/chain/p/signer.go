@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -27,6 +37,7 @@ type SignerBackend interface {
 type txSigner struct {
 	kc      keychain.Keychain
 	backend SignerBackend
+	aliases MultisigAliasGetter
 }
 
 func NewSigner(kc keychain.Keychain, backend SignerBackend) Signer {
@@ -45,6 +56,7 @@ func (s *txSigner) Sign(ctx stdcontext.Context, tx *txs.Tx) error {
 	return tx.Unsigned.Visit(&signerVisitor{
 		kc:      s.kc,
 		backend: s.backend,
+		aliases: s.aliases,
 		ctx:     ctx,
 		tx:      tx,
 	})
@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -17,6 +27,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/hashing"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/locked"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
@@ -39,8 +50,11 @@ var (
 type signerVisitor struct {
 	kc      keychain.Keychain
 	backend SignerBackend
+	aliases MultisigAliasGetter
 	ctx     stdcontext.Context
 	tx      *txs.Tx
+	// owners of tx auths, that can't be derived from tx and backend
+	authOwners []*secp256k1fx.OutputOwners
 }
 
 func (*signerVisitor) AdvanceTimeTx(*txs.AdvanceTimeTx) error {
@@ -52,6 +66,9 @@ func (*signerVisitor) RewardValidatorTx(*txs.RewardValidatorTx) error {
 }
 
 func (s *signerVisitor) AddValidatorTx(tx *txs.AddValidatorTx) error {
+	if caminoTx, ok := s.tx.Unsigned.(*txs.CaminoAddValidatorTx); ok {
+		return s.caminoAddValidatorTx(caminoTx)
+	}
 	txSigners, err := s.getSigners(constants.PlatformChainID, tx.Ins)
 	if err != nil {
 		return err
@@ -168,8 +185,11 @@ func (s *signerVisitor) getSigners(sourceChainID ids.ID, ins []*avax.Transferabl
 	txSigners := make([][]keychain.Signer, len(ins))
 	for credIndex, transferInput := range ins {
 		inIntf := transferInput.In
-		if stakeableIn, ok := inIntf.(*stakeable.LockIn); ok {
-			inIntf = stakeableIn.TransferableIn
+		switch in := inIntf.(type) {
+		case *stakeable.LockIn:
+			inIntf = in.TransferableIn
+		case *locked.In:
+			inIntf = in.TransferableIn
 		}
 
 		input, ok := inIntf.(*secp256k1fx.TransferInput)
@@ -192,8 +212,11 @@ func (s *signerVisitor) getSigners(sourceChainID ids.ID, ins []*avax.Transferabl
 		}
 
 		outIntf := utxo.Out
-		if stakeableOut, ok := outIntf.(*stakeable.LockOut); ok {
-			outIntf = stakeableOut.TransferableOut
+		switch out := outIntf.(type) {
+		case *stakeable.LockOut:
+			outIntf = out.TransferableOut
+		case *locked.Out:
+			outIntf = out.TransferableOut
 		}
 
 		out, ok := outIntf.(*secp256k1fx.TransferOutput)
@@ -201,20 +224,11 @@ func (s *signerVisitor) getSigners(sourceChainID ids.ID, ins []*avax.Transferabl
 			return nil, errUnknownOutputType
 		}
 
-		for sigIndex, addrIndex := range input.SigIndices {
-			if addrIndex >= uint32(len(out.Addrs)) {
-				return nil, errInvalidUTXOSigIndex
-			}
-
-			addr := out.Addrs[addrIndex]
-			key, ok := s.kc.Get(addr)
-			if !ok {
-				// If we don't have access to the key, then we can't sign this
-				// transaction. However, we can attempt to partially sign it.
-				continue
-			}
-			inputSigners[sigIndex] = key
+		inputSigners, err = s.getOwnerSigners(&out.OutputOwners, input.SigIndices)
+		if err != nil {
+			return nil, err
 		}
+		txSigners[credIndex] = inputSigners
 	}
 	return txSigners, nil
 }
@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -53,11 +63,12 @@ func (b *backend) AcceptTx(ctx stdcontext.Context, tx *txs.Tx) error {
 	switch utx := tx.Unsigned.(type) {
 	case *txs.BaseTx, *txs.CreateAssetTx, *txs.OperationTx:
 	case *txs.ImportTx:
-		for _, input := range utx.ImportedIns {
-			utxoID := input.UTXOID.InputID()
-			if err := b.RemoveUTXO(ctx, utx.SourceChain, utxoID); err != nil {
-				return err
-			}
+		if err := b.removeImportedUTXOs(ctx, utx); err != nil {
+			return err
+		}
+	case *txs.MultisigImportTx:
+		if err := b.removeImportedUTXOs(ctx, &utx.ImportTx); err != nil {
+			return err
 		}
 	case *txs.ExportTx:
 		txID := tx.ID()
@@ -100,3 +111,13 @@ func (b *backend) AcceptTx(ctx stdcontext.Context, tx *txs.Tx) error {
 	}
 	return nil
 }
+
+func (b *backend) removeImportedUTXOs(ctx stdcontext.Context, utx *txs.ImportTx) error {
+	for _, input := range utx.ImportedIns {
+		utxoID := input.UTXOID.InputID()
+		if err := b.RemoveUTXO(ctx, utx.SourceChain, utxoID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package x
+
+import (
+	"fmt"
+
+	stdcontext "context"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	_ secp256k1fx.AliasGetter = (*aliasGetter)(nil)
+	_ secp256k1fx.AliasGetter = (*txAliasGetter)(nil)
+)
+
+// MultisigAliasGetter provides multisig alias definitions, required to spend
+// utxos, that are owned by multisig aliases.
+type MultisigAliasGetter interface {
+	// GetMultisigAlias returns alias definition or database.ErrNotFound,
+	// if [aliasID] isn't multisig alias.
+	GetMultisigAlias(ctx stdcontext.Context, aliasID ids.ShortID) (*multisig.AliasWithNonce, error)
+}
+
+// NewCaminoSigner returns signer, that also signs inputs of utxos owned by
+// multisig aliases with multisig credentials. Aliases are resolved with
+// [aliases], aliases registered by multisig import tx take precedence.
+func NewCaminoSigner(kc keychain.Keychain, backend SignerBackend, aliases MultisigAliasGetter) Signer {
+	return &signer{
+		kc:      kc,
+		backend: backend,
+		aliases: aliases,
+	}
+}
+
+func (s *signer) signMultisigImportTx(ctx stdcontext.Context, tx *txs.Tx, utx *txs.MultisigImportTx) error {
+	msig := s.aliasGetter(ctx)
+	if msig != nil {
+		msig = &txAliasGetter{tx: utx, aliases: msig}
+	}
+
+	txCreds, txSigners, err := s.getSigners(ctx, utx.BlockchainID, utx.Ins, msig)
+	if err != nil {
+		return err
+	}
+	txImportCreds, txImportSigners, err := s.getSigners(ctx, utx.SourceChain, utx.ImportedIns, msig)
+	if err != nil {
+		return err
+	}
+	txCreds = append(txCreds, txImportCreds...)
+	txSigners = append(txSigners, txImportSigners...)
+	return sign(tx, txCreds, txSigners)
+}
+
+// aliasGetter returns signer aliases bound to [ctx] or nil, if signer doesn't
+// support multisig aliases.
+func (s *signer) aliasGetter(ctx stdcontext.Context) secp256k1fx.AliasGetter {
+	if s.aliases == nil {
+		return nil
+	}
+	return &aliasGetter{ctx: ctx, aliases: s.aliases}
+}
+
+// getMultisigSigners returns multisig credential and signers for [sigIndices]
+// of [owner], if it's controlled by multisig aliases. Sig indices are counted
+// across addresses of nested aliases, as expected by multisig credentials
+// verification. Returns nil credential, if [owner] doesn't contain aliases.
+func (s *signer) getMultisigSigners(
+	owner *secp256k1fx.OutputOwners,
+	sigIndices []uint32,
+	msig secp256k1fx.AliasGetter,
+) (*secp256k1fx.MultisigCredential, []keychain.Signer, error) {
+	isMultisig := false
+	for _, addr := range owner.Addrs {
+		switch _, err := msig.GetMultisigAlias(addr); err {
+		case nil:
+			isMultisig = true
+		case database.ErrNotFound:
+		default:
+			return nil, nil, err
+		}
+	}
+	if !isMultisig {
+		return nil, nil, nil
+	}
+
+	signers := make([]keychain.Signer, len(sigIndices))
+	tf := func(addr ids.ShortID, totalVisited, totalVerified uint32) (bool, error) {
+		if totalVerified >= uint32(len(sigIndices)) || sigIndices[totalVerified] != totalVisited {
+			return false, nil
+		}
+		if key, ok := s.kc.Get(addr); ok {
+			signers[totalVerified] = key
+		}
+		// If we don't have access to the key, then we can't sign this
+		// transaction. However, we can attempt to partially sign it.
+		return true, nil
+	}
+
+	totalVerified, err := secp256k1fx.TraverseOwners(owner, msig, tf)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", errInvalidUTXOSigIndex, err)
+	}
+	if totalVerified != uint32(len(sigIndices)) {
+		return nil, nil, errInvalidUTXOSigIndex
+	}
+
+	sigIdxs := make([]uint32, len(sigIndices))
+	copy(sigIdxs, sigIndices)
+	return &secp256k1fx.MultisigCredential{SigIdxs: sigIdxs}, signers, nil
+}
+
+// aliasGetter binds [MultisigAliasGetter] to context, so it could be used as secp256k1fx.AliasGetter
+type aliasGetter struct {
+	ctx     stdcontext.Context
+	aliases MultisigAliasGetter
+}
+
+func (g *aliasGetter) GetMultisigAlias(aliasID ids.ShortID) (*multisig.AliasWithNonce, error) {
+	return g.aliases.GetMultisigAlias(g.ctx, aliasID)
+}
+
+// txAliasGetter resolves aliases registered by multisig import [tx],
+// falling back to [aliases].
+type txAliasGetter struct {
+	tx      *txs.MultisigImportTx
+	aliases secp256k1fx.AliasGetter
+}
+
+func (g *txAliasGetter) GetMultisigAlias(aliasID ids.ShortID) (*multisig.AliasWithNonce, error) {
+	for _, alias := range g.tx.Aliases {
+		if alias.ID == aliasID {
+			return alias, nil
+		}
+	}
+	return g.aliases.GetMultisigAlias(aliasID)
+}
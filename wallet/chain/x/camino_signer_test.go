@@ -0,0 +1,180 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package x
+
+import (
+	"testing"
+	"time"
+
+	stdcontext "context"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/multisig"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+type testSignerBackend map[ids.ID]*avax.UTXO
+
+func (b testSignerBackend) GetUTXO(_ stdcontext.Context, _, utxoID ids.ID) (*avax.UTXO, error) {
+	if utxo, ok := b[utxoID]; ok {
+		return utxo, nil
+	}
+	return nil, database.ErrNotFound
+}
+
+type testAliasGetter map[ids.ShortID]*multisig.AliasWithNonce
+
+func (g testAliasGetter) GetMultisigAlias(_ stdcontext.Context, aliasID ids.ShortID) (*multisig.AliasWithNonce, error) {
+	if alias, ok := g[aliasID]; ok {
+		return alias, nil
+	}
+	return nil, database.ErrNotFound
+}
+
+func TestCaminoSignerMultisigCredentials(t *testing.T) {
+	secpFactory := secp256k1.Factory{}
+	keys := make([]*secp256k1.PrivateKey, 3)
+	for i := range keys {
+		key, err := secpFactory.NewPrivateKey()
+		require.NoError(t, err)
+		keys[i] = key
+	}
+	// alias owners addresses must be sorted
+	if keys[1].Address().Less(keys[0].Address()) {
+		keys[0], keys[1] = keys[1], keys[0]
+	}
+
+	alias := &multisig.AliasWithNonce{Alias: multisig.Alias{
+		ID: ids.ShortID{1},
+		Owners: &secp256k1fx.OutputOwners{
+			Threshold: 2,
+			Addrs:     []ids.ShortID{keys[0].Address(), keys[1].Address()},
+		},
+	}}
+	msig := testAliasGetter{alias.ID: alias}
+
+	newUTXO := func(txID ids.ID, owner ids.ShortID) *avax.UTXO {
+		return &avax.UTXO{
+			UTXOID: avax.UTXOID{TxID: txID},
+			Asset:  avax.Asset{ID: ids.ID{1}},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 10,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{owner},
+				},
+			},
+		}
+	}
+	newIn := func(utxo *avax.UTXO, sigIndices []uint32) *avax.TransferableInput {
+		return &avax.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In: &secp256k1fx.TransferInput{
+				Amt:   10,
+				Input: secp256k1fx.Input{SigIndices: sigIndices},
+			},
+		}
+	}
+
+	aliasUTXO := newUTXO(ids.ID{2}, alias.ID)
+	keyUTXO := newUTXO(ids.ID{3}, keys[2].Address())
+	backend := testSignerBackend{
+		aliasUTXO.InputID(): aliasUTXO,
+		keyUTXO.InputID():   keyUTXO,
+	}
+	aliasIn := newIn(aliasUTXO, []uint32{0, 1})
+	keyIn := newIn(keyUTXO, []uint32{0})
+
+	tests := map[string]struct {
+		utx     txs.UnsignedTx
+		aliases MultisigAliasGetter
+		// tx inputs and their utxos in credentials order
+		ins               []*avax.TransferableInput
+		utxos             []*avax.UTXO
+		expectedMultisigs []bool
+	}{
+		"Alias owned input": {
+			utx: &txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    constants.UnitTestID,
+				BlockchainID: ids.ID{4},
+				Ins:          []*avax.TransferableInput{aliasIn, keyIn},
+			}},
+			aliases:           msig,
+			ins:               []*avax.TransferableInput{aliasIn, keyIn},
+			utxos:             []*avax.UTXO{aliasUTXO, keyUTXO},
+			expectedMultisigs: []bool{true, false},
+		},
+		"Alias owned imported input, alias is registered by tx": {
+			utx: &txs.MultisigImportTx{
+				ImportTx: txs.ImportTx{
+					BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+						NetworkID:    constants.UnitTestID,
+						BlockchainID: ids.ID{4},
+						Ins:          []*avax.TransferableInput{keyIn},
+					}},
+					SourceChain: constants.PlatformChainID,
+					ImportedIns: []*avax.TransferableInput{aliasIn},
+				},
+				Aliases: []*multisig.AliasWithNonce{alias},
+			},
+			aliases:           testAliasGetter{},
+			ins:               []*avax.TransferableInput{keyIn, aliasIn},
+			utxos:             []*avax.UTXO{keyUTXO, aliasUTXO},
+			expectedMultisigs: []bool{false, true},
+		},
+		"Signer without aliases": {
+			utx: &txs.BaseTx{BaseTx: avax.BaseTx{
+				NetworkID:    constants.UnitTestID,
+				BlockchainID: ids.ID{4},
+				Ins:          []*avax.TransferableInput{keyIn},
+			}},
+			ins:               []*avax.TransferableInput{keyIn},
+			utxos:             []*avax.UTXO{keyUTXO},
+			expectedMultisigs: []bool{false},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			kc := secp256k1fx.NewKeychain(keys...)
+			s := NewSigner(kc, backend)
+			if tt.aliases != nil {
+				s = NewCaminoSigner(kc, backend, tt.aliases)
+			}
+			tx, err := s.SignUnsigned(stdcontext.Background(), tt.utx)
+			require.NoError(err)
+
+			fx := &secp256k1fx.CaminoFx{}
+			vm := &secp256k1fx.TestVM{Codec: linearcodec.NewDefault(), Log: logging.NoLog{}}
+			vm.Clk.Set(time.Unix(0, 0))
+			require.NoError(fx.Initialize(vm))
+			require.NoError(fx.Bootstrapped())
+
+			require.Len(tx.Creds, len(tt.ins))
+			for i, in := range tt.ins {
+				cred := tx.Creds[i].Verifiable
+				_, isMultisig := cred.(*secp256k1fx.MultisigCredential)
+				require.Equal(tt.expectedMultisigs[i], isMultisig)
+				require.NoError(fx.VerifyTransferWithAliases(
+					tx.Unsigned,
+					in.In,
+					cred,
+					tt.utxos[i].Out,
+					&aliasGetter{ctx: stdcontext.Background(), aliases: msig},
+				))
+			}
+		})
+	}
+}
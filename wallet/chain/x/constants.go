@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -23,7 +33,7 @@ var Parser blocks.Parser
 func init() {
 	var err error
 	Parser, err = blocks.NewParser([]fxs.Fx{
-		&secp256k1fx.Fx{},
+		&secp256k1fx.CaminoFx{},
 		&nftfx.Fx{},
 		&propertyfx.Fx{},
 	})
@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -48,6 +58,7 @@ type SignerBackend interface {
 type signer struct {
 	kc      keychain.Keychain
 	backend SignerBackend
+	aliases MultisigAliasGetter // may be nil
 }
 
 func NewSigner(kc keychain.Keychain, backend SignerBackend) Signer {
@@ -75,13 +86,15 @@ func (s *signer) Sign(ctx stdcontext.Context, tx *txs.Tx) error {
 		return s.signImportTx(ctx, tx, utx)
 	case *txs.ExportTx:
 		return s.signExportTx(ctx, tx, utx)
+	case *txs.MultisigImportTx:
+		return s.signMultisigImportTx(ctx, tx, utx)
 	default:
 		return fmt.Errorf("%w: %T", errUnknownTxType, tx.Unsigned)
 	}
 }
 
 func (s *signer) signBaseTx(ctx stdcontext.Context, tx *txs.Tx, utx *txs.BaseTx) error {
-	txCreds, txSigners, err := s.getSigners(ctx, utx.BlockchainID, utx.Ins)
+	txCreds, txSigners, err := s.getSigners(ctx, utx.BlockchainID, utx.Ins, s.aliasGetter(ctx))
 	if err != nil {
 		return err
 	}
@@ -89,7 +102,7 @@ func (s *signer) signBaseTx(ctx stdcontext.Context, tx *txs.Tx, utx *txs.BaseTx)
 }
 
 func (s *signer) signCreateAssetTx(ctx stdcontext.Context, tx *txs.Tx, utx *txs.CreateAssetTx) error {
-	txCreds, txSigners, err := s.getSigners(ctx, utx.BlockchainID, utx.Ins)
+	txCreds, txSigners, err := s.getSigners(ctx, utx.BlockchainID, utx.Ins, s.aliasGetter(ctx))
 	if err != nil {
 		return err
 	}
@@ -97,7 +110,7 @@ func (s *signer) signCreateAssetTx(ctx stdcontext.Context, tx *txs.Tx, utx *txs.
 }
 
 func (s *signer) signOperationTx(ctx stdcontext.Context, tx *txs.Tx, utx *txs.OperationTx) error {
-	txCreds, txSigners, err := s.getSigners(ctx, utx.BlockchainID, utx.Ins)
+	txCreds, txSigners, err := s.getSigners(ctx, utx.BlockchainID, utx.Ins, s.aliasGetter(ctx))
 	if err != nil {
 		return err
 	}
@@ -111,11 +124,11 @@ func (s *signer) signOperationTx(ctx stdcontext.Context, tx *txs.Tx, utx *txs.Op
 }
 
 func (s *signer) signImportTx(ctx stdcontext.Context, tx *txs.Tx, utx *txs.ImportTx) error {
-	txCreds, txSigners, err := s.getSigners(ctx, utx.BlockchainID, utx.Ins)
+	txCreds, txSigners, err := s.getSigners(ctx, utx.BlockchainID, utx.Ins, s.aliasGetter(ctx))
 	if err != nil {
 		return err
 	}
-	txImportCreds, txImportSigners, err := s.getSigners(ctx, utx.SourceChain, utx.ImportedIns)
+	txImportCreds, txImportSigners, err := s.getSigners(ctx, utx.SourceChain, utx.ImportedIns, s.aliasGetter(ctx))
 	if err != nil {
 		return err
 	}
@@ -125,14 +138,21 @@ func (s *signer) signImportTx(ctx stdcontext.Context, tx *txs.Tx, utx *txs.Impor
 }
 
 func (s *signer) signExportTx(ctx stdcontext.Context, tx *txs.Tx, utx *txs.ExportTx) error {
-	txCreds, txSigners, err := s.getSigners(ctx, utx.BlockchainID, utx.Ins)
+	txCreds, txSigners, err := s.getSigners(ctx, utx.BlockchainID, utx.Ins, s.aliasGetter(ctx))
 	if err != nil {
 		return err
 	}
 	return sign(tx, txCreds, txSigners)
 }
 
-func (s *signer) getSigners(ctx stdcontext.Context, sourceChainID ids.ID, ins []*avax.TransferableInput) ([]verify.Verifiable, [][]keychain.Signer, error) {
+// getSigners returns credentials and signers for [ins]. If [msig] isn't nil,
+// inputs of utxos owned by multisig aliases get multisig credentials.
+func (s *signer) getSigners(
+	ctx stdcontext.Context,
+	sourceChainID ids.ID,
+	ins []*avax.TransferableInput,
+	msig secp256k1fx.AliasGetter,
+) ([]verify.Verifiable, [][]keychain.Signer, error) {
 	txCreds := make([]verify.Verifiable, len(ins))
 	txSigners := make([][]keychain.Signer, len(ins))
 	for credIndex, transferInput := range ins {
@@ -161,6 +181,18 @@ func (s *signer) getSigners(ctx stdcontext.Context, sourceChainID ids.ID, ins []
 			return nil, nil, errUnknownOutputType
 		}
 
+		if msig != nil {
+			cred, signers, err := s.getMultisigSigners(&out.OutputOwners, input.SigIndices, msig)
+			if err != nil {
+				return nil, nil, err
+			}
+			if cred != nil {
+				txCreds[credIndex] = cred
+				txSigners[credIndex] = signers
+				continue
+			}
+		}
+
 		for sigIndex, addrIndex := range input.SigIndices {
 			if addrIndex >= uint32(len(out.Addrs)) {
 				return nil, nil, errInvalidUTXOSigIndex
@@ -283,6 +315,8 @@ func sign(tx *txs.Tx, creds []verify.Verifiable, txSigners [][]keychain.Signer)
 		switch credImpl := credIntf.(type) {
 		case *secp256k1fx.Credential:
 			cred = credImpl
+		case *secp256k1fx.MultisigCredential:
+			cred = &credImpl.Credential
 		case *nftfx.Credential:
 			cred = &credImpl.Credential
 		case *propertyfx.Credential:
@@ -0,0 +1,101 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package primary
+
+import (
+	"context"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/vms/avm"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/wallet/chain/p"
+	"github.com/ava-labs/avalanchego/wallet/chain/x"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+var _ CaminoWallet = (*caminoWallet)(nil)
+
+// CaminoWallet provides chain wallets for the primary network,
+// its P-chain wallet is also able to issue Camino transactions.
+type CaminoWallet interface {
+	Wallet
+	CaminoP() p.CaminoWallet
+}
+
+type caminoWallet struct {
+	p p.CaminoWallet
+	x x.Wallet
+}
+
+func (w *caminoWallet) P() p.Wallet {
+	return w.p
+}
+
+func (w *caminoWallet) CaminoP() p.CaminoWallet {
+	return w.p
+}
+
+func (w *caminoWallet) X() x.Wallet {
+	return w.x
+}
+
+// NewCaminoWalletFromURI works like NewWalletFromURI, but returns CaminoWallet.
+// Multisig aliases, that own UTXOs or authorize Camino transactions, are fetched
+// from the provided [uri], when needed.
+func NewCaminoWalletFromURI(ctx context.Context, uri string, kc keychain.Keychain) (CaminoWallet, error) {
+	pCTX, xCTX, utxos, err := FetchState(ctx, uri, kc.Addresses())
+	if err != nil {
+		return nil, err
+	}
+	return NewCaminoWalletWithTxsAndState(uri, pCTX, xCTX, utxos, kc, make(map[ids.ID]*txs.Tx)), nil
+}
+
+// Creates a Camino wallet with pre-loaded/cached P-chain transactions and state.
+func NewCaminoWalletWithTxsAndState(
+	uri string,
+	pCTX p.Context,
+	xCTX x.Context,
+	utxos UTXOs,
+	kc keychain.Keychain,
+	pTXs map[ids.ID]*txs.Tx,
+) CaminoWallet {
+	addrs := kc.Addresses()
+	pUTXOs := NewChainUTXOs(constants.PlatformChainID, utxos)
+	pBackend := p.NewBackend(pCTX, pUTXOs, pTXs)
+	pClient := platformvm.NewClient(uri)
+	pAliases := p.NewMultisigAliasGetterFromClient(pClient, constants.GetHRP(pCTX.NetworkID()))
+	pBuilder := p.NewCaminoBuilder(addrs, pBackend, pAliases)
+	pSigner := p.NewCaminoSigner(kc, pBackend, pAliases)
+
+	xChainID := xCTX.BlockchainID()
+	xUTXOs := NewChainUTXOs(xChainID, utxos)
+	xBackend := x.NewBackend(xCTX, xChainID, xUTXOs)
+	xBuilder := x.NewBuilder(addrs, xBackend)
+	xSigner := x.NewCaminoSigner(kc, xBackend, pAliases)
+	xClient := avm.NewClient(uri, "X")
+
+	return NewCaminoWallet(
+		p.NewCaminoWallet(pBuilder, pSigner, pClient, pBackend),
+		x.NewWallet(xBuilder, xSigner, xClient, xBackend),
+	)
+}
+
+// Creates a Camino wallet with the given set of options
+func NewCaminoWalletWithOptions(w CaminoWallet, options ...common.Option) CaminoWallet {
+	return NewCaminoWallet(
+		p.NewCaminoWalletWithOptions(w.CaminoP(), options...),
+		x.NewWalletWithOptions(w.X(), options...),
+	)
+}
+
+// Creates a new Camino wallet
+func NewCaminoWallet(p p.CaminoWallet, x x.Wallet) CaminoWallet {
+	return &caminoWallet{
+		p: p,
+		x: x,
+	}
+}
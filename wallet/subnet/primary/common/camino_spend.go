@@ -0,0 +1,44 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+// MatchMultisigOwners attempts to match a list of addresses up to the provided
+// threshold, resolving multisig aliases with [msig]. Returned sig indices are
+// counted across all visited non-alias addresses, as expected by multisig
+// credentials verification.
+func MatchMultisigOwners(
+	owners *secp256k1fx.OutputOwners,
+	addrs set.Set[ids.ShortID],
+	minIssuanceTime uint64,
+	msig secp256k1fx.AliasGetter,
+) ([]uint32, bool) {
+	if owners.Locktime > minIssuanceTime {
+		return nil, false
+	}
+
+	sigs := make([]uint32, 0, owners.Threshold)
+	tf := func(addr ids.ShortID, totalVisited, totalVerified uint32) (bool, error) {
+		if !addrs.Contains(addr) {
+			return false, nil
+		}
+		// In case a nested alias doesn't meet threshold
+		if totalVerified < uint32(len(sigs)) {
+			sigs = sigs[:totalVerified]
+		}
+		sigs = append(sigs, totalVisited)
+		return true, nil
+	}
+
+	totalVerified, err := secp256k1fx.TraverseOwners(owners, msig, tf)
+	if err != nil {
+		return nil, false
+	}
+	return sigs[:totalVerified], true
+}
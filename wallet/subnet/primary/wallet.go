@@ -1,3 +1,13 @@
+// Copyright (C) 2023, Chain4Travel AG. All rights reserved.
+//
+// This file is a derived work, based on ava-labs code whose
+// original notices appear below.
+//
+// It is distributed under the same license conditions as the
+// original code from which it is derived.
+//
+// Much love to the original authors for their work.
+// **********************************************************
 // Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
 // See the file LICENSE for licensing terms.
 
@@ -7,9 +17,7 @@ import (
 	"context"
 
 	"github.com/ava-labs/avalanchego/ids"
-	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
-	"github.com/ava-labs/avalanchego/vms/avm"
 	"github.com/ava-labs/avalanchego/vms/platformvm"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/wallet/chain/p"
@@ -78,6 +86,7 @@ func NewWalletWithTxs(ctx context.Context, uri string, kc keychain.Keychain, pre
 }
 
 // Creates a wallet with pre-loaded/cached P-chain transactions and state.
+// Returned wallet is also CaminoWallet.
 func NewWalletWithTxsAndState(
 	uri string,
 	pCTX p.Context,
@@ -86,24 +95,7 @@ func NewWalletWithTxsAndState(
 	kc keychain.Keychain,
 	pTXs map[ids.ID]*txs.Tx,
 ) Wallet {
-	addrs := kc.Addresses()
-	pUTXOs := NewChainUTXOs(constants.PlatformChainID, utxos)
-	pBackend := p.NewBackend(pCTX, pUTXOs, pTXs)
-	pBuilder := p.NewBuilder(addrs, pBackend)
-	pSigner := p.NewSigner(kc, pBackend)
-	pClient := platformvm.NewClient(uri)
-
-	xChainID := xCTX.BlockchainID()
-	xUTXOs := NewChainUTXOs(xChainID, utxos)
-	xBackend := x.NewBackend(xCTX, xChainID, xUTXOs)
-	xBuilder := x.NewBuilder(addrs, xBackend)
-	xSigner := x.NewSigner(kc, xBackend)
-	xClient := avm.NewClient(uri, "X")
-
-	return NewWallet(
-		p.NewWallet(pBuilder, pSigner, pClient, pBackend),
-		x.NewWallet(xBuilder, xSigner, xClient, xBackend),
-	)
+	return NewCaminoWalletWithTxsAndState(uri, pCTX, xCTX, utxos, kc, pTXs)
 }
 
 // Creates a wallet with pre-fetched state.
@@ -120,6 +112,9 @@ func NewWalletWithState(
 
 // Creates a Wallet with the given set of options
 func NewWalletWithOptions(w Wallet, options ...common.Option) Wallet {
+	if caminoWallet, ok := w.(CaminoWallet); ok {
+		return NewCaminoWalletWithOptions(caminoWallet, options...)
+	}
 	return NewWallet(
 		p.NewWalletWithOptions(w.P(), options...),
 		x.NewWalletWithOptions(w.X(), options...),